@@ -0,0 +1,403 @@
+/*
+This file is part of the AtomBPMN (R) project.
+Copyright (c) 2025 Matreska Market LLC (ООО «Matreska Market»).
+Authors: Matreska Team.
+
+This project is dual-licensed under AGPL-3.0 and AtomBPMN Commercial License.
+*/
+
+package batch
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"atom-engine/src/core/clock"
+	"atom-engine/src/core/config"
+	"atom-engine/src/core/logger"
+	"atom-engine/src/core/models"
+	"atom-engine/src/incidents"
+	"atom-engine/src/storage"
+)
+
+// ProcessOperations defines process component methods used by batch operations
+// Определяет методы process компонента используемые пакетными операциями
+type ProcessOperations interface {
+	GetProcessInstanceStatus(instanceID string) (*models.ProcessInstance, error)
+	GetTokensByProcessInstance(instanceID string) ([]*models.Token, error)
+	CancelProcessInstance(instanceID, reason string) error
+	SetProcessInstanceVariables(instanceID string, variables map[string]interface{}) error
+	MigrateProcessInstance(instanceID string, targetVersion int) error
+}
+
+// IncidentOperations defines incidents component methods used by batch operations
+// Определяет методы incidents компонента используемые пакетными операциями
+type IncidentOperations interface {
+	ListIncidents(ctx context.Context, filter *incidents.IncidentFilter) ([]*incidents.Incident, int, error)
+	ResolveIncident(ctx context.Context, request *incidents.ResolveIncidentRequest) (*incidents.Incident, error)
+}
+
+// Component executes persisted batch operations asynchronously in chunks
+// Выполняет сохраненные пакетные операции асинхронно порциями
+type Component struct {
+	storage storage.Storage
+	logger  logger.ComponentLogger
+
+	chunkSize   int
+	chunkDelay  time.Duration
+	maxFailures int
+
+	processes ProcessOperations
+	incidents IncidentOperations
+
+	mu      sync.Mutex
+	batches map[string]*Batch
+	items   map[string][]string // Item snapshots of unfinished batches
+
+	wake   chan struct{}
+	ready  bool
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// NewComponent creates new batch operations component
+// Создает новый компонент пакетных операций
+func NewComponent(cfg *config.Config, storage storage.Storage) *Component {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	c := &Component{
+		storage:     storage,
+		logger:      logger.NewComponentLogger("batch"),
+		chunkSize:   100,
+		chunkDelay:  100 * time.Millisecond,
+		maxFailures: 1000,
+		batches:     make(map[string]*Batch),
+		items:       make(map[string][]string),
+		wake:        make(chan struct{}, 1),
+		ctx:         ctx,
+		cancel:      cancel,
+	}
+
+	if cfg != nil {
+		if cfg.Batch.ChunkSize > 0 {
+			c.chunkSize = cfg.Batch.ChunkSize
+		}
+		if delay, err := time.ParseDuration(cfg.Batch.ChunkDelay); err == nil && delay >= 0 {
+			c.chunkDelay = delay
+		}
+		if cfg.Batch.MaxFailures > 0 {
+			c.maxFailures = cfg.Batch.MaxFailures
+		}
+	}
+
+	return c
+}
+
+// SetProcessComponent sets process component executing instance operations
+// Устанавливает process компонент выполняющий операции над экземплярами
+func (c *Component) SetProcessComponent(processes ProcessOperations) {
+	c.processes = processes
+}
+
+// SetIncidentsComponent sets incidents component executing incident operations
+// Устанавливает incidents компонент выполняющий операции над инцидентами
+func (c *Component) SetIncidentsComponent(incidents IncidentOperations) {
+	c.incidents = incidents
+}
+
+// Init loads persisted batch operations
+// Загружает сохраненные пакетные операции
+func (c *Component) Init() error {
+	c.logger.Info("Initializing batch component")
+
+	if c.storage == nil {
+		return fmt.Errorf("storage is required for batch component")
+	}
+	if c.processes == nil || c.incidents == nil {
+		return fmt.Errorf("process and incidents components are required for batch component")
+	}
+
+	records, err := c.storage.LoadBatchOperations()
+	if err != nil {
+		return fmt.Errorf("failed to load batch operations: %w", err)
+	}
+
+	for _, data := range records {
+		var batch Batch
+		if err := json.Unmarshal(data, &batch); err != nil {
+			c.logger.Warn("Skipping invalid batch operation", logger.String("error", err.Error()))
+			continue
+		}
+		c.batches[batch.ID] = &batch
+	}
+
+	c.logger.Info("Batch component initialized", logger.Int("batches", len(c.batches)))
+	return nil
+}
+
+// Start starts chunk worker, unfinished batches continue from saved progress
+// Запускает обработчик порций, незавершенные пакеты продолжаются с сохраненного прогресса
+func (c *Component) Start() error {
+	c.logger.Info("Starting batch component")
+
+	c.ready = true
+	c.wg.Add(1)
+	go c.run()
+	c.notify()
+
+	c.logger.Info("Batch component started")
+	return nil
+}
+
+// Stop stops chunk worker, running batches continue after restart
+// Останавливает обработчик порций, выполняемые пакеты продолжатся после перезапуска
+func (c *Component) Stop() error {
+	c.logger.Info("Stopping batch component")
+
+	c.ready = false
+	c.cancel()
+	c.wg.Wait()
+
+	c.logger.Info("Batch component stopped")
+	return nil
+}
+
+// IsReady returns component ready status
+// Возвращает статус готовности компонента
+func (c *Component) IsReady() bool {
+	return c.ready && c.storage != nil && c.storage.IsReady()
+}
+
+// Create selects batch items by filter and submits batch operation for execution
+// Выбирает элементы пакета по фильтру и отправляет пакетную операцию на выполнение
+func (c *Component) Create(ctx context.Context, request *CreateRequest) (*Batch, error) {
+	if !c.IsReady() {
+		return nil, fmt.Errorf("batch component not ready")
+	}
+	if request == nil {
+		return nil, fmt.Errorf("invalid request: request is required")
+	}
+	if err := request.Validate(); err != nil {
+		return nil, err
+	}
+
+	itemIDs, err := c.selectItems(ctx, request.Type, &request.Filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to select batch items: %w", err)
+	}
+
+	chunkSize := request.ChunkSize
+	if chunkSize == 0 {
+		chunkSize = c.chunkSize
+	}
+
+	now := clock.Now()
+	batch := &Batch{
+		ID:        models.GenerateID(),
+		Type:      request.Type,
+		Filter:    request.Filter,
+		Params:    request.Params,
+		State:     StatePending,
+		ChunkSize: chunkSize,
+		CreatedBy: request.CreatedBy,
+		Total:     len(itemIDs),
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	if batch.Total == 0 {
+		batch.State = StateCompleted
+		batch.CompletedAt = &now
+	}
+
+	data, err := json.Marshal(itemIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal batch items: %w", err)
+	}
+	if err := c.storage.SaveBatchOperationItems(batch.ID, data); err != nil {
+		return nil, fmt.Errorf("failed to save batch items: %w", err)
+	}
+
+	c.mu.Lock()
+	if err := c.save(batch); err != nil {
+		c.mu.Unlock()
+		return nil, err
+	}
+	c.batches[batch.ID] = batch
+	if !batch.State.IsFinished() {
+		c.items[batch.ID] = itemIDs
+	}
+	result := batch.clone()
+	c.mu.Unlock()
+
+	c.logger.Info("Batch operation created",
+		logger.String("batch_id", batch.ID),
+		logger.String("type", string(batch.Type)),
+		logger.Int("items", batch.Total))
+
+	c.notify()
+	return result, nil
+}
+
+// Get returns batch operation by ID
+// Возвращает пакетную операцию по ID
+func (c *Component) Get(batchID string) (*Batch, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	batch, exists := c.batches[batchID]
+	if !exists {
+		return nil, fmt.Errorf("%w: %s", ErrBatchNotFound, batchID)
+	}
+	return batch.clone(), nil
+}
+
+// List returns batch operations newest first without item failures
+// Возвращает пакетные операции начиная с новых без ошибок элементов
+func (c *Component) List(filter *ListFilter) []*Batch {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	result := make([]*Batch, 0, len(c.batches))
+	for _, batch := range c.batches {
+		if filter != nil {
+			if filter.Type != "" && batch.Type != filter.Type {
+				continue
+			}
+			if filter.State != "" && batch.State != filter.State {
+				continue
+			}
+		}
+		listed := *batch
+		listed.Failures = nil
+		result = append(result, &listed)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].CreatedAt.After(result[j].CreatedAt)
+	})
+	return result
+}
+
+// Pause stops batch operation after current item, remaining items wait for resume
+// Приостанавливает пакетную операцию после текущего элемента
+func (c *Component) Pause(batchID string) (*Batch, error) {
+	return c.transition(batchID, StatePaused, StatePending, StateRunning)
+}
+
+// Resume continues paused batch operation from saved progress
+// Продолжает приостановленную пакетную операцию с сохраненного прогресса
+func (c *Component) Resume(batchID string) (*Batch, error) {
+	batch, err := c.transition(batchID, StateRunning, StatePaused)
+	if err == nil {
+		c.notify()
+	}
+	return batch, err
+}
+
+// Cancel stops batch operation after current item, remaining items are skipped
+// Отменяет пакетную операцию после текущего элемента, оставшиеся элементы пропускаются
+func (c *Component) Cancel(batchID string) (*Batch, error) {
+	return c.transition(batchID, StateCanceled, StatePending, StateRunning, StatePaused)
+}
+
+// Delete removes finished batch operation with its item snapshot
+// Удаляет завершенную пакетную операцию вместе со снимком элементов
+func (c *Component) Delete(batchID string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	batch, exists := c.batches[batchID]
+	if !exists {
+		return fmt.Errorf("%w: %s", ErrBatchNotFound, batchID)
+	}
+	if !batch.State.IsFinished() {
+		return fmt.Errorf("%w: batch operation %s is %s, cancel it first", ErrStateConflict, batchID, batch.State)
+	}
+
+	if err := c.storage.DeleteBatchOperation(batchID); err != nil {
+		return fmt.Errorf("failed to delete batch operation: %w", err)
+	}
+	delete(c.batches, batchID)
+	delete(c.items, batchID)
+
+	c.logger.Info("Batch operation deleted", logger.String("batch_id", batchID))
+	return nil
+}
+
+// transition moves batch operation to target state from one of allowed states
+// Переводит пакетную операцию в целевое состояние из одного из допустимых
+func (c *Component) transition(batchID string, target State, allowed ...State) (*Batch, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	batch, exists := c.batches[batchID]
+	if !exists {
+		return nil, fmt.Errorf("%w: %s", ErrBatchNotFound, batchID)
+	}
+
+	permitted := false
+	for _, state := range allowed {
+		if batch.State == state {
+			permitted = true
+			break
+		}
+	}
+	if !permitted {
+		return nil, fmt.Errorf("%w: batch operation %s is %s", ErrStateConflict, batchID, batch.State)
+	}
+
+	previous := *batch
+	now := clock.Now()
+	batch.State = target
+	batch.UpdatedAt = now
+	if target.IsFinished() {
+		batch.CompletedAt = &now
+		delete(c.items, batchID)
+	}
+	if err := c.save(batch); err != nil {
+		*batch = previous
+		return nil, err
+	}
+
+	c.logger.Info("Batch operation state changed",
+		logger.String("batch_id", batchID),
+		logger.String("from", string(previous.State)),
+		logger.String("to", string(target)))
+
+	return batch.clone(), nil
+}
+
+// save persists batch operation, caller holds mu
+// Сохраняет пакетную операцию, вызывающий удерживает mu
+func (c *Component) save(batch *Batch) error {
+	data, err := json.Marshal(batch)
+	if err != nil {
+		return fmt.Errorf("failed to marshal batch operation: %w", err)
+	}
+	if err := c.storage.SaveBatchOperation(batch.ID, data); err != nil {
+		return fmt.Errorf("failed to save batch operation: %w", err)
+	}
+	return nil
+}
+
+// notify wakes chunk worker
+// Пробуждает обработчик порций
+func (c *Component) notify() {
+	select {
+	case c.wake <- struct{}{}:
+	default:
+	}
+}
+
+// clone returns copy of batch operation safe to hand out
+// Возвращает копию пакетной операции безопасную для передачи
+func (b *Batch) clone() *Batch {
+	copied := *b
+	copied.Failures = append([]Failure(nil), b.Failures...)
+	return &copied
+}
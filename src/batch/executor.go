@@ -0,0 +1,354 @@
+/*
+This file is part of the AtomBPMN (R) project.
+Copyright (c) 2025 Matreska Market LLC (ООО «Matreska Market»).
+Authors: Matreska Team.
+
+This project is dual-licensed under AGPL-3.0 and AtomBPMN Commercial License.
+*/
+
+package batch
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	"atom-engine/src/core/clock"
+	"atom-engine/src/core/logger"
+	"atom-engine/src/core/models"
+	"atom-engine/src/incidents"
+	"atom-engine/src/storage"
+)
+
+// run processes one chunk of every runnable batch operation in turn, pausing
+// between rounds, and sleeps until notified when nothing is runnable
+// Обрабатывает по одной порции каждой выполняемой пакетной операции по очереди
+func (c *Component) run() {
+	defer c.wg.Done()
+
+	for {
+		if !c.runChunks() {
+			select {
+			case <-c.ctx.Done():
+				return
+			case <-c.wake:
+			}
+			continue
+		}
+
+		if c.chunkDelay > 0 {
+			select {
+			case <-c.ctx.Done():
+				return
+			case <-time.After(c.chunkDelay):
+			}
+		} else if c.ctx.Err() != nil {
+			return
+		}
+	}
+}
+
+// runChunks processes one chunk of each runnable batch, oldest first
+// Обрабатывает по одной порции каждого выполняемого пакета, начиная со старых
+func (c *Component) runChunks() bool {
+	c.mu.Lock()
+	var runnable []*Batch
+	for _, batch := range c.batches {
+		if batch.State == StatePending || batch.State == StateRunning {
+			runnable = append(runnable, batch)
+		}
+	}
+	c.mu.Unlock()
+
+	sort.Slice(runnable, func(i, j int) bool {
+		return runnable[i].CreatedAt.Before(runnable[j].CreatedAt)
+	})
+
+	for _, batch := range runnable {
+		if c.ctx.Err() != nil {
+			return false
+		}
+		c.processChunk(batch)
+	}
+	return len(runnable) > 0
+}
+
+// processChunk executes next chunk of batch items and saves progress. Items of
+// interrupted chunk are executed again after restart.
+// Выполняет следующую порцию элементов пакета и сохраняет прогресс
+func (c *Component) processChunk(batch *Batch) {
+	itemIDs, err := c.loadItems(batch.ID)
+
+	c.mu.Lock()
+	if batch.State != StatePending && batch.State != StateRunning {
+		c.mu.Unlock()
+		return
+	}
+	if err != nil {
+		now := clock.Now()
+		batch.State = StateFailed
+		batch.Error = err.Error()
+		batch.UpdatedAt = now
+		batch.CompletedAt = &now
+		c.saveLogged(batch)
+		c.mu.Unlock()
+		return
+	}
+	batch.State = StateRunning
+	if batch.StartedAt == nil {
+		now := clock.Now()
+		batch.StartedAt = &now
+	}
+	operation := batch.Type
+	params := batch.Params
+	start := batch.Processed
+	c.mu.Unlock()
+
+	end := start + batch.ChunkSize
+	if end > len(itemIDs) {
+		end = len(itemIDs)
+	}
+
+	for i := start; i < end; i++ {
+		if c.ctx.Err() != nil {
+			break
+		}
+
+		c.mu.Lock()
+		running := batch.State == StateRunning
+		c.mu.Unlock()
+		if !running {
+			break
+		}
+
+		itemErr := c.executeItem(batch.ID, operation, &params, itemIDs[i])
+
+		c.mu.Lock()
+		batch.Processed++
+		if itemErr != nil {
+			batch.Failed++
+			if len(batch.Failures) < c.maxFailures {
+				batch.Failures = append(batch.Failures, Failure{
+					ItemID: itemIDs[i],
+					Error:  itemErr.Error(),
+					At:     clock.Now(),
+				})
+			}
+		} else {
+			batch.Succeeded++
+		}
+		c.mu.Unlock()
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.batches[batch.ID] != batch {
+		return // Deleted while chunk was running
+	}
+
+	now := clock.Now()
+	batch.UpdatedAt = now
+	if batch.Processed >= batch.Total && batch.State == StateRunning {
+		batch.State = StateCompleted
+		batch.CompletedAt = &now
+		delete(c.items, batch.ID)
+
+		c.logger.Info("Batch operation completed",
+			logger.String("batch_id", batch.ID),
+			logger.String("type", string(batch.Type)),
+			logger.Int("succeeded", batch.Succeeded),
+			logger.Int("failed", batch.Failed))
+	}
+	c.saveLogged(batch)
+}
+
+// loadItems returns item snapshot of batch, loading it from storage after restart
+// Возвращает снимок элементов пакета, загружая его из storage после перезапуска
+func (c *Component) loadItems(batchID string) ([]string, error) {
+	c.mu.Lock()
+	itemIDs, cached := c.items[batchID]
+	c.mu.Unlock()
+	if cached {
+		return itemIDs, nil
+	}
+
+	data, err := c.storage.LoadBatchOperationItems(batchID)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(data, &itemIDs); err != nil {
+		return nil, fmt.Errorf("failed to parse batch items: %w", err)
+	}
+
+	c.mu.Lock()
+	c.items[batchID] = itemIDs
+	c.mu.Unlock()
+	return itemIDs, nil
+}
+
+// saveLogged persists batch progress logging failure, caller holds mu
+// Сохраняет прогресс пакета с логированием ошибки, вызывающий удерживает mu
+func (c *Component) saveLogged(batch *Batch) {
+	if err := c.save(batch); err != nil {
+		c.logger.Error("Failed to save batch operation progress",
+			logger.String("batch_id", batch.ID),
+			logger.String("error", err.Error()))
+	}
+}
+
+// executeItem applies batch operation to single process instance or incident
+// Применяет пакетную операцию к одному экземпляру процесса или инциденту
+func (c *Component) executeItem(batchID string, operation OperationType, params *Params, itemID string) error {
+	resolvedBy := "batch:" + batchID
+
+	switch operation {
+	case OperationCancel:
+		reason := params.Reason
+		if reason == "" {
+			reason = "canceled by batch operation " + batchID
+		}
+		return c.processes.CancelProcessInstance(itemID, reason)
+	case OperationModify:
+		return c.processes.SetProcessInstanceVariables(itemID, params.Variables)
+	case OperationMigrate:
+		return c.processes.MigrateProcessInstance(itemID, params.TargetVersion)
+	case OperationResolve:
+		_, err := c.incidents.ResolveIncident(c.ctx, &incidents.ResolveIncidentRequest{
+			IncidentID: itemID,
+			Action:     incidents.ResolveActionDismiss,
+			Comment:    params.Comment,
+			ResolvedBy: resolvedBy,
+		})
+		return err
+	case OperationRetry:
+		_, err := c.incidents.ResolveIncident(c.ctx, &incidents.ResolveIncidentRequest{
+			IncidentID: itemID,
+			Action:     incidents.ResolveActionRetry,
+			Comment:    params.Comment,
+			ResolvedBy: resolvedBy,
+			NewRetries: params.NewRetries,
+			Variables:  params.Variables,
+		})
+		return err
+	default:
+		return fmt.Errorf("unsupported operation type %s", operation)
+	}
+}
+
+// unfinishedInstanceStates are instance states selected by filter without states
+var unfinishedInstanceStates = []string{
+	string(models.ProcessInstanceStateActive),
+	string(models.ProcessInstanceStateMessages),
+	string(models.ProcessInstanceStateSuspended),
+}
+
+// selectItems resolves filter into snapshot of process instance IDs, or of
+// open incident IDs of matching instances for incident operations
+// Разрешает фильтр в снимок ID экземпляров процессов или открытых инцидентов
+func (c *Component) selectItems(ctx context.Context, operation OperationType, filter *Filter) ([]string, error) {
+	var candidates []*models.ProcessInstance
+	if len(filter.InstanceIDs) > 0 {
+		for _, instanceID := range filter.InstanceIDs {
+			instance, err := c.processes.GetProcessInstanceStatus(instanceID)
+			if err != nil || instance == nil {
+				continue
+			}
+			candidates = append(candidates, instance)
+		}
+	} else {
+		// Storage narrows by key, state and tenant while streaming, so only
+		// matching instances are held
+		scanFilter := storage.ProcessInstanceFilter{
+			ProcessKey: filter.ProcessKey,
+			States:     filter.States,
+		}
+		if len(scanFilter.States) == 0 {
+			scanFilter.States = unfinishedInstanceStates
+		}
+		if tenantID, scoped := filter.Tenant(); scoped {
+			scanFilter.TenantIDs = []string{tenantID}
+		}
+		err := c.storage.ScanProcessInstances(scanFilter, func(instance *models.ProcessInstance) error {
+			if filter.matchesInstance(instance) {
+				candidates = append(candidates, instance)
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var instances []*models.ProcessInstance
+	for _, instance := range candidates {
+		if !filter.matchesInstance(instance) {
+			continue
+		}
+		if filter.ElementID != "" && !operation.targetsIncidents() {
+			atElement, err := c.hasTokenAtElement(instance.InstanceID, filter.ElementID)
+			if err != nil {
+				return nil, err
+			}
+			if !atElement {
+				continue
+			}
+		}
+		instances = append(instances, instance)
+	}
+
+	sort.Slice(instances, func(i, j int) bool {
+		return instances[i].StartedAt.Before(instances[j].StartedAt)
+	})
+
+	if !operation.targetsIncidents() {
+		itemIDs := make([]string, 0, len(instances))
+		for _, instance := range instances {
+			itemIDs = append(itemIDs, instance.InstanceID)
+		}
+		return itemIDs, nil
+	}
+
+	selected := make(map[string]bool, len(instances))
+	for _, instance := range instances {
+		selected[instance.InstanceID] = true
+	}
+
+	open, _, err := c.incidents.ListIncidents(ctx, &incidents.IncidentFilter{
+		Status: []incidents.IncidentStatus{incidents.IncidentStatusOpen},
+		Type:   filter.IncidentTypes,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(open, func(i, j int) bool {
+		return open[i].CreatedAt.Before(open[j].CreatedAt)
+	})
+
+	itemIDs := make([]string, 0, len(open))
+	for _, incident := range open {
+		if selected[incident.ProcessInstanceID] && filter.matchesIncident(incident) {
+			itemIDs = append(itemIDs, incident.ID)
+		}
+	}
+	return itemIDs, nil
+}
+
+// hasTokenAtElement checks whether instance has unfinished token at element
+// Проверяет есть ли у экземпляра незавершенный токен на элементе
+func (c *Component) hasTokenAtElement(instanceID, elementID string) (bool, error) {
+	tokens, err := c.processes.GetTokensByProcessInstance(instanceID)
+	if err != nil {
+		return false, err
+	}
+	for _, token := range tokens {
+		if token.CurrentElementID == elementID &&
+			token.State != models.TokenStateCompleted && token.State != models.TokenStateCanceled {
+			return true, nil
+		}
+	}
+	return false, nil
+}
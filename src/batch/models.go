@@ -0,0 +1,318 @@
+/*
+This file is part of the AtomBPMN (R) project.
+Copyright (c) 2025 Matreska Market LLC (ООО «Matreska Market»).
+Authors: Matreska Team.
+
+This project is dual-licensed under AGPL-3.0 and AtomBPMN Commercial License.
+*/
+
+package batch
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"atom-engine/src/core/models"
+	"atom-engine/src/incidents"
+)
+
+// Batch operation errors
+// Ошибки пакетных операций
+var (
+	ErrBatchNotFound = errors.New("batch operation not found")
+	ErrStateConflict = errors.New("batch operation state conflict")
+)
+
+// OperationType represents action applied to every batch item
+// Представляет действие применяемое к каждому элементу пакета
+type OperationType string
+
+const (
+	// OperationCancel cancels matching process instances
+	OperationCancel OperationType = "CANCEL"
+	// OperationResolve dismisses open incidents of matching process instances
+	OperationResolve OperationType = "RESOLVE"
+	// OperationRetry retries open incidents of matching process instances
+	OperationRetry OperationType = "RETRY"
+	// OperationMigrate moves matching process instances to another process version
+	OperationMigrate OperationType = "MIGRATE"
+	// OperationModify applies variable patch to matching process instances
+	OperationModify OperationType = "MODIFY"
+)
+
+// State represents batch operation execution state
+// Представляет состояние выполнения пакетной операции
+type State string
+
+const (
+	StatePending   State = "PENDING"
+	StateRunning   State = "RUNNING"
+	StatePaused    State = "PAUSED"
+	StateCompleted State = "COMPLETED"
+	StateCanceled  State = "CANCELED"
+	StateFailed    State = "FAILED"
+)
+
+// IsFinished checks whether batch operation reached final state
+// Проверяет достигла ли пакетная операция конечного состояния
+func (s State) IsFinished() bool {
+	return s == StateCompleted || s == StateCanceled || s == StateFailed
+}
+
+// Filter selects process instances of batch operation. Empty fields match any
+// value, empty states match unfinished instances.
+// Выбирает экземпляры процессов пакетной операции
+type Filter struct {
+	InstanceIDs   []string                 `json:"instance_ids,omitempty"`
+	ProcessKey    string                   `json:"process_key,omitempty"` // Process ID or versioned key
+	Version       int                      `json:"version,omitempty"`
+	States        []string                 `json:"states,omitempty"`
+	ElementID     string                   `json:"element_id,omitempty"` // Unfinished token or incident at element
+	Variables     map[string]interface{}   `json:"variables,omitempty"`  // Equal process variable values
+	StartedAfter  *time.Time               `json:"started_after,omitempty"`
+	StartedBefore *time.Time               `json:"started_before,omitempty"`
+	IncidentTypes []incidents.IncidentType `json:"incident_types,omitempty"` // RESOLVE and RETRY only
+	TenantID      string                   `json:"tenant_id,omitempty"`      // Empty = every tenant
+}
+
+// Params holds operation specific arguments
+// Содержит аргументы конкретной операции
+type Params struct {
+	Reason        string                 `json:"reason,omitempty"`         // CANCEL
+	Comment       string                 `json:"comment,omitempty"`        // RESOLVE, RETRY
+	NewRetries    int                    `json:"new_retries,omitempty"`    // RETRY
+	Variables     map[string]interface{} `json:"variables,omitempty"`      // MODIFY, RETRY
+	TargetVersion int                    `json:"target_version,omitempty"` // MIGRATE
+}
+
+// Failure records item that could not be processed
+// Фиксирует элемент который не удалось обработать
+type Failure struct {
+	ItemID string    `json:"item_id"`
+	Error  string    `json:"error"`
+	At     time.Time `json:"at"`
+}
+
+// Batch represents persisted batch operation with progress
+// Представляет сохраненную пакетную операцию с прогрессом
+type Batch struct {
+	ID          string        `json:"id"`
+	Type        OperationType `json:"type"`
+	Filter      Filter        `json:"filter"`
+	Params      Params        `json:"params"`
+	State       State         `json:"state"`
+	ChunkSize   int           `json:"chunk_size"`
+	CreatedBy   string        `json:"created_by,omitempty"`
+	Total       int           `json:"total"`
+	Processed   int           `json:"processed"` // Cursor into item snapshot
+	Succeeded   int           `json:"succeeded"`
+	Failed      int           `json:"failed"`
+	Failures    []Failure     `json:"failures,omitempty"` // Capped by batch.max_failures
+	Error       string        `json:"error,omitempty"`    // Reason of FAILED state
+	CreatedAt   time.Time     `json:"created_at"`
+	UpdatedAt   time.Time     `json:"updated_at"`
+	StartedAt   *time.Time    `json:"started_at,omitempty"`
+	CompletedAt *time.Time    `json:"completed_at,omitempty"`
+}
+
+// CreateRequest describes batch operation to submit
+// Описывает отправляемую пакетную операцию
+type CreateRequest struct {
+	Type      OperationType `json:"type"`
+	Filter    Filter        `json:"filter"`
+	Params    Params        `json:"params"`
+	ChunkSize int           `json:"chunk_size,omitempty"` // Overrides batch.chunk_size
+	CreatedBy string        `json:"-"`                    // Authenticated API key name
+}
+
+// ListFilter selects batch operations for listing
+// Выбирает пакетные операции для вывода списка
+type ListFilter struct {
+	Type  OperationType `json:"type,omitempty"`
+	State State         `json:"state,omitempty"`
+}
+
+// ParseOperationType parses operation type case-insensitively
+// Разбирает тип операции без учета регистра
+func ParseOperationType(value string) (OperationType, error) {
+	operation := OperationType(strings.ToUpper(strings.TrimSpace(value)))
+	switch operation {
+	case OperationCancel, OperationResolve, OperationRetry, OperationMigrate, OperationModify:
+		return operation, nil
+	default:
+		return "", fmt.Errorf("invalid operation type %q, expected CANCEL, RESOLVE, RETRY, MIGRATE or MODIFY", value)
+	}
+}
+
+// targetsIncidents checks whether operation items are incidents
+// Проверяет являются ли элементы операции инцидентами
+func (t OperationType) targetsIncidents() bool {
+	return t == OperationResolve || t == OperationRetry
+}
+
+// Validate checks create request consistency
+// Проверяет согласованность запроса создания
+func (r *CreateRequest) Validate() error {
+	operation, err := ParseOperationType(string(r.Type))
+	if err != nil {
+		return err
+	}
+	r.Type = operation
+
+	if r.ChunkSize < 0 {
+		return fmt.Errorf("invalid chunk_size %d", r.ChunkSize)
+	}
+	if r.Filter.isEmpty() {
+		return fmt.Errorf("invalid filter: at least one criterion is required")
+	}
+	if r.Filter.Version < 0 {
+		return fmt.Errorf("invalid filter version %d", r.Filter.Version)
+	}
+	if r.Filter.TenantID != "" {
+		if err := models.ValidateTenantID(models.NormalizeTenantID(r.Filter.TenantID)); err != nil {
+			return fmt.Errorf("invalid filter: %w", err)
+		}
+	}
+	for i, state := range r.Filter.States {
+		normalized := models.ProcessInstanceState(strings.ToUpper(state))
+		switch normalized {
+		case models.ProcessInstanceStateActive, models.ProcessInstanceStateMessages,
+			models.ProcessInstanceStateCompleted, models.ProcessInstanceStateCanceled,
+			models.ProcessInstanceStateFailed, models.ProcessInstanceStateSuspended:
+			r.Filter.States[i] = string(normalized)
+		default:
+			return fmt.Errorf("invalid filter state %q", state)
+		}
+	}
+	if r.Filter.StartedAfter != nil && r.Filter.StartedBefore != nil &&
+		!r.Filter.StartedAfter.Before(*r.Filter.StartedBefore) {
+		return fmt.Errorf("invalid filter: started_after must be before started_before")
+	}
+	if len(r.Filter.IncidentTypes) > 0 && !r.Type.targetsIncidents() {
+		return fmt.Errorf("invalid filter: incident_types apply to RESOLVE and RETRY only")
+	}
+	for i, incidentType := range r.Filter.IncidentTypes {
+		normalized := incidents.IncidentType(strings.ToUpper(string(incidentType)))
+		switch normalized {
+		case incidents.IncidentTypeJobFailure, incidents.IncidentTypeBPMNError,
+			incidents.IncidentTypeExpressionError, incidents.IncidentTypeProcessError,
+			incidents.IncidentTypeTimerError, incidents.IncidentTypeMessageError,
+			incidents.IncidentTypeSystemError:
+			r.Filter.IncidentTypes[i] = normalized
+		default:
+			return fmt.Errorf("invalid filter incident type %q", incidentType)
+		}
+	}
+
+	switch r.Type {
+	case OperationMigrate:
+		if r.Params.TargetVersion < 1 {
+			return fmt.Errorf("invalid params: target_version is required for MIGRATE")
+		}
+		if r.Filter.ProcessKey == "" {
+			return fmt.Errorf("invalid filter: process_key is required for MIGRATE")
+		}
+	case OperationModify:
+		if len(r.Params.Variables) == 0 {
+			return fmt.Errorf("invalid params: variables are required for MODIFY")
+		}
+	case OperationRetry:
+		if r.Params.NewRetries < 0 {
+			return fmt.Errorf("invalid params: new_retries cannot be negative")
+		}
+	}
+
+	return nil
+}
+
+// isEmpty checks whether filter has no criteria
+// Проверяет что фильтр не содержит условий
+func (f *Filter) isEmpty() bool {
+	return len(f.InstanceIDs) == 0 && f.ProcessKey == "" && f.Version == 0 &&
+		len(f.States) == 0 && f.ElementID == "" && len(f.Variables) == 0 &&
+		f.StartedAfter == nil && f.StartedBefore == nil && len(f.IncidentTypes) == 0 && f.TenantID == ""
+}
+
+// Tenant returns tenant batch is scoped to, false when filter spans every tenant.
+// Tenant of versioned process key takes part when tenant is not set explicitly.
+// Возвращает арендатора пакетной операции
+func (f *Filter) Tenant() (string, bool) {
+	if f.TenantID != "" {
+		return models.NormalizeTenantID(f.TenantID), true
+	}
+	if strings.Contains(f.ProcessKey, models.TenantSeparator) {
+		tenantID, _ := models.SplitTenantScopedKey(f.ProcessKey)
+		return tenantID, true
+	}
+	return models.DefaultTenantID, false
+}
+
+// matchesInstance checks instance fields against filter, element is checked
+// separately because it requires tokens
+// Проверяет поля экземпляра по фильтру
+func (f *Filter) matchesInstance(instance *models.ProcessInstance) bool {
+	if f.ProcessKey != "" && instance.ProcessID != f.ProcessKey && instance.ProcessKey != f.ProcessKey {
+		return false
+	}
+	if f.Version > 0 && instance.ProcessVersion != f.Version {
+		return false
+	}
+	if tenantID, scoped := f.Tenant(); scoped && instance.TenantID != tenantID {
+		return false
+	}
+	if len(f.States) > 0 {
+		if !containsString(f.States, string(instance.State)) {
+			return false
+		}
+	} else if instance.IsCompleted() {
+		return false
+	}
+	if f.StartedAfter != nil && instance.StartedAt.Before(*f.StartedAfter) {
+		return false
+	}
+	if f.StartedBefore != nil && !instance.StartedAt.Before(*f.StartedBefore) {
+		return false
+	}
+	for name, expected := range f.Variables {
+		actual, exists := instance.Variables[name]
+		if !exists || !reflect.DeepEqual(actual, expected) {
+			return false
+		}
+	}
+	return true
+}
+
+// matchesIncident checks open incident against incident criteria of filter
+// Проверяет открытый инцидент по условиям инцидентов фильтра
+func (f *Filter) matchesIncident(incident *incidents.Incident) bool {
+	if f.ElementID != "" && incident.ElementID != f.ElementID {
+		return false
+	}
+	if len(f.IncidentTypes) > 0 {
+		matched := false
+		for _, incidentType := range f.IncidentTypes {
+			if incident.Type == incidentType {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return false
+		}
+	}
+	return true
+}
+
+// containsString checks whether slice contains value
+// Проверяет содержит ли срез значение
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -11,24 +11,35 @@ package auth
 import (
 	"crypto/subtle"
 	"strings"
+	"sync"
+	"time"
 
 	"atom-engine/src/core/logger"
 )
 
-// apiKeyManager implements APIKeyValidator interface
+// apiKeyManager implements APIKeyValidator and APIKeyStore interfaces.
+// Config keys bootstrap access, managed keys are persisted as salted hashes.
 type apiKeyManager struct {
-	apiKeys map[string]*APIKey // map[key]APIKey for fast lookup
+	apiKeys         map[string]*APIKey       // map[key]APIKey for fast lookup
+	storedKeys      map[string]*StoredAPIKey // map[id]StoredAPIKey
+	lastUsedSaved   map[string]time.Time     // Last persisted use per managed key
+	storage         StorageInterface
+	rotationOverlap time.Duration
+	mutex           sync.RWMutex
 }
 
 // NewAPIKeyManager creates a new API key manager
-func NewAPIKeyManager(apiKeys []APIKey) APIKeyValidator {
+func NewAPIKeyManager(apiKeys []APIKey, rotationOverlap time.Duration) APIKeyValidator {
 	keyMap := make(map[string]*APIKey)
 	for i := range apiKeys {
 		keyMap[apiKeys[i].Key] = &apiKeys[i]
 	}
 
 	return &apiKeyManager{
-		apiKeys: keyMap,
+		apiKeys:         keyMap,
+		storedKeys:      make(map[string]*StoredAPIKey),
+		lastUsedSaved:   make(map[string]time.Time),
+		rotationOverlap: rotationOverlap,
 	}
 }
 
@@ -38,6 +49,13 @@ func (m *apiKeyManager) ValidateAPIKey(key string) (*APIKey, bool) {
 		return nil, false
 	}
 
+	if apiKey, ok := m.validateStoredKey(key); ok {
+		return apiKey, true
+	}
+
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
 	// Use constant-time comparison to prevent timing attacks
 	for storedKey, apiKey := range m.apiKeys {
 		if subtle.ConstantTimeCompare([]byte(key), []byte(storedKey)) == 1 {
@@ -55,6 +73,9 @@ func (m *apiKeyManager) ValidateAPIKey(key string) (*APIKey, bool) {
 
 // GetAPIKeys returns all configured API keys
 func (m *apiKeyManager) GetAPIKeys() []APIKey {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
 	keys := make([]APIKey, 0, len(m.apiKeys))
 	for _, apiKey := range m.apiKeys {
 		keys = append(keys, *apiKey)
@@ -68,7 +89,9 @@ func (m *apiKeyManager) UpdateAPIKeys(apiKeys []APIKey) {
 	for i := range apiKeys {
 		keyMap[apiKeys[i].Key] = &apiKeys[i]
 	}
+	m.mutex.Lock()
 	m.apiKeys = keyMap
+	m.mutex.Unlock()
 
 	logger.Info("API keys updated", logger.Int("count", len(apiKeys)))
 }
@@ -84,8 +107,12 @@ func (m *apiKeyManager) ValidatePermission(apiKey *APIKey, permission string) bo
 
 // GetAPIKeyStats returns statistics about API keys
 func (m *apiKeyManager) GetAPIKeyStats() map[string]interface{} {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
 	stats := make(map[string]interface{})
 	stats["total_keys"] = len(m.apiKeys)
+	stats["managed_keys"] = len(m.storedKeys)
 
 	// Count keys by permission types
 	permissionCounts := make(map[string]int)
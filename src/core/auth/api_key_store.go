@@ -0,0 +1,535 @@
+/*
+This file is part of the AtomBPMN (R) project.
+Copyright (c) 2025 Matreska Market LLC (ООО «Matreska Market»).
+Authors: Matreska Team.
+
+This project is dual-licensed under AGPL-3.0 and AtomBPMN Commercial License.
+*/
+
+package auth
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"atom-engine/src/core/logger"
+	"atom-engine/src/core/models"
+)
+
+const (
+	// managedKeyPrefix starts every managed key, followed by key ID and secret:
+	// atm_<16 hex ID>_<64 hex secret>
+	managedKeyPrefix = "atm_"
+	managedKeyIDLen  = 16
+	managedSecretLen = 64
+	// lastUsedPersistInterval limits last-used writes of busy keys
+	lastUsedPersistInterval = time.Minute
+)
+
+// API key sources and statuses
+const (
+	APIKeySourceStore  = "store"
+	APIKeySourceConfig = "config"
+
+	APIKeyStatusActive  = "ACTIVE"
+	APIKeyStatusExpired = "EXPIRED"
+	APIKeyStatusRevoked = "REVOKED"
+)
+
+var (
+	ErrAPIKeyNotFound       = errors.New("API key not found")
+	ErrAPIKeyInactive       = errors.New("API key is revoked or expired")
+	ErrConfigAPIKey         = errors.New("config-defined API key cannot be managed, edit config.yaml")
+	ErrAPIKeyStoreNotReady  = errors.New("API key store not ready")
+	ErrInvalidAPIKeyRequest = errors.New("invalid API key request")
+)
+
+// StoredAPIKey is persisted managed API key, secret is kept only as salted hash
+// Сохраненный управляемый API ключ, секрет хранится только как соленый хеш
+type StoredAPIKey struct {
+	ID           string     `json:"id"`
+	Name         string     `json:"name"`
+	Salt         string     `json:"salt"`
+	Hash         string     `json:"hash"` // hex SHA-256 of salt and secret
+	Permissions  []string   `json:"permissions"`
+	AllowedHosts []string   `json:"allowed_hosts,omitempty"`
+	Tenants      []string   `json:"tenants,omitempty"`
+	CreatedAt    time.Time  `json:"created_at"`
+	ExpiresAt    *time.Time `json:"expires_at,omitempty"`
+	LastUsedAt   *time.Time `json:"last_used_at,omitempty"`
+	RevokedAt    *time.Time `json:"revoked_at,omitempty"`
+	RotatedFrom  string     `json:"rotated_from,omitempty"`
+	RotatedTo    string     `json:"rotated_to,omitempty"`
+}
+
+// APIKeyInfo is API key as shown by management API, without secret material
+// API ключ в ответах API управления, без секретных данных
+type APIKeyInfo struct {
+	ID           string     `json:"id"`
+	Name         string     `json:"name"`
+	Source       string     `json:"source"` // store, config
+	Status       string     `json:"status"` // ACTIVE, EXPIRED, REVOKED
+	Prefix       string     `json:"prefix"` // Leading key characters for identification
+	Permissions  []string   `json:"permissions"`
+	AllowedHosts []string   `json:"allowed_hosts,omitempty"`
+	Tenants      []string   `json:"tenants,omitempty"`
+	CreatedAt    *time.Time `json:"created_at,omitempty"`
+	ExpiresAt    *time.Time `json:"expires_at,omitempty"`
+	LastUsedAt   *time.Time `json:"last_used_at,omitempty"`
+	RevokedAt    *time.Time `json:"revoked_at,omitempty"`
+	RotatedFrom  string     `json:"rotated_from,omitempty"`
+	RotatedTo    string     `json:"rotated_to,omitempty"`
+}
+
+// CreateAPIKeyRequest describes managed API key to issue
+// Описывает выпускаемый управляемый API ключ
+type CreateAPIKeyRequest struct {
+	Name         string     `json:"name"`
+	Permissions  []string   `json:"permissions"`
+	AllowedHosts []string   `json:"allowed_hosts,omitempty"`
+	Tenants      []string   `json:"tenants,omitempty"` // Accessible tenants, empty = all
+	ExpiresAt    *time.Time `json:"expires_at,omitempty"`
+	ExpiresIn    string     `json:"expires_in,omitempty"` // Alternative to expires_at, e.g. "720h"
+}
+
+// CreatedAPIKey is newly issued key with its secret, never returned again
+// Выпущенный ключ с секретом, который больше не возвращается
+type CreatedAPIKey struct {
+	APIKeyInfo
+	Key string `json:"key"`
+}
+
+// status returns key status at given time
+func (k *StoredAPIKey) status(now time.Time) string {
+	switch {
+	case k.RevokedAt != nil:
+		return APIKeyStatusRevoked
+	case k.ExpiresAt != nil && !now.Before(*k.ExpiresAt):
+		return APIKeyStatusExpired
+	default:
+		return APIKeyStatusActive
+	}
+}
+
+// info converts stored key to management view
+func (k *StoredAPIKey) info(now time.Time) *APIKeyInfo {
+	createdAt := k.CreatedAt
+	return &APIKeyInfo{
+		ID:           k.ID,
+		Name:         k.Name,
+		Source:       APIKeySourceStore,
+		Status:       k.status(now),
+		Prefix:       managedKeyPrefix + k.ID,
+		Permissions:  k.Permissions,
+		AllowedHosts: k.AllowedHosts,
+		Tenants:      k.Tenants,
+		CreatedAt:    &createdAt,
+		ExpiresAt:    k.ExpiresAt,
+		LastUsedAt:   k.LastUsedAt,
+		RevokedAt:    k.RevokedAt,
+		RotatedFrom:  k.RotatedFrom,
+		RotatedTo:    k.RotatedTo,
+	}
+}
+
+// SetStorage sets storage and loads managed keys
+// Устанавливает storage и загружает управляемые ключи
+func (m *apiKeyManager) SetStorage(storage StorageInterface) error {
+	records, err := storage.LoadAPIKeys()
+	if err != nil {
+		return err
+	}
+
+	keys := make(map[string]*StoredAPIKey, len(records))
+	for _, data := range records {
+		var key StoredAPIKey
+		if err := json.Unmarshal(data, &key); err != nil {
+			logger.Warn("Skipping unreadable API key record", logger.String("error", err.Error()))
+			continue
+		}
+		keys[key.ID] = &key
+	}
+
+	m.mutex.Lock()
+	m.storage = storage
+	m.storedKeys = keys
+	m.mutex.Unlock()
+
+	logger.Info("Managed API keys loaded", logger.Int("count", len(keys)))
+	return nil
+}
+
+// CreateAPIKey issues new managed key, secret is returned only once
+// Выпускает новый управляемый ключ, секрет возвращается однократно
+func (m *apiKeyManager) CreateAPIKey(request *CreateAPIKeyRequest) (*CreatedAPIKey, error) {
+	if request == nil || strings.TrimSpace(request.Name) == "" {
+		return nil, fmt.Errorf("%w: name is required", ErrInvalidAPIKeyRequest)
+	}
+	if len(request.Permissions) == 0 {
+		return nil, fmt.Errorf("%w: at least one permission is required", ErrInvalidAPIKeyRequest)
+	}
+	for _, tenantID := range request.Tenants {
+		if tenantID == models.AllTenants {
+			continue
+		}
+		if err := models.ValidateTenantID(models.NormalizeTenantID(tenantID)); err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidAPIKeyRequest, err)
+		}
+	}
+
+	now := time.Now()
+	expiresAt := request.ExpiresAt
+	if request.ExpiresIn != "" {
+		expiresIn, err := time.ParseDuration(request.ExpiresIn)
+		if err != nil || expiresIn <= 0 {
+			return nil, fmt.Errorf("%w: expires_in must be a positive duration, got %s",
+				ErrInvalidAPIKeyRequest, request.ExpiresIn)
+		}
+		deadline := now.Add(expiresIn)
+		expiresAt = &deadline
+	}
+	if expiresAt != nil && !expiresAt.After(now) {
+		return nil, fmt.Errorf("%w: expiry must be in the future", ErrInvalidAPIKeyRequest)
+	}
+
+	key, secret, err := newStoredAPIKey(
+		request.Name, request.Permissions, request.AllowedHosts, request.Tenants, expiresAt, now)
+	if err != nil {
+		return nil, err
+	}
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	if err := m.save(key); err != nil {
+		return nil, err
+	}
+	m.storedKeys[key.ID] = key
+
+	logger.Info("API key created",
+		logger.String("key_id", key.ID),
+		logger.String("name", key.Name),
+		logger.Any("permissions", key.Permissions))
+
+	return &CreatedAPIKey{APIKeyInfo: *key.info(now), Key: secret}, nil
+}
+
+// ListAPIKeys returns managed keys newest first followed by config keys
+// Возвращает управляемые ключи от новых к старым, затем ключи из конфигурации
+func (m *apiKeyManager) ListAPIKeys(includeInactive bool) []*APIKeyInfo {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	now := time.Now()
+	managed := make([]*APIKeyInfo, 0, len(m.storedKeys))
+	for _, key := range m.storedKeys {
+		if includeInactive || key.status(now) == APIKeyStatusActive {
+			managed = append(managed, key.info(now))
+		}
+	}
+	sort.Slice(managed, func(i, j int) bool {
+		return managed[i].CreatedAt.After(*managed[j].CreatedAt)
+	})
+
+	configured := make([]*APIKeyInfo, 0, len(m.apiKeys))
+	for secret, key := range m.apiKeys {
+		configured = append(configured, configKeyInfo(secret, key))
+	}
+	sort.Slice(configured, func(i, j int) bool {
+		return configured[i].Name < configured[j].Name
+	})
+
+	return append(managed, configured...)
+}
+
+// GetAPIKey returns key by ID without secret
+// Возвращает ключ по ID без секрета
+func (m *apiKeyManager) GetAPIKey(id string) (*APIKeyInfo, error) {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	if key, ok := m.storedKeys[id]; ok {
+		return key.info(time.Now()), nil
+	}
+	for secret, key := range m.apiKeys {
+		if configKeyID(secret) == id {
+			return configKeyInfo(secret, key), nil
+		}
+	}
+	return nil, fmt.Errorf("%w: %s", ErrAPIKeyNotFound, id)
+}
+
+// RevokeAPIKey disables managed key immediately
+// Немедленно отключает управляемый ключ
+func (m *apiKeyManager) RevokeAPIKey(id string) (*APIKeyInfo, error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	key, err := m.managedKey(id)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	if key.RevokedAt != nil {
+		return key.info(now), nil
+	}
+
+	revoked := *key
+	revoked.RevokedAt = &now
+	if err := m.save(&revoked); err != nil {
+		return nil, err
+	}
+	m.storedKeys[id] = &revoked
+
+	logger.Info("API key revoked",
+		logger.String("key_id", id),
+		logger.String("name", revoked.Name))
+
+	return revoked.info(now), nil
+}
+
+// RotateAPIKey issues successor with same name, permissions, hosts and expiry.
+// Replaced key stays valid for overlap, empty overlap uses auth.rotation_overlap.
+// Выпускает ключ-преемник, заменяемый ключ действует еще overlap
+func (m *apiKeyManager) RotateAPIKey(id string, overlap string) (*CreatedAPIKey, error) {
+	window := m.rotationOverlap
+	if overlap != "" {
+		parsed, err := time.ParseDuration(overlap)
+		if err != nil || parsed < 0 {
+			return nil, fmt.Errorf("%w: overlap must be a non-negative duration, got %s",
+				ErrInvalidAPIKeyRequest, overlap)
+		}
+		window = parsed
+	}
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	key, err := m.managedKey(id)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	if key.status(now) != APIKeyStatusActive {
+		return nil, fmt.Errorf("%w: %s", ErrAPIKeyInactive, id)
+	}
+
+	successor, secret, err := newStoredAPIKey(
+		key.Name, key.Permissions, key.AllowedHosts, key.Tenants, key.ExpiresAt, now)
+	if err != nil {
+		return nil, err
+	}
+	successor.RotatedFrom = key.ID
+
+	replaced := *key
+	replaced.RotatedTo = successor.ID
+	deadline := now.Add(window)
+	if replaced.ExpiresAt == nil || deadline.Before(*replaced.ExpiresAt) {
+		replaced.ExpiresAt = &deadline
+	}
+
+	if err := m.save(successor); err != nil {
+		return nil, err
+	}
+	if err := m.save(&replaced); err != nil {
+		return nil, err
+	}
+	m.storedKeys[successor.ID] = successor
+	m.storedKeys[id] = &replaced
+
+	logger.Info("API key rotated",
+		logger.String("key_id", id),
+		logger.String("successor_id", successor.ID),
+		logger.String("name", key.Name),
+		logger.String("overlap", window.String()))
+
+	return &CreatedAPIKey{APIKeyInfo: *successor.info(now), Key: secret}, nil
+}
+
+// validateStoredKey checks managed key found by ID embedded in key
+// Проверяет управляемый ключ, найденный по встроенному ID
+func (m *apiKeyManager) validateStoredKey(key string) (*APIKey, bool) {
+	id, secret, ok := parseManagedKey(key)
+	if !ok {
+		return nil, false
+	}
+
+	m.mutex.RLock()
+	stored, found := m.storedKeys[id]
+	var record StoredAPIKey
+	if found {
+		record = *stored
+	}
+	m.mutex.RUnlock()
+
+	if !found || subtle.ConstantTimeCompare([]byte(hashSecret(record.Salt, secret)), []byte(record.Hash)) != 1 {
+		return nil, false
+	}
+
+	now := time.Now()
+	if status := record.status(now); status != APIKeyStatusActive {
+		logger.Debug("Managed API key rejected",
+			logger.String("key_id", id),
+			logger.String("status", status))
+		return nil, false
+	}
+
+	m.recordUse(id, now)
+
+	logger.Debug("API key validated successfully",
+		logger.String("key_name", record.Name),
+		logger.String("key_id", id))
+	return &APIKey{
+		Name:         record.Name,
+		Permissions:  record.Permissions,
+		AllowedHosts: record.AllowedHosts,
+		Tenants:      record.Tenants,
+	}, true
+}
+
+// recordUse updates last-used time, persisting it at most once per interval
+func (m *apiKeyManager) recordUse(id string, now time.Time) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	key, ok := m.storedKeys[id]
+	if !ok {
+		return
+	}
+	used := *key
+	used.LastUsedAt = &now
+	m.storedKeys[id] = &used
+
+	if now.Sub(m.lastUsedSaved[id]) < lastUsedPersistInterval {
+		return
+	}
+	if err := m.save(&used); err != nil {
+		logger.Warn("Failed to persist API key last use",
+			logger.String("key_id", id),
+			logger.String("error", err.Error()))
+		return
+	}
+	m.lastUsedSaved[id] = now
+}
+
+// managedKey returns managed key by ID, caller holds mutex
+func (m *apiKeyManager) managedKey(id string) (*StoredAPIKey, error) {
+	if key, ok := m.storedKeys[id]; ok {
+		return key, nil
+	}
+	for secret := range m.apiKeys {
+		if configKeyID(secret) == id {
+			return nil, ErrConfigAPIKey
+		}
+	}
+	return nil, fmt.Errorf("%w: %s", ErrAPIKeyNotFound, id)
+}
+
+// save persists managed key, caller holds mutex
+func (m *apiKeyManager) save(key *StoredAPIKey) error {
+	if m.storage == nil {
+		return ErrAPIKeyStoreNotReady
+	}
+	data, err := json.Marshal(key)
+	if err != nil {
+		return fmt.Errorf("failed to marshal API key: %w", err)
+	}
+	if err := m.storage.SaveAPIKey(key.ID, data); err != nil {
+		return fmt.Errorf("failed to save API key: %w", err)
+	}
+	return nil
+}
+
+// newStoredAPIKey generates key ID, secret and salted hash
+// Генерирует ID ключа, секрет и соленый хеш
+func newStoredAPIKey(
+	name string,
+	permissions, allowedHosts, tenants []string,
+	expiresAt *time.Time,
+	now time.Time,
+) (*StoredAPIKey, string, error) {
+	id, err := randomHex(managedKeyIDLen / 2)
+	if err != nil {
+		return nil, "", err
+	}
+	secret, err := randomHex(managedSecretLen / 2)
+	if err != nil {
+		return nil, "", err
+	}
+	salt, err := randomHex(16)
+	if err != nil {
+		return nil, "", err
+	}
+
+	key := &StoredAPIKey{
+		ID:           id,
+		Name:         strings.TrimSpace(name),
+		Salt:         salt,
+		Hash:         hashSecret(salt, secret),
+		Permissions:  permissions,
+		AllowedHosts: allowedHosts,
+		Tenants:      tenants,
+		CreatedAt:    now,
+		ExpiresAt:    expiresAt,
+	}
+	return key, managedKeyPrefix + id + "_" + secret, nil
+}
+
+// parseManagedKey splits managed key into ID and secret
+func parseManagedKey(key string) (string, string, bool) {
+	if len(key) != len(managedKeyPrefix)+managedKeyIDLen+1+managedSecretLen ||
+		!strings.HasPrefix(key, managedKeyPrefix) {
+		return "", "", false
+	}
+	rest := key[len(managedKeyPrefix):]
+	if rest[managedKeyIDLen] != '_' {
+		return "", "", false
+	}
+	return rest[:managedKeyIDLen], rest[managedKeyIDLen+1:], true
+}
+
+// hashSecret returns hex SHA-256 of salt and secret. Secrets are 256-bit
+// random values, so a fast hash is sufficient against brute force.
+func hashSecret(salt, secret string) string {
+	sum := sha256.Sum256([]byte(salt + secret))
+	return hex.EncodeToString(sum[:])
+}
+
+// randomHex returns n random bytes encoded as hex
+func randomHex(n int) (string, error) {
+	buf := make([]byte, n)
+	if _, err := rand.Read(buf); err != nil {
+		return "", fmt.Errorf("failed to generate random value: %w", err)
+	}
+	return hex.EncodeToString(buf), nil
+}
+
+// configKeyID returns stable ID of config-defined key not revealing the key
+func configKeyID(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+	return "config-" + hex.EncodeToString(sum[:])[:12]
+}
+
+// configKeyInfo converts config-defined key to management view
+func configKeyInfo(secret string, key *APIKey) *APIKeyInfo {
+	return &APIKeyInfo{
+		ID:           configKeyID(secret),
+		Name:         key.Name,
+		Source:       APIKeySourceConfig,
+		Status:       APIKeyStatusActive,
+		Prefix:       maskAPIKey(secret),
+		Permissions:  key.Permissions,
+		AllowedHosts: key.AllowedHosts,
+		Tenants:      key.Tenants,
+	}
+}
@@ -10,11 +10,13 @@ package auth
 
 import (
 	"context"
+	"crypto/tls"
 	"errors"
 	"net"
 	"strings"
 	"time"
 
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/peer"
 )
@@ -44,6 +46,11 @@ func CreateAuthContextFromGRPC(ctx context.Context, method string) AuthContext {
 				authCtx.ClientIP = host
 			}
 		}
+
+		// Client certificate verified during TLS handshake
+		if tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo); ok {
+			authCtx.SetClientCertificate(&tlsInfo.State)
+		}
 	}
 
 	// Extract metadata
@@ -75,6 +82,18 @@ func CreateAuthContextFromHTTP(clientIP, userAgent, method, path, authHeader str
 	}
 }
 
+// SetClientCertificate fills client certificate identity from verified TLS connection state
+// Заполняет идентичность клиентского сертификата из состояния проверенного TLS соединения
+func (a *AuthContext) SetClientCertificate(state *tls.ConnectionState) {
+	// Only chains verified against client CA count, unverified certificates are ignored
+	if state == nil || len(state.VerifiedChains) == 0 || len(state.VerifiedChains[0]) == 0 {
+		return
+	}
+	leaf := state.VerifiedChains[0][0]
+	a.ClientCertSubject = leaf.Subject.String()
+	a.ClientCertCommonName = leaf.Subject.CommonName
+}
+
 // extractBearerToken extracts token from "Bearer <token>" format
 func extractBearerToken(authHeader string) string {
 	const bearerPrefix = "Bearer "
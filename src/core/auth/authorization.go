@@ -0,0 +1,251 @@
+/*
+This file is part of the AtomBPMN (R) project.
+Copyright (c) 2025 Matreska Market LLC (ООО «Matreska Market»).
+Authors: Matreska Team.
+
+This project is dual-licensed under AGPL-3.0 and AtomBPMN Commercial License.
+*/
+
+package auth
+
+import (
+	"strings"
+
+	"atom-engine/src/core/models"
+)
+
+// Actions checked by resource-scoped authorization
+const (
+	ActionAll      = "*"
+	ActionCreate   = "create"
+	ActionRead     = "read"
+	ActionUpdate   = "update"
+	ActionDelete   = "delete"
+	ActionComplete = "complete"
+	ActionResolve  = "resolve"
+	ActionDeploy   = "deploy"
+)
+
+// RolePrefix marks permission entry that binds a role, e.g. "role:billing-operator"
+const RolePrefix = "role:"
+
+// Authorize reports whether result allows action on resource with given ID.
+// Permission constants double as resource types. A flat permission equal to
+// resource type allows every action on it, "read" allows reading any resource
+// except admin. Empty resourceID stands for operation over all resources of
+// the type and is allowed only by grants without ID restriction.
+// Проверяет, разрешает ли результат действие над ресурсом с заданным ID
+func (r *AuthResult) Authorize(action, resource, resourceID string) bool {
+	if r == nil {
+		return false
+	}
+	if r.hasFlatAccess(action, resource) {
+		return true
+	}
+
+	for _, grant := range r.Grants {
+		if grantAllows(grant, action, resource) && grantMatchesID(grant, resourceID) {
+			return true
+		}
+	}
+	return false
+}
+
+// AllowsTenant reports whether result grants access to data of given tenant
+// Проверяет, есть ли у результата доступ к данным арендатора
+func (r *AuthResult) AllowsTenant(tenantID string) bool {
+	if r == nil {
+		return false
+	}
+	return r.AllTenants() || models.TenantAllowed(r.TenantIDs, tenantID)
+}
+
+// AllTenants reports whether result is not restricted to a tenant list
+// Проверяет, что результат не ограничен списком арендаторов
+func (r *AuthResult) AllTenants() bool {
+	if r == nil {
+		return false
+	}
+	if len(r.TenantIDs) == 0 {
+		return true
+	}
+	for _, tenantID := range r.TenantIDs {
+		if tenantID == models.AllTenants {
+			return true
+		}
+	}
+	return false
+}
+
+// ResolveTenant picks tenant for write operation. Empty request falls back to
+// the only tenant of restricted caller, otherwise to the default tenant.
+// Определяет арендатора для операции записи
+func (r *AuthResult) ResolveTenant(requested string) (string, bool) {
+	tenantID := models.NormalizeTenantID(requested)
+	if tenantID == models.DefaultTenantID && r != nil && len(r.TenantIDs) == 1 &&
+		r.TenantIDs[0] != models.AllTenants {
+		tenantID = models.NormalizeTenantID(r.TenantIDs[0])
+	}
+	return tenantID, r.AllowsTenant(tenantID)
+}
+
+// CanPerform reports whether result allows action on at least some resources of the type
+// Проверяет, разрешено ли действие хотя бы над частью ресурсов типа
+func (r *AuthResult) CanPerform(action, resource string) bool {
+	if r == nil {
+		return false
+	}
+	if r.hasFlatAccess(action, resource) {
+		return true
+	}
+
+	for _, grant := range r.Grants {
+		if grantAllows(grant, action, resource) {
+			return true
+		}
+	}
+	return false
+}
+
+// HasAccess reports whether result allows any action on resource type
+// Проверяет наличие любого разрешения на тип ресурса
+func (r *AuthResult) HasAccess(resource string) bool {
+	if r == nil {
+		return false
+	}
+	if r.hasFlatAccess(ActionRead, resource) {
+		return true
+	}
+
+	for _, grant := range r.Grants {
+		if grant.Resource == resource || grant.Resource == PermissionAll {
+			return true
+		}
+	}
+	return false
+}
+
+// hasFlatAccess checks legacy flat permissions
+func (r *AuthResult) hasFlatAccess(action, resource string) bool {
+	if HasPermission(r.Permissions, resource) {
+		return true
+	}
+	return action == ActionRead && resource != PermissionAdmin && HasPermission(r.Permissions, PermissionRead)
+}
+
+// grantAllows checks grant resource type and actions
+func grantAllows(grant Grant, action, resource string) bool {
+	if grant.Resource != resource && grant.Resource != PermissionAll {
+		return false
+	}
+	for _, allowed := range grant.Actions {
+		if allowed == action || allowed == ActionAll {
+			return true
+		}
+	}
+	return false
+}
+
+// grantMatchesID checks resource ID against grant patterns, empty ID needs unrestricted grant
+func grantMatchesID(grant Grant, resourceID string) bool {
+	if len(grant.IDs) == 0 {
+		return true
+	}
+	for _, pattern := range grant.IDs {
+		if pattern == "*" || (resourceID != "" && MatchPattern(pattern, resourceID)) {
+			return true
+		}
+	}
+	return false
+}
+
+// MatchPattern reports whether value matches pattern where "*" matches any character sequence
+// Проверяет соответствие значения шаблону, где "*" соответствует любой последовательности
+func MatchPattern(pattern, value string) bool {
+	parts := strings.Split(pattern, "*")
+	if len(parts) == 1 {
+		return pattern == value
+	}
+
+	if !strings.HasPrefix(value, parts[0]) {
+		return false
+	}
+	value = value[len(parts[0]):]
+
+	last := parts[len(parts)-1]
+	for _, part := range parts[1 : len(parts)-1] {
+		index := strings.Index(value, part)
+		if index < 0 {
+			return false
+		}
+		value = value[index+len(part):]
+	}
+	return len(value) >= len(last) && strings.HasSuffix(value, last)
+}
+
+// roleBindings resolves grants of roles bound to callers
+type roleBindings struct {
+	grants    map[string][]Grant  // role name -> grants
+	byKey     map[string][]string // API key name -> role names
+	bySubject map[string][]string // JWT subject or client certificate name -> role names
+}
+
+// newRoleBindings indexes configured roles by name, API key and subject
+func newRoleBindings(roles []RoleConfig) *roleBindings {
+	bindings := &roleBindings{
+		grants:    make(map[string][]Grant, len(roles)),
+		byKey:     make(map[string][]string),
+		bySubject: make(map[string][]string),
+	}
+	for _, role := range roles {
+		bindings.grants[role.Name] = role.Grants
+		for _, key := range role.APIKeys {
+			bindings.byKey[key] = append(bindings.byKey[key], role.Name)
+		}
+		for _, subject := range role.Subjects {
+			bindings.bySubject[subject] = append(bindings.bySubject[subject], role.Name)
+		}
+	}
+	return bindings
+}
+
+// resolve returns grants of bound roles and roles referenced by "role:" permissions
+func (b *roleBindings) resolve(bound []string, permissions []string) []Grant {
+	if b == nil {
+		return nil
+	}
+
+	roles := append([]string(nil), bound...)
+	for _, permission := range permissions {
+		if name, ok := strings.CutPrefix(permission, RolePrefix); ok {
+			roles = append(roles, name)
+		}
+	}
+
+	var grants []Grant
+	seen := make(map[string]bool, len(roles))
+	for _, name := range roles {
+		if seen[name] {
+			continue
+		}
+		seen[name] = true
+		grants = append(grants, b.grants[name]...)
+	}
+	return grants
+}
+
+// forKey returns grants of API key by its name
+func (b *roleBindings) forKey(name string, permissions []string) []Grant {
+	if b == nil {
+		return nil
+	}
+	return b.resolve(b.byKey[name], permissions)
+}
+
+// forSubject returns grants of JWT subject or client certificate identity
+func (b *roleBindings) forSubject(subject string, permissions []string) []Grant {
+	if b == nil {
+		return nil
+	}
+	return b.resolve(b.bySubject[subject], permissions)
+}
@@ -11,6 +11,8 @@ package auth
 import (
 	"errors"
 	"fmt"
+	"sync/atomic"
+	"time"
 
 	"atom-engine/src/core/logger"
 )
@@ -19,9 +21,11 @@ import (
 type component struct {
 	config        *AuthConfig
 	apiKeyManager APIKeyValidator
+	jwtValidator  JWTValidator
 	ipValidator   IPValidator
 	rateLimiter   RateLimiter
 	auditLogger   AuditLogger
+	roles         atomic.Pointer[roleBindings]
 	initialized   bool
 	running       bool
 }
@@ -43,16 +47,31 @@ func (c *component) Initialize(config *AuthConfig) error {
 	c.config = config
 
 	// Initialize sub-components
-	c.apiKeyManager = NewAPIKeyManager(config.APIKeys)
+	rotationOverlap, err := time.ParseDuration(config.RotationOverlap)
+	if err != nil {
+		rotationOverlap = 24 * time.Hour
+	}
+	c.apiKeyManager = NewAPIKeyManager(config.APIKeys, rotationOverlap)
 	c.ipValidator = NewIPValidator(config.AllowedHosts)
 	c.rateLimiter = NewRateLimiter(config.RateLimit.Enabled, config.RateLimit.RequestsPerMinute)
 	c.auditLogger = NewAuditLogger(config.Audit)
+	c.roles.Store(newRoleBindings(config.Roles))
+
+	if config.JWT.Enabled {
+		jwtValidator, err := NewJWTValidator(config.JWT)
+		if err != nil {
+			return fmt.Errorf("failed to initialize JWT validator: %w", err)
+		}
+		c.jwtValidator = jwtValidator
+	}
 
 	c.initialized = true
 
 	logger.Info("Auth component initialized",
 		logger.Bool("enabled", config.Enabled),
 		logger.Int("api_keys_count", len(config.APIKeys)),
+		logger.Bool("jwt_enabled", c.jwtValidator != nil),
+		logger.Int("roles_count", len(config.Roles)),
 		logger.Int("allowed_hosts_count", len(config.AllowedHosts)))
 
 	return nil
@@ -64,6 +83,12 @@ func (c *component) Start() error {
 		return errors.New("auth component not initialized")
 	}
 
+	if c.jwtValidator != nil {
+		if err := c.jwtValidator.Start(); err != nil {
+			return fmt.Errorf("failed to start JWT validator: %w", err)
+		}
+	}
+
 	c.running = true
 	logger.Info("Auth component started")
 	return nil
@@ -80,6 +105,10 @@ func (c *component) Stop() error {
 		rl.Stop()
 	}
 
+	if c.jwtValidator != nil {
+		c.jwtValidator.Stop()
+	}
+
 	c.running = false
 	logger.Info("Auth component stopped")
 	return nil
@@ -142,6 +171,16 @@ func (c *component) Authenticate(ctx AuthContext) (*AuthResult, error) {
 	// Record the request for rate limiting
 	c.rateLimiter.RecordRequest(ctx.ClientIP, ctx.APIKey)
 
+	// Verified client certificate identifies caller that sent no bearer value
+	if ctx.APIKey == "" && ctx.ClientCertSubject != "" {
+		return c.authenticateClientCert(ctx), nil
+	}
+
+	// Bearer value shaped as JWT is validated as token, anything else as API key
+	if c.jwtValidator != nil && IsJWT(ctx.APIKey) {
+		return c.authenticateJWT(ctx), nil
+	}
+
 	// Validate API key
 	apiKey, valid := c.apiKeyManager.ValidateAPIKey(ctx.APIKey)
 	if !valid {
@@ -166,6 +205,8 @@ func (c *component) Authenticate(ctx AuthContext) (*AuthResult, error) {
 		Authenticated: true,
 		APIKeyName:    apiKey.Name,
 		Permissions:   apiKey.Permissions,
+		Grants:        c.roles.Load().forKey(apiKey.Name, apiKey.Permissions),
+		TenantIDs:     apiKey.Tenants,
 		Reason:        "Authentication successful",
 	}
 
@@ -173,6 +214,86 @@ func (c *component) Authenticate(ctx AuthContext) (*AuthResult, error) {
 	return result, nil
 }
 
+// authenticateJWT validates JWT bearer token and maps its claims to result
+// Проверяет JWT bearer токен и переносит его claims в результат
+func (c *component) authenticateJWT(ctx AuthContext) *AuthResult {
+	identity, err := c.jwtValidator.ValidateToken(ctx.APIKey)
+	if err != nil {
+		reason := "Invalid token: " + err.Error()
+		c.auditLogger.LogAuthFailure(ctx, reason)
+		return &AuthResult{
+			Authenticated: false,
+			Reason:        reason,
+		}
+	}
+
+	// Tokens carry no per-caller hosts, global whitelist applies
+	if !c.ipValidator.ValidateIP(ctx.ClientIP, nil) {
+		c.auditLogger.LogIPBlocked(ctx, fmt.Sprintf("IP %s not in whitelist", ctx.ClientIP))
+		return &AuthResult{
+			Authenticated: false,
+			Reason:        fmt.Sprintf("IP %s not allowed", ctx.ClientIP),
+		}
+	}
+
+	result := &AuthResult{
+		Authenticated: true,
+		APIKeyName:    identity.Name,
+		Permissions:   identity.Permissions,
+		Grants:        c.roles.Load().forSubject(identity.Name, identity.Permissions),
+		TenantIDs:     identity.TenantIDs,
+		Reason:        "Authentication successful",
+	}
+
+	c.auditLogger.LogAuthSuccess(ctx, result)
+	return result
+}
+
+// authenticateClientCert maps verified TLS client certificate to configured identity
+// Сопоставляет проверенный клиентский TLS сертификат настроенной идентичности
+func (c *component) authenticateClientCert(ctx AuthContext) *AuthResult {
+	identity, ok := matchClientCert(c.config.ClientCerts, ctx.ClientCertSubject, ctx.ClientCertCommonName)
+	if !ok {
+		reason := fmt.Sprintf("Client certificate %s not mapped", ctx.ClientCertSubject)
+		c.auditLogger.LogAuthFailure(ctx, reason)
+		return &AuthResult{
+			Authenticated: false,
+			Reason:        reason,
+		}
+	}
+
+	// Certificates carry no per-caller hosts, global whitelist applies
+	if !c.ipValidator.ValidateIP(ctx.ClientIP, nil) {
+		c.auditLogger.LogIPBlocked(ctx, fmt.Sprintf("IP %s not in whitelist", ctx.ClientIP))
+		return &AuthResult{
+			Authenticated: false,
+			Reason:        fmt.Sprintf("IP %s not allowed", ctx.ClientIP),
+		}
+	}
+
+	result := &AuthResult{
+		Authenticated: true,
+		APIKeyName:    identity.Name,
+		Permissions:   identity.Permissions,
+		Grants:        c.roles.Load().forSubject(identity.Name, identity.Permissions),
+		TenantIDs:     identity.Tenants,
+		Reason:        "Authentication successful",
+	}
+
+	c.auditLogger.LogAuthSuccess(ctx, result)
+	return result
+}
+
+// matchClientCert returns first entry whose subject pattern matches full subject or common name
+func matchClientCert(entries []ClientCert, subject, commonName string) (ClientCert, bool) {
+	for _, entry := range entries {
+		if MatchPattern(entry.Subject, subject) || (commonName != "" && MatchPattern(entry.Subject, commonName)) {
+			return entry, true
+		}
+	}
+	return ClientCert{}, false
+}
+
 // CheckPermission validates if authenticated context has required permission
 func (c *component) CheckPermission(result *AuthResult, permission string) bool {
 	if result == nil || !result.Authenticated {
@@ -187,6 +308,17 @@ func (c *component) GetAPIKeyValidator() APIKeyValidator {
 	return c.apiKeyManager
 }
 
+// GetAPIKeyStore returns managed API key store
+func (c *component) GetAPIKeyStore() APIKeyStore {
+	store, _ := c.apiKeyManager.(APIKeyStore)
+	return store
+}
+
+// GetJWTValidator returns JWT validator, nil when JWT is disabled
+func (c *component) GetJWTValidator() JWTValidator {
+	return c.jwtValidator
+}
+
 // GetIPValidator returns IP validator
 func (c *component) GetIPValidator() IPValidator {
 	return c.ipValidator
@@ -205,6 +337,13 @@ func (c *component) GetAuditLogger() AuditLogger {
 // SetStorage sets storage for persistent auth operations
 // Устанавливает storage для персистентных auth операций
 func (c *component) SetStorage(storage StorageInterface) error {
+	if akm, ok := c.apiKeyManager.(*apiKeyManager); ok {
+		if err := akm.SetStorage(storage); err != nil {
+			logger.Warn("Failed to load managed API keys from storage", logger.String("error", err.Error()))
+			return err
+		}
+	}
+
 	if c.rateLimiter != nil {
 		c.rateLimiter.SetStorage(storage)
 		// Load existing state from storage
@@ -235,6 +374,8 @@ func (c *component) UpdateConfig(config *AuthConfig) error {
 		ipv.UpdateAllowedHosts(config.AllowedHosts)
 	}
 
+	c.roles.Store(newRoleBindings(config.Roles))
+
 	if rl, ok := c.rateLimiter.(*rateLimiter); ok {
 		rl.UpdateConfig(config.RateLimit.Enabled, config.RateLimit.RequestsPerMinute)
 	}
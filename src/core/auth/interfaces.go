@@ -12,6 +12,8 @@ import "atom-engine/src/storage"
 
 // StorageInterface defines minimal storage interface needed by auth components
 type StorageInterface interface {
+	SaveAPIKey(keyID string, data []byte) error
+	LoadAPIKeys() ([][]byte, error)
 	SaveRateLimitInfo(identifier string, info *storage.RateLimitInfo) error
 	LoadRateLimitInfo(identifier string) (*storage.RateLimitInfo, error)
 	LoadAllRateLimitInfo() (map[string]*storage.RateLimitInfo, error)
@@ -43,6 +45,37 @@ type APIKeyValidator interface {
 	GetAPIKeys() []APIKey
 }
 
+// APIKeyStore defines interface for managed API keys persisted as salted hashes
+type APIKeyStore interface {
+	// CreateAPIKey issues new key, secret is returned only once
+	CreateAPIKey(request *CreateAPIKeyRequest) (*CreatedAPIKey, error)
+
+	// ListAPIKeys returns managed and config-defined keys without secrets,
+	// revoked and expired keys only when includeInactive is set
+	ListAPIKeys(includeInactive bool) []*APIKeyInfo
+
+	// GetAPIKey returns key by ID without secret
+	GetAPIKey(id string) (*APIKeyInfo, error)
+
+	// RevokeAPIKey disables key immediately
+	RevokeAPIKey(id string) (*APIKeyInfo, error)
+
+	// RotateAPIKey issues successor key, replaced key stays valid for overlap
+	RotateAPIKey(id string, overlap string) (*CreatedAPIKey, error)
+}
+
+// JWTValidator defines interface for JWT bearer token validation
+type JWTValidator interface {
+	// ValidateToken verifies token signature and claims and returns caller identity
+	ValidateToken(token string) (*JWTIdentity, error)
+
+	// Start starts periodic JWKS refresh
+	Start() error
+
+	// Stop stops periodic JWKS refresh
+	Stop()
+}
+
 // IPValidator defines interface for IP whitelist validation
 type IPValidator interface {
 	// ValidateIP checks if IP is allowed based on configuration
@@ -107,6 +140,12 @@ type Component interface {
 	// GetAPIKeyValidator returns API key validator
 	GetAPIKeyValidator() APIKeyValidator
 
+	// GetAPIKeyStore returns managed API key store
+	GetAPIKeyStore() APIKeyStore
+
+	// GetJWTValidator returns JWT validator, nil when JWT is disabled
+	GetJWTValidator() JWTValidator
+
 	// GetIPValidator returns IP validator
 	GetIPValidator() IPValidator
 
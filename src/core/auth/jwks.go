@@ -0,0 +1,162 @@
+/*
+This file is part of the AtomBPMN (R) project.
+Copyright (c) 2025 Matreska Market LLC (ООО «Matreska Market»).
+Authors: Matreska Team.
+
+This project is dual-licensed under AGPL-3.0 and AtomBPMN Commercial License.
+*/
+
+package auth
+
+import (
+	"crypto/ecdh"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"atom-engine/src/core/logger"
+)
+
+// minRSAKeyBits is smallest accepted RSA verification key
+const minRSAKeyBits = 2048
+
+// jsonWebKeySet is JWKS document as served by OIDC providers
+type jsonWebKeySet struct {
+	Keys []jsonWebKey `json:"keys"`
+}
+
+// jsonWebKey is single key of JSON Web Key Set
+type jsonWebKey struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+	K   string `json:"k"`
+}
+
+// verificationKey is parsed key usable for token signature verification
+// Разобранный ключ для проверки подписи токена
+type verificationKey struct {
+	id        string
+	algorithm string      // Empty = any algorithm of key type
+	key       interface{} // *rsa.PublicKey, *ecdsa.PublicKey or []byte
+}
+
+// parseJWKS parses JWKS document skipping keys that cannot verify
+// RS256, ES256 or HS256 signatures
+// Разбирает JWKS документ, пропуская неподдерживаемые ключи
+func parseJWKS(data []byte) ([]verificationKey, error) {
+	var set jsonWebKeySet
+	if err := json.Unmarshal(data, &set); err != nil {
+		return nil, fmt.Errorf("invalid JWKS document: %w", err)
+	}
+
+	keys := make([]verificationKey, 0, len(set.Keys))
+	for _, jwk := range set.Keys {
+		if jwk.Use != "" && jwk.Use != "sig" {
+			continue
+		}
+		key, err := parseJWK(jwk)
+		if err != nil {
+			logger.Warn("Skipping JWKS key",
+				logger.String("kid", jwk.Kid),
+				logger.String("kty", jwk.Kty),
+				logger.String("error", err.Error()))
+			continue
+		}
+		keys = append(keys, key)
+	}
+	return keys, nil
+}
+
+// parseJWK converts JSON Web Key to verification key
+// Преобразует JSON Web Key в ключ проверки подписи
+func parseJWK(jwk jsonWebKey) (verificationKey, error) {
+	result := verificationKey{id: jwk.Kid, algorithm: jwk.Alg}
+
+	switch jwk.Kty {
+	case "RSA":
+		n, err := decodeBase64URL(jwk.N)
+		if err != nil {
+			return result, fmt.Errorf("invalid modulus: %w", err)
+		}
+		e, err := decodeBase64URL(jwk.E)
+		if err != nil {
+			return result, fmt.Errorf("invalid exponent: %w", err)
+		}
+		exponent := new(big.Int).SetBytes(e)
+		if !exponent.IsInt64() || exponent.Int64() < 3 || exponent.Int64() > 1<<31-1 {
+			return result, errors.New("unsupported exponent")
+		}
+		key := &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(exponent.Int64())}
+		if key.N.BitLen() < minRSAKeyBits {
+			return result, fmt.Errorf("key size %d bits is below %d", key.N.BitLen(), minRSAKeyBits)
+		}
+		result.key = key
+	case "EC":
+		if jwk.Crv != "P-256" {
+			return result, fmt.Errorf("unsupported curve %s", jwk.Crv)
+		}
+		x, err := decodeBase64URL(jwk.X)
+		if err != nil || len(x) != 32 {
+			return result, errors.New("invalid x coordinate")
+		}
+		y, err := decodeBase64URL(jwk.Y)
+		if err != nil || len(y) != 32 {
+			return result, errors.New("invalid y coordinate")
+		}
+		// ecdh rejects points outside of curve
+		point := append(append([]byte{4}, x...), y...)
+		if _, err := ecdh.P256().NewPublicKey(point); err != nil {
+			return result, fmt.Errorf("invalid curve point: %w", err)
+		}
+		result.key = &ecdsa.PublicKey{
+			Curve: elliptic.P256(),
+			X:     new(big.Int).SetBytes(x),
+			Y:     new(big.Int).SetBytes(y),
+		}
+	case "oct":
+		secret, err := decodeBase64URL(jwk.K)
+		if err != nil || len(secret) == 0 {
+			return result, errors.New("invalid symmetric key")
+		}
+		result.key = secret
+	default:
+		return result, fmt.Errorf("unsupported key type %s", jwk.Kty)
+	}
+
+	return result, nil
+}
+
+// supportsAlgorithm reports whether key can verify signature of algorithm
+// Сообщает, может ли ключ проверить подпись алгоритма
+func (k verificationKey) supportsAlgorithm(algorithm string) bool {
+	if k.algorithm != "" && k.algorithm != algorithm {
+		return false
+	}
+	switch k.key.(type) {
+	case *rsa.PublicKey:
+		return algorithm == "RS256"
+	case *ecdsa.PublicKey:
+		return algorithm == "ES256"
+	case []byte:
+		return algorithm == "HS256"
+	}
+	return false
+}
+
+// decodeBase64URL decodes base64url value with or without padding
+func decodeBase64URL(value string) ([]byte, error) {
+	return base64.RawURLEncoding.DecodeString(strings.TrimRight(value, "="))
+}
@@ -0,0 +1,472 @@
+/*
+This file is part of the AtomBPMN (R) project.
+Copyright (c) 2025 Matreska Market LLC (ООО «Matreska Market»).
+Authors: Matreska Team.
+
+This project is dual-licensed under AGPL-3.0 and AtomBPMN Commercial License.
+*/
+
+package auth
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/hmac"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"atom-engine/src/core/logger"
+	"atom-engine/src/core/models"
+)
+
+const (
+	// minKeyReload is minimal delay between JWKS reloads caused by unknown key ID
+	minKeyReload = time.Minute
+	// maxJWKSSize limits JWKS response body
+	maxJWKSSize = 1 << 20
+)
+
+var (
+	ErrMalformedToken       = errors.New("malformed token")
+	ErrUnsupportedAlgorithm = errors.New("unsupported signing algorithm")
+	ErrInvalidSignature     = errors.New("invalid signature")
+	ErrTokenExpired         = errors.New("token expired")
+	ErrTokenNotYetValid     = errors.New("token not yet valid")
+	ErrInvalidIssuer        = errors.New("invalid issuer")
+	ErrInvalidAudience      = errors.New("invalid audience")
+)
+
+// JWTIdentity is caller identity taken from validated token claims
+// Идентичность вызывающего из claims проверенного токена
+type JWTIdentity struct {
+	Name        string
+	Permissions []string
+	TenantIDs   []string
+	ExpiresAt   time.Time
+}
+
+// jwtHeader is JOSE header of token
+type jwtHeader struct {
+	Alg string `json:"alg"`
+	Kid string `json:"kid"`
+}
+
+// jwtValidator implements JWTValidator interface
+type jwtValidator struct {
+	config     JWTConfig
+	algorithms map[string]bool
+	clockSkew  time.Duration
+	refresh    time.Duration
+	hmacKey    []byte
+	fileKeys   []verificationKey
+	urlKeys    []verificationKey
+	loadedAt   time.Time
+	mutex      sync.RWMutex
+	client     *http.Client
+	stopCh     chan struct{}
+	wg         sync.WaitGroup
+}
+
+// NewJWTValidator creates JWT validator and loads its verification keys.
+// Unreachable JWKS URL is not fatal, keys are fetched again on next refresh.
+// Создает валидатор JWT и загружает ключи проверки подписи
+func NewJWTValidator(config JWTConfig) (JWTValidator, error) {
+	v := &jwtValidator{
+		config:     config,
+		algorithms: make(map[string]bool),
+		clockSkew:  30 * time.Second,
+		refresh:    15 * time.Minute,
+		client:     &http.Client{Timeout: 10 * time.Second},
+		stopCh:     make(chan struct{}),
+	}
+	for _, algorithm := range config.Algorithms {
+		v.algorithms[algorithm] = true
+	}
+	if skew, err := time.ParseDuration(config.ClockSkew); err == nil {
+		v.clockSkew = skew
+	}
+	if refresh, err := time.ParseDuration(config.JWKSRefresh); err == nil && refresh > 0 {
+		v.refresh = refresh
+	}
+	if config.HMACSecret != "" {
+		v.hmacKey = []byte(config.HMACSecret)
+	}
+
+	if err := v.reloadKeys(); err != nil {
+		// Local file is configuration error, remote JWKS may come up later
+		if len(v.fileKeys) == 0 && config.JWKSFile != "" {
+			return nil, err
+		}
+		logger.Warn("Failed to load JWKS, retrying on next refresh",
+			logger.String("error", err.Error()))
+	}
+
+	return v, nil
+}
+
+// Start starts periodic JWKS refresh
+func (v *jwtValidator) Start() error {
+	if v.config.JWKSURL == "" && v.config.JWKSFile == "" {
+		return nil
+	}
+
+	v.wg.Add(1)
+	go func() {
+		defer v.wg.Done()
+		ticker := time.NewTicker(v.refresh)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				if err := v.reloadKeys(); err != nil {
+					logger.Warn("Failed to refresh JWKS", logger.String("error", err.Error()))
+				}
+			case <-v.stopCh:
+				return
+			}
+		}
+	}()
+
+	logger.Info("JWT validator started",
+		logger.String("jwks_url", v.config.JWKSURL),
+		logger.String("jwks_file", v.config.JWKSFile),
+		logger.String("refresh", v.refresh.String()))
+	return nil
+}
+
+// Stop stops periodic JWKS refresh
+func (v *jwtValidator) Stop() {
+	select {
+	case <-v.stopCh:
+		return
+	default:
+		close(v.stopCh)
+	}
+	v.wg.Wait()
+}
+
+// ValidateToken verifies token signature and claims and returns caller identity
+// Проверяет подпись и claims токена и возвращает идентичность вызывающего
+func (v *jwtValidator) ValidateToken(token string) (*JWTIdentity, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, ErrMalformedToken
+	}
+
+	var header jwtHeader
+	if err := decodeSegment(parts[0], &header); err != nil {
+		return nil, ErrMalformedToken
+	}
+	if !v.algorithms[header.Alg] {
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedAlgorithm, header.Alg)
+	}
+
+	signature, err := decodeBase64URL(parts[2])
+	if err != nil {
+		return nil, ErrMalformedToken
+	}
+	if err := v.verifySignature(header, parts[0]+"."+parts[1], signature); err != nil {
+		return nil, err
+	}
+
+	var claims map[string]interface{}
+	if err := decodeSegment(parts[1], &claims); err != nil {
+		return nil, ErrMalformedToken
+	}
+
+	expiresAt, err := v.validateClaims(claims)
+	if err != nil {
+		return nil, err
+	}
+
+	identity := &JWTIdentity{
+		Name:        "jwt",
+		Permissions: v.mapPermissions(claimValues(claims, v.config.PermissionsClaim)),
+		TenantIDs:   jwtTenantIDs(claimValues(claims, v.config.TenantsClaim)),
+		ExpiresAt:   expiresAt,
+	}
+	if names := claimValues(claims, v.config.NameClaim); len(names) > 0 {
+		identity.Name = names[0]
+	}
+	return identity, nil
+}
+
+// verifySignature checks signature against keys matching header, reloading
+// JWKS once when key ID is unknown to pick up rotated keys
+// Проверяет подпись ключами, подходящими заголовку
+func (v *jwtValidator) verifySignature(header jwtHeader, signingInput string, signature []byte) error {
+	candidates := v.candidateKeys(header)
+	if len(candidates) == 0 && header.Kid != "" && v.reloadAllowed() {
+		if err := v.reloadKeys(); err != nil {
+			logger.Warn("Failed to reload JWKS for unknown key",
+				logger.String("kid", header.Kid),
+				logger.String("error", err.Error()))
+		}
+		candidates = v.candidateKeys(header)
+	}
+
+	digest := sha256.Sum256([]byte(signingInput))
+	for _, key := range candidates {
+		switch pub := key.key.(type) {
+		case *rsa.PublicKey:
+			if rsa.VerifyPKCS1v15(pub, crypto.SHA256, digest[:], signature) == nil {
+				return nil
+			}
+		case *ecdsa.PublicKey:
+			if len(signature) != 64 {
+				continue
+			}
+			r := new(big.Int).SetBytes(signature[:32])
+			s := new(big.Int).SetBytes(signature[32:])
+			if ecdsa.Verify(pub, digest[:], r, s) {
+				return nil
+			}
+		case []byte:
+			mac := hmac.New(sha256.New, pub)
+			mac.Write([]byte(signingInput))
+			if hmac.Equal(mac.Sum(nil), signature) {
+				return nil
+			}
+		}
+	}
+	return ErrInvalidSignature
+}
+
+// candidateKeys returns keys able to verify token with header
+func (v *jwtValidator) candidateKeys(header jwtHeader) []verificationKey {
+	v.mutex.RLock()
+	defer v.mutex.RUnlock()
+
+	var candidates []verificationKey
+	for _, key := range append(append([]verificationKey{}, v.fileKeys...), v.urlKeys...) {
+		if header.Kid != "" && key.id != "" && key.id != header.Kid {
+			continue
+		}
+		if key.supportsAlgorithm(header.Alg) {
+			candidates = append(candidates, key)
+		}
+	}
+	if header.Alg == "HS256" && v.hmacKey != nil {
+		candidates = append(candidates, verificationKey{algorithm: "HS256", key: v.hmacKey})
+	}
+	return candidates
+}
+
+// validateClaims checks expiry, not-before, issuer and audience claims
+// Проверяет claims срока действия, издателя и аудитории
+func (v *jwtValidator) validateClaims(claims map[string]interface{}) (time.Time, error) {
+	now := time.Now()
+
+	exp, ok := claims["exp"].(float64)
+	if !ok {
+		return time.Time{}, fmt.Errorf("%w: missing exp claim", ErrMalformedToken)
+	}
+	expiresAt := time.Unix(int64(exp), 0)
+	if now.After(expiresAt.Add(v.clockSkew)) {
+		return time.Time{}, ErrTokenExpired
+	}
+	if nbf, ok := claims["nbf"].(float64); ok {
+		if now.Add(v.clockSkew).Before(time.Unix(int64(nbf), 0)) {
+			return time.Time{}, ErrTokenNotYetValid
+		}
+	}
+
+	if v.config.Issuer != "" {
+		if issuer, _ := claims["iss"].(string); issuer != v.config.Issuer {
+			return time.Time{}, ErrInvalidIssuer
+		}
+	}
+
+	if len(v.config.Audience) > 0 && !audienceMatches(claims["aud"], v.config.Audience) {
+		return time.Time{}, ErrInvalidAudience
+	}
+
+	return expiresAt, nil
+}
+
+// mapPermissions translates claim values through permission map. Values
+// without map entry grant nothing and are dropped.
+// Преобразует значения claim в разрешения через карту разрешений
+func (v *jwtValidator) mapPermissions(values []string) []string {
+	seen := make(map[string]bool)
+	var permissions []string
+	var unmapped []string
+	for _, value := range values {
+		mapped, ok := v.config.PermissionMap[value]
+		if !ok {
+			unmapped = append(unmapped, value)
+			continue
+		}
+		for _, permission := range mapped {
+			if !seen[permission] {
+				seen[permission] = true
+				permissions = append(permissions, permission)
+			}
+		}
+	}
+
+	if len(unmapped) > 0 {
+		logger.Debug("Dropped JWT permission claim values without permission_map entry",
+			logger.String("values", strings.Join(unmapped, ",")))
+	}
+	return permissions
+}
+
+// jwtTenantIDs returns tenants of token. Missing or empty claim restricts
+// token to default tenant, all tenants require explicit "*".
+// Возвращает арендаторов токена, без claim доступен только арендатор по умолчанию
+func jwtTenantIDs(values []string) []string {
+	if len(values) == 0 {
+		return []string{models.DefaultTenantID}
+	}
+	return values
+}
+
+// reloadKeys loads keys from JWKS file and URL. Keys of source that fails
+// to load stay in use until next successful reload.
+// Загружает ключи из JWKS файла и URL
+func (v *jwtValidator) reloadKeys() error {
+	var errs []error
+	if v.config.JWKSFile != "" {
+		keys, err := v.readJWKSFile()
+		if err != nil {
+			errs = append(errs, err)
+		} else {
+			v.mutex.Lock()
+			v.fileKeys = keys
+			v.mutex.Unlock()
+		}
+	}
+	if v.config.JWKSURL != "" {
+		keys, err := v.fetchJWKS()
+		if err != nil {
+			errs = append(errs, err)
+		} else {
+			v.mutex.Lock()
+			v.urlKeys = keys
+			v.mutex.Unlock()
+		}
+	}
+
+	v.mutex.Lock()
+	v.loadedAt = time.Now()
+	logger.Debug("JWKS loaded", logger.Int("keys", len(v.fileKeys)+len(v.urlKeys)))
+	v.mutex.Unlock()
+	return errors.Join(errs...)
+}
+
+// reloadAllowed reports whether enough time passed since last JWKS load
+func (v *jwtValidator) reloadAllowed() bool {
+	v.mutex.RLock()
+	defer v.mutex.RUnlock()
+	return time.Since(v.loadedAt) >= minKeyReload
+}
+
+// readJWKSFile reads keys from local JWKS file
+func (v *jwtValidator) readJWKSFile() ([]verificationKey, error) {
+	data, err := os.ReadFile(v.config.JWKSFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read JWKS file: %w", err)
+	}
+	return parseJWKS(data)
+}
+
+// fetchJWKS downloads keys from JWKS URL
+func (v *jwtValidator) fetchJWKS() ([]verificationKey, error) {
+	resp, err := v.client.Get(v.config.JWKSURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch JWKS: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to fetch JWKS: unexpected status %d", resp.StatusCode)
+	}
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxJWKSSize))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read JWKS: %w", err)
+	}
+	return parseJWKS(data)
+}
+
+// IsJWT reports whether bearer credential has JWT compact serialization form.
+// API keys never contain dots, so the check does not shadow them.
+// Сообщает, имеет ли bearer значение форму JWT
+func IsJWT(token string) bool {
+	return strings.Count(token, ".") == 2
+}
+
+// decodeSegment decodes base64url JSON segment of token
+func decodeSegment(segment string, target interface{}) error {
+	data, err := decodeBase64URL(segment)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, target)
+}
+
+// claimValues returns string values of claim at dot separated path. String
+// claim is split on spaces as OAuth scope, array claim yields its strings.
+// Возвращает строковые значения claim по пути через точку
+func claimValues(claims map[string]interface{}, path string) []string {
+	if path == "" {
+		return nil
+	}
+
+	var value interface{} = claims
+	for _, segment := range strings.Split(path, ".") {
+		object, ok := value.(map[string]interface{})
+		if !ok {
+			return nil
+		}
+		value = object[segment]
+	}
+
+	switch typed := value.(type) {
+	case string:
+		return strings.Fields(typed)
+	case []interface{}:
+		values := make([]string, 0, len(typed))
+		for _, item := range typed {
+			if text, ok := item.(string); ok {
+				values = append(values, text)
+			}
+		}
+		return values
+	}
+	return nil
+}
+
+// audienceMatches reports whether aud claim contains any accepted audience
+func audienceMatches(aud interface{}, accepted []string) bool {
+	var audiences []string
+	switch typed := aud.(type) {
+	case string:
+		audiences = []string{typed}
+	case []interface{}:
+		for _, item := range typed {
+			if text, ok := item.(string); ok {
+				audiences = append(audiences, text)
+			}
+		}
+	}
+
+	for _, audience := range audiences {
+		for _, expected := range accepted {
+			if audience == expected {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -20,6 +20,10 @@ type (
 	APIKey          = config.APIKeyConfig
 	RateLimitConfig = config.RateLimitConfig
 	AuditConfig     = config.AuditConfig
+	JWTConfig       = config.JWTConfig
+	RoleConfig      = config.RoleConfig
+	Grant           = config.GrantConfig
+	ClientCert      = config.ClientCertConfig
 )
 
 // AuthContext represents protocol-agnostic authentication context
@@ -31,6 +35,10 @@ type AuthContext struct {
 	Method      string
 	Protocol    string // "grpc" or "http"
 	Timestamp   time.Time
+
+	// Verified TLS client certificate, empty without mutual TLS
+	ClientCertSubject    string // RFC 2253 subject
+	ClientCertCommonName string
 }
 
 // AuthResult represents the result of authentication
@@ -38,7 +46,9 @@ type AuthResult struct {
 	Authenticated bool
 	APIKeyName    string
 	Permissions   []string
-	Reason        string // Reason for failure if not authenticated
+	Grants        []Grant  // Resource-scoped grants of bound roles
+	TenantIDs     []string // Accessible tenants from key, certificate or JWT claims, empty = all
+	Reason        string   // Reason for failure if not authenticated
 }
 
 // AuditEvent represents a security audit event
@@ -55,7 +65,7 @@ type AuditEvent struct {
 	Reason      string    `json:"reason,omitempty"`
 }
 
-// Permission constants for common permissions
+// Permission constants for common permissions, also resource types of grants
 const (
 	PermissionAll        = "*"
 	PermissionRead       = "read"
@@ -69,6 +79,8 @@ const (
 	PermissionIncident   = "incident"
 	PermissionExpression = "expression"
 	PermissionBPMN       = "bpmn"
+	PermissionToken      = "token"
+	PermissionSystem     = "system"
 )
 
 // HasPermission checks if the given permissions include the required permission
@@ -0,0 +1,376 @@
+/*
+This file is part of the AtomBPMN (R) project.
+Copyright (c) 2025 Matreska Market LLC (ООО «Matreska Market»).
+Authors: Matreska Team.
+
+This project is dual-licensed under AGPL-3.0 and AtomBPMN Commercial License.
+*/
+
+package calendar
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"atom-engine/src/core/models"
+)
+
+// ErrNoWorkingTime is returned when calendar has no working time ahead
+// Возвращается когда в календаре впереди нет рабочего времени
+var ErrNoWorkingTime = errors.New("business calendar has no working time")
+
+// Defaults for omitted calendar fields
+// Значения по умолчанию для незаданных полей календаря
+var (
+	DefaultWorkingHours = []string{"09:00-18:00"}
+	DefaultWorkingDays  = []string{"mon", "tue", "wed", "thu", "fri"}
+)
+
+// maxIdleDays bounds search through consecutive days without working time
+// Ограничивает поиск по идущим подряд дням без рабочего времени
+const maxIdleDays = 3 * 366
+
+var (
+	namePattern = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9_.-]*$`)
+	timePattern = regexp.MustCompile(`^(\d{1,2}):(\d{2})$`)
+)
+
+var weekdays = map[string]time.Weekday{
+	"sun": time.Sunday, "sunday": time.Sunday,
+	"mon": time.Monday, "monday": time.Monday,
+	"tue": time.Tuesday, "tuesday": time.Tuesday,
+	"wed": time.Wednesday, "wednesday": time.Wednesday,
+	"thu": time.Thursday, "thursday": time.Thursday,
+	"fri": time.Friday, "friday": time.Friday,
+	"sat": time.Saturday, "saturday": time.Saturday,
+}
+
+// span is working period of day in minutes since midnight, end exclusive
+// Рабочий период дня в минутах от полуночи, конец не включается
+type span struct {
+	start int
+	end   int
+}
+
+// Calendar is compiled business calendar
+// Скомпилированный бизнес-календарь
+type Calendar struct {
+	definition *models.BusinessCalendar
+	location   *time.Location
+	week       [7][]span
+	holidays   map[string]bool // "2006-01-02"
+	yearly     map[string]bool // "01-02"
+}
+
+// Compile validates calendar definition and builds calendar
+// Валидирует определение календаря и строит календарь
+func Compile(def *models.BusinessCalendar) (*Calendar, error) {
+	if def == nil {
+		return nil, fmt.Errorf("calendar definition is required")
+	}
+	if !namePattern.MatchString(def.Name) {
+		return nil, fmt.Errorf("invalid calendar name %q", def.Name)
+	}
+
+	location := time.UTC
+	if def.TimeZone != "" {
+		loaded, err := time.LoadLocation(def.TimeZone)
+		if err != nil {
+			return nil, fmt.Errorf("calendar %s: invalid time zone %q: %w", def.Name, def.TimeZone, err)
+		}
+		location = loaded
+	}
+
+	c := &Calendar{
+		definition: def,
+		location:   location,
+		holidays:   make(map[string]bool),
+		yearly:     make(map[string]bool),
+	}
+
+	hoursSpec := def.WorkingHours
+	if len(hoursSpec) == 0 {
+		hoursSpec = DefaultWorkingHours
+	}
+	hours, err := parseSpans(hoursSpec)
+	if err != nil {
+		return nil, fmt.Errorf("calendar %s: working hours: %w", def.Name, err)
+	}
+
+	days := def.WorkingDays
+	if len(days) == 0 {
+		days = DefaultWorkingDays
+	}
+	for _, name := range days {
+		day, ok := weekdays[strings.ToLower(strings.TrimSpace(name))]
+		if !ok {
+			return nil, fmt.Errorf("calendar %s: unknown weekday %q", def.Name, name)
+		}
+		c.week[day] = hours
+	}
+
+	for name, ranges := range def.WeeklySchedule {
+		day, ok := weekdays[strings.ToLower(strings.TrimSpace(name))]
+		if !ok {
+			return nil, fmt.Errorf("calendar %s: unknown weekday %q", def.Name, name)
+		}
+		daySpans, err := parseSpans(ranges)
+		if err != nil {
+			return nil, fmt.Errorf("calendar %s: schedule of %s: %w", def.Name, name, err)
+		}
+		c.week[day] = daySpans
+	}
+
+	working := false
+	for _, daySpans := range c.week {
+		if len(daySpans) > 0 {
+			working = true
+		}
+	}
+	if !working {
+		return nil, fmt.Errorf("calendar %s: %w", def.Name, ErrNoWorkingTime)
+	}
+
+	for _, holiday := range def.Holidays {
+		holiday = strings.TrimSpace(holiday)
+		if _, err := time.Parse("2006-01-02", holiday); err == nil {
+			c.holidays[holiday] = true
+			continue
+		}
+		// Leap year keeps 02-29 valid
+		// Високосный год оставляет 02-29 допустимым
+		if _, err := time.Parse("2006-01-02", "2000-"+holiday); err == nil {
+			c.yearly[holiday] = true
+			continue
+		}
+		return nil, fmt.Errorf("calendar %s: invalid holiday %q, expected YYYY-MM-DD or MM-DD", def.Name, holiday)
+	}
+
+	return c, nil
+}
+
+// parseSpans parses sorted non-overlapping "HH:MM-HH:MM" ranges
+// Парсит отсортированные непересекающиеся диапазоны "HH:MM-HH:MM"
+func parseSpans(ranges []string) ([]span, error) {
+	spans := make([]span, 0, len(ranges))
+	for _, value := range ranges {
+		parts := strings.Split(strings.TrimSpace(value), "-")
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("invalid time range %q, expected HH:MM-HH:MM", value)
+		}
+		start, err := parseClock(parts[0])
+		if err != nil {
+			return nil, fmt.Errorf("invalid time range %q: %w", value, err)
+		}
+		end, err := parseClock(parts[1])
+		if err != nil {
+			return nil, fmt.Errorf("invalid time range %q: %w", value, err)
+		}
+		if end <= start {
+			return nil, fmt.Errorf("invalid time range %q: end must be after start", value)
+		}
+		spans = append(spans, span{start: start, end: end})
+	}
+
+	sort.Slice(spans, func(i, j int) bool { return spans[i].start < spans[j].start })
+	for i := 1; i < len(spans); i++ {
+		if spans[i].start < spans[i-1].end {
+			return nil, fmt.Errorf("time ranges overlap: %v", ranges)
+		}
+	}
+	return spans, nil
+}
+
+// parseClock parses "HH:MM" into minutes since midnight, "24:00" is allowed
+// Парсит "HH:MM" в минуты от полуночи, "24:00" допускается
+func parseClock(value string) (int, error) {
+	matches := timePattern.FindStringSubmatch(strings.TrimSpace(value))
+	if matches == nil {
+		return 0, fmt.Errorf("invalid time %q, expected HH:MM", value)
+	}
+	hours, _ := strconv.Atoi(matches[1])
+	minutes, _ := strconv.Atoi(matches[2])
+	if minutes > 59 || hours > 24 || (hours == 24 && minutes != 0) {
+		return 0, fmt.Errorf("invalid time %q", value)
+	}
+	return hours*60 + minutes, nil
+}
+
+// Name returns calendar name
+// Возвращает имя календаря
+func (c *Calendar) Name() string {
+	return c.definition.Name
+}
+
+// Definition returns calendar definition
+// Возвращает определение календаря
+func (c *Calendar) Definition() *models.BusinessCalendar {
+	return c.definition
+}
+
+// Location returns calendar time zone
+// Возвращает часовой пояс календаря
+func (c *Calendar) Location() *time.Location {
+	return c.location
+}
+
+// day returns local midnight of date offset days after t
+// Возвращает локальную полночь даты через offset дней после t
+func (c *Calendar) day(t time.Time, offset int) time.Time {
+	year, month, date := t.In(c.location).Date()
+	return time.Date(year, month, date+offset, 0, 0, 0, 0, c.location)
+}
+
+// at returns moment minutes after start of day
+// Возвращает момент через minutes после начала дня
+func (c *Calendar) at(day time.Time, minutes int) time.Time {
+	year, month, date := day.Date()
+	return time.Date(year, month, date, 0, minutes, 0, 0, c.location)
+}
+
+// spansOn returns working periods of day, nil for days off and holidays
+// Возвращает рабочие периоды дня, nil для выходных и праздников
+func (c *Calendar) spansOn(day time.Time) []span {
+	if c.holidays[day.Format("2006-01-02")] || c.yearly[day.Format("01-02")] {
+		return nil
+	}
+	return c.week[day.Weekday()]
+}
+
+// IsBusinessDay reports whether date of t has working time
+// Проверяет есть ли рабочее время в дате t
+func (c *Calendar) IsBusinessDay(t time.Time) bool {
+	return len(c.spansOn(c.day(t, 0))) > 0
+}
+
+// IsWorkingTime reports whether t falls into working period
+// Проверяет попадает ли t в рабочий период
+func (c *Calendar) IsWorkingTime(t time.Time) bool {
+	day := c.day(t, 0)
+	for _, s := range c.spansOn(day) {
+		if !t.Before(c.at(day, s.start)) && t.Before(c.at(day, s.end)) {
+			return true
+		}
+	}
+	return false
+}
+
+// NextWorkingTime returns t when it is working time, otherwise start of next working period
+// Возвращает t если это рабочее время, иначе начало следующего рабочего периода
+func (c *Calendar) NextWorkingTime(t time.Time) (time.Time, error) {
+	for offset := 0; offset <= maxIdleDays; offset++ {
+		day := c.day(t, offset)
+		for _, s := range c.spansOn(day) {
+			start, end := c.at(day, s.start), c.at(day, s.end)
+			if !end.After(t) {
+				continue
+			}
+			if start.After(t) {
+				return start, nil
+			}
+			return t, nil
+		}
+	}
+	return time.Time{}, ErrNoWorkingTime
+}
+
+// AddWorkingTime returns moment when d of working time has elapsed since start
+// Возвращает момент когда с start прошло d рабочего времени
+func (c *Calendar) AddWorkingTime(start time.Time, d time.Duration) (time.Time, error) {
+	if d <= 0 {
+		return c.NextWorkingTime(start)
+	}
+
+	remaining := d
+	idle := 0
+	for offset := 0; idle <= maxIdleDays; offset++ {
+		day := c.day(start, offset)
+		spans := c.spansOn(day)
+		if len(spans) == 0 {
+			idle++
+			continue
+		}
+		idle = 0
+
+		for _, s := range spans {
+			from, to := c.at(day, s.start), c.at(day, s.end)
+			if !to.After(start) {
+				continue
+			}
+			if from.Before(start) {
+				from = start
+			}
+			available := to.Sub(from)
+			if remaining <= available {
+				return from.Add(remaining), nil
+			}
+			remaining -= available
+		}
+	}
+	return time.Time{}, ErrNoWorkingTime
+}
+
+// AddBusinessDays moves start to next working time and then n business days
+// ahead keeping time of day, result is moved into working time.
+// Сдвигает start к рабочему времени и затем на n рабочих дней вперед
+func (c *Calendar) AddBusinessDays(start time.Time, n int) (time.Time, error) {
+	current, err := c.NextWorkingTime(start)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if n <= 0 {
+		return current, nil
+	}
+
+	local := current.In(c.location)
+	minutes := local.Hour()*60 + local.Minute()
+	remainder := time.Duration(local.Second())*time.Second + time.Duration(local.Nanosecond())
+
+	idle := 0
+	for offset := 1; idle <= maxIdleDays; offset++ {
+		day := c.day(current, offset)
+		if len(c.spansOn(day)) == 0 {
+			idle++
+			continue
+		}
+		idle = 0
+
+		n--
+		if n == 0 {
+			return c.NextWorkingTime(c.at(day, minutes).Add(remainder))
+		}
+	}
+	return time.Time{}, ErrNoWorkingTime
+}
+
+// NextBusinessDay returns business day after date of t at clock time "HH:MM",
+// empty clock time means start of first working period of that day.
+// Возвращает следующий за датой t рабочий день в указанное время "HH:MM"
+func (c *Calendar) NextBusinessDay(t time.Time, clockTime string) (time.Time, error) {
+	minutes := -1
+	if clockTime != "" {
+		parsed, err := parseClock(clockTime)
+		if err != nil {
+			return time.Time{}, err
+		}
+		minutes = parsed
+	}
+
+	for offset := 1; offset <= maxIdleDays; offset++ {
+		day := c.day(t, offset)
+		spans := c.spansOn(day)
+		if len(spans) == 0 {
+			continue
+		}
+		if minutes < 0 {
+			return c.at(day, spans[0].start), nil
+		}
+		return c.at(day, minutes), nil
+	}
+	return time.Time{}, ErrNoWorkingTime
+}
@@ -0,0 +1,68 @@
+/*
+This file is part of the AtomBPMN (R) project.
+Copyright (c) 2025 Matreska Market LLC (ООО «Matreska Market»).
+Authors: Matreska Team.
+
+This project is dual-licensed under AGPL-3.0 and AtomBPMN Commercial License.
+*/
+
+package calendar
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+)
+
+// ErrNotFound is returned when calendar is not registered
+// Возвращается когда календарь не зарегистрирован
+var ErrNotFound = errors.New("business calendar not found")
+
+var (
+	mu        sync.RWMutex
+	calendars = make(map[string]*Calendar)
+)
+
+// Register adds or replaces calendar in engine registry
+// Добавляет или заменяет календарь в реестре движка
+func Register(c *Calendar) {
+	mu.Lock()
+	defer mu.Unlock()
+	calendars[c.Name()] = c
+}
+
+// Unregister removes calendar from engine registry
+// Удаляет календарь из реестра движка
+func Unregister(name string) {
+	mu.Lock()
+	defer mu.Unlock()
+	delete(calendars, name)
+}
+
+// Get returns registered calendar by name
+// Возвращает зарегистрированный календарь по имени
+func Get(name string) (*Calendar, error) {
+	mu.RLock()
+	defer mu.RUnlock()
+
+	c, ok := calendars[name]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrNotFound, name)
+	}
+	return c, nil
+}
+
+// List returns registered calendars sorted by name
+// Возвращает зарегистрированные календари отсортированные по имени
+func List() []*Calendar {
+	mu.RLock()
+	defer mu.RUnlock()
+
+	result := make([]*Calendar, 0, len(calendars))
+	for _, c := range calendars {
+		result = append(result, c)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Name() < result[j].Name() })
+	return result
+}
@@ -0,0 +1,161 @@
+/*
+This file is part of the AtomBPMN (R) project.
+Copyright (c) 2025 Matreska Market LLC (ООО «Matreska Market»).
+Authors: Matreska Team.
+
+This project is dual-licensed under AGPL-3.0 and AtomBPMN Commercial License.
+*/
+
+package clock
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// Clock modes supported by configuration
+// Режимы часов поддерживаемые конфигурацией
+const (
+	ModeReal   = "real"
+	ModeManual = "manual"
+)
+
+// ErrNotManual is returned when manual clock operation is requested on real clock
+// Возвращается при попытке управлять реальными часами
+var ErrNotManual = errors.New("engine clock is not manual")
+
+// Clock provides engine time
+// Предоставляет время движка
+type Clock interface {
+	Now() time.Time
+}
+
+// Real is wall clock
+// Реальные часы
+type Real struct{}
+
+// Now returns current wall time
+// Возвращает текущее реальное время
+func (Real) Now() time.Time {
+	return time.Now()
+}
+
+// Manual is clock that only moves when advanced explicitly
+// Часы которые двигаются только при явном продвижении
+type Manual struct {
+	mu  sync.RWMutex
+	now time.Time
+}
+
+// NewManual creates manual clock stopped at given time
+// Создает ручные часы остановленные на заданном времени
+func NewManual(start time.Time) *Manual {
+	return &Manual{now: start}
+}
+
+// Now returns current manual time
+// Возвращает текущее ручное время
+func (m *Manual) Now() time.Time {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.now
+}
+
+// Advance moves clock forward by duration and returns new time
+// Продвигает часы вперед на длительность и возвращает новое время
+func (m *Manual) Advance(d time.Duration) (time.Time, error) {
+	if d < 0 {
+		return time.Time{}, fmt.Errorf("cannot move clock backwards by %s", d)
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.now = m.now.Add(d)
+	return m.now, nil
+}
+
+// Set moves clock to given time, clock never goes backwards
+// Переводит часы на заданное время, часы не идут назад
+func (m *Manual) Set(t time.Time) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if t.After(m.now) {
+		m.now = t
+	}
+}
+
+var (
+	mu      sync.RWMutex
+	current Clock = Real{}
+)
+
+// SetDefault replaces engine clock, nil restores wall clock
+// Заменяет часы движка, nil восстанавливает реальные часы
+func SetDefault(c Clock) {
+	mu.Lock()
+	defer mu.Unlock()
+	if c == nil {
+		c = Real{}
+	}
+	current = c
+}
+
+// Default returns engine clock
+// Возвращает часы движка
+func Default() Clock {
+	mu.RLock()
+	defer mu.RUnlock()
+	return current
+}
+
+// ManualClock returns engine clock if it is manual
+// Возвращает часы движка если они ручные
+func ManualClock() (*Manual, error) {
+	manual, ok := Default().(*Manual)
+	if !ok {
+		return nil, ErrNotManual
+	}
+	return manual, nil
+}
+
+// Now returns engine time
+// Возвращает время движка
+func Now() time.Time {
+	return Default().Now()
+}
+
+// Since returns time elapsed since t by engine clock
+// Возвращает время прошедшее с t по часам движка
+func Since(t time.Time) time.Duration {
+	return Now().Sub(t)
+}
+
+// Until returns duration until t by engine clock
+// Возвращает длительность до t по часам движка
+func Until(t time.Time) time.Duration {
+	return t.Sub(Now())
+}
+
+// New creates clock for configuration mode. Manual clock starts at start
+// time in RFC3339 format or at current wall time when start is empty.
+// Создает часы для режима конфигурации
+func New(mode, start string) (Clock, error) {
+	switch mode {
+	case "", ModeReal:
+		return Real{}, nil
+	case ModeManual:
+		startTime := time.Now()
+		if start != "" {
+			parsed, err := time.Parse(time.RFC3339, start)
+			if err != nil {
+				return nil, fmt.Errorf("invalid manual clock start %q: %w", start, err)
+			}
+			startTime = parsed
+		}
+		return NewManual(startTime), nil
+	default:
+		return nil, fmt.Errorf("unknown clock mode: %s", mode)
+	}
+}
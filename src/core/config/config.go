@@ -13,21 +13,33 @@ import (
 	"os"
 	"path/filepath"
 
+	"atom-engine/src/core/models"
+
 	"gopkg.in/yaml.v2"
 )
 
 // Config holds application configuration
 // Содержит конфигурацию приложения
 type Config struct {
-	InstanceName string         `yaml:"instance_name"` // Instance/deployment name
-	BasePath     string         `yaml:"base_path"`     // Base path for all relative paths
-	Database     DatabaseConfig `yaml:"database"`
-	GRPC         GRPCConfig     `yaml:"grpc"`
-	RestAPI      RestAPIConfig  `yaml:"rest_api"`
-	Logger       LoggerConfig   `yaml:"logger"`
-	Storage      StorageConfig  `yaml:"storage"`
-	BPMN         BPMNConfig     `yaml:"bpmn"`
-	Auth         AuthConfig     `yaml:"auth"`
+	InstanceName string                   `yaml:"instance_name"` // Instance/deployment name
+	BasePath     string                   `yaml:"base_path"`     // Base path for all relative paths
+	Database     DatabaseConfig           `yaml:"database"`
+	GRPC         GRPCConfig               `yaml:"grpc"`
+	RestAPI      RestAPIConfig            `yaml:"rest_api"`
+	Logger       LoggerConfig             `yaml:"logger"`
+	Storage      StorageConfig            `yaml:"storage"`
+	BPMN         BPMNConfig               `yaml:"bpmn"`
+	Auth         AuthConfig               `yaml:"auth"`
+	Jobs         JobsConfig               `yaml:"jobs"`
+	Clock        ClockConfig              `yaml:"clock"`
+	Timewheel    TimewheelConfig          `yaml:"timewheel"`
+	Calendars    []BusinessCalendarConfig `yaml:"calendars"`
+	Incidents    IncidentsConfig          `yaml:"incidents"`
+	Batch        BatchConfig              `yaml:"batch"`
+	Tracing      TracingConfig            `yaml:"tracing"`
+	Events       EventsConfig             `yaml:"events"`
+	Export       ExportConfig             `yaml:"export"`
+	Retention    RetentionConfig          `yaml:"retention"`
 }
 
 // DatabaseConfig holds database configuration
@@ -39,15 +51,30 @@ type DatabaseConfig struct {
 // GRPCConfig holds gRPC server configuration
 // Конфигурация gRPC сервера
 type GRPCConfig struct {
-	Port int    `yaml:"port"`
-	Host string `yaml:"host"`
+	Port int       `yaml:"port"`
+	Host string    `yaml:"host"`
+	TLS  TLSConfig `yaml:"tls"`
 }
 
 // RestAPIConfig holds REST API server configuration
 // Конфигурация REST API сервера
 type RestAPIConfig struct {
-	Port int    `yaml:"port"`
-	Host string `yaml:"host"`
+	Port int       `yaml:"port"`
+	Host string    `yaml:"host"`
+	TLS  TLSConfig `yaml:"tls"`
+}
+
+// TLSConfig holds listener TLS configuration, changed files are reloaded without restart
+// Конфигурация TLS слушателя, измененные файлы перечитываются без перезапуска
+type TLSConfig struct {
+	Enabled        bool     `yaml:"enabled"`
+	CertFile       string   `yaml:"cert_file"`       // PEM certificate chain
+	KeyFile        string   `yaml:"key_file"`        // PEM private key
+	MinVersion     string   `yaml:"min_version"`     // "1.2" or "1.3"
+	CipherSuites   []string `yaml:"cipher_suites"`   // TLS 1.2 suite names, empty = Go defaults
+	ClientAuth     string   `yaml:"client_auth"`     // none, optional, require
+	ClientCAFile   string   `yaml:"client_ca_file"`  // CA bundle verifying client certificates
+	ReloadInterval string   `yaml:"reload_interval"` // Check interval for rotated files, e.g. "1m"
 }
 
 // StorageConfig holds storage configuration
@@ -126,11 +153,26 @@ type BPMNConfig struct {
 // AuthConfig holds auth configuration
 // Конфигурация авторизации
 type AuthConfig struct {
-	Enabled      bool            `yaml:"enabled"`
-	AllowedHosts []string        `yaml:"allowed_hosts"`
-	APIKeys      []APIKeyConfig  `yaml:"api_keys"`
-	RateLimit    RateLimitConfig `yaml:"rate_limiting"`
-	Audit        AuditConfig     `yaml:"audit"`
+	Enabled         bool            `yaml:"enabled"`
+	AllowedHosts    []string        `yaml:"allowed_hosts"`
+	APIKeys         []APIKeyConfig  `yaml:"api_keys"`         // Bootstrap keys, managed keys are stored hashed in database
+	RotationOverlap string          `yaml:"rotation_overlap"` // Validity of replaced key after rotation, e.g. "24h"
+	RateLimit       RateLimitConfig `yaml:"rate_limiting"`
+	Audit           AuditConfig     `yaml:"audit"`
+	JWT             JWTConfig       `yaml:"jwt"`
+	Roles           []RoleConfig    `yaml:"roles"` // Resource-scoped roles bound to API keys and JWT subjects
+
+	// Identities of verified TLS client certificates
+	ClientCerts []ClientCertConfig `yaml:"client_certs"`
+}
+
+// ClientCertConfig maps verified TLS client certificate subject to caller identity
+// Сопоставляет subject проверенного клиентского TLS сертификата идентичности
+type ClientCertConfig struct {
+	Subject     string   `yaml:"subject"` // Common name or RFC 2253 subject, "*" wildcard allowed
+	Name        string   `yaml:"name"`    // Identity in logs, audit and role subjects
+	Permissions []string `yaml:"permissions"`
+	Tenants     []string `yaml:"tenants,omitempty"` // Accessible tenants, empty = all
 }
 
 // APIKeyConfig represents an API key configuration
@@ -139,6 +181,42 @@ type APIKeyConfig struct {
 	Name         string   `yaml:"name"`
 	Permissions  []string `yaml:"permissions"`
 	AllowedHosts []string `yaml:"allowed_hosts,omitempty"`
+	Tenants      []string `yaml:"tenants,omitempty"` // Accessible tenants, empty = all
+}
+
+// RoleConfig defines named set of resource grants and its bindings
+// Именованный набор разрешений на ресурсы и его привязки
+type RoleConfig struct {
+	Name     string        `yaml:"name"`
+	Grants   []GrantConfig `yaml:"grants"`
+	APIKeys  []string      `yaml:"api_keys"` // API key names, managed keys keep name across rotation
+	Subjects []string      `yaml:"subjects"` // JWT subjects (jwt.name_claim) and client_certs names
+}
+
+// GrantConfig allows actions on resources of one type matching ID patterns
+// Разрешает действия над ресурсами одного типа по шаблонам ID
+type GrantConfig struct {
+	Resource string   `yaml:"resource"` // process, bpmn, job, message, incident, timer, token, ... or "*"
+	IDs      []string `yaml:"ids"`      // ID patterns with "*" wildcard, empty = all
+	Actions  []string `yaml:"actions"`  // create, read, update, delete, complete, resolve, deploy or "*"
+}
+
+// JWTConfig holds JWT/OIDC bearer token validation configuration
+// Конфигурация проверки JWT/OIDC bearer токенов
+type JWTConfig struct {
+	Enabled          bool                `yaml:"enabled"`
+	Issuer           string              `yaml:"issuer"`            // Required iss claim, empty = not checked
+	Audience         []string            `yaml:"audience"`          // Accepted aud values, empty = not checked
+	Algorithms       []string            `yaml:"algorithms"`        // RS256, ES256, HS256
+	JWKSURL          string              `yaml:"jwks_url"`          // Remote JWKS, e.g. OIDC provider jwks_uri
+	JWKSFile         string              `yaml:"jwks_file"`         // Local JWKS file
+	JWKSRefresh      string              `yaml:"jwks_refresh"`      // JWKS reload interval, e.g. "15m"
+	HMACSecret       string              `yaml:"hmac_secret"`       // Shared HS256 secret
+	ClockSkew        string              `yaml:"clock_skew"`        // Leeway for exp and nbf checks
+	NameClaim        string              `yaml:"name_claim"`        // Claim identifying caller in logs and audit
+	PermissionsClaim string              `yaml:"permissions_claim"` // Dot separated path, e.g. "realm_access.roles"
+	TenantsClaim     string              `yaml:"tenants_claim"`     // Dot separated path of tenant IDs, default tenant when missing
+	PermissionMap    map[string][]string `yaml:"permission_map"`    // Claim value to engine permissions, unmapped values dropped
 }
 
 // RateLimitConfig represents rate limiting configuration
@@ -154,6 +232,244 @@ type AuditConfig struct {
 	LogSuccessfulAuth bool `yaml:"log_successful_auth"`
 }
 
+// JobsConfig holds job activation scheduling configuration
+// Конфигурация планирования активации заданий
+type JobsConfig struct {
+	SchedulingPolicy string                        `yaml:"scheduling_policy"` // fair, fifo
+	TypeLimits       map[string]JobTypeLimitConfig `yaml:"type_limits,omitempty"`
+	RetryBackoff     RetryBackoffConfig            `yaml:"retry_backoff"`
+	MaxPayloadSize   int                           `yaml:"max_payload_size"` // Bytes of job variables JSON, negative = unlimited
+}
+
+// RetryBackoffConfig holds engine default delay between job retries
+// Конфигурация задержки между повторами заданий по умолчанию
+type RetryBackoffConfig struct {
+	Policy       string  `yaml:"policy"`        // fixed, linear, exponential
+	InitialDelay string  `yaml:"initial_delay"` // Delay before first retry, e.g. "5s"
+	MaxDelay     string  `yaml:"max_delay"`     // Upper bound for computed delay
+	Multiplier   float64 `yaml:"multiplier"`    // Growth factor for exponential policy
+	Jitter       float64 `yaml:"jitter"`        // Random spread as fraction of delay, 0..1
+}
+
+// ClockConfig holds engine clock configuration
+// Конфигурация часов движка
+type ClockConfig struct {
+	Mode  string `yaml:"mode"`  // real, manual
+	Start string `yaml:"start"` // Initial manual time in RFC3339, empty = daemon start time
+}
+
+// TimewheelConfig holds timing wheel configuration
+// Конфигурация timing wheel
+type TimewheelConfig struct {
+	Resolution string `yaml:"resolution"` // Level 0 tick, e.g. "10ms", must divide 1s
+}
+
+// BusinessCalendarConfig holds named business calendar definition
+// Определение именованного бизнес-календаря
+type BusinessCalendarConfig struct {
+	Name           string              `yaml:"name"`
+	TimeZone       string              `yaml:"time_zone"`       // IANA zone, empty = UTC
+	WorkingHours   []string            `yaml:"working_hours"`   // "09:00-18:00" ranges, empty = 09:00-18:00
+	WorkingDays    []string            `yaml:"working_days"`    // mon..sun, empty = mon-fri
+	WeeklySchedule map[string][]string `yaml:"weekly_schedule"` // Per weekday ranges, empty list = day off
+	Holidays       []string            `yaml:"holidays"`        // "2006-01-02" or yearly "01-02"
+}
+
+// Definition converts calendar configuration to business calendar model
+// Преобразует конфигурацию календаря в модель бизнес-календаря
+func (bc BusinessCalendarConfig) Definition() *models.BusinessCalendar {
+	return &models.BusinessCalendar{
+		Name:           bc.Name,
+		TimeZone:       bc.TimeZone,
+		WorkingHours:   bc.WorkingHours,
+		WorkingDays:    bc.WorkingDays,
+		WeeklySchedule: bc.WeeklySchedule,
+		Holidays:       bc.Holidays,
+		Source:         models.CalendarSourceConfig,
+	}
+}
+
+// IncidentsConfig holds incidents component configuration
+// Конфигурация компонента инцидентов
+type IncidentsConfig struct {
+	Notifications IncidentNotificationsConfig `yaml:"notifications"`
+	AutoRetry     []IncidentRetryRuleConfig   `yaml:"auto_retry"`
+}
+
+// IncidentRetryRuleConfig holds automatic incident retry rule, first matching rule applies
+// Правило автоматического повтора инцидентов, применяется первое подходящее правило
+type IncidentRetryRuleConfig struct {
+	Name         string   `yaml:"name"`
+	Types        []string `yaml:"types"`         // Incident types, empty = all
+	JobTypes     []string `yaml:"job_types"`     // Job types, empty = all
+	ProcessKeys  []string `yaml:"process_keys"`  // BPMN process IDs or versioned keys, empty = all
+	ElementIDs   []string `yaml:"element_ids"`   // BPMN element IDs, empty = all
+	ErrorCodes   []string `yaml:"error_codes"`   // Exact error codes, empty = all
+	ErrorPattern string   `yaml:"error_pattern"` // Regular expression over error code and message
+	MaxAttempts  int      `yaml:"max_attempts"`  // Retries before incident is escalated
+	Interval     string   `yaml:"interval"`      // Delay before each retry, e.g. "5m" or "PT5M"
+	JobRetries   int      `yaml:"job_retries"`   // Job retries granted per attempt, default 1
+}
+
+// IncidentNotificationsConfig holds incident notifier targets and delivery settings
+// Конфигурация получателей уведомлений об инцидентах и доставки
+type IncidentNotificationsConfig struct {
+	Webhooks     []IncidentWebhookConfig `yaml:"webhooks"`
+	Email        []IncidentEmailConfig   `yaml:"email"`
+	MaxAttempts  int                     `yaml:"max_attempts"`  // Delivery attempts before notification is dropped
+	InitialDelay string                  `yaml:"initial_delay"` // Delay before first redelivery, doubled per attempt
+	MaxDelay     string                  `yaml:"max_delay"`     // Upper bound for redelivery delay
+	DedupWindow  string                  `yaml:"dedup_window"`  // Repeated incidents per element within window are suppressed, "0s" disables
+	RateLimit    int                     `yaml:"rate_limit"`    // Notifications per minute per notifier, 0 = unlimited
+}
+
+// IncidentNotifierFilterConfig selects incidents delivered to notifier
+// Выбирает инциденты доставляемые получателю
+type IncidentNotifierFilterConfig struct {
+	Events      []string `yaml:"events"`       // created, resolved, dismissed; empty = all
+	Types       []string `yaml:"types"`        // Incident types, empty = all
+	ProcessKeys []string `yaml:"process_keys"` // BPMN process IDs, empty = all
+	ErrorCodes  []string `yaml:"error_codes"`  // Error codes, empty = all
+}
+
+// IncidentWebhookConfig holds HTTP webhook notifier target
+// Конфигурация HTTP webhook получателя уведомлений
+type IncidentWebhookConfig struct {
+	Name    string                       `yaml:"name"`
+	URL     string                       `yaml:"url"`
+	Secret  string                       `yaml:"secret"`  // HMAC-SHA256 key, empty = unsigned
+	Timeout string                       `yaml:"timeout"` // Request timeout, e.g. "10s"
+	Headers map[string]string            `yaml:"headers,omitempty"`
+	Filter  IncidentNotifierFilterConfig `yaml:"filter"`
+}
+
+// IncidentEmailConfig holds SMTP email notifier target
+// Конфигурация SMTP email получателя уведомлений
+type IncidentEmailConfig struct {
+	Name     string                       `yaml:"name"`
+	Host     string                       `yaml:"host"`
+	Port     int                          `yaml:"port"`
+	Protocol string                       `yaml:"protocol"` // NONE, TLS, SSL, STARTTLS
+	Username string                       `yaml:"username"` // Empty = no authentication
+	Password string                       `yaml:"password"`
+	From     string                       `yaml:"from"`
+	To       string                       `yaml:"to"` // Comma separated recipients
+	Filter   IncidentNotifierFilterConfig `yaml:"filter"`
+}
+
+// BatchConfig holds batch operations execution settings
+// Конфигурация выполнения пакетных операций
+type BatchConfig struct {
+	ChunkSize   int    `yaml:"chunk_size"`   // Items processed per chunk
+	ChunkDelay  string `yaml:"chunk_delay"`  // Pause between chunks, e.g. "100ms"
+	MaxFailures int    `yaml:"max_failures"` // Per-item failures recorded per batch
+}
+
+// TracingConfig holds OpenTelemetry trace export configuration
+// Конфигурация экспорта трассировки OpenTelemetry
+type TracingConfig struct {
+	Enabled     bool              `yaml:"enabled"`
+	Exporter    string            `yaml:"exporter"`          // otlp-grpc, otlp-http, file
+	Endpoint    string            `yaml:"endpoint"`          // Collector host:port for OTLP exporters
+	Insecure    bool              `yaml:"insecure"`          // Plain text connection to collector
+	Headers     map[string]string `yaml:"headers,omitempty"` // Extra OTLP request headers
+	File        string            `yaml:"file"`              // Span output of file exporter, JSON per line
+	ServiceName string            `yaml:"service_name"`      // Resource service.name, empty = instance name
+	SampleRatio float64           `yaml:"sample_ratio"`      // Fraction of new traces sampled, 0 = all
+}
+
+// EventsConfig holds engine event stream settings
+// Конфигурация потока событий движка
+type EventsConfig struct {
+	HistorySize       int    `yaml:"history_size"`       // Recent events kept for resumption by sequence
+	SubscriberBuffer  int    `yaml:"subscriber_buffer"`  // Pending events before slow subscriber is dropped
+	HeartbeatInterval string `yaml:"heartbeat_interval"` // Keep-alive of idle streams, e.g. "15s"
+}
+
+// ExportConfig holds export log and outbound exporter settings
+// Конфигурация журнала экспорта и внешних экспортеров
+type ExportConfig struct {
+	CompactionInterval string           `yaml:"compaction_interval"` // Removes records acked by all exporters, "0s" = off
+	Exporters          []ExporterConfig `yaml:"exporters"`
+}
+
+// ExporterConfig configures single exporter of export log records
+// Конфигурация одного экспортера записей журнала экспорта
+type ExporterConfig struct {
+	ID              string              `yaml:"id"`                // Unique, keys acknowledged position
+	Type            string              `yaml:"type"`              // file, http, kafka or registered custom type
+	BatchSize       int                 `yaml:"batch_size"`        // Records passed to exporter at once
+	FlushInterval   string              `yaml:"flush_interval"`    // Wait for full batch at most, "0s" exports at once
+	RetryBackoff    string              `yaml:"retry_backoff"`     // Delay after failed export, doubled per failure
+	MaxRetryBackoff string              `yaml:"max_retry_backoff"` // Upper bound for retry delay
+	File            FileExporterConfig  `yaml:"file"`
+	HTTP            HTTPExporterConfig  `yaml:"http"`
+	Kafka           KafkaExporterConfig `yaml:"kafka"`
+	Args            map[string]string   `yaml:"args,omitempty"` // Settings of custom exporter types
+}
+
+// FileExporterConfig holds JSON lines file exporter settings
+// Конфигурация файлового экспортера JSON lines
+type FileExporterConfig struct {
+	Path     string `yaml:"path"`      // Active file, rotated files get timestamp suffix
+	MaxSize  int64  `yaml:"max_size"`  // Size in MB that triggers rotation
+	MaxFiles int    `yaml:"max_files"` // Rotated files kept, 0 = all
+}
+
+// HTTPExporterConfig holds HTTP batch exporter settings
+// Конфигурация HTTP экспортера пакетов
+type HTTPExporterConfig struct {
+	URL         string            `yaml:"url"`
+	Headers     map[string]string `yaml:"headers,omitempty"`
+	Secret      string            `yaml:"secret"`       // HMAC-SHA256 key, empty = unsigned
+	Timeout     string            `yaml:"timeout"`      // Request timeout, e.g. "10s"
+	MaxAttempts int               `yaml:"max_attempts"` // Requests per batch before export fails
+	RetryDelay  string            `yaml:"retry_delay"`  // Delay before second request, doubled per attempt
+}
+
+// KafkaExporterConfig holds Kafka producer exporter settings
+// Конфигурация экспортера Kafka producer
+type KafkaExporterConfig struct {
+	Brokers      []string `yaml:"brokers"` // host:port bootstrap addresses
+	Topic        string   `yaml:"topic"`
+	ClientID     string   `yaml:"client_id"`
+	RequiredAcks string   `yaml:"required_acks"` // all, one, none
+	Compression  string   `yaml:"compression"`   // none, gzip, snappy, lz4, zstd
+	Timeout      string   `yaml:"timeout"`       // Produce request timeout, e.g. "10s"
+}
+
+// RetentionConfig holds history retention policies. Periods are Go durations
+// like "720h", empty or "0s" keeps records forever.
+// Конфигурация политик хранения истории
+type RetentionConfig struct {
+	Enabled             bool                     `yaml:"enabled"`
+	Interval            string                   `yaml:"interval"`             // Cleanup period, first cleanup runs at start
+	BatchSize           int                      `yaml:"batch_size"`           // Finished instances selected per cleanup pass
+	ProcessInstances    string                   `yaml:"process_instances"`    // Finished instances with all their records
+	Jobs                string                   `yaml:"jobs"`                 // Completed, canceled and error thrown jobs
+	Incidents           string                   `yaml:"incidents"`            // Resolved and dismissed incidents
+	MessageCorrelations string                   `yaml:"message_correlations"` // Message correlation results
+	SystemEvents        string                   `yaml:"system_events"`        // Engine startup and shutdown events
+	Processes           []ProcessRetentionConfig `yaml:"processes"`            // Per process overrides of process_instances
+	Archive             FileExporterConfig       `yaml:"archive"`              // Records are archived here before deletion, empty path = off
+}
+
+// ProcessRetentionConfig overrides instance retention of single process
+// Переопределяет хранение экземпляров одного процесса
+type ProcessRetentionConfig struct {
+	ProcessID        string `yaml:"process_id"`
+	TenantID         string `yaml:"tenant_id,omitempty"` // Empty matches every tenant, "<default>" default tenant
+	ProcessInstances string `yaml:"process_instances"`
+}
+
+// JobTypeLimitConfig holds activation limits for a single job type
+// Лимиты активации для одного типа заданий
+type JobTypeLimitConfig struct {
+	MaxConcurrent        int     `yaml:"max_concurrent"`         // Maximum RUNNING jobs, 0 = unlimited
+	ActivationsPerSecond float64 `yaml:"activations_per_second"` // Activation rate, 0 = unlimited
+	Burst                int     `yaml:"burst,omitempty"`        // Activations allowed at once, defaults to rate
+}
+
 // LoadConfig loads configuration from YAML file
 // Загружает конфигурацию из YAML файла
 func LoadConfig(path string) (*Config, error) {
@@ -219,6 +535,19 @@ func setDefaults(config *Config) {
 		config.RestAPI.Port = 27555
 	}
 
+	// TLS defaults
+	for _, tlsConfig := range []*TLSConfig{&config.GRPC.TLS, &config.RestAPI.TLS} {
+		if tlsConfig.MinVersion == "" {
+			tlsConfig.MinVersion = "1.2"
+		}
+		if tlsConfig.ClientAuth == "" {
+			tlsConfig.ClientAuth = "none"
+		}
+		if tlsConfig.ReloadInterval == "" {
+			tlsConfig.ReloadInterval = "1m"
+		}
+	}
+
 	// Database defaults
 	if config.Database.Path == "" {
 		config.Database.Path = "data/badger"
@@ -270,6 +599,199 @@ func setDefaults(config *Config) {
 	if config.Auth.RateLimit.RequestsPerMinute == 0 {
 		config.Auth.RateLimit.RequestsPerMinute = 100 // Default 100 requests per minute
 	}
+
+	if config.Auth.RotationOverlap == "" {
+		config.Auth.RotationOverlap = "24h"
+	}
+
+	// JWT defaults
+	jwt := &config.Auth.JWT
+	if len(jwt.Algorithms) == 0 {
+		jwt.Algorithms = []string{"RS256", "ES256"}
+		if jwt.HMACSecret != "" {
+			jwt.Algorithms = append(jwt.Algorithms, "HS256")
+		}
+	}
+	if jwt.JWKSRefresh == "" {
+		jwt.JWKSRefresh = "15m"
+	}
+	if jwt.ClockSkew == "" {
+		jwt.ClockSkew = "30s"
+	}
+	if jwt.NameClaim == "" {
+		jwt.NameClaim = "sub"
+	}
+	if jwt.PermissionsClaim == "" {
+		jwt.PermissionsClaim = "permissions"
+	}
+	if jwt.TenantsClaim == "" {
+		jwt.TenantsClaim = "tenants"
+	}
+
+	// Jobs defaults
+	if config.Jobs.SchedulingPolicy == "" {
+		config.Jobs.SchedulingPolicy = "fair"
+	}
+
+	// Job retry backoff defaults
+	if config.Jobs.RetryBackoff.Policy == "" {
+		config.Jobs.RetryBackoff.Policy = "exponential"
+	}
+	if config.Jobs.RetryBackoff.InitialDelay == "" {
+		config.Jobs.RetryBackoff.InitialDelay = "5s"
+	}
+	if config.Jobs.RetryBackoff.MaxDelay == "" {
+		config.Jobs.RetryBackoff.MaxDelay = "10m"
+	}
+	if config.Jobs.RetryBackoff.Multiplier == 0 {
+		config.Jobs.RetryBackoff.Multiplier = 2
+	}
+	if config.Jobs.MaxPayloadSize == 0 {
+		config.Jobs.MaxPayloadSize = 4 * 1024 * 1024 // 4 MiB
+	}
+
+	// Clock defaults
+	if config.Clock.Mode == "" {
+		config.Clock.Mode = "real"
+	}
+
+	// Timewheel defaults
+	if config.Timewheel.Resolution == "" {
+		config.Timewheel.Resolution = "1s"
+	}
+
+	// Incident notification defaults
+	notifications := &config.Incidents.Notifications
+	if notifications.MaxAttempts == 0 {
+		notifications.MaxAttempts = 10
+	}
+	if notifications.InitialDelay == "" {
+		notifications.InitialDelay = "5s"
+	}
+	if notifications.MaxDelay == "" {
+		notifications.MaxDelay = "10m"
+	}
+	if notifications.DedupWindow == "" {
+		notifications.DedupWindow = "5m"
+	}
+	for i := range notifications.Webhooks {
+		if notifications.Webhooks[i].Name == "" {
+			notifications.Webhooks[i].Name = fmt.Sprintf("webhook-%d", i+1)
+		}
+		if notifications.Webhooks[i].Timeout == "" {
+			notifications.Webhooks[i].Timeout = "10s"
+		}
+	}
+	for i := range notifications.Email {
+		if notifications.Email[i].Name == "" {
+			notifications.Email[i].Name = fmt.Sprintf("email-%d", i+1)
+		}
+		if notifications.Email[i].Port == 0 {
+			notifications.Email[i].Port = 25
+		}
+	}
+
+	// Batch operation defaults
+	if config.Batch.ChunkSize == 0 {
+		config.Batch.ChunkSize = 100
+	}
+	if config.Batch.ChunkDelay == "" {
+		config.Batch.ChunkDelay = "100ms"
+	}
+	if config.Batch.MaxFailures == 0 {
+		config.Batch.MaxFailures = 1000
+	}
+
+	// Tracing defaults
+	if config.Tracing.Exporter == "" {
+		config.Tracing.Exporter = "otlp-grpc"
+	}
+	if config.Tracing.Endpoint == "" {
+		switch config.Tracing.Exporter {
+		case "otlp-http":
+			config.Tracing.Endpoint = "localhost:4318"
+		default:
+			config.Tracing.Endpoint = "localhost:4317"
+		}
+	}
+	if config.Tracing.File == "" {
+		config.Tracing.File = "logs/traces.jsonl"
+	}
+	if config.Tracing.ServiceName == "" {
+		config.Tracing.ServiceName = config.InstanceName
+	}
+	if config.Tracing.SampleRatio == 0 {
+		config.Tracing.SampleRatio = 1
+	}
+
+	// Event stream defaults
+	if config.Events.HistorySize == 0 {
+		config.Events.HistorySize = 10000
+	}
+	if config.Events.SubscriberBuffer == 0 {
+		config.Events.SubscriberBuffer = 1000
+	}
+	if config.Events.HeartbeatInterval == "" {
+		config.Events.HeartbeatInterval = "15s"
+	}
+
+	// Export defaults
+	if config.Export.CompactionInterval == "" {
+		config.Export.CompactionInterval = "5m"
+	}
+	for i := range config.Export.Exporters {
+		exporter := &config.Export.Exporters[i]
+		if exporter.ID == "" {
+			exporter.ID = fmt.Sprintf("%s-%d", exporter.Type, i+1)
+		}
+		if exporter.BatchSize == 0 {
+			exporter.BatchSize = 100
+		}
+		if exporter.FlushInterval == "" {
+			exporter.FlushInterval = "1s"
+		}
+		if exporter.RetryBackoff == "" {
+			exporter.RetryBackoff = "1s"
+		}
+		if exporter.MaxRetryBackoff == "" {
+			exporter.MaxRetryBackoff = "1m"
+		}
+		if exporter.File.MaxSize == 0 {
+			exporter.File.MaxSize = 100
+		}
+		if exporter.HTTP.Timeout == "" {
+			exporter.HTTP.Timeout = "10s"
+		}
+		if exporter.HTTP.MaxAttempts == 0 {
+			exporter.HTTP.MaxAttempts = 3
+		}
+		if exporter.HTTP.RetryDelay == "" {
+			exporter.HTTP.RetryDelay = "500ms"
+		}
+		if exporter.Kafka.ClientID == "" {
+			exporter.Kafka.ClientID = "atom-engine"
+		}
+		if exporter.Kafka.RequiredAcks == "" {
+			exporter.Kafka.RequiredAcks = "all"
+		}
+		if exporter.Kafka.Compression == "" {
+			exporter.Kafka.Compression = "none"
+		}
+		if exporter.Kafka.Timeout == "" {
+			exporter.Kafka.Timeout = "10s"
+		}
+	}
+
+	// Retention defaults
+	if config.Retention.Interval == "" {
+		config.Retention.Interval = "1h"
+	}
+	if config.Retention.BatchSize == 0 {
+		config.Retention.BatchSize = 10000
+	}
+	if config.Retention.Archive.MaxSize == 0 {
+		config.Retention.Archive.MaxSize = 100
+	}
 }
 
 // resolvePaths resolves relative paths based on base path
@@ -294,4 +816,31 @@ func resolvePaths(config *Config) {
 	if !filepath.IsAbs(config.BPMN.Path) {
 		config.BPMN.Path = filepath.Join(config.BasePath, config.BPMN.Path)
 	}
+
+	// Resolve JWKS file
+	if config.Auth.JWT.JWKSFile != "" && !filepath.IsAbs(config.Auth.JWT.JWKSFile) {
+		config.Auth.JWT.JWKSFile = filepath.Join(config.BasePath, config.Auth.JWT.JWKSFile)
+	}
+
+	// Resolve TLS files
+	for _, tlsConfig := range []*TLSConfig{&config.GRPC.TLS, &config.RestAPI.TLS} {
+		for _, path := range []*string{&tlsConfig.CertFile, &tlsConfig.KeyFile, &tlsConfig.ClientCAFile} {
+			if *path != "" && !filepath.IsAbs(*path) {
+				*path = filepath.Join(config.BasePath, *path)
+			}
+		}
+	}
+
+	// Resolve trace file
+	if !filepath.IsAbs(config.Tracing.File) {
+		config.Tracing.File = filepath.Join(config.BasePath, config.Tracing.File)
+	}
+
+	// Resolve file exporter paths
+	for i := range config.Export.Exporters {
+		file := &config.Export.Exporters[i].File
+		if file.Path != "" && !filepath.IsAbs(file.Path) {
+			file.Path = filepath.Join(config.BasePath, file.Path)
+		}
+	}
 }
@@ -91,6 +91,19 @@ func (c *Config) LoadFromEnv() {
 	if env := os.Getenv("ATOM_LOGGER_ENABLE_CONSOLE"); env != "" {
 		c.Logger.EnableConsole = strings.ToLower(env) == "true"
 	}
+
+	// Clock configuration
+	if env := os.Getenv("ATOM_CLOCK_MODE"); env != "" {
+		c.Clock.Mode = strings.ToLower(env)
+	}
+	if env := os.Getenv("ATOM_CLOCK_START"); env != "" {
+		c.Clock.Start = env
+	}
+
+	// Timewheel configuration
+	if env := os.Getenv("ATOM_TIMEWHEEL_RESOLUTION"); env != "" {
+		c.Timewheel.Resolution = env
+	}
 }
 
 // GetConfigPath returns configuration file path from environment or searches in common locations
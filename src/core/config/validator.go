@@ -9,9 +9,15 @@ This project is dual-licensed under AGPL-3.0 and AtomBPMN Commercial License.
 package config
 
 import (
+	"crypto/tls"
 	"fmt"
 	"os"
+	"regexp"
 	"strings"
+	"time"
+
+	"atom-engine/src/core/calendar"
+	"atom-engine/src/core/duration"
 )
 
 // Validate validates the configuration
@@ -41,6 +47,50 @@ func (c *Config) Validate() error {
 		return fmt.Errorf("logger validation failed: %w", err)
 	}
 
+	if err := c.validateAuth(); err != nil {
+		return fmt.Errorf("auth validation failed: %w", err)
+	}
+
+	if err := c.validateJobs(); err != nil {
+		return fmt.Errorf("jobs validation failed: %w", err)
+	}
+
+	if err := c.validateClock(); err != nil {
+		return fmt.Errorf("clock validation failed: %w", err)
+	}
+
+	if err := c.validateTimewheel(); err != nil {
+		return fmt.Errorf("timewheel validation failed: %w", err)
+	}
+
+	if err := c.validateCalendars(); err != nil {
+		return fmt.Errorf("calendars validation failed: %w", err)
+	}
+
+	if err := c.validateIncidents(); err != nil {
+		return fmt.Errorf("incidents validation failed: %w", err)
+	}
+
+	if err := c.validateBatch(); err != nil {
+		return fmt.Errorf("batch validation failed: %w", err)
+	}
+
+	if err := c.validateTracing(); err != nil {
+		return fmt.Errorf("tracing validation failed: %w", err)
+	}
+
+	if err := c.validateEvents(); err != nil {
+		return fmt.Errorf("events validation failed: %w", err)
+	}
+
+	if err := c.validateExport(); err != nil {
+		return fmt.Errorf("export validation failed: %w", err)
+	}
+
+	if err := c.validateRetention(); err != nil {
+		return fmt.Errorf("retention validation failed: %w", err)
+	}
+
 	if err := c.validatePortConflicts(); err != nil {
 		return fmt.Errorf("port conflicts detected: %w", err)
 	}
@@ -76,7 +126,7 @@ func (c *Config) validateGRPC() error {
 		return fmt.Errorf("grpc host cannot be empty")
 	}
 
-	return nil
+	return validateTLS("grpc", c.GRPC.TLS)
 }
 
 // validateRestAPI validates REST API configuration
@@ -90,7 +140,7 @@ func (c *Config) validateRestAPI() error {
 		return fmt.Errorf("rest_api host cannot be empty")
 	}
 
-	return nil
+	return validateTLS("rest_api", c.RestAPI.TLS)
 }
 
 // validateDatabase validates database configuration
@@ -171,6 +221,66 @@ func (c *Config) validateLogger() error {
 	return nil
 }
 
+// validateJobs validates job scheduling configuration
+// Валидирует конфигурацию планирования заданий
+func (c *Config) validateJobs() error {
+	validPolicies := []string{"fair", "fifo"}
+	valid := false
+	for _, policy := range validPolicies {
+		if strings.ToLower(c.Jobs.SchedulingPolicy) == policy {
+			valid = true
+			break
+		}
+	}
+	if !valid {
+		return fmt.Errorf("jobs scheduling_policy must be one of %v, got %s", validPolicies, c.Jobs.SchedulingPolicy)
+	}
+
+	for jobType, limit := range c.Jobs.TypeLimits {
+		if limit.MaxConcurrent < 0 {
+			return fmt.Errorf("jobs type_limits %s: max_concurrent cannot be negative", jobType)
+		}
+		if limit.ActivationsPerSecond < 0 {
+			return fmt.Errorf("jobs type_limits %s: activations_per_second cannot be negative", jobType)
+		}
+		if limit.Burst < 0 {
+			return fmt.Errorf("jobs type_limits %s: burst cannot be negative", jobType)
+		}
+	}
+
+	backoff := c.Jobs.RetryBackoff
+	validBackoffPolicies := []string{"fixed", "linear", "exponential"}
+	valid = false
+	for _, policy := range validBackoffPolicies {
+		if strings.ToLower(backoff.Policy) == policy {
+			valid = true
+			break
+		}
+	}
+	if !valid {
+		return fmt.Errorf("jobs retry_backoff policy must be one of %v, got %s", validBackoffPolicies, backoff.Policy)
+	}
+
+	// Same syntax as retry backoff task headers
+	initialDelay, err := duration.Parse(backoff.InitialDelay)
+	if err != nil || initialDelay < 0 {
+		return fmt.Errorf("jobs retry_backoff initial_delay must be a non-negative duration, got %s", backoff.InitialDelay)
+	}
+	maxDelay, err := duration.Parse(backoff.MaxDelay)
+	if err != nil || maxDelay < initialDelay {
+		return fmt.Errorf("jobs retry_backoff max_delay must be a duration not less than initial_delay, got %s",
+			backoff.MaxDelay)
+	}
+	if backoff.Multiplier < 1 {
+		return fmt.Errorf("jobs retry_backoff multiplier must be at least 1, got %v", backoff.Multiplier)
+	}
+	if backoff.Jitter < 0 || backoff.Jitter > 1 {
+		return fmt.Errorf("jobs retry_backoff jitter must be between 0 and 1, got %v", backoff.Jitter)
+	}
+
+	return nil
+}
+
 // validatePortConflicts checks for port conflicts
 // Проверяет конфликты портов
 func (c *Config) validatePortConflicts() error {
@@ -192,3 +302,490 @@ func (c *Config) validatePortConflicts() error {
 
 	return nil
 }
+
+// validateClock validates engine clock configuration
+// Валидирует конфигурацию часов движка
+func (c *Config) validateClock() error {
+	switch strings.ToLower(c.Clock.Mode) {
+	case "real", "manual":
+	default:
+		return fmt.Errorf("clock mode must be one of [real manual], got %s", c.Clock.Mode)
+	}
+
+	if c.Clock.Start != "" {
+		if _, err := time.Parse(time.RFC3339, c.Clock.Start); err != nil {
+			return fmt.Errorf("clock start must be RFC3339 time: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// validateTimewheel validates timing wheel configuration
+// Валидирует конфигурацию timing wheel
+func (c *Config) validateTimewheel() error {
+	resolution, err := time.ParseDuration(c.Timewheel.Resolution)
+	if err != nil {
+		return fmt.Errorf("invalid resolution %q: %w", c.Timewheel.Resolution, err)
+	}
+
+	if resolution < time.Millisecond || resolution > time.Second {
+		return fmt.Errorf("resolution must be between 1ms and 1s, got %s", resolution)
+	}
+
+	if time.Second%resolution != 0 {
+		return fmt.Errorf("resolution must divide 1s evenly, got %s", resolution)
+	}
+
+	return nil
+}
+
+// validateCalendars validates business calendar definitions
+// Валидирует определения бизнес-календарей
+func (c *Config) validateCalendars() error {
+	names := make(map[string]bool, len(c.Calendars))
+	for _, calendarConfig := range c.Calendars {
+		if names[calendarConfig.Name] {
+			return fmt.Errorf("duplicate calendar name %s", calendarConfig.Name)
+		}
+		names[calendarConfig.Name] = true
+
+		if _, err := calendar.Compile(calendarConfig.Definition()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validateIncidents validates incident notification configuration
+// Валидирует конфигурацию уведомлений об инцидентах
+func (c *Config) validateIncidents() error {
+	notifications := c.Incidents.Notifications
+
+	if notifications.MaxAttempts < 1 {
+		return fmt.Errorf("notifications max_attempts must be positive, got %d", notifications.MaxAttempts)
+	}
+	initialDelay, err := time.ParseDuration(notifications.InitialDelay)
+	if err != nil || initialDelay < 0 {
+		return fmt.Errorf("notifications initial_delay must be a non-negative duration, got %s",
+			notifications.InitialDelay)
+	}
+	maxDelay, err := time.ParseDuration(notifications.MaxDelay)
+	if err != nil || maxDelay < initialDelay {
+		return fmt.Errorf("notifications max_delay must be a duration not less than initial_delay, got %s",
+			notifications.MaxDelay)
+	}
+	dedupWindow, err := time.ParseDuration(notifications.DedupWindow)
+	if err != nil || dedupWindow < 0 {
+		return fmt.Errorf("notifications dedup_window must be a non-negative duration, got %s",
+			notifications.DedupWindow)
+	}
+	if notifications.RateLimit < 0 {
+		return fmt.Errorf("notifications rate_limit cannot be negative")
+	}
+
+	names := make(map[string]bool)
+	for _, webhook := range notifications.Webhooks {
+		if names[webhook.Name] {
+			return fmt.Errorf("duplicate notifier name %s", webhook.Name)
+		}
+		names[webhook.Name] = true
+
+		if !strings.HasPrefix(webhook.URL, "http://") && !strings.HasPrefix(webhook.URL, "https://") {
+			return fmt.Errorf("webhook %s: url must be http or https, got %q", webhook.Name, webhook.URL)
+		}
+		timeout, err := time.ParseDuration(webhook.Timeout)
+		if err != nil || timeout <= 0 {
+			return fmt.Errorf("webhook %s: timeout must be a positive duration, got %s", webhook.Name, webhook.Timeout)
+		}
+		if err := validateNotifierFilter(webhook.Filter); err != nil {
+			return fmt.Errorf("webhook %s: %w", webhook.Name, err)
+		}
+	}
+
+	for _, email := range notifications.Email {
+		if names[email.Name] {
+			return fmt.Errorf("duplicate notifier name %s", email.Name)
+		}
+		names[email.Name] = true
+
+		if email.Host == "" || email.From == "" || email.To == "" {
+			return fmt.Errorf("email %s: host, from and to are required", email.Name)
+		}
+		switch strings.ToUpper(email.Protocol) {
+		case "", "NONE", "TLS", "SSL", "STARTTLS":
+		default:
+			return fmt.Errorf("email %s: protocol must be one of [NONE TLS SSL STARTTLS], got %s",
+				email.Name, email.Protocol)
+		}
+		if err := validateNotifierFilter(email.Filter); err != nil {
+			return fmt.Errorf("email %s: %w", email.Name, err)
+		}
+	}
+
+	rules := make(map[string]bool)
+	for i, rule := range c.Incidents.AutoRetry {
+		if rule.Name == "" {
+			return fmt.Errorf("auto_retry rule %d: name is required", i+1)
+		}
+		if rules[rule.Name] {
+			return fmt.Errorf("duplicate auto_retry rule name %s", rule.Name)
+		}
+		rules[rule.Name] = true
+
+		if rule.MaxAttempts < 1 {
+			return fmt.Errorf("auto_retry rule %s: max_attempts must be positive", rule.Name)
+		}
+		if rule.Interval == "" {
+			return fmt.Errorf("auto_retry rule %s: interval is required", rule.Name)
+		}
+		if rule.JobRetries < 0 {
+			return fmt.Errorf("auto_retry rule %s: job_retries cannot be negative", rule.Name)
+		}
+		if rule.ErrorPattern != "" {
+			if _, err := regexp.Compile(rule.ErrorPattern); err != nil {
+				return fmt.Errorf("auto_retry rule %s: invalid error_pattern: %w", rule.Name, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// validateBatch validates batch operations configuration
+// Валидирует конфигурацию пакетных операций
+func (c *Config) validateBatch() error {
+	if c.Batch.ChunkSize < 1 {
+		return fmt.Errorf("chunk_size must be positive, got %d", c.Batch.ChunkSize)
+	}
+	chunkDelay, err := time.ParseDuration(c.Batch.ChunkDelay)
+	if err != nil || chunkDelay < 0 {
+		return fmt.Errorf("chunk_delay must be a non-negative duration, got %s", c.Batch.ChunkDelay)
+	}
+	if c.Batch.MaxFailures < 1 {
+		return fmt.Errorf("max_failures must be positive, got %d", c.Batch.MaxFailures)
+	}
+	return nil
+}
+
+// validateAuth validates API key rotation and JWT authentication configuration
+// Валидирует конфигурацию ротации API ключей и JWT аутентификации
+func (c *Config) validateAuth() error {
+	overlap, err := time.ParseDuration(c.Auth.RotationOverlap)
+	if err != nil || overlap < 0 {
+		return fmt.Errorf("rotation_overlap must be a non-negative duration, got %s", c.Auth.RotationOverlap)
+	}
+	if err := validateRoles(c.Auth.Roles); err != nil {
+		return err
+	}
+	for _, clientCert := range c.Auth.ClientCerts {
+		if clientCert.Subject == "" || clientCert.Name == "" {
+			return fmt.Errorf("client_certs entries require subject and name")
+		}
+	}
+
+	jwt := c.Auth.JWT
+	if !jwt.Enabled {
+		return nil
+	}
+
+	if jwt.JWKSURL == "" && jwt.JWKSFile == "" && jwt.HMACSecret == "" {
+		return fmt.Errorf("jwt requires jwks_url, jwks_file or hmac_secret")
+	}
+	for _, algorithm := range jwt.Algorithms {
+		switch algorithm {
+		case "RS256", "ES256", "HS256":
+		default:
+			return fmt.Errorf("jwt algorithm must be RS256, ES256 or HS256, got %s", algorithm)
+		}
+	}
+	refresh, err := time.ParseDuration(jwt.JWKSRefresh)
+	if err != nil || refresh <= 0 {
+		return fmt.Errorf("jwt jwks_refresh must be a positive duration, got %s", jwt.JWKSRefresh)
+	}
+	skew, err := time.ParseDuration(jwt.ClockSkew)
+	if err != nil || skew < 0 {
+		return fmt.Errorf("jwt clock_skew must be a non-negative duration, got %s", jwt.ClockSkew)
+	}
+	return nil
+}
+
+// validateTLS validates listener TLS files, protocol version and cipher suites
+// Валидирует файлы, версию протокола и наборы шифров TLS слушателя
+func validateTLS(section string, cfg TLSConfig) error {
+	if !cfg.Enabled {
+		return nil
+	}
+
+	if cfg.CertFile == "" || cfg.KeyFile == "" {
+		return fmt.Errorf("%s tls requires cert_file and key_file", section)
+	}
+	switch cfg.MinVersion {
+	case "1.2", "1.3":
+	default:
+		return fmt.Errorf("%s tls min_version must be 1.2 or 1.3, got %s", section, cfg.MinVersion)
+	}
+	switch cfg.ClientAuth {
+	case "none":
+	case "optional", "require":
+		if cfg.ClientCAFile == "" {
+			return fmt.Errorf("%s tls client_auth %s requires client_ca_file", section, cfg.ClientAuth)
+		}
+	default:
+		return fmt.Errorf("%s tls client_auth must be none, optional or require, got %s", section, cfg.ClientAuth)
+	}
+
+	known := make(map[string]bool)
+	for _, suite := range tls.CipherSuites() {
+		known[suite.Name] = true
+	}
+	for _, name := range cfg.CipherSuites {
+		if !known[name] {
+			return fmt.Errorf("%s tls unknown or insecure cipher suite %s", section, name)
+		}
+	}
+
+	interval, err := time.ParseDuration(cfg.ReloadInterval)
+	if err != nil || interval <= 0 {
+		return fmt.Errorf("%s tls reload_interval must be a positive duration, got %s", section, cfg.ReloadInterval)
+	}
+	return nil
+}
+
+// validateRoles validates role names, grant resources and actions
+// Валидирует имена ролей, ресурсы и действия разрешений
+func validateRoles(roles []RoleConfig) error {
+	resources := map[string]bool{
+		"*": true, "process": true, "bpmn": true, "job": true, "message": true, "incident": true,
+		"timer": true, "token": true, "expression": true, "storage": true, "system": true, "admin": true,
+	}
+	actions := map[string]bool{
+		"*": true, "create": true, "read": true, "update": true, "delete": true,
+		"complete": true, "resolve": true, "deploy": true,
+	}
+
+	names := make(map[string]bool)
+	for _, role := range roles {
+		if role.Name == "" {
+			return fmt.Errorf("role name is required")
+		}
+		if names[role.Name] {
+			return fmt.Errorf("duplicate role %s", role.Name)
+		}
+		names[role.Name] = true
+
+		for _, grant := range role.Grants {
+			if !resources[grant.Resource] {
+				return fmt.Errorf("role %s: unknown resource %s", role.Name, grant.Resource)
+			}
+			if len(grant.Actions) == 0 {
+				return fmt.Errorf("role %s: grant on %s requires actions", role.Name, grant.Resource)
+			}
+			for _, action := range grant.Actions {
+				if !actions[action] {
+					return fmt.Errorf("role %s: unknown action %s", role.Name, action)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// validateTracing validates trace export configuration
+// Валидирует конфигурацию экспорта трассировки
+func (c *Config) validateTracing() error {
+	switch c.Tracing.Exporter {
+	case "otlp-grpc", "otlp-http", "file":
+	default:
+		return fmt.Errorf("exporter must be otlp-grpc, otlp-http or file, got %s", c.Tracing.Exporter)
+	}
+	if c.Tracing.SampleRatio < 0 || c.Tracing.SampleRatio > 1 {
+		return fmt.Errorf("sample_ratio must be between 0 and 1, got %g", c.Tracing.SampleRatio)
+	}
+	return nil
+}
+
+// validateEvents validates event stream configuration
+// Валидирует конфигурацию потока событий
+func (c *Config) validateEvents() error {
+	if c.Events.HistorySize < 1 {
+		return fmt.Errorf("history_size must be positive, got %d", c.Events.HistorySize)
+	}
+	if c.Events.SubscriberBuffer < 1 {
+		return fmt.Errorf("subscriber_buffer must be positive, got %d", c.Events.SubscriberBuffer)
+	}
+	heartbeat, err := time.ParseDuration(c.Events.HeartbeatInterval)
+	if err != nil || heartbeat <= 0 {
+		return fmt.Errorf("heartbeat_interval must be a positive duration, got %s", c.Events.HeartbeatInterval)
+	}
+	return nil
+}
+
+// validateExport validates export log and exporters configuration.
+// Custom exporter types are checked by their factories on startup.
+// Валидирует конфигурацию журнала экспорта и экспортеров
+func (c *Config) validateExport() error {
+	compaction, err := time.ParseDuration(c.Export.CompactionInterval)
+	if err != nil || compaction < 0 {
+		return fmt.Errorf("compaction_interval must be a non-negative duration, got %s", c.Export.CompactionInterval)
+	}
+
+	ids := make(map[string]bool)
+	for _, exporter := range c.Export.Exporters {
+		if ids[exporter.ID] {
+			return fmt.Errorf("duplicate exporter id %s", exporter.ID)
+		}
+		ids[exporter.ID] = true
+
+		if exporter.Type == "" {
+			return fmt.Errorf("exporter %s: type is required", exporter.ID)
+		}
+		if exporter.BatchSize < 1 {
+			return fmt.Errorf("exporter %s: batch_size must be positive, got %d", exporter.ID, exporter.BatchSize)
+		}
+		flush, err := time.ParseDuration(exporter.FlushInterval)
+		if err != nil || flush < 0 {
+			return fmt.Errorf("exporter %s: flush_interval must be a non-negative duration, got %s",
+				exporter.ID, exporter.FlushInterval)
+		}
+		backoff, err := time.ParseDuration(exporter.RetryBackoff)
+		if err != nil || backoff <= 0 {
+			return fmt.Errorf("exporter %s: retry_backoff must be a positive duration, got %s",
+				exporter.ID, exporter.RetryBackoff)
+		}
+		maxBackoff, err := time.ParseDuration(exporter.MaxRetryBackoff)
+		if err != nil || maxBackoff < backoff {
+			return fmt.Errorf("exporter %s: max_retry_backoff must be a duration not less than retry_backoff, got %s",
+				exporter.ID, exporter.MaxRetryBackoff)
+		}
+
+		switch exporter.Type {
+		case "file":
+			if exporter.File.Path == "" {
+				return fmt.Errorf("exporter %s: file path is required", exporter.ID)
+			}
+			if exporter.File.MaxSize < 1 {
+				return fmt.Errorf("exporter %s: file max_size must be positive", exporter.ID)
+			}
+			if exporter.File.MaxFiles < 0 {
+				return fmt.Errorf("exporter %s: file max_files cannot be negative", exporter.ID)
+			}
+		case "http":
+			url := exporter.HTTP.URL
+			if !strings.HasPrefix(url, "http://") && !strings.HasPrefix(url, "https://") {
+				return fmt.Errorf("exporter %s: url must be http or https, got %q", exporter.ID, url)
+			}
+			timeout, err := time.ParseDuration(exporter.HTTP.Timeout)
+			if err != nil || timeout <= 0 {
+				return fmt.Errorf("exporter %s: timeout must be a positive duration, got %s",
+					exporter.ID, exporter.HTTP.Timeout)
+			}
+			if exporter.HTTP.MaxAttempts < 1 {
+				return fmt.Errorf("exporter %s: max_attempts must be positive", exporter.ID)
+			}
+			retryDelay, err := time.ParseDuration(exporter.HTTP.RetryDelay)
+			if err != nil || retryDelay < 0 {
+				return fmt.Errorf("exporter %s: retry_delay must be a non-negative duration, got %s",
+					exporter.ID, exporter.HTTP.RetryDelay)
+			}
+		case "kafka":
+			if len(exporter.Kafka.Brokers) == 0 || exporter.Kafka.Topic == "" {
+				return fmt.Errorf("exporter %s: kafka brokers and topic are required", exporter.ID)
+			}
+			switch exporter.Kafka.RequiredAcks {
+			case "all", "one", "none":
+			default:
+				return fmt.Errorf("exporter %s: required_acks must be all, one or none, got %s",
+					exporter.ID, exporter.Kafka.RequiredAcks)
+			}
+			switch exporter.Kafka.Compression {
+			case "none", "gzip", "snappy", "lz4", "zstd":
+			default:
+				return fmt.Errorf("exporter %s: compression must be none, gzip, snappy, lz4 or zstd, got %s",
+					exporter.ID, exporter.Kafka.Compression)
+			}
+			timeout, err := time.ParseDuration(exporter.Kafka.Timeout)
+			if err != nil || timeout <= 0 {
+				return fmt.Errorf("exporter %s: timeout must be a positive duration, got %s",
+					exporter.ID, exporter.Kafka.Timeout)
+			}
+		}
+	}
+
+	return nil
+}
+
+// validateRetention validates history retention policies
+// Валидирует политики хранения истории
+func (c *Config) validateRetention() error {
+	retention := c.Retention
+	interval, err := time.ParseDuration(retention.Interval)
+	if err != nil || interval <= 0 {
+		return fmt.Errorf("interval must be a positive duration, got %s", retention.Interval)
+	}
+	if retention.BatchSize < 1 {
+		return fmt.Errorf("batch_size must be positive, got %d", retention.BatchSize)
+	}
+
+	periods := map[string]string{
+		"process_instances":    retention.ProcessInstances,
+		"jobs":                 retention.Jobs,
+		"incidents":            retention.Incidents,
+		"message_correlations": retention.MessageCorrelations,
+		"system_events":        retention.SystemEvents,
+	}
+	for name, period := range periods {
+		if err := validateRetentionPeriod(period); err != nil {
+			return fmt.Errorf("%s %w", name, err)
+		}
+	}
+
+	processes := make(map[string]bool)
+	for _, process := range retention.Processes {
+		if process.ProcessID == "" {
+			return fmt.Errorf("process_id is required in processes")
+		}
+		key := process.TenantID + "/" + process.ProcessID
+		if processes[key] {
+			return fmt.Errorf("duplicate process retention for %s", process.ProcessID)
+		}
+		processes[key] = true
+		if err := validateRetentionPeriod(process.ProcessInstances); err != nil {
+			return fmt.Errorf("process %s: process_instances %w", process.ProcessID, err)
+		}
+	}
+
+	if retention.Archive.Path != "" {
+		if retention.Archive.MaxSize < 1 {
+			return fmt.Errorf("archive max_size must be positive")
+		}
+		if retention.Archive.MaxFiles < 0 {
+			return fmt.Errorf("archive max_files cannot be negative")
+		}
+	}
+	return nil
+}
+
+// validateRetentionPeriod checks optional non-negative retention period
+func validateRetentionPeriod(period string) error {
+	if period == "" {
+		return nil
+	}
+	if d, err := time.ParseDuration(period); err != nil || d < 0 {
+		return fmt.Errorf("must be a non-negative duration, got %s", period)
+	}
+	return nil
+}
+
+// validateNotifierFilter validates incident notifier filter
+// Валидирует фильтр получателя уведомлений об инцидентах
+func validateNotifierFilter(filter IncidentNotifierFilterConfig) error {
+	for _, event := range filter.Events {
+		switch strings.ToLower(event) {
+		case "created", "resolved", "dismissed", "escalated":
+		default:
+			return fmt.Errorf("filter event must be one of [created resolved dismissed escalated], got %s", event)
+		}
+	}
+	return nil
+}
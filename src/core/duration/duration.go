@@ -0,0 +1,168 @@
+/*
+This file is part of the AtomBPMN (R) project.
+Copyright (c) 2025 Matreska Market LLC (ООО «Matreska Market»).
+Authors: Matreska Team.
+
+This project is dual-licensed under AGPL-3.0 and AtomBPMN Commercial License.
+*/
+
+package duration
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// iso8601DurationRegex matches P[nY][nM][nW][nD][T[nH][nM][nS]]
+var iso8601DurationRegex = regexp.MustCompile(
+	`^P(?:(\d+)Y)?(?:(\d+)M)?(?:(\d+)W)?(?:(\d+)D)?(?:T(?:(\d+)H)?(?:(\d+)M)?(?:(\d+(?:\.\d+)?)S)?)?$`,
+)
+
+// Calendar is ISO8601 duration keeping calendar and clock parts apart,
+// so that P1M means "same day next month" rather than 30 days
+// Длительность ISO8601 с раздельными календарной и временной частями
+type Calendar struct {
+	Years  int
+	Months int
+	Days   int
+	Clock  time.Duration // Hours, minutes and seconds / Часы, минуты и секунды
+}
+
+// ParseISO8601 parses ISO8601 duration string like "P1M", "P1DT2H", "P2W"
+// Парсит ISO8601 строку длительности типа "P1M", "P1DT2H", "P2W"
+func ParseISO8601(durationStr string) (Calendar, error) {
+	var d Calendar
+
+	// Convert to uppercase for case-insensitive parsing
+	// Преобразуем в верхний регистр для регистронезависимого парсинга
+	durationStr = strings.ToUpper(strings.TrimSpace(durationStr))
+	if durationStr == "" {
+		return d, fmt.Errorf("empty duration string")
+	}
+
+	matches := iso8601DurationRegex.FindStringSubmatch(durationStr)
+	if matches == nil || durationStr == "P" || strings.HasSuffix(durationStr, "T") {
+		return d, fmt.Errorf("invalid ISO8601 duration format: %s", durationStr)
+	}
+
+	atoi := func(value string) int {
+		n, _ := strconv.Atoi(value)
+		return n
+	}
+
+	d.Years = atoi(matches[1])
+	d.Months = atoi(matches[2])
+	d.Days = atoi(matches[3])*7 + atoi(matches[4])
+	d.Clock = time.Duration(atoi(matches[5]))*time.Hour + time.Duration(atoi(matches[6]))*time.Minute
+
+	// Seconds (can be decimal)
+	// Секунды (могут быть десятичными)
+	if matches[7] != "" {
+		seconds, err := strconv.ParseFloat(matches[7], 64)
+		if err != nil {
+			return d, fmt.Errorf("invalid seconds in duration: %s", durationStr)
+		}
+		d.Clock += time.Duration(seconds * float64(time.Second))
+	}
+
+	return d, nil
+}
+
+// Parse parses Go ("30s") or ISO8601 ("PT30S") duration into fixed length,
+// ISO8601 years and months are approximated
+// Парсит длительность в формате Go ("30s") или ISO8601 ("PT30S")
+func Parse(value string) (time.Duration, error) {
+	value = strings.TrimSpace(value)
+	if strings.HasPrefix(strings.ToUpper(value), "P") {
+		d, err := ParseISO8601(value)
+		if err != nil {
+			return 0, err
+		}
+		return d.Approximate(), nil
+	}
+	return time.ParseDuration(value)
+}
+
+// AddTo returns t moved forward n times by duration. Years and months keep the
+// day of month, clamped to the last day of shorter months (Jan 31 + P1M = Feb 28),
+// days keep the wall clock time across DST changes, hours to seconds are exact.
+// Calendar parts are multiplied before applying so repetition from a fixed anchor
+// does not accumulate month-end clamping.
+// Сдвигает t вперед n раз на длительность с учетом календаря
+func (d Calendar) AddTo(t time.Time, n int) time.Time {
+	if months := n * (d.Years*12 + d.Months); months != 0 {
+		t = addMonths(t, months)
+	}
+	if d.Days != 0 {
+		t = t.AddDate(0, 0, n*d.Days)
+	}
+	return t.Add(time.Duration(n) * d.Clock)
+}
+
+// IsZero reports whether duration has no components
+// Проверяет что длительность нулевая
+func (d Calendar) IsZero() bool {
+	return d.Years == 0 && d.Months == 0 && d.Days == 0 && d.Clock == 0
+}
+
+// Approximate converts duration to fixed length (year = 365 days, month = 30 days)
+// for places that need time.Duration, such as retry backoff
+// Приближенная фиксированная длительность (год = 365 дней, месяц = 30 дней)
+func (d Calendar) Approximate() time.Duration {
+	return time.Duration(d.Years)*365*24*time.Hour +
+		time.Duration(d.Months)*30*24*time.Hour +
+		time.Duration(d.Days)*24*time.Hour +
+		d.Clock
+}
+
+// String formats duration back to ISO8601
+// Форматирует длительность обратно в ISO8601
+func (d Calendar) String() string {
+	var b strings.Builder
+	b.WriteString("P")
+	if d.Years != 0 {
+		fmt.Fprintf(&b, "%dY", d.Years)
+	}
+	if d.Months != 0 {
+		fmt.Fprintf(&b, "%dM", d.Months)
+	}
+	if d.Days != 0 {
+		fmt.Fprintf(&b, "%dD", d.Days)
+	}
+	if d.Clock != 0 || b.Len() == 1 {
+		b.WriteString("T")
+		clock := d.Clock
+		if hours := clock / time.Hour; hours != 0 {
+			fmt.Fprintf(&b, "%dH", hours)
+			clock -= hours * time.Hour
+		}
+		if minutes := clock / time.Minute; minutes != 0 {
+			fmt.Fprintf(&b, "%dM", minutes)
+			clock -= minutes * time.Minute
+		}
+		if clock != 0 || b.String() == "PT" {
+			b.WriteString(strconv.FormatFloat(clock.Seconds(), 'f', -1, 64) + "S")
+		}
+	}
+	return b.String()
+}
+
+// addMonths adds months keeping day of month, clamped to month length
+// Добавляет месяцы сохраняя день месяца в пределах длины месяца
+func addMonths(t time.Time, months int) time.Time {
+	year, month, day := t.Date()
+	first := time.Date(year, month+time.Month(months), 1, 0, 0, 0, 0, t.Location())
+	if last := DaysInMonth(first.Year(), first.Month()); day > last {
+		day = last
+	}
+	return time.Date(first.Year(), first.Month(), day, t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), t.Location())
+}
+
+// DaysInMonth returns number of days in month
+// Возвращает количество дней в месяце
+func DaysInMonth(year int, month time.Month) int {
+	return time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
@@ -0,0 +1,337 @@
+/*
+This file is part of the AtomBPMN (R) project.
+Copyright (c) 2025 Matreska Market LLC (ООО «Matreska Market»).
+Authors: Matreska Team.
+
+This project is dual-licensed under AGPL-3.0 and AtomBPMN Commercial License.
+*/
+
+package events
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"atom-engine/src/core/clock"
+	"atom-engine/src/core/config"
+)
+
+// Default broker settings used until Init applies configuration
+const (
+	DefaultHistorySize       = 10000
+	DefaultSubscriberBuffer  = 1000
+	DefaultHeartbeatInterval = 15 * time.Second
+)
+
+// Errors ending subscriptions
+// Ошибки, завершающие подписки
+var (
+	ErrSequenceUnavailable = errors.New("event sequence not available")
+	ErrSubscriberLagged    = errors.New("subscriber fell behind event stream")
+	ErrClosed              = errors.New("event stream closed")
+)
+
+// Broker keeps recent events for resumption and fans published events out to
+// subscribers. Sequences are kept in memory and start over after restart.
+// Хранит недавние события для возобновления и рассылает события подписчикам
+type Broker struct {
+	mu          sync.Mutex
+	history     []*Event // Ring buffer of recent events
+	next        int      // Ring position of next event
+	size        int      // Events held in ring
+	lastSeq     uint64
+	bufferSize  int
+	heartbeat   time.Duration
+	subscribers map[*Subscription]struct{}
+	closed      bool
+}
+
+// NewBroker creates broker keeping historySize recent events, subscriber is
+// dropped when bufferSize events are waiting for delivery
+// Создает брокер событий
+func NewBroker(historySize, bufferSize int, heartbeat time.Duration) *Broker {
+	return &Broker{
+		history:     make([]*Event, historySize),
+		bufferSize:  bufferSize,
+		heartbeat:   heartbeat,
+		subscribers: make(map[*Subscription]struct{}),
+	}
+}
+
+// Publish assigns next sequence to event, stores it in history and delivers
+// it to matching subscribers without blocking
+// Назначает событию номер, сохраняет его и доставляет подписчикам без блокировки
+func (b *Broker) Publish(event *Event) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.closed {
+		return
+	}
+	if event.Timestamp.IsZero() {
+		event.Timestamp = clock.Now()
+	}
+	b.lastSeq++
+	event.Sequence = b.lastSeq
+
+	if len(b.history) > 0 {
+		b.history[b.next] = event
+		b.next = (b.next + 1) % len(b.history)
+		if b.size < len(b.history) {
+			b.size++
+		}
+	}
+
+	for subscription := range b.subscribers {
+		if !subscription.offer(event) {
+			delete(b.subscribers, subscription)
+		}
+	}
+}
+
+// Subscribe registers subscriber for events passing filter. Non-zero
+// fromSequence replays retained events starting with that sequence first.
+// Регистрирует подписчика, ненулевой fromSequence сначала повторяет сохраненные события
+func (b *Broker) Subscribe(filter Filter, fromSequence uint64) (*Subscription, error) {
+	if err := filter.Validate(); err != nil {
+		return nil, err
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.closed {
+		return nil, ErrClosed
+	}
+
+	subscription := &Subscription{
+		broker: b,
+		filter: filter,
+		limit:  b.bufferSize,
+		notify: make(chan struct{}, 1),
+	}
+
+	if fromSequence > 0 {
+		if fromSequence > b.lastSeq+1 {
+			return nil, fmt.Errorf("%w: sequence %d is ahead of latest %d, stream was restarted",
+				ErrSequenceUnavailable, fromSequence, b.lastSeq)
+		}
+		oldest := b.lastSeq - uint64(b.size) + 1
+		if fromSequence < oldest {
+			return nil, fmt.Errorf("%w: sequence %d is no longer retained, oldest is %d",
+				ErrSequenceUnavailable, fromSequence, oldest)
+		}
+		for i := int(fromSequence - oldest); i < b.size; i++ {
+			event := b.history[(b.next-b.size+i+len(b.history))%len(b.history)]
+			if filter.Matches(event) {
+				subscription.queue = append(subscription.queue, event)
+			}
+		}
+		// Replayed events do not count against subscriber buffer
+		subscription.limit += len(subscription.queue)
+	}
+
+	b.subscribers[subscription] = struct{}{}
+	return subscription, nil
+}
+
+// LastSequence returns sequence of latest published event, 0 when none
+// Возвращает номер последнего опубликованного события
+func (b *Broker) LastSequence() uint64 {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.lastSeq
+}
+
+// HeartbeatInterval returns keep-alive interval of idle streams
+// Возвращает интервал keep-alive для простаивающих потоков
+func (b *Broker) HeartbeatInterval() time.Duration {
+	return b.heartbeat
+}
+
+// Close ends all subscriptions and stops accepting events
+// Завершает все подписки и прекращает прием событий
+func (b *Broker) Close() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.closed = true
+	for subscription := range b.subscribers {
+		subscription.fail(ErrClosed)
+		delete(b.subscribers, subscription)
+	}
+}
+
+// unsubscribe removes subscription from broker
+func (b *Broker) unsubscribe(subscription *Subscription) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	delete(b.subscribers, subscription)
+}
+
+// Subscription receives events published after it was created, preceded by
+// replayed events when subscribed from sequence
+// Подписка на события, опубликованные после ее создания
+type Subscription struct {
+	broker *Broker
+	filter Filter
+	notify chan struct{}
+
+	mu    sync.Mutex
+	queue []*Event
+	limit int
+	err   error
+}
+
+// Next returns next event, waiting up to timeout. Nil event with nil error
+// means timeout elapsed and caller may send keep-alive.
+// Возвращает следующее событие, ожидая не дольше timeout
+func (s *Subscription) Next(ctx context.Context, timeout time.Duration) (*Event, error) {
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+	for {
+		s.mu.Lock()
+		if len(s.queue) > 0 {
+			event := s.queue[0]
+			s.queue[0] = nil
+			s.queue = s.queue[1:]
+			s.mu.Unlock()
+			return event, nil
+		}
+		err := s.err
+		s.mu.Unlock()
+		if err != nil {
+			return nil, err
+		}
+
+		select {
+		case <-s.notify:
+		case <-timer.C:
+			return nil, nil
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+}
+
+// Close unregisters subscription
+// Отменяет подписку
+func (s *Subscription) Close() {
+	s.broker.unsubscribe(s)
+	s.fail(ErrClosed)
+}
+
+// offer queues matching event, returns false when subscription has ended
+func (s *Subscription) offer(event *Event) bool {
+	if !s.filter.Matches(event) {
+		return true
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.err != nil {
+		return false
+	}
+	if len(s.queue) >= s.limit {
+		s.queue = nil
+		s.err = fmt.Errorf("%w: more than %d events pending", ErrSubscriberLagged, s.limit)
+		s.signal()
+		return false
+	}
+	s.queue = append(s.queue, event)
+	s.signal()
+	return true
+}
+
+// fail ends subscription with error unless already ended
+func (s *Subscription) fail(err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.err == nil {
+		s.err = err
+	}
+	s.signal()
+}
+
+// signal wakes waiting Next without blocking
+func (s *Subscription) signal() {
+	select {
+	case s.notify <- struct{}{}:
+	default:
+	}
+}
+
+// Recorder receives every published event before stream subscribers, e.g.
+// to persist it. Record is called synchronously and must not modify event.
+// Получает каждое публикуемое событие до подписчиков потока
+type Recorder interface {
+	Record(event *Event)
+}
+
+// broker is process wide event broker used by engine components
+var broker atomic.Pointer[Broker]
+
+// recorder is optional recorder of process wide published events
+var recorder atomic.Pointer[Recorder]
+
+func init() {
+	broker.Store(NewBroker(DefaultHistorySize, DefaultSubscriberBuffer, DefaultHeartbeatInterval))
+}
+
+// Init replaces process wide broker with one built from configuration
+// Заменяет общий брокер событий брокером из конфигурации
+func Init(cfg *config.EventsConfig) error {
+	heartbeat, err := time.ParseDuration(cfg.HeartbeatInterval)
+	if err != nil {
+		return fmt.Errorf("invalid heartbeat_interval: %w", err)
+	}
+	previous := broker.Swap(NewBroker(cfg.HistorySize, cfg.SubscriberBuffer, heartbeat))
+	previous.Close()
+	return nil
+}
+
+// Publish passes event to recorder and publishes it to process wide broker
+// Передает событие регистратору и публикует его в общий брокер
+func Publish(event *Event) {
+	if event.Timestamp.IsZero() {
+		event.Timestamp = clock.Now()
+	}
+	if r := recorder.Load(); r != nil {
+		(*r).Record(event)
+	}
+	broker.Load().Publish(event)
+}
+
+// SetRecorder installs recorder of published events, nil removes it
+// Устанавливает регистратор публикуемых событий, nil удаляет его
+func SetRecorder(r Recorder) {
+	if r == nil {
+		recorder.Store(nil)
+		return
+	}
+	recorder.Store(&r)
+}
+
+// Subscribe subscribes to process wide broker
+// Подписывается на общий брокер
+func Subscribe(filter Filter, fromSequence uint64) (*Subscription, error) {
+	return broker.Load().Subscribe(filter, fromSequence)
+}
+
+// HeartbeatInterval returns keep-alive interval of process wide broker
+// Возвращает интервал keep-alive общего брокера
+func HeartbeatInterval() time.Duration {
+	return broker.Load().HeartbeatInterval()
+}
+
+// Close ends subscriptions of process wide broker so streams finish before shutdown
+// Завершает подписки общего брокера, чтобы потоки закрылись до остановки
+func Close() {
+	broker.Load().Close()
+}
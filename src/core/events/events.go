@@ -0,0 +1,183 @@
+/*
+This file is part of the AtomBPMN (R) project.
+Copyright (c) 2025 Matreska Market LLC (ООО «Matreska Market»).
+Authors: Matreska Team.
+
+This project is dual-licensed under AGPL-3.0 and AtomBPMN Commercial License.
+*/
+
+package events
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"atom-engine/src/core/models"
+)
+
+// Engine event types
+// Типы событий движка
+const (
+	TypeInstanceStarted   = "instance.started"
+	TypeInstanceCompleted = "instance.completed"
+	TypeInstanceCanceled  = "instance.canceled"
+	TypeElementActivated  = "element.activated"
+	TypeElementCompleted  = "element.completed"
+	TypeJobCreated        = "job.created"
+	TypeJobActivated      = "job.activated"
+	TypeJobCompleted      = "job.completed"
+	TypeJobFailed         = "job.failed"
+	TypeJobRetried        = "job.retried" // Deferred job is pending again after backoff
+	TypeJobRetriesUpdated = "job.retries_updated"
+	TypeJobTimedOut       = "job.timed_out" // Worker lease expired
+	TypeJobErrorThrown    = "job.error_thrown"
+	TypeJobCanceled       = "job.canceled"
+	TypeIncidentOpened    = "incident.opened"
+	TypeIncidentResolved  = "incident.resolved"
+	TypeMessageCorrelated = "message.correlated"
+	TypeTimerScheduled    = "timer.scheduled"
+	TypeTimerFired        = "timer.fired"
+	TypeTimerCanceled     = "timer.canceled"
+	TypeVariableUpdated   = "variable.updated"
+	TypeDeploymentCreated = "deployment.created"
+	TypeDeploymentDeleted = "deployment.deleted"
+)
+
+// Event categories, the part of event type before dot
+// Категории событий, часть типа до точки
+const (
+	CategoryInstance   = "instance"
+	CategoryElement    = "element"
+	CategoryJob        = "job"
+	CategoryIncident   = "incident"
+	CategoryMessage    = "message"
+	CategoryTimer      = "timer"
+	CategoryVariable   = "variable"
+	CategoryDeployment = "deployment"
+)
+
+// knownTypes lists event types accepted by filters
+var knownTypes = map[string]bool{
+	TypeInstanceStarted:   true,
+	TypeInstanceCompleted: true,
+	TypeInstanceCanceled:  true,
+	TypeElementActivated:  true,
+	TypeElementCompleted:  true,
+	TypeJobCreated:        true,
+	TypeJobActivated:      true,
+	TypeJobCompleted:      true,
+	TypeJobFailed:         true,
+	TypeJobRetried:        true,
+	TypeJobRetriesUpdated: true,
+	TypeJobTimedOut:       true,
+	TypeJobErrorThrown:    true,
+	TypeJobCanceled:       true,
+	TypeIncidentOpened:    true,
+	TypeIncidentResolved:  true,
+	TypeMessageCorrelated: true,
+	TypeTimerScheduled:    true,
+	TypeTimerFired:        true,
+	TypeTimerCanceled:     true,
+	TypeVariableUpdated:   true,
+	TypeDeploymentCreated: true,
+	TypeDeploymentDeleted: true,
+}
+
+// Event describes engine state change pushed to stream subscribers
+// Описывает изменение состояния движка, отправляемое подписчикам потока
+type Event struct {
+	Sequence          uint64            `json:"sequence,omitempty"` // Assigned on publish, omitted in export records
+	Type              string            `json:"type"`
+	Timestamp         time.Time         `json:"timestamp"`
+	TenantID          string            `json:"tenant_id,omitempty"`
+	ProcessID         string            `json:"process_id,omitempty"`  // BPMN process ID
+	ProcessKey        string            `json:"process_key,omitempty"` // Process definition key
+	ProcessInstanceID string            `json:"process_instance_id,omitempty"`
+	ElementID         string            `json:"element_id,omitempty"`
+	ElementType       string            `json:"element_type,omitempty"`
+	JobKey            string            `json:"job_key,omitempty"`
+	JobType           string            `json:"job_type,omitempty"`
+	IncidentID        string            `json:"incident_id,omitempty"`
+	MessageName       string            `json:"message_name,omitempty"`
+	Attributes        map[string]string `json:"attributes,omitempty"` // Type specific details
+}
+
+// Category returns event category, the part of type before dot
+// Возвращает категорию события
+func (e *Event) Category() string {
+	category, _, _ := strings.Cut(e.Type, ".")
+	return category
+}
+
+// Filter selects events delivered to subscriber, empty fields match any event
+// Отбирает события для подписчика, пустые поля соответствуют любому событию
+type Filter struct {
+	ProcessKey        string   `json:"process_key,omitempty"` // BPMN process ID or process definition key
+	ProcessInstanceID string   `json:"process_instance_id,omitempty"`
+	Types             []string `json:"types,omitempty"`     // Event types or categories, e.g. "job"
+	TenantID          string   `json:"tenant_id,omitempty"` // "<default>" selects default tenant
+}
+
+// Validate checks filter event types and tenant
+// Проверяет типы событий и арендатора фильтра
+func (f *Filter) Validate() error {
+	for _, eventType := range f.Types {
+		if knownTypes[eventType] || isCategory(eventType) {
+			continue
+		}
+		return fmt.Errorf("unknown event type: %s", eventType)
+	}
+	if f.TenantID != "" {
+		if err := models.ValidateTenantID(models.NormalizeTenantID(f.TenantID)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Matches reports whether event passes filter
+// Проверяет, проходит ли событие через фильтр
+func (f *Filter) Matches(e *Event) bool {
+	if f.ProcessKey != "" && e.ProcessID != f.ProcessKey && e.ProcessKey != f.ProcessKey {
+		return false
+	}
+	if f.ProcessInstanceID != "" && e.ProcessInstanceID != f.ProcessInstanceID {
+		return false
+	}
+	if f.TenantID != "" && e.TenantID != models.NormalizeTenantID(f.TenantID) {
+		return false
+	}
+	if len(f.Types) == 0 {
+		return true
+	}
+	category := e.Category()
+	for _, eventType := range f.Types {
+		if eventType == e.Type || eventType == category {
+			return true
+		}
+	}
+	return false
+}
+
+// ParseTypes splits comma separated event types dropping empty entries
+// Разделяет список типов событий через запятую
+func ParseTypes(value string) []string {
+	var types []string
+	for _, eventType := range strings.Split(value, ",") {
+		if eventType = strings.TrimSpace(eventType); eventType != "" {
+			types = append(types, eventType)
+		}
+	}
+	return types
+}
+
+// isCategory reports whether value names event category
+func isCategory(value string) bool {
+	switch value {
+	case CategoryInstance, CategoryElement, CategoryJob, CategoryIncident, CategoryMessage,
+		CategoryTimer, CategoryVariable, CategoryDeployment:
+		return true
+	}
+	return false
+}
@@ -93,11 +93,21 @@ func (ai *AuthInterceptor) UnaryInterceptor() grpc.UnaryServerInterceptor {
 				return nil, status.Error(codes.PermissionDenied, "IP address not allowed")
 			case strings.Contains(authResult.Reason, "API key"):
 				return nil, status.Error(codes.Unauthenticated, "Invalid or missing API key")
+			case strings.Contains(authResult.Reason, "token"):
+				return nil, status.Error(codes.Unauthenticated, "Invalid or expired bearer token")
 			default:
 				return nil, status.Error(codes.Unauthenticated, "Authentication failed")
 			}
 		}
 
+		// Check method action on resource type, resource IDs are checked by services
+		if err := authorizeMethod(info.FullMethod, authResult); err != nil {
+			logger.Warn("Insufficient permissions",
+				logger.String("method", info.FullMethod),
+				logger.String("api_key_name", authResult.APIKeyName))
+			return nil, err
+		}
+
 		// Add auth result to context for downstream use
 		newCtx := context.WithValue(ctx, authContextKey, authResult)
 
@@ -164,11 +174,21 @@ func (ai *AuthInterceptor) StreamInterceptor() grpc.StreamServerInterceptor {
 				return status.Error(codes.PermissionDenied, "IP address not allowed")
 			case strings.Contains(authResult.Reason, "API key"):
 				return status.Error(codes.Unauthenticated, "Invalid or missing API key")
+			case strings.Contains(authResult.Reason, "token"):
+				return status.Error(codes.Unauthenticated, "Invalid or expired bearer token")
 			default:
 				return status.Error(codes.Unauthenticated, "Authentication failed")
 			}
 		}
 
+		// Check method action on resource type, resource IDs are checked by services
+		if err := authorizeMethod(info.FullMethod, authResult); err != nil {
+			logger.Warn("Insufficient permissions for stream",
+				logger.String("method", info.FullMethod),
+				logger.String("api_key_name", authResult.APIKeyName))
+			return err
+		}
+
 		// Create wrapped stream with auth context
 		wrappedStream := &authServerStream{
 			ServerStream: stream,
@@ -228,7 +248,7 @@ func RequirePermission(ctx context.Context, permission string) error {
 		return status.Error(codes.Internal, "Authentication context not found")
 	}
 
-	if !auth.HasPermission(authResult.Permissions, permission) {
+	if !authResult.HasAccess(permission) {
 		return status.Error(codes.PermissionDenied, "Insufficient permissions")
 	}
 
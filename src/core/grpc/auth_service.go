@@ -0,0 +1,232 @@
+/*
+This file is part of the AtomBPMN (R) project.
+Copyright (c) 2025 Matreska Market LLC (ООО «Matreska Market»).
+Authors: Matreska Team.
+
+This project is dual-licensed under AGPL-3.0 and AtomBPMN Commercial License.
+*/
+
+package grpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"atom-engine/proto/auth/authpb"
+	"atom-engine/src/core/auth"
+	"atom-engine/src/core/logger"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// authServiceServer implements API key management gRPC service
+type authServiceServer struct {
+	authpb.UnimplementedAuthServiceServer
+	core CoreInterface
+}
+
+// getAPIKeyStore helper function for direct API key store access
+// helper функция для прямого доступа к хранилищу API ключей
+func getAPIKeyStore(core CoreInterface) (auth.APIKeyStore, error) {
+	component, ok := core.GetAuthComponent().(auth.Component)
+	if !ok {
+		return nil, fmt.Errorf("auth component not available")
+	}
+
+	store := component.GetAPIKeyStore()
+	if store == nil {
+		return nil, fmt.Errorf("API key store not available")
+	}
+
+	return store, nil
+}
+
+// requireKeyAdmin checks admin permission when authentication is enabled
+// Проверяет разрешение admin если аутентификация включена
+func requireKeyAdmin(ctx context.Context) error {
+	if _, authenticated := GetAuthResultFromContext(ctx); !authenticated {
+		return nil
+	}
+	return RequirePermission(ctx, auth.PermissionAdmin)
+}
+
+// apiKeyStatusError maps API key store error to gRPC status
+// Преобразует ошибку хранилища API ключей в gRPC статус
+func apiKeyStatusError(err error) error {
+	switch {
+	case errors.Is(err, auth.ErrAPIKeyNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, auth.ErrAPIKeyInactive), errors.Is(err, auth.ErrConfigAPIKey):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, auth.ErrInvalidAPIKeyRequest):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, auth.ErrAPIKeyStoreNotReady):
+		return status.Error(codes.Unavailable, err.Error())
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
+
+// CreateAPIKey issues managed API key, secret is returned only once
+// Выпускает управляемый API ключ, секрет возвращается однократно
+func (s *authServiceServer) CreateAPIKey(
+	ctx context.Context,
+	req *authpb.CreateAPIKeyRequest,
+) (*authpb.CreateAPIKeyResponse, error) {
+	logger.Info("CreateAPIKey gRPC request", logger.String("name", req.Name))
+
+	if err := requireKeyAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	store, err := getAPIKeyStore(s.core)
+	if err != nil {
+		return nil, status.Error(codes.Unavailable, err.Error())
+	}
+
+	request := &auth.CreateAPIKeyRequest{
+		Name:         req.Name,
+		Permissions:  req.Permissions,
+		AllowedHosts: req.AllowedHosts,
+		Tenants:      req.Tenants,
+		ExpiresIn:    req.ExpiresIn,
+	}
+	if req.ExpiresAt != nil {
+		expiresAt := req.ExpiresAt.AsTime()
+		request.ExpiresAt = &expiresAt
+	}
+
+	created, err := store.CreateAPIKey(request)
+	if err != nil {
+		return nil, apiKeyStatusError(err)
+	}
+
+	return &authpb.CreateAPIKeyResponse{
+		ApiKey: apiKeyToProto(&created.APIKeyInfo),
+		Key:    created.Key,
+	}, nil
+}
+
+// ListAPIKeys returns managed and config-defined API keys
+// Возвращает управляемые и заданные в конфигурации API ключи
+func (s *authServiceServer) ListAPIKeys(
+	ctx context.Context,
+	req *authpb.ListAPIKeysRequest,
+) (*authpb.ListAPIKeysResponse, error) {
+	if err := requireKeyAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	store, err := getAPIKeyStore(s.core)
+	if err != nil {
+		return nil, status.Error(codes.Unavailable, err.Error())
+	}
+
+	keys := store.ListAPIKeys(req.IncludeInactive)
+	response := &authpb.ListAPIKeysResponse{ApiKeys: make([]*authpb.APIKey, 0, len(keys))}
+	for _, key := range keys {
+		response.ApiKeys = append(response.ApiKeys, apiKeyToProto(key))
+	}
+	return response, nil
+}
+
+// GetAPIKey returns API key by ID
+// Возвращает API ключ по ID
+func (s *authServiceServer) GetAPIKey(
+	ctx context.Context,
+	req *authpb.GetAPIKeyRequest,
+) (*authpb.GetAPIKeyResponse, error) {
+	if err := requireKeyAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	store, err := getAPIKeyStore(s.core)
+	if err != nil {
+		return nil, status.Error(codes.Unavailable, err.Error())
+	}
+
+	key, err := store.GetAPIKey(req.KeyId)
+	if err != nil {
+		return nil, apiKeyStatusError(err)
+	}
+
+	return &authpb.GetAPIKeyResponse{ApiKey: apiKeyToProto(key)}, nil
+}
+
+// RevokeAPIKey revokes managed API key immediately
+// Немедленно отзывает управляемый API ключ
+func (s *authServiceServer) RevokeAPIKey(
+	ctx context.Context,
+	req *authpb.RevokeAPIKeyRequest,
+) (*authpb.RevokeAPIKeyResponse, error) {
+	logger.Info("RevokeAPIKey gRPC request", logger.String("key_id", req.KeyId))
+
+	if err := requireKeyAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	store, err := getAPIKeyStore(s.core)
+	if err != nil {
+		return nil, status.Error(codes.Unavailable, err.Error())
+	}
+
+	key, err := store.RevokeAPIKey(req.KeyId)
+	if err != nil {
+		return nil, apiKeyStatusError(err)
+	}
+
+	return &authpb.RevokeAPIKeyResponse{ApiKey: apiKeyToProto(key)}, nil
+}
+
+// RotateAPIKey issues successor key, replaced key stays valid for overlap window
+// Выпускает ключ-преемник, заменяемый ключ действует в окне перекрытия
+func (s *authServiceServer) RotateAPIKey(
+	ctx context.Context,
+	req *authpb.RotateAPIKeyRequest,
+) (*authpb.RotateAPIKeyResponse, error) {
+	logger.Info("RotateAPIKey gRPC request",
+		logger.String("key_id", req.KeyId),
+		logger.String("overlap", req.Overlap))
+
+	if err := requireKeyAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	store, err := getAPIKeyStore(s.core)
+	if err != nil {
+		return nil, status.Error(codes.Unavailable, err.Error())
+	}
+
+	rotated, err := store.RotateAPIKey(req.KeyId, req.Overlap)
+	if err != nil {
+		return nil, apiKeyStatusError(err)
+	}
+
+	return &authpb.RotateAPIKeyResponse{
+		ApiKey: apiKeyToProto(&rotated.APIKeyInfo),
+		Key:    rotated.Key,
+	}, nil
+}
+
+// apiKeyToProto converts API key view to protobuf message
+// Преобразует представление API ключа в protobuf сообщение
+func apiKeyToProto(key *auth.APIKeyInfo) *authpb.APIKey {
+	return &authpb.APIKey{
+		Id:           key.ID,
+		Name:         key.Name,
+		Source:       key.Source,
+		Status:       key.Status,
+		Prefix:       key.Prefix,
+		Permissions:  key.Permissions,
+		AllowedHosts: key.AllowedHosts,
+		Tenants:      key.Tenants,
+		CreatedAt:    optionalTimestamp(key.CreatedAt),
+		ExpiresAt:    optionalTimestamp(key.ExpiresAt),
+		LastUsedAt:   optionalTimestamp(key.LastUsedAt),
+		RevokedAt:    optionalTimestamp(key.RevokedAt),
+		RotatedFrom:  key.RotatedFrom,
+		RotatedTo:    key.RotatedTo,
+	}
+}
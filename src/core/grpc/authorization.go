@@ -0,0 +1,334 @@
+/*
+This file is part of the AtomBPMN (R) project.
+Copyright (c) 2025 Matreska Market LLC (ООО «Matreska Market»).
+Authors: Matreska Team.
+
+This project is dual-licensed under AGPL-3.0 and AtomBPMN Commercial License.
+*/
+
+package grpc
+
+import (
+	"context"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"atom-engine/src/core/auth"
+	"atom-engine/src/core/models"
+)
+
+// methodAccess describes action and resource type checked before gRPC method runs
+type methodAccess struct {
+	action   string
+	resource string
+}
+
+// methodAccessTable maps gRPC methods to required action on resource type.
+// Resource IDs are checked inside services, unlisted methods require admin.
+// Таблица действий над типами ресурсов для gRPC методов
+var methodAccessTable = map[string]methodAccess{
+	"/atom.process.v1.ProcessService/StartProcessInstance":     {auth.ActionCreate, auth.PermissionProcess},
+	"/atom.process.v1.ProcessService/GetProcessInstanceStatus": {auth.ActionRead, auth.PermissionProcess},
+	"/atom.process.v1.ProcessService/CancelProcessInstance":    {auth.ActionDelete, auth.PermissionProcess},
+	"/atom.process.v1.ProcessService/ListProcessInstances":     {auth.ActionRead, auth.PermissionProcess},
+	"/atom.process.v1.ProcessService/ListTokens":               {auth.ActionRead, auth.PermissionToken},
+	"/atom.process.v1.ProcessService/GetTokenStatus":           {auth.ActionRead, auth.PermissionToken},
+	"/atom.process.v1.ProcessService/GetProcessInstanceInfo":   {auth.ActionRead, auth.PermissionProcess},
+
+	"/parser.ParserService/ParseBPMNFile":      {auth.ActionDeploy, auth.PermissionBPMN},
+	"/parser.ParserService/ListBPMNProcesses":  {auth.ActionRead, auth.PermissionBPMN},
+	"/parser.ParserService/GetBPMNProcess":     {auth.ActionRead, auth.PermissionBPMN},
+	"/parser.ParserService/DeleteBPMNProcess":  {auth.ActionDelete, auth.PermissionBPMN},
+	"/parser.ParserService/GetBPMNStats":       {auth.ActionRead, auth.PermissionBPMN},
+	"/parser.ParserService/GetBPMNProcessJSON": {auth.ActionRead, auth.PermissionBPMN},
+	"/parser.ParserService/GetBPMNProcessXML":  {auth.ActionRead, auth.PermissionBPMN},
+
+	"/jobs.JobsService/CreateJob":        {auth.ActionCreate, auth.PermissionJob},
+	"/jobs.JobsService/ActivateJobs":     {auth.ActionComplete, auth.PermissionJob},
+	"/jobs.JobsService/CompleteJob":      {auth.ActionComplete, auth.PermissionJob},
+	"/jobs.JobsService/FailJob":          {auth.ActionComplete, auth.PermissionJob},
+	"/jobs.JobsService/ThrowError":       {auth.ActionComplete, auth.PermissionJob},
+	"/jobs.JobsService/UpdateJobRetries": {auth.ActionUpdate, auth.PermissionJob},
+	"/jobs.JobsService/UpdateJobTimeout": {auth.ActionUpdate, auth.PermissionJob},
+	"/jobs.JobsService/CancelJob":        {auth.ActionDelete, auth.PermissionJob},
+	"/jobs.JobsService/ListJobs":         {auth.ActionRead, auth.PermissionJob},
+	"/jobs.JobsService/GetJob":           {auth.ActionRead, auth.PermissionJob},
+	"/jobs.JobsService/GetJobStats":      {auth.ActionRead, auth.PermissionJob},
+	"/jobs.JobsService/ListWorkers":      {auth.ActionRead, auth.PermissionJob},
+
+	"/messages.MessagesService/PublishMessage":           {auth.ActionCreate, auth.PermissionMessage},
+	"/messages.MessagesService/ListBufferedMessages":     {auth.ActionRead, auth.PermissionMessage},
+	"/messages.MessagesService/ListMessageSubscriptions": {auth.ActionRead, auth.PermissionMessage},
+	"/messages.MessagesService/GetMessageStats":          {auth.ActionRead, auth.PermissionMessage},
+	"/messages.MessagesService/CleanupExpiredMessages":   {auth.ActionDelete, auth.PermissionMessage},
+
+	"/incidents.IncidentsService/CreateIncident":    {auth.ActionCreate, auth.PermissionIncident},
+	"/incidents.IncidentsService/ResolveIncident":   {auth.ActionResolve, auth.PermissionIncident},
+	"/incidents.IncidentsService/GetIncident":       {auth.ActionRead, auth.PermissionIncident},
+	"/incidents.IncidentsService/ListIncidents":     {auth.ActionRead, auth.PermissionIncident},
+	"/incidents.IncidentsService/GetIncidentStats":  {auth.ActionRead, auth.PermissionIncident},
+	"/incidents.IncidentsService/ListRetryRules":    {auth.ActionRead, auth.PermissionIncident},
+	"/incidents.IncidentsService/PreviewRetryRules": {auth.ActionRead, auth.PermissionIncident},
+	"/incidents.IncidentsService/PutRetryRule":      {auth.ActionUpdate, auth.PermissionAdmin},
+	"/incidents.IncidentsService/DeleteRetryRule":   {auth.ActionDelete, auth.PermissionAdmin},
+
+	"/atom.timewheel.v1.TimeWheelService/AddTimer":          {auth.ActionCreate, auth.PermissionTimer},
+	"/atom.timewheel.v1.TimeWheelService/RemoveTimer":       {auth.ActionDelete, auth.PermissionTimer},
+	"/atom.timewheel.v1.TimeWheelService/GetTimerStatus":    {auth.ActionRead, auth.PermissionTimer},
+	"/atom.timewheel.v1.TimeWheelService/GetTimeWheelStats": {auth.ActionRead, auth.PermissionTimer},
+	"/atom.timewheel.v1.TimeWheelService/ListTimers":        {auth.ActionRead, auth.PermissionTimer},
+	"/atom.timewheel.v1.TimeWheelService/GetClock":          {auth.ActionRead, auth.PermissionTimer},
+	"/atom.timewheel.v1.TimeWheelService/GetCalendar":       {auth.ActionRead, auth.PermissionTimer},
+	"/atom.timewheel.v1.TimeWheelService/ListCalendars":     {auth.ActionRead, auth.PermissionTimer},
+	"/atom.timewheel.v1.TimeWheelService/AdvanceClock":      {auth.ActionUpdate, auth.PermissionAdmin},
+	"/atom.timewheel.v1.TimeWheelService/PutCalendar":       {auth.ActionUpdate, auth.PermissionAdmin},
+	"/atom.timewheel.v1.TimeWheelService/DeleteCalendar":    {auth.ActionDelete, auth.PermissionAdmin},
+
+	"/expression.ExpressionService/EvaluateExpression":    {auth.ActionRead, auth.PermissionExpression},
+	"/expression.ExpressionService/EvaluateBatch":         {auth.ActionRead, auth.PermissionExpression},
+	"/expression.ExpressionService/ParseExpression":       {auth.ActionRead, auth.PermissionExpression},
+	"/expression.ExpressionService/ValidateExpression":    {auth.ActionRead, auth.PermissionExpression},
+	"/expression.ExpressionService/GetSupportedFunctions": {auth.ActionRead, auth.PermissionExpression},
+	"/expression.ExpressionService/EvaluateCondition":     {auth.ActionRead, auth.PermissionExpression},
+	"/expression.ExpressionService/ExtractVariables":      {auth.ActionRead, auth.PermissionExpression},
+	"/expression.ExpressionService/TestExpression":        {auth.ActionRead, auth.PermissionExpression},
+
+	"/atom.storage.v1.StorageService/GetStorageStatus": {auth.ActionRead, auth.PermissionStorage},
+	"/atom.storage.v1.StorageService/GetStorageInfo":   {auth.ActionRead, auth.PermissionStorage},
+	"/atom.storage.v1.StorageService/BackupStorage":    {auth.ActionRead, auth.PermissionAdmin},
+	"/atom.storage.v1.StorageService/RestoreStorage":   {auth.ActionCreate, auth.PermissionAdmin},
+
+	"/batch.BatchService/CreateBatch": {auth.ActionCreate, auth.PermissionAdmin},
+	"/batch.BatchService/GetBatch":    {auth.ActionRead, auth.PermissionProcess},
+	"/batch.BatchService/ListBatches": {auth.ActionRead, auth.PermissionProcess},
+	"/batch.BatchService/PauseBatch":  {auth.ActionUpdate, auth.PermissionAdmin},
+	"/batch.BatchService/ResumeBatch": {auth.ActionUpdate, auth.PermissionAdmin},
+	"/batch.BatchService/CancelBatch": {auth.ActionUpdate, auth.PermissionAdmin},
+	"/batch.BatchService/DeleteBatch": {auth.ActionDelete, auth.PermissionAdmin},
+
+	"/auth.AuthService/CreateAPIKey": {auth.ActionCreate, auth.PermissionAdmin},
+	"/auth.AuthService/ListAPIKeys":  {auth.ActionRead, auth.PermissionAdmin},
+	"/auth.AuthService/GetAPIKey":    {auth.ActionRead, auth.PermissionAdmin},
+	"/auth.AuthService/RevokeAPIKey": {auth.ActionDelete, auth.PermissionAdmin},
+	"/auth.AuthService/RotateAPIKey": {auth.ActionUpdate, auth.PermissionAdmin},
+
+	// Events of other resource types are filtered per event by caller permissions
+	"/events.EventsService/SubscribeEvents": {auth.ActionRead, auth.PermissionProcess},
+
+	// Export log spans all tenants
+	"/exporters.ExportersService/GetExportStatus":  {auth.ActionRead, auth.PermissionAdmin},
+	"/exporters.ExportersService/CompactExportLog": {auth.ActionUpdate, auth.PermissionAdmin},
+}
+
+// authorizeMethod checks that caller may perform method action on some resources of its type
+// Проверяет, что вызывающий может выполнить действие метода хотя бы над частью ресурсов
+func authorizeMethod(fullMethod string, result *auth.AuthResult) error {
+	access, ok := methodAccessTable[fullMethod]
+	if !ok {
+		access = methodAccess{action: auth.ActionAll, resource: auth.PermissionAdmin}
+	}
+
+	if !result.CanPerform(access.action, access.resource) {
+		return status.Errorf(codes.PermissionDenied, "Insufficient permissions: %s on %s required",
+			access.action, access.resource)
+	}
+	return nil
+}
+
+// authorizeResource checks action on resource ID when authentication is enabled.
+// Empty resource ID stands for all resources and also requires access to all tenants.
+// Проверяет действие над ресурсом с ID если аутентификация включена
+func authorizeResource(ctx context.Context, action, resource, resourceID string) error {
+	result, ok := GetAuthResultFromContext(ctx)
+	if !ok {
+		return nil
+	}
+	if result.Authorize(action, resource, resourceID) && (resourceID != "" || result.AllTenants()) {
+		return nil
+	}
+	return status.Errorf(codes.PermissionDenied, "Insufficient permissions to %s %s %s",
+		action, resource, resourceID)
+}
+
+// authorizeTenantResource checks action on resource ID owned by tenant when authentication is enabled
+// Проверяет действие над ресурсом арендатора если аутентификация включена
+func authorizeTenantResource(ctx context.Context, action, resource, tenantID, resourceID string) error {
+	if err := authorizeTenant(ctx, tenantID); err != nil {
+		return err
+	}
+	result, ok := GetAuthResultFromContext(ctx)
+	if !ok || result.Authorize(action, resource, resourceID) {
+		return nil
+	}
+	return status.Errorf(codes.PermissionDenied, "Insufficient permissions to %s %s %s",
+		action, resource, resourceID)
+}
+
+// authorizeTenant checks caller may access data of tenant when authentication is enabled
+// Проверяет доступ вызывающего к данным арендатора если аутентификация включена
+func authorizeTenant(ctx context.Context, tenantID string) error {
+	result, ok := GetAuthResultFromContext(ctx)
+	if !ok || result.AllowsTenant(tenantID) {
+		return nil
+	}
+	return status.Errorf(codes.PermissionDenied, "Access to tenant %q denied", tenantID)
+}
+
+// resolveTenant validates requested tenant of write operation and checks caller may use it.
+// Empty request resolves to the only tenant of restricted caller or to the default tenant.
+// Проверяет арендатора операции записи и доступ вызывающего к нему
+func resolveTenant(ctx context.Context, requested string) (string, error) {
+	if err := models.ValidateTenantID(models.NormalizeTenantID(requested)); err != nil {
+		return "", status.Error(codes.InvalidArgument, err.Error())
+	}
+	result, ok := GetAuthResultFromContext(ctx)
+	if !ok {
+		return models.NormalizeTenantID(requested), nil
+	}
+	tenantID, allowed := result.ResolveTenant(requested)
+	if !allowed {
+		return "", status.Errorf(codes.PermissionDenied, "Access to tenant %q denied", tenantID)
+	}
+	return tenantID, nil
+}
+
+// authorizeInstance checks action on resource owned by process instance,
+// resource ID is BPMN process ID of instance, tenant is tenant of instance
+// Проверяет действие над ресурсом экземпляра процесса по BPMN ID процесса и арендатору
+func authorizeInstance(ctx context.Context, core CoreInterface, action, resource, instanceID string) error {
+	result, ok := GetAuthResultFromContext(ctx)
+	if !ok {
+		return nil
+	}
+	unrestricted := result.Authorize(action, resource, "")
+	if unrestricted && result.AllTenants() {
+		return nil
+	}
+	processID, tenantID := lookupInstance(core, instanceID)
+	if err := authorizeTenant(ctx, tenantID); err != nil {
+		return err
+	}
+	if unrestricted {
+		return nil
+	}
+	return authorizeResource(ctx, action, resource, processID)
+}
+
+// authorizeOwned checks action on resource stamped with tenant and owned by process instance,
+// instance may be empty for resources created outside of processes
+// Проверяет действие над ресурсом арендатора, принадлежащим экземпляру процесса
+func authorizeOwned(ctx context.Context, core CoreInterface, action, resource, tenantID, instanceID string) error {
+	if err := authorizeTenant(ctx, tenantID); err != nil {
+		return err
+	}
+	result, ok := GetAuthResultFromContext(ctx)
+	if !ok || result.Authorize(action, resource, "") {
+		return nil
+	}
+	processID, _ := lookupInstance(core, instanceID)
+	return authorizeTenantResource(ctx, action, resource, tenantID, processID)
+}
+
+// resourceFilter selects list items caller may see
+// Отбирает элементы списка, доступные вызывающему
+type resourceFilter struct {
+	result       *auth.AuthResult
+	core         CoreInterface
+	action       string
+	resource     string
+	unrestricted bool
+	instances    map[string]instanceOwner
+}
+
+// instanceOwner caches BPMN process ID and tenant of process instance
+type instanceOwner struct {
+	processID string
+	tenantID  string
+}
+
+// newResourceFilter returns filter for list results, nil when every item is allowed
+func newResourceFilter(ctx context.Context, core CoreInterface, action, resource string) *resourceFilter {
+	result, ok := GetAuthResultFromContext(ctx)
+	if !ok {
+		return nil
+	}
+	unrestricted := result.Authorize(action, resource, "")
+	if unrestricted && result.AllTenants() {
+		return nil
+	}
+	return &resourceFilter{
+		result:       result,
+		core:         core,
+		action:       action,
+		resource:     resource,
+		unrestricted: unrestricted,
+		instances:    make(map[string]instanceOwner),
+	}
+}
+
+// Allows checks item shared by all tenants, such as worker, by resource ID
+func (f *resourceFilter) Allows(resourceID string) bool {
+	return f == nil || f.unrestricted || f.result.Authorize(f.action, f.resource, resourceID)
+}
+
+// AllowsIn checks item by tenant and resource ID
+func (f *resourceFilter) AllowsIn(tenantID, resourceID string) bool {
+	if f == nil {
+		return true
+	}
+	return f.result.AllowsTenant(tenantID) && f.Allows(resourceID)
+}
+
+// AllowsInstance checks item owned by process instance
+func (f *resourceFilter) AllowsInstance(instanceID string) bool {
+	if f == nil {
+		return true
+	}
+	owner, ok := f.instances[instanceID]
+	if !ok {
+		owner.processID, owner.tenantID = lookupInstance(f.core, instanceID)
+		f.instances[instanceID] = owner
+	}
+	return f.AllowsIn(owner.tenantID, owner.processID)
+}
+
+// AllowsOwned checks item stamped with tenant and owned by process instance
+func (f *resourceFilter) AllowsOwned(tenantID, instanceID string) bool {
+	if f == nil {
+		return true
+	}
+	if !f.result.AllowsTenant(tenantID) {
+		return false
+	}
+	if f.unrestricted {
+		return true
+	}
+	owner, ok := f.instances[instanceID]
+	if !ok {
+		owner.processID, owner.tenantID = lookupInstance(f.core, instanceID)
+		f.instances[instanceID] = owner
+	}
+	return f.result.Authorize(f.action, f.resource, owner.processID)
+}
+
+// lookupInstance returns BPMN process ID and tenant of instance, empty when unknown
+func lookupInstance(core CoreInterface, instanceID string) (string, string) {
+	processComp := core.GetProcessComponent()
+	if processComp == nil || instanceID == "" {
+		return "", models.DefaultTenantID
+	}
+	instance, err := processComp.GetProcessInstanceStatus(instanceID)
+	if err != nil || instance == nil {
+		return "", models.DefaultTenantID
+	}
+	return instance.ProcessID, instance.TenantID
+}
+
+// processIDFromKey strips tenant prefix and version suffix from "processID:version" start key
+func processIDFromKey(processKey string) string {
+	_, processKey = models.SplitTenantScopedKey(processKey)
+	processID, _, _ := strings.Cut(processKey, ":")
+	return processID
+}
@@ -0,0 +1,422 @@
+/*
+This file is part of the AtomBPMN (R) project.
+Copyright (c) 2025 Matreska Market LLC (ООО «Matreska Market»).
+Authors: Matreska Team.
+
+This project is dual-licensed under AGPL-3.0 and AtomBPMN Commercial License.
+*/
+
+package grpc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"atom-engine/proto/batch/batchpb"
+	"atom-engine/src/batch"
+	"atom-engine/src/core/auth"
+	"atom-engine/src/core/logger"
+	"atom-engine/src/core/models"
+	"atom-engine/src/incidents"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// batchServiceServer implements batch operations gRPC service
+type batchServiceServer struct {
+	batchpb.UnimplementedBatchServiceServer
+	core CoreInterface
+}
+
+// getBatchComponent helper function for direct component access
+// helper функция для прямого доступа к компоненту пакетных операций
+func getBatchComponent(core CoreInterface) (*batch.Component, error) {
+	componentIf := core.GetBatchComponent()
+	if componentIf == nil {
+		return nil, fmt.Errorf("batch component not available")
+	}
+
+	component, ok := componentIf.(*batch.Component)
+	if !ok {
+		return nil, fmt.Errorf("batch component type assertion failed")
+	}
+
+	return component, nil
+}
+
+// requireBatchAdmin checks admin permission when authentication is enabled
+// Проверяет разрешение admin если аутентификация включена
+func requireBatchAdmin(ctx context.Context) (string, error) {
+	authResult, authenticated := GetAuthResultFromContext(ctx)
+	if !authenticated {
+		return "", nil
+	}
+	if err := RequirePermission(ctx, auth.PermissionAdmin); err != nil {
+		return "", err
+	}
+	return authResult.APIKeyName, nil
+}
+
+// batchStatusError maps batch component error to gRPC status
+// Преобразует ошибку компонента пакетных операций в gRPC статус
+func batchStatusError(err error) error {
+	switch {
+	case errors.Is(err, batch.ErrBatchNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, batch.ErrStateConflict):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case strings.Contains(err.Error(), "invalid"):
+		return status.Error(codes.InvalidArgument, err.Error())
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
+
+// CreateBatch submits batch operation over instances or incidents matching filter.
+// Requires admin permission when authentication is enabled.
+// Отправляет пакетную операцию над экземплярами или инцидентами по фильтру
+func (s *batchServiceServer) CreateBatch(
+	ctx context.Context,
+	req *batchpb.CreateBatchRequest,
+) (*batchpb.CreateBatchResponse, error) {
+	logger.Info("CreateBatch gRPC request", logger.String("type", req.Type))
+
+	createdBy, err := requireBatchAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	component, err := getBatchComponent(s.core)
+	if err != nil {
+		return nil, status.Error(codes.Unavailable, err.Error())
+	}
+
+	request, err := batchRequestFromProto(req)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	request.CreatedBy = createdBy
+	if err := scopeBatchTenant(ctx, &request.Filter); err != nil {
+		return nil, err
+	}
+
+	created, err := component.Create(ctx, request)
+	if err != nil {
+		return nil, batchStatusError(err)
+	}
+
+	return &batchpb.CreateBatchResponse{Batch: batchToProto(created)}, nil
+}
+
+// GetBatch returns batch operation with progress and failures
+// Возвращает пакетную операцию с прогрессом и ошибками
+func (s *batchServiceServer) GetBatch(
+	ctx context.Context,
+	req *batchpb.GetBatchRequest,
+) (*batchpb.GetBatchResponse, error) {
+	component, err := getBatchComponent(s.core)
+	if err != nil {
+		return nil, status.Error(codes.Unavailable, err.Error())
+	}
+
+	found, err := component.Get(req.BatchId)
+	if err != nil {
+		return nil, batchStatusError(err)
+	}
+
+	// Batches are scoped by tenant and process of their filter
+	// Пакетные операции ограничиваются арендатором и процессом их фильтра
+	processID := processIDFromKey(found.Filter.ProcessKey)
+	err = authorizeTenantResource(ctx, auth.ActionRead, auth.PermissionProcess, batchTenant(found), processID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &batchpb.GetBatchResponse{Batch: batchToProto(found)}, nil
+}
+
+// ListBatches lists batch operations newest first
+// Выводит список пакетных операций начиная с новых
+func (s *batchServiceServer) ListBatches(
+	ctx context.Context,
+	req *batchpb.ListBatchesRequest,
+) (*batchpb.ListBatchesResponse, error) {
+	component, err := getBatchComponent(s.core)
+	if err != nil {
+		return nil, status.Error(codes.Unavailable, err.Error())
+	}
+
+	filter := &batch.ListFilter{State: batch.State(strings.ToUpper(req.State))}
+	if req.Type != "" {
+		operation, err := batch.ParseOperationType(req.Type)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		filter.Type = operation
+	}
+
+	batches := component.List(filter)
+	response := &batchpb.ListBatchesResponse{
+		Batches: make([]*batchpb.Batch, 0, len(batches)),
+	}
+	readFilter := newResourceFilter(ctx, s.core, auth.ActionRead, auth.PermissionProcess)
+	for _, item := range batches {
+		if !readFilter.AllowsIn(batchTenant(item), processIDFromKey(item.Filter.ProcessKey)) {
+			continue
+		}
+		response.Batches = append(response.Batches, batchToProto(item))
+	}
+	return response, nil
+}
+
+// PauseBatch pauses batch operation after current item.
+// Requires admin permission when authentication is enabled.
+// Приостанавливает пакетную операцию после текущего элемента
+func (s *batchServiceServer) PauseBatch(
+	ctx context.Context,
+	req *batchpb.PauseBatchRequest,
+) (*batchpb.PauseBatchResponse, error) {
+	updated, err := s.changeBatchState(ctx, "PauseBatch", req.BatchId, (*batch.Component).Pause)
+	if err != nil {
+		return nil, err
+	}
+	return &batchpb.PauseBatchResponse{Batch: updated}, nil
+}
+
+// ResumeBatch resumes paused batch operation.
+// Requires admin permission when authentication is enabled.
+// Продолжает приостановленную пакетную операцию
+func (s *batchServiceServer) ResumeBatch(
+	ctx context.Context,
+	req *batchpb.ResumeBatchRequest,
+) (*batchpb.ResumeBatchResponse, error) {
+	updated, err := s.changeBatchState(ctx, "ResumeBatch", req.BatchId, (*batch.Component).Resume)
+	if err != nil {
+		return nil, err
+	}
+	return &batchpb.ResumeBatchResponse{Batch: updated}, nil
+}
+
+// CancelBatch cancels batch operation, remaining items are skipped.
+// Requires admin permission when authentication is enabled.
+// Отменяет пакетную операцию, оставшиеся элементы пропускаются
+func (s *batchServiceServer) CancelBatch(
+	ctx context.Context,
+	req *batchpb.CancelBatchRequest,
+) (*batchpb.CancelBatchResponse, error) {
+	updated, err := s.changeBatchState(ctx, "CancelBatch", req.BatchId, (*batch.Component).Cancel)
+	if err != nil {
+		return nil, err
+	}
+	return &batchpb.CancelBatchResponse{Batch: updated}, nil
+}
+
+// DeleteBatch deletes finished batch operation.
+// Requires admin permission when authentication is enabled.
+// Удаляет завершенную пакетную операцию
+func (s *batchServiceServer) DeleteBatch(
+	ctx context.Context,
+	req *batchpb.DeleteBatchRequest,
+) (*batchpb.DeleteBatchResponse, error) {
+	logger.Info("DeleteBatch gRPC request", logger.String("batch_id", req.BatchId))
+
+	if _, err := requireBatchAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	component, err := getBatchComponent(s.core)
+	if err != nil {
+		return nil, status.Error(codes.Unavailable, err.Error())
+	}
+
+	if err := component.Delete(req.BatchId); err != nil {
+		return nil, batchStatusError(err)
+	}
+
+	return &batchpb.DeleteBatchResponse{
+		Success: true,
+		Message: fmt.Sprintf("batch operation %s deleted", req.BatchId),
+	}, nil
+}
+
+// changeBatchState applies state change of batch component
+// Применяет изменение состояния компонента пакетных операций
+func (s *batchServiceServer) changeBatchState(
+	ctx context.Context,
+	method, batchID string,
+	change func(*batch.Component, string) (*batch.Batch, error),
+) (*batchpb.Batch, error) {
+	logger.Info(method+" gRPC request", logger.String("batch_id", batchID))
+
+	if _, err := requireBatchAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	component, err := getBatchComponent(s.core)
+	if err != nil {
+		return nil, status.Error(codes.Unavailable, err.Error())
+	}
+
+	updated, err := change(component, batchID)
+	if err != nil {
+		return nil, batchStatusError(err)
+	}
+	return batchToProto(updated), nil
+}
+
+// scopeBatchTenant checks tenant of batch filter, batch of tenant restricted caller
+// without tenant is limited to its tenant instead of spanning every tenant
+// Проверяет арендатора фильтра пакетной операции
+func scopeBatchTenant(ctx context.Context, filter *batch.Filter) error {
+	if tenantID, scoped := filter.Tenant(); scoped {
+		return authorizeTenant(ctx, tenantID)
+	}
+	result, ok := GetAuthResultFromContext(ctx)
+	if !ok || result.AllTenants() {
+		return nil
+	}
+	tenantID, err := resolveTenant(ctx, "")
+	if err != nil {
+		return err
+	}
+	filter.TenantID = models.TenantLabel(tenantID)
+	return nil
+}
+
+// batchTenant returns tenant batch is scoped to, AllTenants for batch spanning every tenant
+func batchTenant(b *batch.Batch) string {
+	if tenantID, scoped := b.Filter.Tenant(); scoped {
+		return tenantID
+	}
+	return models.AllTenants
+}
+
+// batchRequestFromProto converts protobuf create request to component request
+// Преобразует protobuf запрос создания в запрос компонента
+func batchRequestFromProto(req *batchpb.CreateBatchRequest) (*batch.CreateRequest, error) {
+	request := &batch.CreateRequest{
+		Type:      batch.OperationType(req.Type),
+		ChunkSize: int(req.ChunkSize),
+	}
+
+	if filter := req.Filter; filter != nil {
+		request.Filter = batch.Filter{
+			InstanceIDs: filter.InstanceIds,
+			ProcessKey:  filter.ProcessKey,
+			Version:     int(filter.Version),
+			States:      filter.States,
+			ElementID:   filter.ElementId,
+			TenantID:    filter.TenantId,
+		}
+		if filter.Variables != "" {
+			if err := json.Unmarshal([]byte(filter.Variables), &request.Filter.Variables); err != nil {
+				return nil, fmt.Errorf("invalid filter variables JSON: %w", err)
+			}
+		}
+		if filter.StartedAfter != nil {
+			startedAfter := filter.StartedAfter.AsTime()
+			request.Filter.StartedAfter = &startedAfter
+		}
+		if filter.StartedBefore != nil {
+			startedBefore := filter.StartedBefore.AsTime()
+			request.Filter.StartedBefore = &startedBefore
+		}
+		for _, incidentType := range filter.IncidentTypes {
+			request.Filter.IncidentTypes = append(request.Filter.IncidentTypes, incidents.IncidentType(incidentType))
+		}
+	}
+
+	if params := req.Params; params != nil {
+		request.Params = batch.Params{
+			Reason:        params.Reason,
+			Comment:       params.Comment,
+			NewRetries:    int(params.NewRetries),
+			TargetVersion: int(params.TargetVersion),
+		}
+		if params.Variables != "" {
+			if err := json.Unmarshal([]byte(params.Variables), &request.Params.Variables); err != nil {
+				return nil, fmt.Errorf("invalid params variables JSON: %w", err)
+			}
+		}
+	}
+
+	return request, nil
+}
+
+// batchToProto converts batch operation to protobuf
+// Преобразует пакетную операцию в protobuf
+func batchToProto(b *batch.Batch) *batchpb.Batch {
+	pb := &batchpb.Batch{
+		Id:        b.ID,
+		Type:      string(b.Type),
+		State:     string(b.State),
+		ChunkSize: int32(b.ChunkSize),
+		CreatedBy: b.CreatedBy,
+		Total:     int32(b.Total),
+		Processed: int32(b.Processed),
+		Succeeded: int32(b.Succeeded),
+		Failed:    int32(b.Failed),
+		Error:     b.Error,
+		CreatedAt: timestamppb.New(b.CreatedAt),
+		UpdatedAt: timestamppb.New(b.UpdatedAt),
+		Filter: &batchpb.BatchFilter{
+			InstanceIds:   b.Filter.InstanceIDs,
+			ProcessKey:    b.Filter.ProcessKey,
+			Version:       int32(b.Filter.Version),
+			States:        b.Filter.States,
+			ElementId:     b.Filter.ElementID,
+			Variables:     batchVariablesJSON(b.Filter.Variables),
+			StartedAfter:  optionalTimestamp(b.Filter.StartedAfter),
+			StartedBefore: optionalTimestamp(b.Filter.StartedBefore),
+			TenantId:      b.Filter.TenantID,
+		},
+		Params: &batchpb.BatchParams{
+			Reason:        b.Params.Reason,
+			Comment:       b.Params.Comment,
+			NewRetries:    int32(b.Params.NewRetries),
+			Variables:     batchVariablesJSON(b.Params.Variables),
+			TargetVersion: int32(b.Params.TargetVersion),
+		},
+		StartedAt:   optionalTimestamp(b.StartedAt),
+		CompletedAt: optionalTimestamp(b.CompletedAt),
+	}
+	for _, incidentType := range b.Filter.IncidentTypes {
+		pb.Filter.IncidentTypes = append(pb.Filter.IncidentTypes, string(incidentType))
+	}
+	for _, failure := range b.Failures {
+		pb.Failures = append(pb.Failures, &batchpb.BatchFailure{
+			ItemId: failure.ItemID,
+			Error:  failure.Error,
+			At:     timestamppb.New(failure.At),
+		})
+	}
+	return pb
+}
+
+// batchVariablesJSON encodes variables as JSON object, empty when not set
+// Кодирует переменные в JSON объект, пусто если не заданы
+func batchVariablesJSON(variables map[string]interface{}) string {
+	if len(variables) == 0 {
+		return ""
+	}
+	data, err := json.Marshal(variables)
+	if err != nil {
+		return ""
+	}
+	return string(data)
+}
+
+// optionalTimestamp converts optional time to protobuf timestamp
+// Преобразует необязательное время в protobuf timestamp
+func optionalTimestamp(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+	return timestamppb.New(*t)
+}
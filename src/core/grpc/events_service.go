@@ -0,0 +1,151 @@
+/*
+This file is part of the AtomBPMN (R) project.
+Copyright (c) 2025 Matreska Market LLC (ООО «Matreska Market»).
+Authors: Matreska Team.
+
+This project is dual-licensed under AGPL-3.0 and AtomBPMN Commercial License.
+*/
+
+package grpc
+
+import (
+	"context"
+	"errors"
+
+	"atom-engine/proto/events/eventspb"
+	"atom-engine/src/core/auth"
+	"atom-engine/src/core/events"
+	"atom-engine/src/core/logger"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// eventsServiceServer implements engine event stream gRPC service
+type eventsServiceServer struct {
+	eventspb.UnimplementedEventsServiceServer
+	core CoreInterface
+}
+
+// SubscribeEvents streams engine events matching request filter
+// Передает поток событий движка, подходящих под фильтр запроса
+func (s *eventsServiceServer) SubscribeEvents(
+	req *eventspb.SubscribeEventsRequest,
+	stream eventspb.EventsService_SubscribeEventsServer,
+) error {
+	ctx := stream.Context()
+	logger.Info("SubscribeEvents gRPC request",
+		logger.String("process_key", req.ProcessKey),
+		logger.String("process_instance_id", req.ProcessInstanceId),
+		logger.Any("types", req.Types),
+		logger.String("tenant_id", req.TenantId),
+		logger.Any("from_sequence", req.FromSequence))
+
+	if req.TenantId != "" {
+		if err := authorizeTenant(ctx, req.TenantId); err != nil {
+			return err
+		}
+	}
+	filter := events.Filter{
+		ProcessKey:        req.ProcessKey,
+		ProcessInstanceID: req.ProcessInstanceId,
+		Types:             req.Types,
+		TenantID:          req.TenantId,
+	}
+	subscription, err := events.Subscribe(filter, req.FromSequence)
+	if err != nil {
+		return eventsStatusError(err)
+	}
+	defer subscription.Close()
+
+	access := newEventAccess(ctx, s.core)
+	for {
+		// Timeout only wakes loop, gRPC keepalive keeps idle stream open
+		event, err := subscription.Next(ctx, events.HeartbeatInterval())
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return eventsStatusError(err)
+		}
+		if event == nil || !access.Allows(event) {
+			continue
+		}
+		if err := stream.Send(engineEventToProto(event)); err != nil {
+			return err
+		}
+	}
+}
+
+// eventAccess selects stream events caller may read by resource type of event category
+// Отбирает события потока, доступные вызывающему, по типу ресурса категории события
+type eventAccess struct {
+	process    *resourceFilter
+	job        *resourceFilter
+	incident   *resourceFilter
+	message    *resourceFilter
+	deployment *resourceFilter
+}
+
+// newEventAccess builds read filters of stream caller
+func newEventAccess(ctx context.Context, core CoreInterface) *eventAccess {
+	return &eventAccess{
+		process:    newResourceFilter(ctx, core, auth.ActionRead, auth.PermissionProcess),
+		job:        newResourceFilter(ctx, core, auth.ActionRead, auth.PermissionJob),
+		incident:   newResourceFilter(ctx, core, auth.ActionRead, auth.PermissionIncident),
+		message:    newResourceFilter(ctx, core, auth.ActionRead, auth.PermissionMessage),
+		deployment: newResourceFilter(ctx, core, auth.ActionRead, auth.PermissionBPMN),
+	}
+}
+
+// Allows checks event by its tenant and resource ID of its category
+func (a *eventAccess) Allows(event *events.Event) bool {
+	switch event.Category() {
+	case events.CategoryJob:
+		return a.job.AllowsIn(event.TenantID, event.JobType)
+	case events.CategoryIncident:
+		return a.incident.AllowsIn(event.TenantID, event.ProcessID)
+	case events.CategoryMessage:
+		return a.message.AllowsIn(event.TenantID, event.MessageName)
+	case events.CategoryDeployment:
+		return a.deployment.AllowsIn(event.TenantID, event.ProcessID)
+	default:
+		return a.process.AllowsIn(event.TenantID, event.ProcessID)
+	}
+}
+
+// eventsStatusError maps event stream error to gRPC status
+// Преобразует ошибку потока событий в gRPC статус
+func eventsStatusError(err error) error {
+	switch {
+	case errors.Is(err, events.ErrSequenceUnavailable):
+		return status.Error(codes.OutOfRange, err.Error())
+	case errors.Is(err, events.ErrSubscriberLagged):
+		return status.Error(codes.ResourceExhausted, err.Error())
+	case errors.Is(err, events.ErrClosed):
+		return status.Error(codes.Unavailable, err.Error())
+	default:
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+}
+
+// engineEventToProto converts engine event to protobuf message
+func engineEventToProto(event *events.Event) *eventspb.EngineEvent {
+	return &eventspb.EngineEvent{
+		Sequence:          event.Sequence,
+		Type:              event.Type,
+		Timestamp:         timestamppb.New(event.Timestamp),
+		TenantId:          event.TenantID,
+		ProcessId:         event.ProcessID,
+		ProcessKey:        event.ProcessKey,
+		ProcessInstanceId: event.ProcessInstanceID,
+		ElementId:         event.ElementID,
+		ElementType:       event.ElementType,
+		JobKey:            event.JobKey,
+		JobType:           event.JobType,
+		IncidentId:        event.IncidentID,
+		MessageName:       event.MessageName,
+		Attributes:        event.Attributes,
+	}
+}
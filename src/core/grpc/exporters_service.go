@@ -0,0 +1,139 @@
+/*
+This file is part of the AtomBPMN (R) project.
+Copyright (c) 2025 Matreska Market LLC (ООО «Matreska Market»).
+Authors: Matreska Team.
+
+This project is dual-licensed under AGPL-3.0 and AtomBPMN Commercial License.
+*/
+
+package grpc
+
+import (
+	"context"
+	"fmt"
+
+	"atom-engine/proto/exporters/exporterspb"
+	"atom-engine/src/core/auth"
+	"atom-engine/src/core/logger"
+	"atom-engine/src/exporters"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// exportersServiceServer implements export log gRPC service
+type exportersServiceServer struct {
+	exporterspb.UnimplementedExportersServiceServer
+	core CoreInterface
+}
+
+// getExportersComponent helper function for direct component access
+// helper функция для прямого доступа к компоненту экспортеров
+func getExportersComponent(core CoreInterface) (*exporters.Component, error) {
+	componentIf := core.GetExportersComponent()
+	if componentIf == nil {
+		return nil, fmt.Errorf("exporters component not available")
+	}
+
+	component, ok := componentIf.(*exporters.Component)
+	if !ok {
+		return nil, fmt.Errorf("exporters component type assertion failed")
+	}
+
+	return component, nil
+}
+
+// GetExportStatus returns export log positions and exporter progress.
+// Requires admin permission when authentication is enabled.
+// Возвращает позиции журнала экспорта и прогресс экспортеров
+func (s *exportersServiceServer) GetExportStatus(
+	ctx context.Context,
+	req *exporterspb.GetExportStatusRequest,
+) (*exporterspb.GetExportStatusResponse, error) {
+	if err := requireExportersAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	component, err := getExportersComponent(s.core)
+	if err != nil {
+		return nil, status.Error(codes.Unavailable, err.Error())
+	}
+
+	exportStatus, err := component.Status()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	response := &exporterspb.GetExportStatusResponse{
+		LastPosition:      exportStatus.LastPosition,
+		CompactedPosition: exportStatus.CompactedPosition,
+		Exporters:         make([]*exporterspb.ExporterStatus, 0, len(exportStatus.Exporters)),
+	}
+	for _, exporter := range exportStatus.Exporters {
+		response.Exporters = append(response.Exporters, exporterStatusToProto(exporter))
+	}
+	return response, nil
+}
+
+// CompactExportLog removes records acknowledged by every exporter.
+// Requires admin permission when authentication is enabled.
+// Удаляет записи, подтвержденные всеми экспортерами
+func (s *exportersServiceServer) CompactExportLog(
+	ctx context.Context,
+	req *exporterspb.CompactExportLogRequest,
+) (*exporterspb.CompactExportLogResponse, error) {
+	if err := requireExportersAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	component, err := getExportersComponent(s.core)
+	if err != nil {
+		return nil, status.Error(codes.Unavailable, err.Error())
+	}
+
+	logger.Info("gRPC CompactExportLog request")
+
+	result, err := component.Compact()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &exporterspb.CompactExportLogResponse{
+		Position: result.Position,
+		Deleted:  int32(result.Deleted),
+	}, nil
+}
+
+// requireExportersAdmin checks admin permission when authentication is enabled
+// Проверяет разрешение admin если аутентификация включена
+func requireExportersAdmin(ctx context.Context) error {
+	if _, authenticated := GetAuthResultFromContext(ctx); !authenticated {
+		return nil
+	}
+	return RequirePermission(ctx, auth.PermissionAdmin)
+}
+
+// exporterStatusToProto converts exporter status to protobuf message
+// Преобразует статус экспортера в protobuf сообщение
+func exporterStatusToProto(exporter *exporters.ExporterStatus) *exporterspb.ExporterStatus {
+	var lastExportAt, lastErrorAt *timestamppb.Timestamp
+	if exporter.LastExportAt != nil {
+		lastExportAt = timestamppb.New(*exporter.LastExportAt)
+	}
+	if exporter.LastErrorAt != nil {
+		lastErrorAt = timestamppb.New(*exporter.LastErrorAt)
+	}
+
+	return &exporterspb.ExporterStatus{
+		Id:                  exporter.ID,
+		Type:                exporter.Type,
+		Position:            exporter.Position,
+		Lag:                 exporter.Lag,
+		Exported:            exporter.Exported,
+		LastExportAt:        lastExportAt,
+		LastError:           exporter.LastError,
+		LastErrorAt:         lastErrorAt,
+		ConsecutiveFailures: int32(exporter.ConsecutiveFailures),
+	}
+}
@@ -298,6 +298,41 @@ func (s *expressionServiceServer) GetSupportedFunctions(
 			ReturnType:  "date",
 			Examples:    []string{"now()", "now() + duration(\"P1D\")"},
 		},
+		{
+			Name:        "add_working_time",
+			Category:    "date",
+			Description: "Add working time of business calendar to datetime, days count business days",
+			ReturnType:  "datetime",
+			Examples:    []string{"add_working_time(now(), \"PT8H\", \"support\")"},
+			Parameters: []*expressionpb.ParameterInfo{
+				{Name: "datetime", Type: "datetime", Required: true, Description: "Start datetime"},
+				{Name: "duration", Type: "duration", Required: true, Description: "ISO 8601 working duration"},
+				{Name: "calendar", Type: "string", Required: true, Description: "Business calendar name"},
+			},
+		},
+		{
+			Name:        "next_business_day",
+			Category:    "date",
+			Description: "Next business day of calendar after datetime at given time",
+			ReturnType:  "datetime",
+			Examples:    []string{"next_business_day(now(), \"support\", \"09:00\")"},
+			Parameters: []*expressionpb.ParameterInfo{
+				{Name: "datetime", Type: "datetime", Required: true, Description: "Start datetime"},
+				{Name: "calendar", Type: "string", Required: true, Description: "Business calendar name"},
+				{Name: "time", Type: "string", Required: false, Description: "Time of day HH:MM, default start of working hours"},
+			},
+		},
+		{
+			Name:        "is_working_time",
+			Category:    "date",
+			Description: "Check whether datetime is working time of business calendar",
+			ReturnType:  "boolean",
+			Examples:    []string{"is_working_time(now(), \"support\")"},
+			Parameters: []*expressionpb.ParameterInfo{
+				{Name: "datetime", Type: "datetime", Required: true, Description: "Datetime to check"},
+				{Name: "calendar", Type: "string", Required: true, Description: "Business calendar name"},
+			},
+		},
 	}
 
 	// Filter by category if specified
@@ -11,14 +11,20 @@ package grpc
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"sort"
+	"strings"
 	"time"
 
 	"atom-engine/proto/incidents/incidentspb"
+	"atom-engine/src/core/auth"
 	"atom-engine/src/core/logger"
+	"atom-engine/src/core/models"
 	"atom-engine/src/incidents"
 
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -54,6 +60,18 @@ func (s *incidentsServiceServer) CreateIncident(
 		logger.String("message", req.Message),
 		logger.String("process_instance_id", req.ProcessInstanceId))
 
+	// Incident of process instance belongs to tenant of instance
+	tenantID := ""
+	var err error
+	if req.ProcessInstanceId != "" {
+		err = authorizeInstance(ctx, s.core, auth.ActionCreate, auth.PermissionIncident, req.ProcessInstanceId)
+	} else if tenantID, err = resolveTenant(ctx, req.TenantId); err == nil {
+		err = authorizeTenantResource(ctx, auth.ActionCreate, auth.PermissionIncident, tenantID, "")
+	}
+	if err != nil {
+		return nil, err
+	}
+
 	// Convert protobuf metadata to map
 	metadata := make(map[string]interface{})
 	for k, v := range req.Metadata {
@@ -67,6 +85,7 @@ func (s *incidentsServiceServer) CreateIncident(
 		ErrorCode:         req.ErrorCode,
 		ProcessInstanceID: req.ProcessInstanceId,
 		ProcessKey:        req.ProcessKey,
+		TenantID:          tenantID,
 		ElementID:         req.ElementId,
 		ElementType:       req.ElementType,
 		JobKey:            req.JobKey,
@@ -108,6 +127,7 @@ func (s *incidentsServiceServer) CreateIncident(
 			ErrorCode:         req.ErrorCode,
 			ProcessInstanceId: req.ProcessInstanceId,
 			ProcessKey:        req.ProcessKey,
+			TenantId:          tenantID,
 			ElementId:         req.ElementId,
 			ElementType:       req.ElementType,
 			JobKey:            req.JobKey,
@@ -134,42 +154,69 @@ func (s *incidentsServiceServer) ResolveIncident(
 		logger.String("action", req.Action.String()),
 		logger.String("resolved_by", req.ResolvedBy))
 
-	// Create JSON message for incidents component
-	payload := incidents.ResolveIncidentPayload{
-		IncidentID: req.IncidentId,
-		Action:     convertProtoResolveAction(req.Action),
-		Comment:    req.Comment,
-		ResolvedBy: req.ResolvedBy,
-		NewRetries: int(req.NewRetries),
+	var variables map[string]interface{}
+	if req.Variables != "" {
+		if err := json.Unmarshal([]byte(req.Variables), &variables); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid variables JSON: %v", err)
+		}
 	}
 
-	message, err := incidents.CreateResolveIncidentMessage(payload)
+	// Resolve directly so retry outcome is returned to caller
+	// Разрешаем напрямую чтобы вернуть результат повтора вызывающему
+	component, err := getIncidentsComponent(s.core)
 	if err != nil {
-		logger.Error("Failed to create resolve incident message", logger.String("error", err.Error()))
-		return &incidentspb.ResolveIncidentResponse{
-			Incident: nil,
-		}, fmt.Errorf("failed to create resolve incident message: %w", err)
+		return nil, status.Error(codes.Unavailable, err.Error())
 	}
 
-	// Send JSON message to incidents component through Core
-	if err := s.core.SendMessage("incidents", message); err != nil {
-		logger.Error("Failed to send resolve incident message", logger.String("error", err.Error()))
-		return &incidentspb.ResolveIncidentResponse{
-			Incident: nil,
-		}, fmt.Errorf("failed to send resolve incident message: %w", err)
+	if err := authorizeIncident(ctx, s.core, component, auth.ActionResolve, req.IncidentId); err != nil {
+		return nil, err
+	}
+
+	incident, err := component.ResolveIncident(ctx, &incidents.ResolveIncidentRequest{
+		IncidentID: req.IncidentId,
+		Action:     incidents.ResolveAction(convertProtoResolveAction(req.Action)),
+		Comment:    req.Comment,
+		ResolvedBy: req.ResolvedBy,
+		NewRetries: int(req.NewRetries),
+		Variables:  variables,
+	})
+	if err != nil {
+		logger.Error("Failed to resolve incident",
+			logger.String("incident_id", req.IncidentId),
+			logger.String("error", err.Error()))
+		switch {
+		case errors.Is(err, incidents.ErrRetryFailed):
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		case strings.Contains(err.Error(), "not found"):
+			return nil, status.Error(codes.NotFound, err.Error())
+		case strings.Contains(err.Error(), "invalid"), strings.Contains(err.Error(), "already resolved"):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		default:
+			return nil, status.Error(codes.Internal, err.Error())
+		}
 	}
 
-	logger.Info("Incident resolution request sent successfully")
+	logger.Info("Incident resolved",
+		logger.String("incident_id", incident.ID),
+		logger.String("status", string(incident.Status)))
 
-	// Create a basic response
 	response := &incidentspb.ResolveIncidentResponse{
 		Incident: &incidentspb.Incident{
-			Id:         req.IncidentId,
-			Status:     convertActionToStatus(req.Action),
-			ResolvedBy: req.ResolvedBy,
-			NewRetries: req.NewRetries,
+			Id:                incident.ID,
+			Type:              convertStringToIncidentType(string(incident.Type)),
+			Status:            convertStringToIncidentStatus(string(incident.Status)),
+			Message:           incident.Message,
+			ProcessInstanceId: incident.ProcessInstanceID,
+			ElementId:         incident.ElementID,
+			TokenId:           incident.TokenID,
+			ResolvedBy:        incident.ResolvedBy,
+			ResolveComment:    incident.ResolveComment,
+			NewRetries:        int32(incident.NewRetries),
 		},
 	}
+	if incident.ResolvedAt != nil {
+		response.Incident.ResolvedAt = timestamppb.New(*incident.ResolvedAt)
+	}
 
 	return response, nil
 }
@@ -229,8 +276,10 @@ func (s *incidentsServiceServer) GetIncident(
 			ErrorCode         string                 `json:"error_code"`
 			ProcessInstanceID string                 `json:"process_instance_id"`
 			ProcessKey        string                 `json:"process_key"`
+			TenantID          string                 `json:"tenant_id"`
 			ElementID         string                 `json:"element_id"`
 			ElementType       string                 `json:"element_type"`
+			TokenID           string                 `json:"token_id"`
 			JobKey            string                 `json:"job_key"`
 			JobType           string                 `json:"job_type"`
 			WorkerID          string                 `json:"worker_id"`
@@ -260,6 +309,12 @@ func (s *incidentsServiceServer) GetIncident(
 		}, fmt.Errorf("incident request failed")
 	}
 
+	err = authorizeOwned(ctx, s.core, auth.ActionRead, auth.PermissionIncident,
+		response.Data.TenantID, response.Data.ProcessInstanceID)
+	if err != nil {
+		return nil, err
+	}
+
 	// Convert to protobuf incident
 	incident := &incidentspb.Incident{
 		Id:                response.Data.ID,
@@ -269,8 +324,10 @@ func (s *incidentsServiceServer) GetIncident(
 		ErrorCode:         response.Data.ErrorCode,
 		ProcessInstanceId: response.Data.ProcessInstanceID,
 		ProcessKey:        response.Data.ProcessKey,
+		TenantId:          response.Data.TenantID,
 		ElementId:         response.Data.ElementID,
 		ElementType:       response.Data.ElementType,
+		TokenId:           response.Data.TokenID,
 		JobKey:            response.Data.JobKey,
 		JobType:           response.Data.JobType,
 		WorkerId:          response.Data.WorkerID,
@@ -285,9 +342,7 @@ func (s *incidentsServiceServer) GetIncident(
 	// Convert metadata
 	incident.Metadata = make(map[string]string)
 	for k, v := range response.Data.Metadata {
-		if str, ok := v.(string); ok {
-			incident.Metadata[k] = str
-		}
+		incident.Metadata[k] = metadataValueString(v)
 	}
 
 	// Parse timestamps if available
@@ -348,6 +403,7 @@ func (s *incidentsServiceServer) ListIncidents(
 		Type:              convertProtoIncidentTypeArray(filter.Type),
 		ProcessInstanceID: filter.ProcessInstanceId,
 		ProcessKey:        filter.ProcessKey,
+		TenantID:          filter.TenantId,
 		ElementID:         filter.ElementId,
 		JobKey:            filter.JobKey,
 		WorkerID:          filter.WorkerId,
@@ -401,8 +457,10 @@ func (s *incidentsServiceServer) ListIncidents(
 				ErrorCode         string                 `json:"error_code"`
 				ProcessInstanceID string                 `json:"process_instance_id"`
 				ProcessKey        string                 `json:"process_key"`
+				TenantID          string                 `json:"tenant_id"`
 				ElementID         string                 `json:"element_id"`
 				ElementType       string                 `json:"element_type"`
+				TokenID           string                 `json:"token_id"`
 				JobKey            string                 `json:"job_key"`
 				JobType           string                 `json:"job_type"`
 				WorkerID          string                 `json:"worker_id"`
@@ -436,9 +494,14 @@ func (s *incidentsServiceServer) ListIncidents(
 		}, fmt.Errorf("incidents request failed")
 	}
 
-	// Convert to protobuf incidents
+	// Convert to protobuf incidents skipping incidents caller may not read
+	readFilter := newResourceFilter(ctx, s.core, auth.ActionRead, auth.PermissionIncident)
 	var protoIncidents []*incidentspb.Incident
 	for _, incident := range response.Data.Incidents {
+		if !readFilter.AllowsOwned(incident.TenantID, incident.ProcessInstanceID) {
+			continue
+		}
+
 		protoIncident := &incidentspb.Incident{
 			Id:                incident.ID,
 			Type:              convertStringToIncidentType(incident.Type),
@@ -447,8 +510,10 @@ func (s *incidentsServiceServer) ListIncidents(
 			ErrorCode:         incident.ErrorCode,
 			ProcessInstanceId: incident.ProcessInstanceID,
 			ProcessKey:        incident.ProcessKey,
+			TenantId:          incident.TenantID,
 			ElementId:         incident.ElementID,
 			ElementType:       incident.ElementType,
+			TokenId:           incident.TokenID,
 			JobKey:            incident.JobKey,
 			JobType:           incident.JobType,
 			WorkerId:          incident.WorkerID,
@@ -462,9 +527,7 @@ func (s *incidentsServiceServer) ListIncidents(
 		// Convert metadata
 		protoIncident.Metadata = make(map[string]string)
 		for k, v := range incident.Metadata {
-			if str, ok := v.(string); ok {
-				protoIncident.Metadata[k] = str
-			}
+			protoIncident.Metadata[k] = metadataValueString(v)
 		}
 
 		// Parse timestamps if available
@@ -564,6 +627,10 @@ func (s *incidentsServiceServer) GetIncidentStats(
 ) (*incidentspb.GetIncidentStatsResponse, error) {
 	logger.Info("GetIncidentStats gRPC request")
 
+	if err := authorizeResource(ctx, auth.ActionRead, auth.PermissionIncident, ""); err != nil {
+		return nil, err
+	}
+
 	// Create JSON message for incidents component
 	message, err := incidents.CreateGetIncidentStatsMessage()
 	if err != nil {
@@ -649,8 +716,252 @@ func (s *incidentsServiceServer) GetIncidentStats(
 	}, nil
 }
 
+// ListRetryRules lists automatic retry rules in evaluation order
+// Получает правила автоматического повтора в порядке проверки
+func (s *incidentsServiceServer) ListRetryRules(
+	ctx context.Context,
+	req *incidentspb.ListRetryRulesRequest,
+) (*incidentspb.ListRetryRulesResponse, error) {
+	if err := authorizeResource(ctx, auth.ActionRead, auth.PermissionIncident, ""); err != nil {
+		return nil, err
+	}
+
+	component, err := getIncidentsComponent(s.core)
+	if err != nil {
+		return nil, status.Error(codes.Unavailable, err.Error())
+	}
+
+	rules, err := component.ListRetryRules()
+	if err != nil {
+		return nil, status.Error(codes.Unavailable, err.Error())
+	}
+
+	response := &incidentspb.ListRetryRulesResponse{
+		Rules: make([]*incidentspb.RetryRule, 0, len(rules)),
+	}
+	for _, rule := range rules {
+		response.Rules = append(response.Rules, retryRuleToProto(rule))
+	}
+	return response, nil
+}
+
+// PutRetryRule creates or replaces automatic retry rule.
+// Requires admin permission when authentication is enabled.
+// Создает или заменяет правило автоматического повтора
+func (s *incidentsServiceServer) PutRetryRule(
+	ctx context.Context,
+	req *incidentspb.PutRetryRuleRequest,
+) (*incidentspb.PutRetryRuleResponse, error) {
+	if req.Rule == nil || req.Rule.Name == "" {
+		return nil, status.Error(codes.InvalidArgument, "retry rule name is required")
+	}
+
+	logger.Info("PutRetryRule gRPC request", logger.String("rule", req.Rule.Name))
+
+	if _, authenticated := GetAuthResultFromContext(ctx); authenticated {
+		if err := RequirePermission(ctx, auth.PermissionAdmin); err != nil {
+			return nil, err
+		}
+	}
+
+	component, err := getIncidentsComponent(s.core)
+	if err != nil {
+		return nil, status.Error(codes.Unavailable, err.Error())
+	}
+
+	saved, scheduled, err := component.PutRetryRule(ctx, retryRuleFromProto(req.Rule))
+	if err != nil {
+		if errors.Is(err, incidents.ErrRetryRuleReadOnly) {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		return &incidentspb.PutRetryRuleResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	return &incidentspb.PutRetryRuleResponse{
+		Success:            true,
+		Message:            fmt.Sprintf("retry rule %s saved", saved.Name),
+		Rule:               retryRuleToProto(saved),
+		ScheduledIncidents: int32(scheduled),
+	}, nil
+}
+
+// DeleteRetryRule deletes automatic retry rule created through API.
+// Requires admin permission when authentication is enabled.
+// Удаляет правило автоматического повтора созданное через API
+func (s *incidentsServiceServer) DeleteRetryRule(
+	ctx context.Context,
+	req *incidentspb.DeleteRetryRuleRequest,
+) (*incidentspb.DeleteRetryRuleResponse, error) {
+	logger.Info("DeleteRetryRule gRPC request", logger.String("rule", req.Name))
+
+	if _, authenticated := GetAuthResultFromContext(ctx); authenticated {
+		if err := RequirePermission(ctx, auth.PermissionAdmin); err != nil {
+			return nil, err
+		}
+	}
+
+	component, err := getIncidentsComponent(s.core)
+	if err != nil {
+		return nil, status.Error(codes.Unavailable, err.Error())
+	}
+
+	if err := component.DeleteRetryRule(req.Name); err != nil {
+		switch {
+		case errors.Is(err, incidents.ErrRetryRuleNotFound):
+			return nil, status.Error(codes.NotFound, err.Error())
+		case errors.Is(err, incidents.ErrRetryRuleReadOnly):
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		return &incidentspb.DeleteRetryRuleResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	return &incidentspb.DeleteRetryRuleResponse{
+		Success: true,
+		Message: fmt.Sprintf("retry rule %s deleted", req.Name),
+	}, nil
+}
+
+// PreviewRetryRules reports what retry rules would do with open incidents
+// without acting, with candidate rule only incidents it would pick up
+// Показывает действие правил повтора для открытых инцидентов без выполнения
+func (s *incidentsServiceServer) PreviewRetryRules(
+	ctx context.Context,
+	req *incidentspb.PreviewRetryRulesRequest,
+) (*incidentspb.PreviewRetryRulesResponse, error) {
+	if err := authorizeResource(ctx, auth.ActionRead, auth.PermissionIncident, ""); err != nil {
+		return nil, err
+	}
+
+	component, err := getIncidentsComponent(s.core)
+	if err != nil {
+		return nil, status.Error(codes.Unavailable, err.Error())
+	}
+
+	var candidate *incidents.RetryRule
+	if req.Rule != nil && req.Rule.Name != "" {
+		candidate = retryRuleFromProto(req.Rule)
+	}
+
+	previews, err := component.PreviewRetryRules(ctx, candidate)
+	if err != nil {
+		if errors.Is(err, incidents.ErrRetryRuleReadOnly) {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	response := &incidentspb.PreviewRetryRulesResponse{
+		Previews: make([]*incidentspb.RetryPreview, 0, len(previews)),
+	}
+	for _, preview := range previews {
+		pb := &incidentspb.RetryPreview{
+			IncidentId:  preview.IncidentID,
+			Type:        convertStringToIncidentType(string(preview.Type)),
+			ProcessKey:  preview.ProcessKey,
+			ElementId:   preview.ElementID,
+			JobType:     preview.JobType,
+			Message:     preview.Message,
+			Rule:        preview.Rule,
+			Attempts:    int32(preview.Attempts),
+			MaxAttempts: int32(preview.MaxAttempts),
+			Action:      preview.Action,
+		}
+		if preview.NextAttemptAt != nil {
+			pb.NextAttemptAt = timestamppb.New(*preview.NextAttemptAt)
+		}
+		response.Previews = append(response.Previews, pb)
+	}
+	return response, nil
+}
+
+// authorizeIncident checks action on incident by its tenant and process of its instance
+// Проверяет действие над инцидентом по арендатору и процессу его экземпляра
+func authorizeIncident(
+	ctx context.Context,
+	core CoreInterface,
+	component *incidents.Component,
+	action, incidentID string,
+) error {
+	if _, ok := GetAuthResultFromContext(ctx); !ok {
+		return nil
+	}
+
+	instanceID, tenantID := "", models.DefaultTenantID
+	if incident, err := component.GetIncident(ctx, incidentID); err == nil && incident != nil {
+		instanceID, tenantID = incident.ProcessInstanceID, incident.TenantID
+	}
+	return authorizeOwned(ctx, core, action, auth.PermissionIncident, tenantID, instanceID)
+}
+
 // Helper functions for protobuf conversion
 
+// metadataValueString converts incident metadata value to string,
+// structured values such as auto retry state are encoded as JSON
+// Конвертирует значение метаданных инцидента в строку
+func metadataValueString(value interface{}) string {
+	if str, ok := value.(string); ok {
+		return str
+	}
+	data, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprintf("%v", value)
+	}
+	return string(data)
+}
+
+// retryRuleFromProto converts protobuf retry rule to model
+// Конвертирует protobuf правило повтора в модель
+func retryRuleFromProto(pb *incidentspb.RetryRule) *incidents.RetryRule {
+	rule := &incidents.RetryRule{
+		Name:         pb.Name,
+		JobTypes:     pb.JobTypes,
+		ProcessKeys:  pb.ProcessKeys,
+		ElementIDs:   pb.ElementIds,
+		ErrorCodes:   pb.ErrorCodes,
+		ErrorPattern: pb.ErrorPattern,
+		MaxAttempts:  int(pb.MaxAttempts),
+		Interval:     pb.Interval,
+		JobRetries:   int(pb.JobRetries),
+	}
+	for _, incidentType := range pb.Types {
+		rule.Types = append(rule.Types, incidents.IncidentType(convertProtoIncidentType(incidentType)))
+	}
+	return rule
+}
+
+// retryRuleToProto converts retry rule model to protobuf
+// Конвертирует модель правила повтора в protobuf
+func retryRuleToProto(rule *incidents.RetryRule) *incidentspb.RetryRule {
+	pb := &incidentspb.RetryRule{
+		Name:         rule.Name,
+		JobTypes:     rule.JobTypes,
+		ProcessKeys:  rule.ProcessKeys,
+		ElementIds:   rule.ElementIDs,
+		ErrorCodes:   rule.ErrorCodes,
+		ErrorPattern: rule.ErrorPattern,
+		MaxAttempts:  int32(rule.MaxAttempts),
+		Interval:     rule.Interval,
+		JobRetries:   int32(rule.JobRetries),
+		Source:       rule.Source,
+	}
+	for _, incidentType := range rule.Types {
+		pb.Types = append(pb.Types, convertStringToIncidentType(string(incidentType)))
+	}
+	if rule.CreatedAt != nil {
+		pb.CreatedAt = timestamppb.New(*rule.CreatedAt)
+	}
+	if rule.UpdatedAt != nil {
+		pb.UpdatedAt = timestamppb.New(*rule.UpdatedAt)
+	}
+	return pb
+}
+
 // convertProtoIncidentType converts protobuf incident type to string
 func convertProtoIncidentType(protoType incidentspb.IncidentType) string {
 	switch protoType {
@@ -685,18 +996,6 @@ func convertProtoResolveAction(protoAction incidentspb.ResolveAction) string {
 	}
 }
 
-// convertActionToStatus converts resolve action to incident status
-func convertActionToStatus(action incidentspb.ResolveAction) incidentspb.IncidentStatus {
-	switch action {
-	case incidentspb.ResolveAction_RESOLVE_ACTION_RETRY:
-		return incidentspb.IncidentStatus_INCIDENT_STATUS_RESOLVED
-	case incidentspb.ResolveAction_RESOLVE_ACTION_DISMISS:
-		return incidentspb.IncidentStatus_INCIDENT_STATUS_DISMISSED
-	default:
-		return incidentspb.IncidentStatus_INCIDENT_STATUS_DISMISSED
-	}
-}
-
 // convertProtoIncidentStatusArray converts protobuf status array to string array
 func convertProtoIncidentStatusArray(protoStatuses []incidentspb.IncidentStatus) []string {
 	var statuses []string
@@ -724,7 +1023,7 @@ func convertProtoIncidentTypeArray(protoTypes []incidentspb.IncidentType) []stri
 
 // convertStringToIncidentType converts string to protobuf incident type
 func convertStringToIncidentType(typeStr string) incidentspb.IncidentType {
-	switch typeStr {
+	switch strings.ToUpper(typeStr) {
 	case "JOB_FAILURE":
 		return incidentspb.IncidentType_INCIDENT_TYPE_JOB_FAILURE
 	case "BPMN_ERROR":
@@ -13,9 +13,15 @@ import (
 	"encoding/json"
 	"fmt"
 	"sort"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"atom-engine/proto/jobs/jobspb"
+	"atom-engine/src/core/auth"
 	"atom-engine/src/core/logger"
+	"atom-engine/src/core/models"
 	"atom-engine/src/jobs"
 )
 
@@ -50,6 +56,12 @@ func (s *jobsServiceServer) CreateJob(
 		logger.String("type", req.Type),
 		logger.String("process_instance_id", req.ProcessInstanceId))
 
+	// Job belongs to tenant of its process instance
+	_, tenantID := lookupInstance(s.core, req.ProcessInstanceId)
+	if err := authorizeTenantResource(ctx, auth.ActionCreate, auth.PermissionJob, tenantID, req.Type); err != nil {
+		return nil, err
+	}
+
 	// Parse variables from JSON string
 	variables := make(map[string]interface{})
 	if req.Variables != "" {
@@ -67,7 +79,10 @@ func (s *jobsServiceServer) CreateJob(
 		JobType:           req.Type,
 		ProcessInstanceID: req.ProcessInstanceId,
 		ElementID:         req.ElementId,
+		CustomHeaders:     req.CustomHeaders,
 		Variables:         variables,
+		Retries:           int(req.Retries),
+		Priority:          int(req.Priority),
 	}
 
 	message, err := jobs.CreateJobMessage(payload)
@@ -142,7 +157,16 @@ func (s *jobsServiceServer) ActivateJobs(
 	logger.Info("ActivateJobs gRPC request",
 		logger.String("worker", req.Worker),
 		logger.String("type", req.Type),
-		logger.Int("max_jobs", int(req.MaxJobsToActivate)))
+		logger.Int("max_jobs", int(req.MaxJobsToActivate)),
+		logger.String("tenant_ids", req.TenantIds))
+
+	if err := authorizeResource(stream.Context(), auth.ActionComplete, auth.PermissionJob, req.Type); err != nil {
+		return err
+	}
+	tenantIDs, err := activationTenants(stream.Context(), req.TenantIds)
+	if err != nil {
+		return err
+	}
 
 	// Create JSON message for jobs component
 	payload := jobs.ActivateJobsPayload{
@@ -150,6 +174,9 @@ func (s *jobsServiceServer) ActivateJobs(
 		JobType:    req.Type,
 		MaxJobs:    int(req.MaxJobsToActivate),
 		TimeoutMs:  req.Timeout,
+		// Only requested top-level variables are sent to worker
+		FetchVariables: req.FetchVariable,
+		TenantIDs:      tenantIDs,
 	}
 
 	message, err := jobs.CreateActivateJobsMessage(payload)
@@ -202,12 +229,24 @@ func (s *jobsServiceServer) ActivateJobs(
 					if processInstanceID, ok := jobMap["process_instance_id"].(string); ok {
 						job.ProcessInstanceID = processInstanceID
 					}
+					if tenantID, ok := jobMap["tenant_id"].(string); ok {
+						job.TenantID = tenantID
+					}
 					if variables, ok := jobMap["variables"].(map[string]interface{}); ok {
 						job.Variables = variables
 					}
 					if retries, ok := jobMap["retries"].(float64); ok {
 						job.Retries = int(retries)
 					}
+					if priority, ok := jobMap["priority"].(float64); ok {
+						job.Priority = int(priority)
+					}
+					if headers, ok := jobMap["custom_headers"].(map[string]interface{}); ok {
+						job.CustomHeaders = make(map[string]string, len(headers))
+						for key, value := range headers {
+							job.CustomHeaders[key] = fmt.Sprintf("%v", value)
+						}
+					}
 					activatedJobs = append(activatedJobs, job)
 				}
 			}
@@ -232,6 +271,9 @@ func (s *jobsServiceServer) ActivateJobs(
 			Worker:             job.Worker,
 			Retries:            int32(job.Retries),
 			Deadline:           job.CreatedAt + 30000, // 30 second deadline
+			CustomHeaders:      job.CustomHeaders,
+			Priority:           int32(job.Priority),
+			TenantId:           job.TenantID,
 		}
 
 		response := &jobspb.ActivateJobsResponse{
@@ -264,6 +306,10 @@ func (s *jobsServiceServer) CompleteJob(
 		}, nil
 	}
 
+	if err := authorizeJob(ctx, component, auth.ActionComplete, req.JobKey); err != nil {
+		return nil, err
+	}
+
 	// Parse variables from JSON string
 	variables := make(map[string]interface{})
 	if req.Variables != "" {
@@ -307,8 +353,13 @@ func (s *jobsServiceServer) FailJob(ctx context.Context, req *jobspb.FailJobRequ
 		}, nil
 	}
 
+	if err := authorizeJob(ctx, component, auth.ActionComplete, req.JobKey); err != nil {
+		return nil, err
+	}
+
 	// Fail job through component
-	if err := component.FailJob(req.JobKey, int(req.Retries), req.ErrorMessage); err != nil {
+	retryBackoff := time.Duration(req.RetryBackoff) * time.Millisecond
+	if err := component.FailJobWithBackoff(req.JobKey, int(req.Retries), req.ErrorMessage, retryBackoff); err != nil {
 		logger.Error("Failed to fail job", logger.String("error", err.Error()))
 		return &jobspb.FailJobResponse{
 			Success:      false,
@@ -342,6 +393,10 @@ func (s *jobsServiceServer) ThrowError(
 		}, nil
 	}
 
+	if err := authorizeJob(ctx, component, auth.ActionComplete, req.JobKey); err != nil {
+		return nil, err
+	}
+
 	// Call ThrowError on component
 	err = component.ThrowError(req.JobKey, req.ErrorCode, req.ErrorMessage)
 	if err != nil {
@@ -368,6 +423,10 @@ func (s *jobsServiceServer) GetJobStats(
 ) (*jobspb.GetJobStatsResponse, error) {
 	logger.Info("GetJobStats gRPC request")
 
+	if err := authorizeResource(ctx, auth.ActionRead, auth.PermissionJob, ""); err != nil {
+		return nil, err
+	}
+
 	// Get jobs component from core
 	component, err := getJobsComponent(s.core)
 	if err != nil {
@@ -458,7 +517,8 @@ func (s *jobsServiceServer) ListJobs(
 	}
 
 	// List all jobs for sorting/pagination
-	jobInfos, total, err := component.ListJobs(req.Type, req.Worker, req.ProcessInstanceId, req.State, 0, 0)
+	jobInfos, total, err := component.ListJobs(
+		req.Type, req.Worker, req.ProcessInstanceId, req.State, req.TenantId, 0, 0)
 	if err != nil {
 		logger.Error("Failed to list jobs", logger.String("error", err.Error()))
 		return &jobspb.ListJobsResponse{
@@ -467,6 +527,18 @@ func (s *jobsServiceServer) ListJobs(
 		}, nil
 	}
 
+	// Skip jobs of tenants and types caller may not read
+	if filter := newResourceFilter(ctx, s.core, auth.ActionRead, auth.PermissionJob); filter != nil {
+		allowed := jobInfos[:0]
+		for _, job := range jobInfos {
+			if filter.AllowsIn(job.TenantID, job.Type) {
+				allowed = append(allowed, job)
+			}
+		}
+		jobInfos = allowed
+		total = len(jobInfos)
+	}
+
 	// Store total count before pagination
 	totalCount := total
 
@@ -544,6 +616,10 @@ func (s *jobsServiceServer) ListJobs(
 			CreatedAt:          job.CreatedAt,
 			Status:             job.Status,
 			ErrorMessage:       job.ErrorMessage,
+			CustomHeaders:      job.CustomHeaders,
+			Priority:           int32(job.Priority),
+			LeaseExpiry:        job.LeaseExpiry,
+			TenantId:           job.TenantID,
 		}
 	}
 
@@ -597,6 +673,11 @@ func (s *jobsServiceServer) GetJob(
 		}, nil
 	}
 
+	err = authorizeTenantResource(ctx, auth.ActionRead, auth.PermissionJob, jobInfo.TenantID, jobInfo.Type)
+	if err != nil {
+		return nil, err
+	}
+
 	// Convert variables to protobuf map format
 	variables := make(map[string]string)
 	for k, v := range jobInfo.Variables {
@@ -618,6 +699,10 @@ func (s *jobsServiceServer) GetJob(
 		CreatedAt:          jobInfo.CreatedAt,
 		Status:             jobInfo.Status,
 		ErrorMessage:       jobInfo.ErrorMessage,
+		CustomHeaders:      jobInfo.CustomHeaders,
+		Priority:           int32(jobInfo.Priority),
+		LeaseExpiry:        jobInfo.LeaseExpiry,
+		TenantId:           jobInfo.TenantID,
 	}
 
 	logger.Info("Job found successfully", logger.String("job_key", req.JobKey))
@@ -646,6 +731,10 @@ func (s *jobsServiceServer) CancelJob(
 		}, nil
 	}
 
+	if err := authorizeJob(ctx, component, auth.ActionDelete, req.JobKey); err != nil {
+		return nil, err
+	}
+
 	// Cancel job through component
 	if err := component.CancelJob(req.JobKey, req.Reason); err != nil {
 		logger.Error("Failed to cancel job", logger.String("error", err.Error()))
@@ -661,3 +750,105 @@ func (s *jobsServiceServer) CancelJob(
 		Success: true,
 	}, nil
 }
+
+// ListWorkers lists workers with their active job leases
+func (s *jobsServiceServer) ListWorkers(
+	ctx context.Context,
+	req *jobspb.ListWorkersRequest,
+) (*jobspb.ListWorkersResponse, error) {
+	logger.Info("ListWorkers gRPC request", logger.String("type", req.Type))
+
+	component, err := getJobsComponent(s.core)
+	if err != nil {
+		return &jobspb.ListWorkersResponse{
+			Success:      false,
+			ErrorMessage: err.Error(),
+		}, nil
+	}
+
+	workers, err := component.ListWorkers(req.Type)
+	if err != nil {
+		logger.Error("Failed to list workers", logger.String("error", err.Error()))
+		return &jobspb.ListWorkersResponse{
+			Success:      false,
+			ErrorMessage: err.Error(),
+		}, nil
+	}
+
+	filter := newResourceFilter(ctx, s.core, auth.ActionRead, auth.PermissionJob)
+	protoWorkers := make([]*jobspb.WorkerInfo, 0, len(workers))
+	for _, worker := range workers {
+		if !filter.Allows(worker.JobType) {
+			continue
+		}
+
+		leases := make([]*jobspb.WorkerLease, len(worker.Leases))
+		for j, lease := range worker.Leases {
+			leases[j] = &jobspb.WorkerLease{
+				JobKey:             lease.JobKey,
+				Type:               lease.Type,
+				ProcessInstanceKey: lease.ProcessInstanceID,
+				ActivatedAt:        lease.ActivatedAt,
+				LeaseExpiry:        lease.LeaseExpiry,
+			}
+		}
+
+		protoWorkers = append(protoWorkers, &jobspb.WorkerInfo{
+			Worker:     worker.Worker,
+			Registered: worker.Registered,
+			Type:       worker.JobType,
+			LastSeen:   worker.LastSeen,
+			MaxJobs:    int32(worker.MaxJobs),
+			Timeout:    worker.TimeoutMs,
+			Leases:     leases,
+		})
+	}
+
+	return &jobspb.ListWorkersResponse{
+		Success: true,
+		Workers: protoWorkers,
+	}, nil
+}
+
+// authorizeJob checks action on job by its tenant and type
+// Проверяет действие над заданием по его арендатору и типу
+func authorizeJob(ctx context.Context, component *jobs.Component, action, jobKey string) error {
+	result, ok := GetAuthResultFromContext(ctx)
+	if !ok || (result.Authorize(action, auth.PermissionJob, "") && result.AllTenants()) {
+		return nil
+	}
+
+	jobType, tenantID := "", models.DefaultTenantID
+	if job, err := component.GetJob(jobKey); err == nil && job != nil {
+		jobType, tenantID = job.Type, job.TenantID
+	}
+	return authorizeTenantResource(ctx, action, auth.PermissionJob, tenantID, jobType)
+}
+
+// activationTenants returns tenants whose jobs worker activates, nil for every tenant.
+// Requested comma-separated list must be accessible to caller, empty list falls back
+// to tenants of restricted caller.
+// Возвращает арендаторов, job'ы которых активирует воркер
+func activationTenants(ctx context.Context, requested string) ([]string, error) {
+	tenantIDs := models.ParseTenantList(requested)
+	for _, tenantID := range tenantIDs {
+		if tenantID == models.AllTenants {
+			continue
+		}
+		if err := models.ValidateTenantID(tenantID); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		if err := authorizeTenant(ctx, tenantID); err != nil {
+			return nil, err
+		}
+	}
+
+	result, ok := GetAuthResultFromContext(ctx)
+	if !ok || result.AllTenants() {
+		return tenantIDs, nil
+	}
+	if tenantIDs == nil || models.TenantAllowed(tenantIDs, models.AllTenants) {
+		return result.TenantIDs, nil
+	}
+	return tenantIDs, nil
+}
@@ -15,6 +15,7 @@ import (
 	"sort"
 
 	"atom-engine/proto/messages/messagespb"
+	"atom-engine/src/core/auth"
 	"atom-engine/src/core/logger"
 	"atom-engine/src/messages"
 )
@@ -35,6 +36,14 @@ func (s *messagesServiceServer) PublishMessage(
 		logger.String("message_name", req.MessageName),
 		logger.String("correlation_key", req.CorrelationKey))
 
+	if err := authorizeResource(ctx, auth.ActionCreate, auth.PermissionMessage, req.MessageName); err != nil {
+		return nil, err
+	}
+	tenantID, err := resolveTenant(ctx, req.TenantId)
+	if err != nil {
+		return nil, err
+	}
+
 	// Convert variables
 	variables := make(map[string]interface{})
 	for k, v := range req.Variables {
@@ -43,7 +52,7 @@ func (s *messagesServiceServer) PublishMessage(
 
 	// Create JSON message for messages component
 	payload := messages.PublishMessagePayload{
-		TenantID:       req.TenantId,
+		TenantID:       tenantID,
 		MessageName:    req.MessageName,
 		CorrelationKey: req.CorrelationKey,
 		Variables:      variables,
@@ -175,9 +184,14 @@ func (s *messagesServiceServer) ListBufferedMessages(
 		}, nil
 	}
 
-	// Convert to protobuf format
-	pbMessages := make([]*messagespb.BufferedMessage, len(messages))
-	for i, msg := range messages {
+	// Convert to protobuf format skipping messages caller may not read
+	filter := newResourceFilter(ctx, s.core, auth.ActionRead, auth.PermissionMessage)
+	pbMessages := make([]*messagespb.BufferedMessage, 0, len(messages))
+	for _, msg := range messages {
+		if !filter.AllowsIn(msg.TenantID, msg.Name) {
+			continue
+		}
+
 		expiresAt := int64(0)
 		if msg.ExpiresAt != nil {
 			expiresAt = msg.ExpiresAt.Unix()
@@ -193,7 +207,7 @@ func (s *messagesServiceServer) ListBufferedMessages(
 			}
 		}
 
-		pbMessages[i] = &messagespb.BufferedMessage{
+		pbMessages = append(pbMessages, &messagespb.BufferedMessage{
 			Id:             msg.ID,
 			TenantId:       msg.TenantID,
 			Name:           msg.Name,
@@ -204,7 +218,7 @@ func (s *messagesServiceServer) ListBufferedMessages(
 			ExpiresAt:      expiresAt,
 			Reason:         msg.Reason,
 			ElementId:      msg.ElementID,
-		}
+		})
 	}
 
 	// Store total count before pagination
@@ -339,10 +353,14 @@ func (s *messagesServiceServer) ListMessageSubscriptions(
 		}, nil
 	}
 
-	// Convert to protobuf format
-	pbSubscriptions := make([]*messagespb.MessageSubscription, len(subscriptions))
-	for i, sub := range subscriptions {
-		pbSubscriptions[i] = &messagespb.MessageSubscription{
+	// Convert to protobuf format skipping subscriptions caller may not read
+	filter := newResourceFilter(ctx, s.core, auth.ActionRead, auth.PermissionMessage)
+	pbSubscriptions := make([]*messagespb.MessageSubscription, 0, len(subscriptions))
+	for _, sub := range subscriptions {
+		if !filter.AllowsIn(sub.TenantID, sub.MessageName) {
+			continue
+		}
+		pbSubscriptions = append(pbSubscriptions, &messagespb.MessageSubscription{
 			Id:                   sub.ID,
 			TenantId:             sub.TenantID,
 			ProcessDefinitionKey: sub.ProcessDefinitionKey,
@@ -354,7 +372,7 @@ func (s *messagesServiceServer) ListMessageSubscriptions(
 			IsActive:             sub.IsActive,
 			CreatedAt:            sub.CreatedAt.Unix(),
 			UpdatedAt:            sub.UpdatedAt.Unix(),
-		}
+		})
 	}
 
 	// Store total count before pagination
@@ -436,6 +454,10 @@ func (s *messagesServiceServer) GetMessageStats(
 ) (*messagespb.GetMessageStatsResponse, error) {
 	logger.Info("GetMessageStats gRPC request", logger.String("tenant_id", req.TenantId))
 
+	if err := authorizeResource(ctx, auth.ActionRead, auth.PermissionMessage, ""); err != nil {
+		return nil, err
+	}
+
 	// Get messages component from core
 	componentIf := s.core.GetMessagesComponent()
 	if componentIf == nil {
@@ -484,6 +506,10 @@ func (s *messagesServiceServer) CleanupExpiredMessages(
 ) (*messagespb.CleanupExpiredMessagesResponse, error) {
 	logger.Info("CleanupExpiredMessages gRPC request", logger.String("tenant_id", req.TenantId))
 
+	if err := authorizeResource(ctx, auth.ActionDelete, auth.PermissionMessage, ""); err != nil {
+		return nil, err
+	}
+
 	// Get messages component from core
 	componentIf := s.core.GetMessagesComponent()
 	if componentIf == nil {
@@ -12,6 +12,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"os"
 	"sort"
 	"time"
 
@@ -19,7 +20,9 @@ import (
 	"google.golang.org/grpc/status"
 
 	"atom-engine/proto/parser/parserpb"
+	"atom-engine/src/core/auth"
 	"atom-engine/src/core/logger"
+	"atom-engine/src/core/models"
 	"atom-engine/src/parser"
 )
 
@@ -47,12 +50,22 @@ func (s *ParserService) ParseBPMNFile(
 	logger.Info("Received ParseBPMNFile request",
 		logger.String("file_path", req.FilePath),
 		logger.String("process_id", req.ProcessId),
+		logger.String("tenant_id", req.TenantId),
 		logger.Bool("force", req.Force))
 
+	tenantID, err := resolveTenant(ctx, req.TenantId)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.authorizeDeploy(ctx, req, tenantID); err != nil {
+		return nil, err
+	}
+
 	// Create JSON message for parser component
 	payload := parser.ParseBPMNFilePayload{
 		FilePath:  req.FilePath,
 		ProcessID: req.ProcessId,
+		TenantID:  tenantID,
 		Force:     req.Force,
 	}
 
@@ -122,6 +135,9 @@ func (s *ParserService) ParseBPMNFile(
 		if processName, ok := resultData["process_name"].(string); ok {
 			response.ProcessName = processName
 		}
+		if tenantID, ok := resultData["tenant_id"].(string); ok {
+			response.TenantId = tenantID
+		}
 		if elementsCount, ok := resultData["elements_count"].(float64); ok {
 			response.TotalElements = int32(elementsCount)
 			response.SuccessfulElements = int32(elementsCount) // Parser only saves successfully parsed elements
@@ -188,19 +204,27 @@ func (s *ParserService) ListBPMNProcesses(
 		}, status.Error(codes.Internal, err.Error())
 	}
 
-	// Convert to protobuf format
-	processes := make([]*parserpb.BPMNProcessSummary, len(processList))
-	for i, process := range processList {
-		processes[i] = &parserpb.BPMNProcessSummary{
+	// Convert to protobuf format skipping processes caller may not read
+	filter := newResourceFilter(ctx, s.core, auth.ActionRead, auth.PermissionBPMN)
+	processes := make([]*parserpb.BPMNProcessSummary, 0, len(processList))
+	for _, process := range processList {
+		if req.TenantId != "" && models.NormalizeTenantID(req.TenantId) != process.TenantID {
+			continue
+		}
+		if !filter.AllowsIn(process.TenantID, process.ProcessID) {
+			continue
+		}
+		processes = append(processes, &parserpb.BPMNProcessSummary{
 			ProcessKey:    process.BPMNID,
 			ProcessId:     process.ProcessID,
 			ProcessName:   process.ProcessName,
 			Version:       fmt.Sprintf("v%d", process.ProcessVersion),
+			TenantId:      process.TenantID,
 			Status:        process.Status,
 			TotalElements: int32(process.TotalElements),
 			CreatedAt:     process.CreatedAt.Format(time.RFC3339),
 			UpdatedAt:     process.CreatedAt.Format(time.RFC3339),
-		}
+		})
 	}
 
 	// Store total count before pagination
@@ -312,6 +336,10 @@ func (s *ParserService) GetBPMNProcess(
 		}, status.Error(codes.Internal, "Invalid parser component type")
 	}
 
+	if err := authorizeBPMN(ctx, parserComp, auth.ActionRead, req.ProcessKey); err != nil {
+		return nil, err
+	}
+
 	processInfo, err := parserComp.GetBPMNProcessDetails(req.ProcessKey)
 	if err != nil {
 		logger.Error("Failed to get BPMN process",
@@ -329,6 +357,7 @@ func (s *ParserService) GetBPMNProcess(
 		ProcessName:    processInfo.ProcessName,
 		Version:        fmt.Sprintf("v%d", processInfo.ProcessVersion),
 		ProcessVersion: int32(processInfo.ProcessVersion),
+		TenantId:       processInfo.TenantID,
 		Status:         processInfo.Status,
 		TotalElements:  int32(processInfo.GetTotalElements()),
 		ContentHash:    processInfo.ContentHash,
@@ -377,6 +406,10 @@ func (s *ParserService) DeleteBPMNProcess(
 		}, status.Error(codes.Internal, "Invalid parser component type")
 	}
 
+	if err := authorizeBPMN(ctx, parserComp, auth.ActionDelete, req.ProcessId); err != nil {
+		return nil, err
+	}
+
 	err := parserComp.DeleteBPMNProcess(req.ProcessId)
 	if err != nil {
 		logger.Error("Failed to delete BPMN process",
@@ -402,6 +435,10 @@ func (s *ParserService) GetBPMNStats(
 ) (*parserpb.GetBPMNStatsResponse, error) {
 	logger.Info("Received GetBPMNStats request")
 
+	if err := authorizeResource(ctx, auth.ActionRead, auth.PermissionBPMN, ""); err != nil {
+		return nil, err
+	}
+
 	parserCompInterface := s.core.GetParserComponent()
 	if parserCompInterface == nil {
 		return &parserpb.GetBPMNStatsResponse{
@@ -481,6 +518,10 @@ func (s *ParserService) GetBPMNProcessJSON(
 		}, status.Error(codes.Internal, "Invalid parser component type")
 	}
 
+	if err := authorizeBPMN(ctx, parserComp, auth.ActionRead, req.ProcessKey); err != nil {
+		return nil, err
+	}
+
 	jsonData, err := parserComp.GetBPMNProcessJSON(req.ProcessKey)
 	if err != nil {
 		logger.Error("Failed to get BPMN process JSON",
@@ -524,6 +565,10 @@ func (s *ParserService) GetBPMNProcessXML(
 		}, status.Error(codes.Internal, "Invalid parser component type")
 	}
 
+	if err := authorizeBPMN(ctx, parserComp, auth.ActionRead, req.ProcessKey); err != nil {
+		return nil, err
+	}
+
 	xmlData, err := parserComp.GetBPMNProcessXML(req.ProcessKey)
 	if err != nil {
 		logger.Error("Failed to get BPMN process XML",
@@ -551,3 +596,43 @@ func (s *ParserService) GetBPMNProcessXML(
 		FileSize: int32(len(xmlData)),
 	}, nil
 }
+
+// authorizeDeploy checks deploy of BPMN file into tenant by process ID it declares
+// Проверяет развертывание BPMN файла в арендатора по объявленному в нем ID процесса
+func (s *ParserService) authorizeDeploy(
+	ctx context.Context,
+	req *parserpb.ParseBPMNFileRequest,
+	tenantID string,
+) error {
+	result, ok := GetAuthResultFromContext(ctx)
+	if !ok {
+		return nil
+	}
+	if result.Authorize(auth.ActionDeploy, auth.PermissionBPMN, "") {
+		return authorizeTenant(ctx, tenantID)
+	}
+
+	processID := req.ProcessId
+	if processID == "" {
+		if content, err := os.ReadFile(req.FilePath); err == nil {
+			processID, _ = parser.ExtractProcessID(content)
+		}
+	}
+	return authorizeTenantResource(ctx, auth.ActionDeploy, auth.PermissionBPMN, tenantID, processID)
+}
+
+// authorizeBPMN checks action on stored BPMN process by its tenant and BPMN process ID
+// Проверяет действие над сохраненным BPMN процессом по его арендатору и BPMN ID
+func authorizeBPMN(ctx context.Context, parserComp *parser.Component, action, processKey string) error {
+	result, ok := GetAuthResultFromContext(ctx)
+	if !ok || (result.AllTenants() && result.Authorize(action, auth.PermissionBPMN, "")) {
+		return nil
+	}
+
+	tenantID, _ := models.SplitTenantScopedKey(processKey)
+	processID := ""
+	if details, err := parserComp.GetBPMNProcessDetails(processKey); err == nil {
+		tenantID, processID = details.TenantID, details.ProcessID
+	}
+	return authorizeTenantResource(ctx, action, auth.PermissionBPMN, tenantID, processID)
+}
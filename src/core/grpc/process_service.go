@@ -22,6 +22,7 @@ import (
 	"atom-engine/proto/parser/parserpb"
 	"atom-engine/proto/process/processpb"
 	"atom-engine/proto/timewheel/timewheelpb"
+	"atom-engine/src/core/auth"
 	"atom-engine/src/core/interfaces"
 	"atom-engine/src/core/logger"
 	"atom-engine/src/core/models"
@@ -42,8 +43,18 @@ func (s *processServiceServer) StartProcessInstance(
 ) (*processpb.StartProcessInstanceResponse, error) {
 	logger.Info("=== gRPC StartProcessInstance RECEIVED ===",
 		logger.String("process_id", req.ProcessId),
+		logger.String("tenant_id", req.TenantId),
 		logger.String("variables_count", fmt.Sprintf("%d", len(req.Variables))))
 
+	processID := processIDFromKey(req.ProcessId)
+	if err := authorizeResource(ctx, auth.ActionCreate, auth.PermissionProcess, processID); err != nil {
+		return nil, err
+	}
+	tenantID, err := resolveTenant(ctx, req.TenantId)
+	if err != nil {
+		return nil, err
+	}
+
 	// Get process component
 	processComp := s.core.GetProcessComponent()
 	if processComp == nil {
@@ -81,7 +92,7 @@ func (s *processServiceServer) StartProcessInstance(
 	}
 
 	// Start process instance
-	result, err := processComp.StartProcessInstance(req.ProcessId, variables)
+	result, err := processComp.StartProcessInstance(ctx, req.ProcessId, tenantID, variables)
 	if err != nil {
 		logger.Error("Failed to start process instance",
 			logger.String("process_id", req.ProcessId),
@@ -102,6 +113,7 @@ func (s *processServiceServer) StartProcessInstance(
 		Status:     result.State,
 		Success:    true,
 		Message:    "process instance started successfully",
+		TenantId:   result.TenantID,
 	}, nil
 }
 
@@ -114,6 +126,10 @@ func (s *processServiceServer) GetProcessInstanceStatus(
 	logger.Info("GetProcessInstanceStatus request",
 		logger.String("instance_id", req.InstanceId))
 
+	if err := authorizeInstance(ctx, s.core, auth.ActionRead, auth.PermissionProcess, req.InstanceId); err != nil {
+		return nil, err
+	}
+
 	// Get process component
 	processComp := s.core.GetProcessComponent()
 	if processComp == nil {
@@ -155,6 +171,7 @@ func (s *processServiceServer) GetProcessInstanceStatus(
 		ProcessId:       result.ProcessID,
 		ProcessKey:      result.ProcessKey,
 		ProcessVersion:  int32(extractVersionFromKey(result.ProcessKey)), // Extract version from ProcessKey
+		TenantId:        result.TenantID,
 	}, nil
 }
 
@@ -168,6 +185,10 @@ func (s *processServiceServer) CancelProcessInstance(
 		logger.String("instance_id", req.InstanceId),
 		logger.String("reason", req.Reason))
 
+	if err := authorizeInstance(ctx, s.core, auth.ActionDelete, auth.PermissionProcess, req.InstanceId); err != nil {
+		return nil, err
+	}
+
 	// Get process component
 	processComp := s.core.GetProcessComponent()
 	if processComp == nil {
@@ -253,6 +274,22 @@ func (s *processServiceServer) ListProcessInstances(
 		}, nil
 	}
 
+	// Hide instances of other tenants and of processes caller may not read
+	filter := newResourceFilter(ctx, s.core, auth.ActionRead, auth.PermissionProcess)
+	if filter != nil || req.TenantId != "" {
+		tenantID := models.NormalizeTenantID(req.TenantId)
+		allowed := instances[:0]
+		for _, instance := range instances {
+			if req.TenantId != "" && instance.TenantID != tenantID {
+				continue
+			}
+			if filter.AllowsIn(instance.TenantID, instance.ProcessID) {
+				allowed = append(allowed, instance)
+			}
+		}
+		instances = allowed
+	}
+
 	// Store total count before pagination
 	totalCount := len(instances)
 
@@ -323,6 +360,7 @@ func (s *processServiceServer) ListProcessInstances(
 		protoInstance := &processpb.ProcessInstanceInfo{
 			InstanceId:      instance.InstanceID,
 			ProcessKey:      instance.ProcessID,
+			TenantId:        instance.TenantID,
 			Status:          instance.State,
 			CurrentActivity: instance.CurrentActivity,
 			StartedAt:       instance.StartedAt,
@@ -406,6 +444,9 @@ func (s *processServiceServer) ListTokens(
 	}
 
 	if req.InstanceIdFilter != "" {
+		if err := authorizeInstance(ctx, s.core, auth.ActionRead, auth.PermissionToken, req.InstanceIdFilter); err != nil {
+			return nil, err
+		}
 		// Filter by process instance - load ALL tokens for this instance (including FAILED)
 		tokens, err = processComp.GetTokensByProcessInstance(req.InstanceIdFilter)
 	} else {
@@ -455,6 +496,17 @@ func (s *processServiceServer) ListTokens(
 		}, nil
 	}
 
+	// Hide tokens of processes caller may not read
+	if filter := newResourceFilter(ctx, s.core, auth.ActionRead, auth.PermissionToken); filter != nil {
+		allowed := tokens[:0]
+		for _, token := range tokens {
+			if filter.AllowsInstance(token.ProcessInstanceID) {
+				allowed = append(allowed, token)
+			}
+		}
+		tokens = allowed
+	}
+
 	// Store total count before pagination
 	totalCount := len(tokens)
 
@@ -532,6 +584,7 @@ func (s *processServiceServer) ListTokens(
 			CreatedAt:         token.CreatedAt.Unix(),
 			UpdatedAt:         token.UpdatedAt.Unix(),
 			Variables:         variables,
+			TenantId:          token.TenantID,
 		}
 		protoTokens = append(protoTokens, protoToken)
 	}
@@ -581,6 +634,10 @@ func (s *processServiceServer) GetTokenStatus(
 		}, nil
 	}
 
+	if err := authorizeInstance(ctx, s.core, auth.ActionRead, auth.PermissionToken, token.ProcessInstanceID); err != nil {
+		return nil, err
+	}
+
 	// Convert variables map
 	variables := make(map[string]string)
 	for key, value := range token.Variables {
@@ -601,6 +658,7 @@ func (s *processServiceServer) GetTokenStatus(
 		CreatedAt:         token.CreatedAt.Unix(),
 		UpdatedAt:         token.UpdatedAt.Unix(),
 		Variables:         variables,
+		TenantId:          token.TenantID,
 	}
 
 	logger.Info("Token status retrieved successfully", logger.String("token_id", req.TokenId))
@@ -621,6 +679,10 @@ func (s *processServiceServer) GetProcessInstanceInfo(
 	logger.Info("GetProcessInstanceInfo request",
 		logger.String("instance_id", req.InstanceId))
 
+	if err := authorizeInstance(ctx, s.core, auth.ActionRead, auth.PermissionProcess, req.InstanceId); err != nil {
+		return nil, err
+	}
+
 	// Get process instance status first
 	statusResp, err := s.GetProcessInstanceStatus(ctx, &processpb.GetProcessInstanceStatusRequest{
 		InstanceId: req.InstanceId,
@@ -655,8 +717,9 @@ func (s *processServiceServer) GetProcessInstanceInfo(
 	if statusResp.ProcessKey != "" {
 		processKey = statusResp.ProcessKey
 	} else if statusResp.ProcessId != "" && statusResp.ProcessVersion > 0 {
-		// Format: "ProcessID:vVersion"
-		processKey = fmt.Sprintf("%s:v%d", statusResp.ProcessId, statusResp.ProcessVersion)
+		// Format: "tenant/ProcessID:vVersion"
+		processKey = models.TenantScopedKey(statusResp.TenantId,
+			fmt.Sprintf("%s:v%d", statusResp.ProcessId, statusResp.ProcessVersion))
 	}
 
 	// Find BPMN Process Key by process ID and version from status
@@ -667,8 +730,10 @@ func (s *processServiceServer) GetProcessInstanceInfo(
 
 		// Get parser service to find BPMN process
 		parserService := &ParserService{core: s.core}
-		// Get all BPMN processes and find matching one
-		listResp, err := parserService.ListBPMNProcesses(ctx, &parserpb.ListBPMNProcessesRequest{})
+		// Get all BPMN processes of instance tenant and find matching one
+		listResp, err := parserService.ListBPMNProcesses(ctx, &parserpb.ListBPMNProcessesRequest{
+			TenantId: models.TenantLabel(statusResp.TenantId),
+		})
 		if err == nil && listResp != nil && listResp.Success {
 			for _, process := range listResp.Processes {
 				if process.ProcessId == processID && process.Version == version {
@@ -810,6 +875,7 @@ func (s *processServiceServer) GetProcessInstanceInfo(
 		InstanceId:       statusResp.InstanceId,
 		ProcessKey:       processKey,
 		BpmnProcessKey:   bpmnProcessKey,
+		TenantId:         statusResp.TenantId,
 		Status:           statusResp.Status,
 		CurrentActivity:  statusResp.CurrentActivity,
 		StartedAt:        statusResp.StartedAt,
@@ -15,8 +15,14 @@ import (
 	"time"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/reflection"
 
+	"atom-engine/proto/auth/authpb"
+	"atom-engine/proto/batch/batchpb"
+	"atom-engine/proto/events/eventspb"
+	"atom-engine/proto/exporters/exporterspb"
 	"atom-engine/proto/expression/expressionpb"
 	"atom-engine/proto/incidents/incidentspb"
 	"atom-engine/proto/jobs/jobspb"
@@ -25,9 +31,11 @@ import (
 	"atom-engine/proto/process/processpb"
 	"atom-engine/proto/timewheel/timewheelpb"
 	"atom-engine/src/core/auth"
+	"atom-engine/src/core/config"
 	"atom-engine/src/core/interfaces"
 	"atom-engine/src/core/logger"
 	"atom-engine/src/core/models"
+	"atom-engine/src/core/tlsconfig"
 )
 
 // Server represents gRPC server
@@ -36,6 +44,8 @@ type Server struct {
 	grpcServer *grpc.Server
 	listener   net.Listener
 	port       int
+	tlsConfig  config.TLSConfig
+	tls        *tlsconfig.Manager
 	core       CoreInterface
 }
 
@@ -94,22 +104,36 @@ type JobsComponentInterface interface {
 // Config holds gRPC server configuration
 // Конфигурация gRPC сервера
 type Config struct {
-	Port int `yaml:"port"`
+	Port int              `yaml:"port"`
+	TLS  config.TLSConfig `yaml:"tls"`
 }
 
 // NewServer creates new gRPC server instance
 // Создает новый экземпляр gRPC сервера
 func NewServer(config *Config, core CoreInterface) *Server {
 	return &Server{
-		port: config.Port,
-		core: core,
+		port:      config.Port,
+		tlsConfig: config.TLS,
+		core:      core,
 	}
 }
 
 // Start starts gRPC server
 // Запускает gRPC сервер
 func (s *Server) Start() error {
-	logger.Info("Starting gRPC server", logger.Int("port", s.port))
+	logger.Info("Starting gRPC server",
+		logger.Int("port", s.port),
+		logger.Bool("tls", s.tlsConfig.Enabled))
+
+	var serverOptions []grpc.ServerOption
+	if s.tlsConfig.Enabled {
+		manager, err := tlsconfig.NewManager(s.tlsConfig)
+		if err != nil {
+			return fmt.Errorf("failed to configure gRPC TLS: %w", err)
+		}
+		s.tls = manager
+		serverOptions = append(serverOptions, grpc.Creds(credentials.NewTLS(manager.ServerConfig("h2"))))
+	}
 
 	listener, err := net.Listen("tcp", fmt.Sprintf(":%d", s.port))
 	if err != nil {
@@ -117,22 +141,31 @@ func (s *Server) Start() error {
 	}
 	s.listener = listener
 
-	// Setup interceptors
-	var opts []grpc.ServerOption
+	// Setup interceptors, metrics first so rejected requests are measured too
+	unaryInterceptors := []grpc.UnaryServerInterceptor{
+		MetricsUnaryInterceptor(),
+		TracingUnaryInterceptor(),
+	}
+	streamInterceptors := []grpc.StreamServerInterceptor{
+		MetricsStreamInterceptor(),
+		TracingStreamInterceptor(),
+	}
 
 	// Add auth interceptor if auth component is available
 	if authComp := s.core.GetAuthComponent(); authComp != nil {
 		if authComponent, ok := authComp.(auth.Component); ok {
 			authInterceptor := NewAuthInterceptor(authComponent)
-			opts = append(opts,
-				grpc.UnaryInterceptor(authInterceptor.UnaryInterceptor()),
-				grpc.StreamInterceptor(authInterceptor.StreamInterceptor()),
-			)
+			unaryInterceptors = append(unaryInterceptors, authInterceptor.UnaryInterceptor())
+			streamInterceptors = append(streamInterceptors, authInterceptor.StreamInterceptor())
 			logger.Info("Auth interceptors enabled for gRPC server")
 		}
 	}
 
-	s.grpcServer = grpc.NewServer(opts...)
+	serverOptions = append(serverOptions,
+		grpc.ChainUnaryInterceptor(unaryInterceptors...),
+		grpc.ChainStreamInterceptor(streamInterceptors...),
+	)
+	s.grpcServer = grpc.NewServer(serverOptions...)
 
 	// Register storage service
 	RegisterStorageServiceServer(s.grpcServer, &storageServiceServer{core: s.core})
@@ -153,12 +186,28 @@ func (s *Server) Start() error {
 	// Register incidents service
 	incidentspb.RegisterIncidentsServiceServer(s.grpcServer, &incidentsServiceServer{core: s.core})
 
+	// Register batch operations service
+	batchpb.RegisterBatchServiceServer(s.grpcServer, &batchServiceServer{core: s.core})
+
+	// Register API key management service
+	authpb.RegisterAuthServiceServer(s.grpcServer, &authServiceServer{core: s.core})
+
+	// Register engine event stream service
+	eventspb.RegisterEventsServiceServer(s.grpcServer, &eventsServiceServer{core: s.core})
+
+	// Register export log service
+	exporterspb.RegisterExportersServiceServer(s.grpcServer, &exportersServiceServer{core: s.core})
+
 	// Register expression service
 	expressionpb.RegisterExpressionServiceServer(s.grpcServer, &expressionServiceServer{core: s.core})
 
 	// Enable reflection for development
 	reflection.Register(s.grpcServer)
 
+	if s.tls != nil {
+		s.tls.Start()
+	}
+
 	logger.Info("gRPC server started successfully", logger.Int("port", s.port))
 
 	go func() {
@@ -180,6 +229,9 @@ func (s *Server) Stop() error {
 	if s.listener != nil {
 		s.listener.Close()
 	}
+	if s.tls != nil {
+		s.tls.Stop()
+	}
 	return nil
 }
 
@@ -199,7 +251,11 @@ func (s *Server) GetLoopbackConnection() (*grpc.ClientConn, error) {
 	// Create a connection to localhost on the server port
 	// Создаем соединение к localhost на порту сервера
 	target := fmt.Sprintf("localhost:%d", s.port)
-	conn, err := grpc.Dial(target, grpc.WithInsecure())
+	transportCreds := insecure.NewCredentials()
+	if s.tls != nil {
+		transportCreds = credentials.NewTLS(s.tls.LoopbackClientConfig())
+	}
+	conn, err := grpc.Dial(target, grpc.WithTransportCredentials(transportCreds))
 	if err != nil {
 		return nil, fmt.Errorf("failed to create loopback connection to %s: %w", target, err)
 	}
@@ -9,10 +9,23 @@ This project is dual-licensed under AGPL-3.0 and AtomBPMN Commercial License.
 package grpc
 
 import (
+	"bufio"
 	"context"
+	"errors"
 	"fmt"
+	"io"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"atom-engine/src/core/logger"
 )
 
+// backupChunkSize is size of backup data carried by one stream message
+const backupChunkSize = 256 * 1024
+
 // storageServiceServer implements StorageService gRPC interface
 // Реализует gRPC интерфейс StorageService
 type storageServiceServer struct {
@@ -65,3 +78,97 @@ func (s *storageServiceServer) GetStorageInfo(
 		Statistics:     statistics,
 	}, nil
 }
+
+// BackupStorage streams online backup of storage in chunks
+// Передает резервную копию storage потоком частей
+func (s *storageServiceServer) BackupStorage(
+	req *BackupStorageRequest,
+	stream grpc.ServerStreamingServer[BackupStorageChunk],
+) error {
+	logger.Info("gRPC BackupStorage request", logger.Any("since", req.Since))
+
+	writer := bufio.NewWriterSize(&backupChunkWriter{stream: stream}, backupChunkSize)
+	if _, err := s.core.BackupStorage(writer, req.Since); err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+	if err := writer.Flush(); err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+	return nil
+}
+
+// RestoreStorage restores streamed backup into directory from first message
+// Восстанавливает переданную потоком резервную копию в каталог из первого сообщения
+func (s *storageServiceServer) RestoreStorage(
+	stream grpc.ClientStreamingServer[RestoreStorageRequest, RestoreStorageResponse],
+) error {
+	first, err := stream.Recv()
+	if err != nil {
+		return status.Error(codes.InvalidArgument, "restore stream is empty")
+	}
+	if first.Directory == "" {
+		return status.Error(codes.InvalidArgument, "directory is required")
+	}
+
+	logger.Info("gRPC RestoreStorage request", logger.String("directory", first.Directory))
+
+	reader := &restoreChunkReader{stream: stream, data: first.Data}
+	metadata, err := s.core.RestoreStorage(reader, first.Directory)
+	if err != nil {
+		if reader.err != nil {
+			return status.Error(codes.Aborted, reader.err.Error())
+		}
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
+
+	return stream.SendAndClose(&RestoreStorageResponse{
+		Directory:     first.Directory,
+		EngineVersion: metadata.EngineVersion,
+		SchemaVersion: int32(metadata.SchemaVersion),
+		CreatedAt:     timestamppb.New(metadata.CreatedAt),
+		Since:         metadata.Since,
+		Version:       metadata.Version,
+	})
+}
+
+// backupChunkWriter sends written data as backup chunks
+type backupChunkWriter struct {
+	stream grpc.ServerStreamingServer[BackupStorageChunk]
+}
+
+// Write sends data as single chunk
+func (w *backupChunkWriter) Write(data []byte) (int, error) {
+	// Stream may keep message after Send returns, so data is copied
+	chunk := make([]byte, len(data))
+	copy(chunk, data)
+	if err := w.stream.Send(&BackupStorageChunk{Data: chunk}); err != nil {
+		return 0, err
+	}
+	return len(data), nil
+}
+
+// restoreChunkReader reads data of received restore chunks
+type restoreChunkReader struct {
+	stream grpc.ClientStreamingServer[RestoreStorageRequest, RestoreStorageResponse]
+	data   []byte
+	err    error // Receive failure other than end of stream
+}
+
+// Read returns buffered chunk data, receiving next chunk when drained
+func (r *restoreChunkReader) Read(p []byte) (int, error) {
+	for len(r.data) == 0 {
+		chunk, err := r.stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return 0, io.EOF
+		}
+		if err != nil {
+			r.err = err
+			return 0, err
+		}
+		r.data = chunk.Data
+	}
+
+	n := copy(p, r.data)
+	r.data = r.data[n:]
+	return n, nil
+}
@@ -10,11 +10,19 @@ package grpc
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"sort"
+	"strings"
 	"time"
 
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
 	"atom-engine/proto/timewheel/timewheelpb"
+	"atom-engine/src/core/auth"
+	"atom-engine/src/core/calendar"
+	"atom-engine/src/core/clock"
 	"atom-engine/src/core/logger"
 	"atom-engine/src/core/models"
 	"atom-engine/src/storage"
@@ -39,6 +47,16 @@ func (s *timewheelServiceServer) AddTimer(
 		logger.Int64("delay_ms", req.DelayMs),
 		logger.Bool("repeating", req.Repeating))
 
+	// Ad-hoc timers are not bound to process instance and belong to caller tenant
+	// Ручные таймеры не привязаны к экземпляру процесса и принадлежат арендатору вызывающего
+	tenantID, err := resolveTenant(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+	if err := authorizeTenantResource(ctx, auth.ActionCreate, auth.PermissionTimer, tenantID, ""); err != nil {
+		return nil, err
+	}
+
 	component := s.core.GetTimewheelComponent()
 	if component == nil {
 		return &timewheelpb.AddTimerResponse{
@@ -59,6 +77,7 @@ func (s *timewheelServiceServer) AddTimer(
 			ProcessKey:      "cli-timer",
 			ProcessVersion:  1,
 			ProcessName:     "CLI Timer",
+			TenantID:        tenantID,
 			ComponentSource: "logs", // Send response to logs
 		},
 	}
@@ -111,7 +130,7 @@ func (s *timewheelServiceServer) AddTimer(
 
 	// Use consistent base time for all calculations
 	// Используем консистентное базовое время для всех расчетов
-	baseTime := time.Now()
+	baseTime := clock.Now()
 	timerReq.BaseTime = &baseTime
 
 	// Calculate correct scheduled time for both legacy and ISO 8601 formats
@@ -124,21 +143,11 @@ func (s *timewheelServiceServer) AddTimer(
 	} else {
 		// ISO 8601 format - try to parse and calculate from the timer request
 		// ISO 8601 формат - пытаемся парсить и вычислить из запроса таймера
-		if req.Duration != "" {
-			// Parse ISO duration and calculate scheduled time
-			// Парсим ISO длительность и вычисляем время срабатывания
-			if parser := timewheel.NewISO8601DurationParser(); parser != nil {
-				if duration, err := parser.ParseDuration(req.Duration); err == nil {
-					scheduledAt = baseTime.Add(duration).Unix()
-				}
-			}
-		} else if req.Interval != "" {
-			// Parse ISO cycle and get first execution time
-			// Парсим ISO цикл и получаем время первого выполнения
-			if parser := timewheel.NewISO8601DurationParser(); parser != nil {
-				if _, interval, err := parser.ParseRepeatingInterval(req.Interval); err == nil {
-					scheduledAt = baseTime.Add(interval).Unix()
-				}
+		if req.Duration != "" || req.Interval != "" {
+			// Parse ISO duration or cycle and get first execution time
+			// Парсим ISO длительность или цикл и получаем время первого выполнения
+			if dueDate, err := timewheel.CalculateDueDate(nil, timerReq.TimeDuration, timerReq.TimeCycle, baseTime); err == nil {
+				scheduledAt = dueDate.Unix()
 			}
 		}
 
@@ -169,6 +178,10 @@ func (s *timewheelServiceServer) RemoveTimer(
 ) (*timewheelpb.RemoveTimerResponse, error) {
 	logger.Info("RemoveTimer gRPC request", logger.String("timer_id", req.TimerId))
 
+	if err := authorizeTimer(ctx, s.core, auth.ActionDelete, req.TimerId); err != nil {
+		return nil, err
+	}
+
 	component := s.core.GetTimewheelComponent()
 	if component == nil {
 		return &timewheelpb.RemoveTimerResponse{
@@ -252,6 +265,12 @@ func (s *timewheelServiceServer) GetTimerStatus(
 		}, nil
 	}
 
+	err = authorizeOwned(ctx, s.core, auth.ActionRead, auth.PermissionTimer,
+		timerRecord.TenantID, timerRecord.ProcessInstanceID)
+	if err != nil {
+		return nil, err
+	}
+
 	// Determine status based on storage state and wheel presence
 	var status string
 	var remainingMs int64
@@ -300,6 +319,10 @@ func (s *timewheelServiceServer) GetTimeWheelStats(
 ) (*timewheelpb.GetTimeWheelStatsResponse, error) {
 	logger.Info("GetTimeWheelStats gRPC request")
 
+	if err := authorizeResource(ctx, auth.ActionRead, auth.PermissionTimer, ""); err != nil {
+		return nil, err
+	}
+
 	return s.core.GetTimewheelStats()
 }
 
@@ -329,6 +352,7 @@ func (s *timewheelServiceServer) ListTimers(
 
 	logger.Info("ListTimers gRPC request",
 		logger.String("status_filter", req.StatusFilter),
+		logger.String("tenant_id", req.TenantId),
 		logger.Int("limit", int(req.Limit)),
 		logger.Int("page_size", int(pageSize)),
 		logger.Int("page", int(page)),
@@ -345,6 +369,22 @@ func (s *timewheelServiceServer) ListTimers(
 		}, err
 	}
 
+	// Skip timers of other tenants and of instances caller may not read
+	filter := newResourceFilter(ctx, s.core, auth.ActionRead, auth.PermissionTimer)
+	if filter != nil || req.TenantId != "" {
+		tenantID := models.NormalizeTenantID(req.TenantId)
+		allowed := allTimersResponse.Timers[:0]
+		for _, timer := range allTimersResponse.Timers {
+			if req.TenantId != "" && timer.TenantId != tenantID {
+				continue
+			}
+			if filter.AllowsOwned(timer.TenantId, timer.ProcessInstanceId) {
+				allowed = append(allowed, timer)
+			}
+		}
+		allTimersResponse.Timers = allowed
+	}
+
 	// Store total count before pagination
 	totalCount := len(allTimersResponse.Timers)
 
@@ -419,3 +459,272 @@ func (s *timewheelServiceServer) ListTimers(
 		TotalPages: int32(totalPages),
 	}, nil
 }
+
+// authorizeTimer checks action on stored timer by its tenant and process of its instance
+// Проверяет действие над сохраненным таймером по арендатору и процессу его экземпляра
+func authorizeTimer(ctx context.Context, core CoreInterface, action, timerID string) error {
+	if _, ok := GetAuthResultFromContext(ctx); !ok {
+		return nil
+	}
+
+	instanceID, tenantID := "", models.DefaultTenantID
+	if storageComp, ok := core.GetStorage().(storage.Storage); ok {
+		if timerRecord, err := storageComp.LoadTimer(timerID); err == nil && timerRecord != nil {
+			instanceID, tenantID = timerRecord.ProcessInstanceID, timerRecord.TenantID
+		}
+	}
+	return authorizeOwned(ctx, core, action, auth.PermissionTimer, tenantID, instanceID)
+}
+
+// getTimewheelComponent gets typed timewheel component from core
+// Получает типизированный timewheel компонент из core
+func getTimewheelComponent(core CoreInterface) (*timewheel.Component, error) {
+	component, ok := core.GetTimewheelComponent().(*timewheel.Component)
+	if !ok || component == nil {
+		return nil, fmt.Errorf("timewheel component not available")
+	}
+	return component, nil
+}
+
+// GetClock returns engine clock mode and time
+// Возвращает режим и время часов движка
+func (s *timewheelServiceServer) GetClock(
+	ctx context.Context,
+	req *timewheelpb.GetClockRequest,
+) (*timewheelpb.GetClockResponse, error) {
+	component, err := getTimewheelComponent(s.core)
+	if err != nil {
+		return nil, status.Error(codes.Unavailable, err.Error())
+	}
+
+	clockStatus, err := component.GetClockStatus()
+	if err != nil {
+		return nil, status.Error(codes.Unavailable, err.Error())
+	}
+
+	return &timewheelpb.GetClockResponse{
+		Mode: clockStatus.Mode,
+		Now:  clockStatus.Now.UnixMilli(),
+	}, nil
+}
+
+// AdvanceClock advances manual engine clock firing due timers in order.
+// Requires manual clock and admin permission even when authentication is disabled.
+// Продвигает ручные часы движка, требует ручные часы и право admin даже без авторизации
+func (s *timewheelServiceServer) AdvanceClock(
+	ctx context.Context,
+	req *timewheelpb.AdvanceClockRequest,
+) (*timewheelpb.AdvanceClockResponse, error) {
+	logger.Info("AdvanceClock gRPC request", logger.String("duration", req.Duration))
+
+	if _, err := clock.ManualClock(); err != nil {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+	// Advance fires every due timer, so it is never open to anonymous callers
+	if _, authenticated := GetAuthResultFromContext(ctx); !authenticated {
+		return nil, status.Error(codes.PermissionDenied, "clock advance requires authenticated caller with admin permission")
+	}
+	if err := RequirePermission(ctx, auth.PermissionAdmin); err != nil {
+		return nil, err
+	}
+
+	component, err := getTimewheelComponent(s.core)
+	if err != nil {
+		return nil, status.Error(codes.Unavailable, err.Error())
+	}
+
+	result, err := component.AdvanceClock(req.Duration)
+	if err != nil {
+		logger.Warn("Failed to advance engine clock",
+			logger.String("duration", req.Duration),
+			logger.String("error", err.Error()))
+		return &timewheelpb.AdvanceClockResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	return &timewheelpb.AdvanceClockResponse{
+		Success:     true,
+		Message:     fmt.Sprintf("clock advanced by %s, %d timers fired", req.Duration, result.FiredTimers),
+		From:        result.From.UnixMilli(),
+		To:          result.To.UnixMilli(),
+		FiredTimers: int32(result.FiredTimers),
+	}, nil
+}
+
+// PutCalendar creates or replaces business calendar.
+// Requires admin permission when authentication is enabled.
+// Создает или заменяет бизнес-календарь, требует право admin при включенной авторизации
+func (s *timewheelServiceServer) PutCalendar(
+	ctx context.Context,
+	req *timewheelpb.PutCalendarRequest,
+) (*timewheelpb.PutCalendarResponse, error) {
+	if req.Calendar == nil || req.Calendar.Name == "" {
+		return nil, status.Error(codes.InvalidArgument, "calendar name is required")
+	}
+
+	logger.Info("PutCalendar gRPC request", logger.String("calendar", req.Calendar.Name))
+
+	if _, authenticated := GetAuthResultFromContext(ctx); authenticated {
+		if err := RequirePermission(ctx, auth.PermissionAdmin); err != nil {
+			return nil, err
+		}
+	}
+
+	component, err := getTimewheelComponent(s.core)
+	if err != nil {
+		return nil, status.Error(codes.Unavailable, err.Error())
+	}
+
+	saved, err := component.PutCalendar(calendarFromProto(req.Calendar))
+	if err != nil {
+		if errors.Is(err, timewheel.ErrCalendarReadOnly) {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		return &timewheelpb.PutCalendarResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	return &timewheelpb.PutCalendarResponse{
+		Success:  true,
+		Message:  fmt.Sprintf("business calendar %s saved", saved.Name),
+		Calendar: calendarToProto(saved),
+	}, nil
+}
+
+// GetCalendar returns business calendar by name
+// Возвращает бизнес-календарь по имени
+func (s *timewheelServiceServer) GetCalendar(
+	ctx context.Context,
+	req *timewheelpb.GetCalendarRequest,
+) (*timewheelpb.GetCalendarResponse, error) {
+	component, err := getTimewheelComponent(s.core)
+	if err != nil {
+		return nil, status.Error(codes.Unavailable, err.Error())
+	}
+
+	def, err := component.GetCalendar(req.Name)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	return &timewheelpb.GetCalendarResponse{Calendar: calendarToProto(def)}, nil
+}
+
+// ListCalendars returns all business calendars
+// Возвращает все бизнес-календари
+func (s *timewheelServiceServer) ListCalendars(
+	ctx context.Context,
+	req *timewheelpb.ListCalendarsRequest,
+) (*timewheelpb.ListCalendarsResponse, error) {
+	component, err := getTimewheelComponent(s.core)
+	if err != nil {
+		return nil, status.Error(codes.Unavailable, err.Error())
+	}
+
+	defs := component.ListCalendars()
+	calendars := make([]*timewheelpb.BusinessCalendar, 0, len(defs))
+	for _, def := range defs {
+		calendars = append(calendars, calendarToProto(def))
+	}
+
+	return &timewheelpb.ListCalendarsResponse{Calendars: calendars}, nil
+}
+
+// DeleteCalendar deletes business calendar created through API.
+// Requires admin permission when authentication is enabled.
+// Удаляет бизнес-календарь созданный через API
+func (s *timewheelServiceServer) DeleteCalendar(
+	ctx context.Context,
+	req *timewheelpb.DeleteCalendarRequest,
+) (*timewheelpb.DeleteCalendarResponse, error) {
+	logger.Info("DeleteCalendar gRPC request", logger.String("calendar", req.Name))
+
+	if _, authenticated := GetAuthResultFromContext(ctx); authenticated {
+		if err := RequirePermission(ctx, auth.PermissionAdmin); err != nil {
+			return nil, err
+		}
+	}
+
+	component, err := getTimewheelComponent(s.core)
+	if err != nil {
+		return nil, status.Error(codes.Unavailable, err.Error())
+	}
+
+	if err := component.DeleteCalendar(req.Name); err != nil {
+		switch {
+		case errors.Is(err, calendar.ErrNotFound):
+			return nil, status.Error(codes.NotFound, err.Error())
+		case errors.Is(err, timewheel.ErrCalendarReadOnly):
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		return &timewheelpb.DeleteCalendarResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	return &timewheelpb.DeleteCalendarResponse{
+		Success: true,
+		Message: fmt.Sprintf("business calendar %s deleted", req.Name),
+	}, nil
+}
+
+// calendarFromProto converts protobuf business calendar to model
+// Конвертирует protobuf бизнес-календарь в модель
+func calendarFromProto(pb *timewheelpb.BusinessCalendar) *models.BusinessCalendar {
+	def := &models.BusinessCalendar{
+		Name:         pb.Name,
+		TimeZone:     pb.TimeZone,
+		WorkingHours: pb.WorkingHours,
+		WorkingDays:  pb.WorkingDays,
+		Holidays:     pb.Holidays,
+	}
+	if len(pb.WeeklySchedule) > 0 {
+		def.WeeklySchedule = make(map[string][]string, len(pb.WeeklySchedule))
+		for day, ranges := range pb.WeeklySchedule {
+			def.WeeklySchedule[day] = splitRanges(ranges)
+		}
+	}
+	return def
+}
+
+// calendarToProto converts business calendar model to protobuf
+// Конвертирует модель бизнес-календаря в protobuf
+func calendarToProto(def *models.BusinessCalendar) *timewheelpb.BusinessCalendar {
+	pb := &timewheelpb.BusinessCalendar{
+		Name:         def.Name,
+		TimeZone:     def.TimeZone,
+		WorkingHours: def.WorkingHours,
+		WorkingDays:  def.WorkingDays,
+		Holidays:     def.Holidays,
+		Source:       def.Source,
+	}
+	// Calendars from configuration have no timestamps
+	if !def.CreatedAt.IsZero() {
+		pb.CreatedAt = def.CreatedAt.UnixMilli()
+		pb.UpdatedAt = def.UpdatedAt.UnixMilli()
+	}
+	if len(def.WeeklySchedule) > 0 {
+		pb.WeeklySchedule = make(map[string]string, len(def.WeeklySchedule))
+		for day, ranges := range def.WeeklySchedule {
+			pb.WeeklySchedule[day] = strings.Join(ranges, ",")
+		}
+	}
+	return pb
+}
+
+// splitRanges splits comma separated working time ranges
+// Разделяет рабочие интервалы перечисленные через запятую
+func splitRanges(value string) []string {
+	ranges := make([]string, 0)
+	for _, part := range strings.Split(value, ",") {
+		if part = strings.TrimSpace(part); part != "" {
+			ranges = append(ranges, part)
+		}
+	}
+	return ranges
+}
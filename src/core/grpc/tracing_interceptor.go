@@ -0,0 +1,95 @@
+/*
+This file is part of the AtomBPMN (R) project.
+Copyright (c) 2025 Matreska Market LLC (ООО «Matreska Market»).
+Authors: Matreska Team.
+
+This project is dual-licensed under AGPL-3.0 and AtomBPMN Commercial License.
+*/
+
+package grpc
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"atom-engine/src/core/tracing"
+)
+
+// TracingUnaryInterceptor opens server span per unary call, continuing trace
+// from incoming traceparent metadata
+// Открывает серверный спан на unary вызов, продолжая трассу из traceparent
+func TracingUnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		ctx, span := startGRPCSpan(ctx, info.FullMethod)
+		resp, err := handler(ctx, req)
+		endGRPCSpan(span, err)
+		return resp, err
+	}
+}
+
+// TracingStreamInterceptor opens server span per stream
+// Открывает серверный спан на поток
+func TracingStreamInterceptor() grpc.StreamServerInterceptor {
+	return func(
+		srv interface{},
+		stream grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		ctx, span := startGRPCSpan(stream.Context(), info.FullMethod)
+		err := handler(srv, &tracedServerStream{ServerStream: stream, ctx: ctx})
+		endGRPCSpan(span, err)
+		return err
+	}
+}
+
+// startGRPCSpan starts server span under traceparent from request metadata
+// Запускает серверный спан под traceparent из метаданных запроса
+func startGRPCSpan(ctx context.Context, method string) (context.Context, trace.Span) {
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(tracing.TraceparentKey); len(values) > 0 {
+			ctx = tracing.ContextWithTraceparent(ctx, values[0])
+		}
+	}
+	return tracing.Tracer().Start(ctx, method,
+		trace.WithSpanKind(trace.SpanKindServer),
+		trace.WithAttributes(
+			attribute.String("rpc.system", "grpc"),
+			attribute.String("rpc.method", method),
+		))
+}
+
+// endGRPCSpan records status code and finishes span
+// Записывает код статуса и завершает спан
+func endGRPCSpan(span trace.Span, err error) {
+	code := status.Code(err)
+	span.SetAttributes(attribute.String("rpc.grpc.status_code", code.String()))
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// tracedServerStream overrides stream context with span context
+// Подменяет контекст потока контекстом со спаном
+type tracedServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+// Context returns stream context carrying server span
+// Возвращает контекст потока с серверным спаном
+func (s *tracedServerStream) Context() context.Context {
+	return s.ctx
+}
@@ -21,6 +21,10 @@ type GetStorageStatusRequest = storagepb.GetStorageStatusRequest
 type GetStorageStatusResponse = storagepb.GetStorageStatusResponse
 type GetStorageInfoRequest = storagepb.GetStorageInfoRequest
 type GetStorageInfoResponse = storagepb.GetStorageInfoResponse
+type BackupStorageRequest = storagepb.BackupStorageRequest
+type BackupStorageChunk = storagepb.BackupStorageChunk
+type RestoreStorageRequest = storagepb.RestoreStorageRequest
+type RestoreStorageResponse = storagepb.RestoreStorageResponse
 
 // Type aliases for interfaces package to maintain compatibility
 // Псевдонимы типов из пакета interfaces для поддержания совместимости
@@ -10,6 +10,7 @@ package interfaces
 
 import (
 	"context"
+	"io"
 	"time"
 
 	"atom-engine/proto/timewheel/timewheelpb"
@@ -25,6 +26,8 @@ type CoreInterface interface {
 	// Операции с хранилищем
 	GetStorageStatus() (*StorageStatusResponse, error)
 	GetStorageInfo() (*StorageInfoResponse, error)
+	BackupStorage(w io.Writer, since uint64) (*storage.BackupMetadata, error)
+	RestoreStorage(r io.Reader, directory string) (*storage.BackupMetadata, error)
 
 	// Component access - typed interfaces
 	// Доступ к компонентам - типизированные интерфейсы
@@ -39,6 +42,8 @@ type CoreInterface interface {
 	GetParserComponent() interface{}
 	GetExpressionComponent() interface{}
 	GetIncidentsComponent() interface{}
+	GetBatchComponent() interface{}
+	GetExportersComponent() interface{}
 	GetAuthComponent() interface{}
 	GetStorage() interface{}
 
@@ -167,7 +172,11 @@ type StorageComponentInterface interface {
 type ProcessComponentInterface interface {
 	// Legacy methods for backward compatibility
 	// Устаревшие методы для обратной совместимости
-	StartProcessInstance(processKey string, variables map[string]interface{}) (*ProcessInstanceResult, error)
+	StartProcessInstance(
+		ctx context.Context,
+		processKey, tenantID string,
+		variables map[string]interface{},
+	) (*ProcessInstanceResult, error)
 	GetProcessInstanceStatus(instanceID string) (*ProcessInstanceStatus, error)
 	CancelProcessInstance(instanceID string, reason string) error
 	ListProcessInstances(statusFilter string, processKeyFilter string, limit int) ([]*ProcessInstanceStatus, error)
@@ -197,6 +206,7 @@ type ProcessInstanceResult struct {
 	ProcessKey      string                 `json:"process_key"`
 	ProcessID       string                 `json:"process_id"`
 	ProcessName     string                 `json:"process_name"`
+	TenantID        string                 `json:"tenant_id,omitempty"`
 	Version         int32                  `json:"version"`
 	Variables       map[string]interface{} `json:"variables"`
 	Status          string                 `json:"status"`
@@ -215,6 +225,7 @@ type ProcessInstanceStatus struct {
 	ProcessKey      string                 `json:"process_key"`
 	ProcessID       string                 `json:"process_id"`
 	ProcessName     string                 `json:"process_name"`
+	TenantID        string                 `json:"tenant_id,omitempty"`
 	Status          string                 `json:"status"`
 	State           string                 `json:"state"`
 	CurrentActivity string                 `json:"current_activity"`
@@ -0,0 +1,76 @@
+/*
+This file is part of the AtomBPMN (R) project.
+Copyright (c) 2025 Matreska Market LLC (ООО «Matreska Market»).
+Authors: Matreska Team.
+
+This project is dual-licensed under AGPL-3.0 and AtomBPMN Commercial License.
+*/
+
+package metrics
+
+// Engine metrics updated by components. Gauges read from component state are
+// refreshed by scrape hook registered by core.
+// Метрики движка, обновляемые компонентами. Gauge из состояния компонентов
+// обновляются хуком сбора, зарегистрированным ядром.
+var (
+	// Process instances
+	ProcessInstancesStarted = NewCounterVec("atom_process_instances_started_total",
+		"Process instances started", "process_key")
+	ProcessInstancesCompleted = NewCounterVec("atom_process_instances_completed_total",
+		"Process instances completed", "process_key")
+	ProcessInstancesCanceled = NewCounterVec("atom_process_instances_canceled_total",
+		"Process instances canceled", "process_key")
+	ElementExecutionDuration = NewHistogramVec("atom_element_execution_duration_seconds",
+		"Element executor latency", DefaultBuckets, "element_type")
+
+	// Jobs
+	JobsActivated = NewCounterVec("atom_jobs_activated_total",
+		"Jobs activated by workers", "job_type")
+	JobsCompleted = NewCounterVec("atom_jobs_completed_total",
+		"Jobs completed by workers", "job_type")
+	JobsFailed = NewCounterVec("atom_jobs_failed_total",
+		"Jobs failed by workers", "job_type")
+	JobQueueDepth = NewGaugeVec("atom_job_queue_depth",
+		"Jobs waiting for activation", "job_type")
+
+	// Timers
+	TimewheelTimers = NewGaugeVec("atom_timewheel_timers",
+		"Timers scheduled in timewheel level", "level")
+	TimerFireLateness = NewHistogramVec("atom_timer_fire_lateness_seconds",
+		"Delay between timer due date and fire",
+		[]float64{0.01, 0.05, 0.1, 0.25, 0.5, 1, 2, 5, 10, 30, 60, 300}, "level")
+
+	// Messages
+	MessagesPublished = NewCounterVec("atom_messages_published_total",
+		"Published messages by outcome: correlated, buffered or uncorrelated", "result")
+	MessagesBuffered = NewGaugeVec("atom_messages_buffered",
+		"Messages buffered without matching subscription")
+
+	// Incidents
+	IncidentsOpen = NewGaugeVec("atom_incidents_open",
+		"Open incidents", "incident_type")
+
+	// Exporters
+	ExporterRecordsExported = NewCounterVec("atom_exporter_records_exported_total",
+		"Export log records acknowledged by exporter", "exporter")
+	ExporterFailures = NewCounterVec("atom_exporter_failures_total",
+		"Failed exporter open, load and export attempts", "exporter")
+	ExporterLag = NewGaugeVec("atom_exporter_lag_records",
+		"Export log records waiting for exporter", "exporter")
+
+	// Retention
+	RetentionRecordsDeleted = NewCounterVec("atom_retention_records_deleted_total",
+		"History records deleted by retention policies", "kind")
+
+	// API
+	GRPCRequestDuration = NewHistogramVec("atom_grpc_request_duration_seconds",
+		"gRPC request latency", DefaultBuckets, "method", "code")
+	HTTPRequestDuration = NewHistogramVec("atom_http_request_duration_seconds",
+		"REST API request latency", DefaultBuckets, "method", "route", "status")
+
+	// Storage
+	BadgerLSMSize = NewGaugeVec("atom_badger_lsm_size_bytes",
+		"BadgerDB LSM tree size")
+	BadgerVlogSize = NewGaugeVec("atom_badger_vlog_size_bytes",
+		"BadgerDB value log size")
+)
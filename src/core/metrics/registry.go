@@ -0,0 +1,116 @@
+/*
+This file is part of the AtomBPMN (R) project.
+Copyright (c) 2025 Matreska Market LLC (ООО «Matreska Market»).
+Authors: Matreska Team.
+
+This project is dual-licensed under AGPL-3.0 and AtomBPMN Commercial License.
+*/
+
+package metrics
+
+import (
+	"net/http"
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	dto "github.com/prometheus/client_model/go"
+)
+
+// Metric families of Prometheus client used by components
+// Семейства метрик клиента Prometheus, используемые компонентами
+type (
+	CounterVec   = prometheus.CounterVec
+	GaugeVec     = prometheus.GaugeVec
+	HistogramVec = prometheus.HistogramVec
+	Observer     = prometheus.Observer
+)
+
+// Registry is Prometheus registry running scrape hooks before every gather
+// Реестр Prometheus, выполняющий хуки сбора перед каждым сбором
+type Registry struct {
+	*prometheus.Registry
+
+	scrapeMu sync.Mutex // Serializes scrapes, hooks reset gauges
+	mu       sync.Mutex
+	hooks    []func()
+}
+
+// NewRegistry creates registry with Go runtime and process collectors
+// Создает реестр с коллекторами Go runtime и процесса
+func NewRegistry() *Registry {
+	r := &Registry{Registry: prometheus.NewRegistry()}
+	r.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+	)
+	return r
+}
+
+// Default is registry of engine metrics
+// Реестр метрик движка
+var Default = NewRegistry()
+
+// OnCollect registers hook called before every scrape, used to refresh gauges
+// read from components
+// Регистрирует хук, вызываемый перед каждым сбором, для обновления gauge
+func (r *Registry) OnCollect(hook func()) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.hooks = append(r.hooks, hook)
+}
+
+// Gather runs scrape hooks and gathers all registered metrics
+// Выполняет хуки сбора и собирает все зарегистрированные метрики
+func (r *Registry) Gather() ([]*dto.MetricFamily, error) {
+	r.scrapeMu.Lock()
+	defer r.scrapeMu.Unlock()
+
+	r.mu.Lock()
+	hooks := append([]func(){}, r.hooks...)
+	r.mu.Unlock()
+
+	for _, hook := range hooks {
+		hook()
+	}
+
+	return r.Registry.Gather()
+}
+
+// Handler serves registry in OpenMetrics format when scraper accepts it,
+// Prometheus text format otherwise
+// Отдает реестр в формате OpenMetrics или текстовом формате Prometheus
+func (r *Registry) Handler() http.Handler {
+	return promhttp.HandlerFor(r, promhttp.HandlerOpts{EnableOpenMetrics: true})
+}
+
+// DefaultBuckets are latency buckets in seconds
+// Корзины задержек в секундах по умолчанию
+var DefaultBuckets = []float64{0.001, 0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10}
+
+// NewCounterVec creates counter family in default registry, name includes
+// _total suffix
+// Создает семейство счетчиков в реестре по умолчанию, имя с суффиксом _total
+func NewCounterVec(name, help string, labelNames ...string) *CounterVec {
+	cv := prometheus.NewCounterVec(prometheus.CounterOpts{Name: name, Help: help}, labelNames)
+	Default.MustRegister(cv)
+	return cv
+}
+
+// NewGaugeVec creates gauge family in default registry
+// Создает семейство gauge в реестре по умолчанию
+func NewGaugeVec(name, help string, labelNames ...string) *GaugeVec {
+	gv := prometheus.NewGaugeVec(prometheus.GaugeOpts{Name: name, Help: help}, labelNames)
+	Default.MustRegister(gv)
+	return gv
+}
+
+// NewHistogramVec creates histogram family in default registry, buckets are
+// upper bounds without +Inf
+// Создает семейство гистограмм в реестре по умолчанию, корзины без +Inf
+func NewHistogramVec(name, help string, buckets []float64, labelNames ...string) *HistogramVec {
+	hv := prometheus.NewHistogramVec(prometheus.HistogramOpts{Name: name, Help: help, Buckets: buckets}, labelNames)
+	Default.MustRegister(hv)
+	return hv
+}
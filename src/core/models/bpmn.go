@@ -14,6 +14,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"time"
+
+	"atom-engine/src/core/clock"
 )
 
 // BPMNProcess represents a BPMN process stored in database
@@ -24,6 +26,7 @@ type BPMNProcess struct {
 	ProcessName    string `json:"process_name"`
 	Version        string `json:"version"`         // BPMN modeler version
 	ProcessVersion int    `json:"process_version"` // Our internal version (1, 2, 3...)
+	TenantID       string `json:"tenant_id,omitempty"`
 	ContentHash    string `json:"content_hash"`    // Hash of original content for change detection
 	IsExecutable   bool   `json:"is_executable"`
 
@@ -68,7 +71,7 @@ type BPMNFile struct {
 // NewBPMNProcess creates new BPMN process record
 // Создает новую запись BPMN процесса
 func NewBPMNProcess(processID, processName string) *BPMNProcess {
-	now := time.Now()
+	now := clock.Now()
 	return &BPMNProcess{
 		ProcessID:     processID,
 		ProcessName:   processName,
@@ -97,7 +100,7 @@ func (bp *BPMNProcess) FromJSON(data []byte) error {
 // Добавляет элемент в процесс
 func (bp *BPMNProcess) AddElement(elementID string, element interface{}) {
 	bp.Elements[elementID] = element
-	bp.UpdatedAt = time.Now()
+	bp.UpdatedAt = clock.Now()
 }
 
 // GetElement gets element by ID
@@ -121,11 +124,47 @@ func (bp *BPMNProcess) GetElementsByType(elementType string) []interface{} {
 	return elements
 }
 
+// GetProcessProperty returns value of zeebe:property declared in extension
+// elements of process element
+// Возвращает значение zeebe:property из элементов расширения процесса
+func (bp *BPMNProcess) GetProcessProperty(name string) (string, bool) {
+	element, exists := bp.GetElement(bp.ProcessID)
+	if !exists {
+		return "", false
+	}
+	elementMap, ok := element.(map[string]interface{})
+	if !ok || elementMap["type"] != "process" {
+		return "", false
+	}
+
+	extensionElements, _ := elementMap["extension_elements"].([]interface{})
+	for _, extensionElement := range extensionElements {
+		extensionMap, _ := extensionElement.(map[string]interface{})
+		extensions, _ := extensionMap["extensions"].([]interface{})
+		for _, extension := range extensions {
+			extMap, _ := extension.(map[string]interface{})
+			if extMap["type"] != "properties" {
+				continue
+			}
+			properties, _ := extMap["properties"].([]interface{})
+			for _, property := range properties {
+				propertyMap, _ := property.(map[string]interface{})
+				if propertyMap["name"] != name {
+					continue
+				}
+				value, _ := propertyMap["value"].(string)
+				return value, true
+			}
+		}
+	}
+	return "", false
+}
+
 // UpdateElementCount updates element count for specific type
 // Обновляет количество элементов для определенного типа
 func (bp *BPMNProcess) UpdateElementCount(elementType string, count int) {
 	bp.ElementCounts[elementType] = count
-	bp.UpdatedAt = time.Now()
+	bp.UpdatedAt = clock.Now()
 }
 
 // GetTotalElements returns total number of business elements only (excluding metadata and diagram elements)
@@ -183,14 +222,14 @@ func (bp *BPMNProcess) isBusinessElementType(elementType string) bool {
 // Устанавливает статус процесса
 func (bp *BPMNProcess) SetStatus(status string) {
 	bp.Status = status
-	bp.UpdatedAt = time.Now()
+	bp.UpdatedAt = clock.Now()
 }
 
 // AddMetadata adds metadata field
 // Добавляет поле метаданных
 func (bp *BPMNProcess) AddMetadata(key string, value interface{}) {
 	bp.Metadata[key] = value
-	bp.UpdatedAt = time.Now()
+	bp.UpdatedAt = clock.Now()
 }
 
 // GetMetadata gets metadata field
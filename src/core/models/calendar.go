@@ -0,0 +1,61 @@
+/*
+This file is part of the AtomBPMN (R) project.
+Copyright (c) 2025 Matreska Market LLC (ООО «Matreska Market»).
+Authors: Matreska Team.
+
+This project is dual-licensed under AGPL-3.0 and AtomBPMN Commercial License.
+*/
+
+package models
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// Business calendar sources
+// Источники бизнес-календарей
+const (
+	CalendarSourceConfig = "config"
+	CalendarSourceAPI    = "api"
+)
+
+// BusinessCalendar defines working time of named business calendar.
+// Time ranges are "HH:MM-HH:MM" in calendar time zone, "24:00" ends day.
+// Определяет рабочее время именованного бизнес-календаря
+type BusinessCalendar struct {
+	Name     string `json:"name"`
+	TimeZone string `json:"time_zone,omitempty"` // IANA zone, empty = UTC
+
+	// Working hours of every working day, empty = 09:00-18:00
+	// Рабочие часы каждого рабочего дня, пусто = 09:00-18:00
+	WorkingHours []string `json:"working_hours,omitempty"`
+
+	// Working weekdays (mon..sun), empty = mon-fri
+	// Рабочие дни недели (mon..sun), пусто = пн-пт
+	WorkingDays []string `json:"working_days,omitempty"`
+
+	// Per weekday hours overriding working days and hours, empty list = day off
+	// Часы по дням недели поверх рабочих дней и часов, пустой список = выходной
+	WeeklySchedule map[string][]string `json:"weekly_schedule,omitempty"`
+
+	// Non-working dates "2006-01-02", or "01-02" repeating every year
+	// Нерабочие даты "2006-01-02" или "01-02" повторяющиеся каждый год
+	Holidays []string `json:"holidays,omitempty"`
+
+	Source    string    `json:"source"` // config, api
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// ToJSON serializes business calendar to JSON
+// Сериализует бизнес-календарь в JSON
+func (bc *BusinessCalendar) ToJSON() ([]byte, error) {
+	return json.Marshal(bc)
+}
+
+// FromJSON deserializes business calendar from JSON
+// Десериализует бизнес-календарь из JSON
+func (bc *BusinessCalendar) FromJSON(data []byte) error {
+	return json.Unmarshal(data, bc)
+}
@@ -11,6 +11,8 @@ package models
 import (
 	"encoding/json"
 	"time"
+
+	"atom-engine/src/core/clock"
 )
 
 // GatewaySyncState tracks token synchronization state for parallel gateways
@@ -28,7 +30,7 @@ type GatewaySyncState struct {
 // NewGatewaySyncState creates new gateway synchronization state
 // Создает новое состояние синхронизации шлюза
 func NewGatewaySyncState(gatewayID, processInstanceID string, expectedCount int) *GatewaySyncState {
-	now := time.Now()
+	now := clock.Now()
 	return &GatewaySyncState{
 		ID:                 GenerateID(),
 		GatewayID:          gatewayID,
@@ -44,7 +46,7 @@ func NewGatewaySyncState(gatewayID, processInstanceID string, expectedCount int)
 // Добавляет пришедший токен в состояние синхронизации
 func (gss *GatewaySyncState) AddToken(tokenID string) {
 	gss.ArrivedTokens = append(gss.ArrivedTokens, tokenID)
-	gss.UpdatedAt = time.Now()
+	gss.UpdatedAt = clock.Now()
 }
 
 // IsComplete checks if all expected tokens have arrived
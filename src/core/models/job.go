@@ -10,6 +10,8 @@ package models
 
 import (
 	"time"
+
+	"atom-engine/src/core/clock"
 )
 
 // JobStatus represents job status
@@ -38,9 +40,11 @@ type Job struct {
 
 	// Related entities
 	ProcessInstanceID string `json:"process_instance_id"`
+	ProcessKey        string `json:"process_key,omitempty"` // Process definition that created this job
 	ElementID         string `json:"element_id"`
 	ElementInstanceID string `json:"element_instance_id"`
 	TokenID           string `json:"token_id"` // Token that created this job
+	TenantID          string `json:"tenant_id,omitempty"`
 
 	// Job data
 	CustomHeaders map[string]string      `json:"custom_headers"`
@@ -53,8 +57,9 @@ type Job struct {
 	CompletedAt *time.Time `json:"completed_at,omitempty"`
 
 	// Scheduling
-	ScheduledAt *time.Time `json:"scheduled_at,omitempty"`
-	Priority    int        `json:"priority"`
+	ScheduledAt  *time.Time `json:"scheduled_at,omitempty"` // Lease expiry when RUNNING, retry time when DEFERRED
+	LeaseTimerID string     `json:"lease_timer_id,omitempty"`
+	Priority     int        `json:"priority"`
 
 	// Metadata
 	ErrorMessage string            `json:"error_message,omitempty"`
@@ -63,7 +68,7 @@ type Job struct {
 
 // NewJob creates a new job
 func NewJob(jobType, processInstanceID, elementID string) *Job {
-	now := time.Now()
+	now := clock.Now()
 	return &Job{
 		ID:                GenerateID(),
 		Type:              jobType,
@@ -100,7 +105,7 @@ func (j *Job) CanRetry() bool {
 
 // MarkAsStarted marks job as started
 func (j *Job) MarkAsStarted(workerID string) {
-	now := time.Now()
+	now := clock.Now()
 	j.Status = JobStatusRunning
 	j.WorkerID = workerID
 	j.StartedAt = &now
@@ -109,7 +114,7 @@ func (j *Job) MarkAsStarted(workerID string) {
 
 // MarkAsCompleted marks job as completed
 func (j *Job) MarkAsCompleted() {
-	now := time.Now()
+	now := clock.Now()
 	j.Status = JobStatusCompleted
 	j.CompletedAt = &now
 	j.UpdatedAt = now
@@ -117,7 +122,7 @@ func (j *Job) MarkAsCompleted() {
 
 // MarkAsFailed marks job as failed
 func (j *Job) MarkAsFailed(errorMessage string) {
-	now := time.Now()
+	now := clock.Now()
 	j.Status = JobStatusFailed
 	j.ErrorMessage = errorMessage
 	j.Retries++
@@ -129,12 +134,12 @@ func (j *Job) MarkAsFailed(errorMessage string) {
 func (j *Job) MarkAsDeferred(scheduledAt time.Time) {
 	j.Status = JobStatusDeferred
 	j.ScheduledAt = &scheduledAt
-	j.UpdatedAt = time.Now()
+	j.UpdatedAt = clock.Now()
 }
 
 // MarkAsErrorThrown marks job as completed with BPMN error
 func (j *Job) MarkAsErrorThrown(errorCode, errorMessage string) {
-	now := time.Now()
+	now := clock.Now()
 	j.Status = JobStatusErrorThrown
 	j.ErrorMessage = errorMessage
 	j.CompletedAt = &now
@@ -10,6 +10,8 @@ package models
 
 import (
 	"time"
+
+	"atom-engine/src/core/clock"
 )
 
 // ProcessMessageSubscription represents process message subscription
@@ -60,12 +62,12 @@ func (bm *BufferedMessage) IsExpired() bool {
 	if bm.ExpiresAt == nil {
 		return false
 	}
-	return time.Now().After(*bm.ExpiresAt)
+	return clock.Now().After(*bm.ExpiresAt)
 }
 
 // NewProcessMessageSubscription creates new process message subscription
 func NewProcessMessageSubscription(tenantID, processKey, startEventID, messageName string) *ProcessMessageSubscription {
-	now := time.Now()
+	now := clock.Now()
 	return &ProcessMessageSubscription{
 		ID:                   GenerateID(),
 		TenantID:             tenantID,
@@ -84,7 +86,7 @@ func NewBufferedMessage(
 	variables map[string]interface{},
 	reason, elementID string,
 ) *BufferedMessage {
-	now := time.Now()
+	now := clock.Now()
 	return &BufferedMessage{
 		ID:             GenerateID(),
 		TenantID:       tenantID,
@@ -106,6 +108,6 @@ func NewMessageCorrelationResult(messageID, tenantID, messageName, correlationKe
 		TenantID:       tenantID,
 		MessageName:    messageName,
 		CorrelationKey: correlationKey,
-		CreatedAt:      time.Now(),
+		CreatedAt:      clock.Now(),
 	}
 }
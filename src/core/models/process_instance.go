@@ -11,6 +11,8 @@ package models
 import (
 	"encoding/json"
 	"time"
+
+	"atom-engine/src/core/clock"
 )
 
 // ProcessInstanceState defines state of process instance
@@ -34,6 +36,7 @@ type ProcessInstance struct {
 	ProcessName     string                 `json:"process_name"`    // Human readable name
 	ProcessVersion  int                    `json:"process_version"` // Version of process definition
 	ProcessKey      string                 `json:"process_key"`     // Unique process key (BPMN ID)
+	TenantID        string                 `json:"tenant_id,omitempty"`
 	State           ProcessInstanceState   `json:"state"`
 	Variables       map[string]interface{} `json:"variables"`        // Process variables
 	CurrentActivity string                 `json:"current_activity"` // Current active element ID
@@ -49,13 +52,16 @@ type ProcessInstance struct {
 // NewProcessInstance creates new process instance
 // Создает новый экземпляр процесса
 func NewProcessInstance(processID, processName string, processVersion int, processKey string) *ProcessInstance {
-	now := time.Now()
+	now := clock.Now()
+	// Tenant is encoded in tenant-scoped process storage key
+	tenantID, _ := SplitTenantScopedKey(processKey)
 	return &ProcessInstance{
 		InstanceID:     GenerateID(),
 		ProcessID:      processID,
 		ProcessName:    processName,
 		ProcessVersion: processVersion,
 		ProcessKey:     processKey,
+		TenantID:       tenantID,
 		State:          ProcessInstanceStateActive,
 		Variables:      make(map[string]interface{}),
 		Metadata:       make(map[string]interface{}),
@@ -83,7 +89,7 @@ func (pi *ProcessInstance) SetVariable(key string, value interface{}) {
 		pi.Variables = make(map[string]interface{})
 	}
 	pi.Variables[key] = value
-	pi.UpdatedAt = time.Now()
+	pi.UpdatedAt = clock.Now()
 }
 
 // GetVariable gets process variable
@@ -102,26 +108,26 @@ func (pi *ProcessInstance) SetVariables(variables map[string]interface{}) {
 	for key, value := range variables {
 		pi.Variables[key] = value
 	}
-	pi.UpdatedAt = time.Now()
+	pi.UpdatedAt = clock.Now()
 }
 
 // SetCurrentActivity sets current active element
 // Устанавливает текущий активный элемент
 func (pi *ProcessInstance) SetCurrentActivity(elementID string) {
 	pi.CurrentActivity = elementID
-	pi.UpdatedAt = time.Now()
+	pi.UpdatedAt = clock.Now()
 }
 
 // SetState sets process instance state
 // Устанавливает состояние экземпляра процесса
 func (pi *ProcessInstance) SetState(state ProcessInstanceState) {
 	pi.State = state
-	pi.UpdatedAt = time.Now()
+	pi.UpdatedAt = clock.Now()
 
 	if state == ProcessInstanceStateCompleted ||
 		state == ProcessInstanceStateCanceled ||
 		state == ProcessInstanceStateFailed {
-		now := time.Now()
+		now := clock.Now()
 		pi.CompletedAt = &now
 	}
 }
@@ -133,7 +139,7 @@ func (pi *ProcessInstance) AddMetadata(key string, value interface{}) {
 		pi.Metadata = make(map[string]interface{})
 	}
 	pi.Metadata[key] = value
-	pi.UpdatedAt = time.Now()
+	pi.UpdatedAt = clock.Now()
 }
 
 // GetMetadata gets metadata field
@@ -8,7 +8,11 @@ This project is dual-licensed under AGPL-3.0 and AtomBPMN Commercial License.
 
 package models
 
-import "time"
+import (
+	"time"
+
+	"atom-engine/src/core/clock"
+)
 
 // SystemEvent represents system lifecycle events
 // Представляет события жизненного цикла системы
@@ -29,6 +33,7 @@ const (
 	EventTypeBPMNParse  = "bpmn_parse"
 	EventTypeBPMNDelete = "bpmn_delete"
 	EventTypeError      = "error"
+	EventTypeJobExpired = "job_lease_expired"
 )
 
 // System event statuses
@@ -46,6 +51,6 @@ func NewSystemEvent(eventType, status, message string) *SystemEvent {
 		EventType: eventType,
 		Status:    status,
 		Message:   message,
-		CreatedAt: time.Now(),
+		CreatedAt: clock.Now(),
 	}
 }
@@ -0,0 +1,115 @@
+/*
+This file is part of the AtomBPMN (R) project.
+Copyright (c) 2025 Matreska Market LLC (ООО «Matreska Market»).
+Authors: Matreska Team.
+
+This project is dual-licensed under AGPL-3.0 and AtomBPMN Commercial License.
+*/
+
+package models
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+const (
+	// DefaultTenantID is tenant of resources created without tenant, keeps single tenant data valid
+	DefaultTenantID = ""
+	// DefaultTenantName names default tenant in tenant lists and output
+	DefaultTenantName = "<default>"
+	// AllTenants in caller tenant list grants every tenant
+	AllTenants = "*"
+	// TenantSeparator separates tenant from tenant-scoped storage key, BPMN IDs cannot contain it
+	TenantSeparator = "/"
+	// legacyDefaultTenantID was stamped on message subscriptions before tenants were supported
+	legacyDefaultTenantID = "DEFAULT_TENANT"
+	// maxTenantIDLength limits tenant ID used in storage keys and file paths
+	maxTenantIDLength = 64
+)
+
+var tenantIDPattern = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9_.-]*$`)
+
+// ValidateTenantID checks tenant ID is safe for storage keys and file paths
+// Проверяет, что ID арендатора допустим для ключей хранилища и путей файлов
+func ValidateTenantID(tenantID string) error {
+	if tenantID == DefaultTenantID {
+		return nil
+	}
+	if len(tenantID) > maxTenantIDLength || !tenantIDPattern.MatchString(tenantID) {
+		return fmt.Errorf("invalid tenant ID %q: letters, digits, '_', '.', '-' up to %d characters",
+			tenantID, maxTenantIDLength)
+	}
+	return nil
+}
+
+// NormalizeTenantID maps default tenant name and legacy default tenant marker to default tenant
+// Приводит имя и устаревший маркер арендатора по умолчанию к арендатору по умолчанию
+func NormalizeTenantID(tenantID string) string {
+	if tenantID == DefaultTenantName || tenantID == legacyDefaultTenantID {
+		return DefaultTenantID
+	}
+	return tenantID
+}
+
+// TenantLabel returns tenant ID for filters and output, DefaultTenantName for default tenant
+// Возвращает ID арендатора для фильтров и вывода
+func TenantLabel(tenantID string) string {
+	if tenantID == DefaultTenantID {
+		return DefaultTenantName
+	}
+	return tenantID
+}
+
+// TenantAllowed reports whether tenant is in list, nil list allows every tenant
+// Проверяет наличие арендатора в списке, nil список разрешает всех арендаторов
+func TenantAllowed(tenantIDs []string, tenantID string) bool {
+	if tenantIDs == nil {
+		return true
+	}
+	tenantID = NormalizeTenantID(tenantID)
+	for _, allowed := range tenantIDs {
+		if allowed == AllTenants || NormalizeTenantID(allowed) == tenantID {
+			return true
+		}
+	}
+	return false
+}
+
+// ParseTenantList splits comma-separated tenant list, DefaultTenantName stands for default tenant.
+// Returns nil for empty list.
+// Разбирает список арендаторов через запятую
+func ParseTenantList(list string) []string {
+	var tenantIDs []string
+	for _, tenantID := range strings.Split(list, ",") {
+		tenantID = strings.TrimSpace(tenantID)
+		switch tenantID {
+		case "":
+			continue
+		case DefaultTenantName:
+			tenantID = DefaultTenantID
+		}
+		tenantIDs = append(tenantIDs, tenantID)
+	}
+	return tenantIDs
+}
+
+// TenantScopedKey prefixes key built from user-chosen ID with tenant,
+// default tenant keys stay unprefixed
+// Добавляет арендатора к ключу из пользовательского ID
+func TenantScopedKey(tenantID, key string) string {
+	if tenantID == DefaultTenantID {
+		return key
+	}
+	return tenantID + TenantSeparator + key
+}
+
+// SplitTenantScopedKey returns tenant and key without tenant prefix
+// Возвращает арендатора и ключ без префикса арендатора
+func SplitTenantScopedKey(scopedKey string) (string, string) {
+	if tenantID, key, ok := strings.Cut(scopedKey, TenantSeparator); ok {
+		return tenantID, key
+	}
+	return DefaultTenantID, scopedKey
+}
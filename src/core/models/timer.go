@@ -17,9 +17,13 @@ import (
 type TimerType string
 
 const (
-	TimerTypeStart    TimerType = "START"    // Start event timer
-	TimerTypeBoundary TimerType = "BOUNDARY" // Boundary event timer
-	TimerTypeEvent    TimerType = "EVENT"    // Intermediate timer event
+	TimerTypeStart    TimerType = "START"     // Start event timer
+	TimerTypeBoundary TimerType = "BOUNDARY"  // Boundary event timer
+	TimerTypeEvent    TimerType = "EVENT"     // Intermediate timer event
+	TimerTypeJobRetry TimerType = "JOB_RETRY" // Deferred job retry, token_id carries job key
+	TimerTypeJobLease TimerType = "JOB_LEASE" // Activated job lease expiry, token_id carries job key
+
+	TimerTypeIncidentRetry TimerType = "INCIDENT_RETRY" // Automatic incident retry, token_id carries incident ID
 )
 
 // TimerState defines state of timer
@@ -58,4 +62,5 @@ type TimerProcessContext struct {
 	ProcessVersion  int    `json:"process_version"`  // Process version
 	ProcessName     string `json:"process_name"`     // Human readable name
 	ComponentSource string `json:"component_source"` // Component that created timer
+	TenantID        string `json:"tenant_id,omitempty"`
 }
@@ -11,6 +11,8 @@ package models
 import (
 	"encoding/json"
 	"time"
+
+	"atom-engine/src/core/clock"
 )
 
 // TokenState defines state of execution token
@@ -47,6 +49,7 @@ type Token struct {
 	TokenID           string                 `json:"token_id"`
 	ProcessInstanceID string                 `json:"process_instance_id"`
 	ProcessKey        string                 `json:"process_key"`
+	TenantID          string                 `json:"tenant_id,omitempty"`
 	CurrentElementID  string                 `json:"current_element_id"`
 	PreviousElementID string                 `json:"previous_element_id,omitempty"`
 	State             TokenState             `json:"state"`
@@ -82,11 +85,14 @@ type Token struct {
 // NewToken creates new execution token
 // Создает новый токен выполнения
 func NewToken(processInstanceID, processKey, elementID string) *Token {
-	now := time.Now()
+	now := clock.Now()
+	// Tenant is encoded in tenant-scoped process storage key
+	tenantID, _ := SplitTenantScopedKey(processKey)
 	return &Token{
 		TokenID:           GenerateID(),
 		ProcessInstanceID: processInstanceID,
 		ProcessKey:        processKey,
+		TenantID:          tenantID,
 		CurrentElementID:  elementID,
 		State:             TokenStateActive,
 		Type:              TokenTypeExecution,
@@ -132,19 +138,19 @@ func (t *Token) FromJSON(data []byte) error {
 func (t *Token) MoveTo(elementID string) {
 	t.PreviousElementID = t.CurrentElementID
 	t.CurrentElementID = elementID
-	t.UpdatedAt = time.Now()
+	t.UpdatedAt = clock.Now()
 }
 
 // SetState sets token state
 // Устанавливает состояние токена
 func (t *Token) SetState(state TokenState) {
 	t.State = state
-	t.UpdatedAt = time.Now()
+	t.UpdatedAt = clock.Now()
 
 	if state == TokenStateCompleted ||
 		state == TokenStateCanceled ||
 		state == TokenStateFailed {
-		now := time.Now()
+		now := clock.Now()
 		t.CompletedAt = &now
 	}
 }
@@ -156,7 +162,7 @@ func (t *Token) SetVariable(key string, value interface{}) {
 		t.Variables = make(map[string]interface{})
 	}
 	t.Variables[key] = value
-	t.UpdatedAt = time.Now()
+	t.UpdatedAt = clock.Now()
 }
 
 // GetVariable gets token variable
@@ -175,7 +181,7 @@ func (t *Token) SetVariables(variables map[string]interface{}) {
 	for key, value := range variables {
 		t.Variables[key] = value
 	}
-	t.UpdatedAt = time.Now()
+	t.UpdatedAt = clock.Now()
 }
 
 // MergeVariables merges variables from another source
@@ -187,7 +193,7 @@ func (t *Token) MergeVariables(variables map[string]interface{}) {
 	for key, value := range variables {
 		t.Variables[key] = value
 	}
-	t.UpdatedAt = time.Now()
+	t.UpdatedAt = clock.Now()
 }
 
 // SetExecutionContext sets execution context field
@@ -197,7 +203,7 @@ func (t *Token) SetExecutionContext(key string, value interface{}) {
 		t.ExecutionContext = make(map[string]interface{})
 	}
 	t.ExecutionContext[key] = value
-	t.UpdatedAt = time.Now()
+	t.UpdatedAt = clock.Now()
 }
 
 // GetExecutionContext gets execution context field
@@ -215,7 +221,7 @@ func (t *Token) GetExecutionContext(key string) (interface{}, bool) {
 func (t *Token) SetWaitingFor(waitingFor string) {
 	t.WaitingFor = waitingFor
 	t.State = TokenStateWaiting
-	t.UpdatedAt = time.Now()
+	t.UpdatedAt = clock.Now()
 }
 
 // ClearWaitingFor clears waiting state
@@ -225,14 +231,14 @@ func (t *Token) ClearWaitingFor() {
 	if t.State == TokenStateWaiting {
 		t.State = TokenStateActive
 	}
-	t.UpdatedAt = time.Now()
+	t.UpdatedAt = clock.Now()
 }
 
 // AddChildToken adds child token ID
 // Добавляет ID дочернего токена
 func (t *Token) AddChildToken(childTokenID string) {
 	t.ChildTokenIDs = append(t.ChildTokenIDs, childTokenID)
-	t.UpdatedAt = time.Now()
+	t.UpdatedAt = clock.Now()
 }
 
 // RemoveChildToken removes child token ID
@@ -244,7 +250,7 @@ func (t *Token) RemoveChildToken(childTokenID string) {
 			break
 		}
 	}
-	t.UpdatedAt = time.Now()
+	t.UpdatedAt = clock.Now()
 }
 
 // HasChildTokens checks if token has child tokens
@@ -257,7 +263,7 @@ func (t *Token) HasChildTokens() bool {
 // Добавляет ID boundary таймера к токену
 func (t *Token) AddBoundaryTimer(timerID string) {
 	t.BoundaryTimerIDs = append(t.BoundaryTimerIDs, timerID)
-	t.UpdatedAt = time.Now()
+	t.UpdatedAt = clock.Now()
 }
 
 // RemoveBoundaryTimer removes boundary timer ID from token
@@ -269,7 +275,7 @@ func (t *Token) RemoveBoundaryTimer(timerID string) {
 			break
 		}
 	}
-	t.UpdatedAt = time.Now()
+	t.UpdatedAt = clock.Now()
 }
 
 // HasBoundaryTimers checks if token has boundary timers
@@ -314,7 +320,7 @@ func (t *Token) SetTimerCallback() {
 // Очищает флаг timer callback
 func (t *Token) ClearTimerCallback() {
 	delete(t.ExecutionContext, ContextKeyTimerCallback)
-	t.UpdatedAt = time.Now()
+	t.UpdatedAt = clock.Now()
 }
 
 // IsFromTimerCallback checks if token execution is from timer callback
@@ -331,11 +337,12 @@ func (t *Token) IsFromTimerCallback() bool {
 // Clone creates a copy of token for parallel execution
 // Создает копию токена для параллельного выполнения
 func (t *Token) Clone() *Token {
-	now := time.Now()
+	now := clock.Now()
 	clone := &Token{
 		TokenID:           GenerateID(),
 		ProcessInstanceID: t.ProcessInstanceID,
 		ProcessKey:        t.ProcessKey,
+		TenantID:          t.TenantID,
 		CurrentElementID:  t.CurrentElementID,
 		PreviousElementID: t.PreviousElementID,
 		State:             t.State,
@@ -0,0 +1,269 @@
+/*
+This file is part of the AtomBPMN (R) project.
+Copyright (c) 2025 Matreska Market LLC (ООО «Matreska Market»).
+Authors: Matreska Team.
+
+This project is dual-licensed under AGPL-3.0 and AtomBPMN Commercial License.
+*/
+
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"atom-engine/src/core/auth"
+	"atom-engine/src/core/logger"
+	"atom-engine/src/core/restapi/middleware"
+	"atom-engine/src/core/restapi/models"
+	"atom-engine/src/core/restapi/utils"
+)
+
+// AuthHandler handles API key management HTTP requests
+type AuthHandler struct {
+	coreInterface AuthCoreInterface
+}
+
+// AuthCoreInterface defines methods needed for API key management
+type AuthCoreInterface interface {
+	GetAuthComponent() interface{}
+}
+
+// RotateAPIKeyRequest represents API key rotation request body
+type RotateAPIKeyRequest struct {
+	Overlap string `json:"overlap,omitempty"` // Validity of replaced key, empty = auth.rotation_overlap
+}
+
+// NewAuthHandler creates new API key management handler
+func NewAuthHandler(coreInterface AuthCoreInterface) *AuthHandler {
+	return &AuthHandler{
+		coreInterface: coreInterface,
+	}
+}
+
+// RegisterRoutes registers API key management routes
+func (h *AuthHandler) RegisterRoutes(router *gin.RouterGroup, authMiddleware *middleware.AuthMiddleware) {
+	keys := router.Group("/auth/keys")
+
+	// Apply auth middleware with required permissions
+	if authMiddleware != nil {
+		keys.Use(authMiddleware.RequirePermission("admin"))
+	}
+
+	{
+		keys.POST("", h.CreateAPIKey)
+		keys.GET("", h.ListAPIKeys)
+		keys.GET("/:id", h.GetAPIKey)
+		keys.POST("/:id/revoke", h.RevokeAPIKey)
+		keys.POST("/:id/rotate", h.RotateAPIKey)
+	}
+}
+
+// CreateAPIKey handles POST /api/v1/auth/keys
+// @Summary Create API key
+// @Description Issue managed API key stored as salted hash. Key secret is returned
+// @Description only in this response. Requires admin permission
+// @Tags auth
+// @Accept json
+// @Produce json
+// @Param request body auth.CreateAPIKeyRequest true "API key"
+// @Success 201 {object} models.APIResponse{data=auth.CreatedAPIKey}
+// @Failure 400 {object} models.APIResponse{error=models.APIError}
+// @Failure 401 {object} models.APIResponse{error=models.APIError}
+// @Failure 403 {object} models.APIResponse{error=models.APIError}
+// @Security ApiKeyAuth
+// @Router /api/v1/auth/keys [post]
+func (h *AuthHandler) CreateAPIKey(c *gin.Context) {
+	requestID := h.getRequestID(c)
+
+	var request auth.CreateAPIKeyRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		apiErr := models.BadRequestError("Invalid request body: " + err.Error())
+		c.JSON(http.StatusBadRequest, models.ErrorResponse(apiErr, requestID))
+		return
+	}
+
+	store, ok := h.getAPIKeyStore(c, requestID)
+	if !ok {
+		return
+	}
+
+	logger.Info("Creating API key",
+		logger.String("request_id", requestID),
+		logger.String("name", request.Name))
+
+	created, err := store.CreateAPIKey(&request)
+	if err != nil {
+		h.writeError(c, requestID, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, models.SuccessResponse(created, requestID))
+}
+
+// ListAPIKeys handles GET /api/v1/auth/keys
+// @Summary List API keys
+// @Description List managed keys newest first followed by config-defined keys, without secrets
+// @Tags auth
+// @Produce json
+// @Param all query bool false "Include revoked and expired keys"
+// @Success 200 {object} models.APIResponse{data=models.ListResponse}
+// @Failure 401 {object} models.APIResponse{error=models.APIError}
+// @Failure 403 {object} models.APIResponse{error=models.APIError}
+// @Security ApiKeyAuth
+// @Router /api/v1/auth/keys [get]
+func (h *AuthHandler) ListAPIKeys(c *gin.Context) {
+	requestID := h.getRequestID(c)
+
+	store, ok := h.getAPIKeyStore(c, requestID)
+	if !ok {
+		return
+	}
+
+	keys := store.ListAPIKeys(c.Query("all") == "true")
+	c.JSON(http.StatusOK, models.SuccessResponse(&models.ListResponse{
+		Items:      keys,
+		TotalCount: len(keys),
+	}, requestID))
+}
+
+// GetAPIKey handles GET /api/v1/auth/keys/:id
+// @Summary Get API key
+// @Description Get API key by ID without secret
+// @Tags auth
+// @Produce json
+// @Param id path string true "API key ID"
+// @Success 200 {object} models.APIResponse{data=auth.APIKeyInfo}
+// @Failure 404 {object} models.APIResponse{error=models.APIError}
+// @Security ApiKeyAuth
+// @Router /api/v1/auth/keys/{id} [get]
+func (h *AuthHandler) GetAPIKey(c *gin.Context) {
+	requestID := h.getRequestID(c)
+
+	store, ok := h.getAPIKeyStore(c, requestID)
+	if !ok {
+		return
+	}
+
+	key, err := store.GetAPIKey(c.Param("id"))
+	if err != nil {
+		h.writeError(c, requestID, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, models.SuccessResponse(key, requestID))
+}
+
+// RevokeAPIKey handles POST /api/v1/auth/keys/:id/revoke
+// @Summary Revoke API key
+// @Description Revoke managed API key immediately, config-defined keys cannot be revoked
+// @Tags auth
+// @Produce json
+// @Param id path string true "API key ID"
+// @Success 200 {object} models.APIResponse{data=auth.APIKeyInfo}
+// @Failure 404 {object} models.APIResponse{error=models.APIError}
+// @Failure 409 {object} models.APIResponse{error=models.APIError}
+// @Security ApiKeyAuth
+// @Router /api/v1/auth/keys/{id}/revoke [post]
+func (h *AuthHandler) RevokeAPIKey(c *gin.Context) {
+	requestID := h.getRequestID(c)
+
+	store, ok := h.getAPIKeyStore(c, requestID)
+	if !ok {
+		return
+	}
+
+	logger.Info("Revoking API key",
+		logger.String("request_id", requestID),
+		logger.String("key_id", c.Param("id")))
+
+	key, err := store.RevokeAPIKey(c.Param("id"))
+	if err != nil {
+		h.writeError(c, requestID, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, models.SuccessResponse(key, requestID))
+}
+
+// RotateAPIKey handles POST /api/v1/auth/keys/:id/rotate
+// @Summary Rotate API key
+// @Description Issue successor with same name, permissions and hosts. Replaced key stays
+// @Description valid for overlap window. Successor secret is returned only in this response
+// @Tags auth
+// @Accept json
+// @Produce json
+// @Param id path string true "API key ID"
+// @Param request body RotateAPIKeyRequest false "Rotation options"
+// @Success 201 {object} models.APIResponse{data=auth.CreatedAPIKey}
+// @Failure 400 {object} models.APIResponse{error=models.APIError}
+// @Failure 404 {object} models.APIResponse{error=models.APIError}
+// @Failure 409 {object} models.APIResponse{error=models.APIError}
+// @Security ApiKeyAuth
+// @Router /api/v1/auth/keys/{id}/rotate [post]
+func (h *AuthHandler) RotateAPIKey(c *gin.Context) {
+	requestID := h.getRequestID(c)
+
+	var request RotateAPIKeyRequest
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&request); err != nil {
+			apiErr := models.BadRequestError("Invalid request body: " + err.Error())
+			c.JSON(http.StatusBadRequest, models.ErrorResponse(apiErr, requestID))
+			return
+		}
+	}
+
+	store, ok := h.getAPIKeyStore(c, requestID)
+	if !ok {
+		return
+	}
+
+	logger.Info("Rotating API key",
+		logger.String("request_id", requestID),
+		logger.String("key_id", c.Param("id")),
+		logger.String("overlap", request.Overlap))
+
+	rotated, err := store.RotateAPIKey(c.Param("id"), request.Overlap)
+	if err != nil {
+		h.writeError(c, requestID, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, models.SuccessResponse(rotated, requestID))
+}
+
+// Helper methods
+
+func (h *AuthHandler) getAPIKeyStore(c *gin.Context, requestID string) (auth.APIKeyStore, bool) {
+	component, ok := h.coreInterface.GetAuthComponent().(auth.Component)
+	if !ok || component.GetAPIKeyStore() == nil {
+		apiErr := models.InternalServerError("Auth component not available")
+		c.JSON(http.StatusInternalServerError, models.ErrorResponse(apiErr, requestID))
+		return nil, false
+	}
+	return component.GetAPIKeyStore(), true
+}
+
+func (h *AuthHandler) writeError(c *gin.Context, requestID string, err error) {
+	var apiErr *models.APIError
+	switch {
+	case errors.Is(err, auth.ErrAPIKeyNotFound):
+		apiErr = models.NotFoundError(err.Error())
+	case errors.Is(err, auth.ErrAPIKeyInactive), errors.Is(err, auth.ErrConfigAPIKey):
+		apiErr = models.ConflictError(err.Error())
+	case errors.Is(err, auth.ErrInvalidAPIKeyRequest):
+		apiErr = models.BadRequestError(err.Error())
+	default:
+		apiErr = models.InternalServerError(err.Error())
+	}
+	c.JSON(models.HTTPStatusFromErrorCode(apiErr.Code), models.ErrorResponse(apiErr, requestID))
+}
+
+func (h *AuthHandler) getRequestID(c *gin.Context) string {
+	if requestID := c.GetHeader("X-Request-ID"); requestID != "" {
+		return requestID
+	}
+	return utils.GenerateSecureRequestID("auth")
+}
@@ -0,0 +1,256 @@
+/*
+This file is part of the AtomBPMN (R) project.
+Copyright (c) 2025 Matreska Market LLC (ООО «Matreska Market»).
+Authors: Matreska Team.
+
+This project is dual-licensed under AGPL-3.0 and AtomBPMN Commercial License.
+*/
+
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"atom-engine/src/core/auth"
+	"atom-engine/src/core/interfaces"
+	"atom-engine/src/core/logger"
+	coremodels "atom-engine/src/core/models"
+	"atom-engine/src/core/restapi/middleware"
+	"atom-engine/src/core/restapi/models"
+)
+
+// instanceLookup defines process instance lookup used to scope instance owned resources
+type instanceLookup interface {
+	GetProcessComponent() interfaces.ProcessComponentInterface
+}
+
+// authorizeRequest checks action on resource ID when authentication is enabled,
+// writes 403 response and returns false when denied. Empty resource ID stands
+// for all resources and also requires access to all tenants.
+func authorizeRequest(c *gin.Context, requestID, action, resource, resourceID string) bool {
+	result, ok := middleware.GetAuthResult(c)
+	if !ok || (result.Authorize(action, resource, resourceID) && (resourceID != "" || result.AllTenants())) {
+		return true
+	}
+	return denyResource(c, requestID, result, action, resource, resourceID)
+}
+
+// authorizeTenantRequest checks action on resource ID owned by tenant when authentication is enabled
+func authorizeTenantRequest(c *gin.Context, requestID, action, resource, tenantID, resourceID string) bool {
+	if !authorizeTenant(c, requestID, tenantID) {
+		return false
+	}
+	result, ok := middleware.GetAuthResult(c)
+	if !ok || result.Authorize(action, resource, resourceID) {
+		return true
+	}
+	return denyResource(c, requestID, result, action, resource, resourceID)
+}
+
+// authorizeTenant checks caller may access data of tenant, writes 403 response when denied
+func authorizeTenant(c *gin.Context, requestID, tenantID string) bool {
+	result, ok := middleware.GetAuthResult(c)
+	if !ok || result.AllowsTenant(tenantID) {
+		return true
+	}
+
+	logger.Warn("Tenant access denied",
+		logger.String("request_id", requestID),
+		logger.String("path", c.Request.URL.Path),
+		logger.String("tenant_id", tenantID),
+		logger.String("api_key_name", result.APIKeyName))
+
+	apiErr := models.ForbiddenError(fmt.Sprintf("Access to tenant %q denied", tenantID))
+	c.JSON(http.StatusForbidden, models.ErrorResponse(apiErr, requestID))
+	return false
+}
+
+// resolveTenantRequest validates requested tenant of write operation and checks caller may use it.
+// Empty request resolves to the only tenant of restricted caller or to the default tenant.
+func resolveTenantRequest(c *gin.Context, requestID, requested string) (string, bool) {
+	if err := coremodels.ValidateTenantID(coremodels.NormalizeTenantID(requested)); err != nil {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse(models.BadRequestError(err.Error()), requestID))
+		return "", false
+	}
+	result, ok := middleware.GetAuthResult(c)
+	if !ok {
+		return coremodels.NormalizeTenantID(requested), true
+	}
+	tenantID, _ := result.ResolveTenant(requested)
+	return tenantID, authorizeTenant(c, requestID, tenantID)
+}
+
+// requireAdmin checks caller holds admin even when authentication is disabled,
+// for operations no anonymous caller may run. Writes 403 response when denied.
+func requireAdmin(c *gin.Context, requestID string) bool {
+	result, ok := middleware.GetAuthResult(c)
+	if ok && result.Authorize(auth.ActionAll, auth.PermissionAdmin, "") {
+		return true
+	}
+
+	logger.Warn("Admin access denied",
+		logger.String("request_id", requestID),
+		logger.String("path", c.Request.URL.Path),
+		logger.Bool("authenticated", ok))
+
+	apiErr := models.ForbiddenError("Operation requires authenticated caller with admin permission")
+	c.JSON(http.StatusForbidden, models.ErrorResponse(apiErr, requestID))
+	return false
+}
+
+// denyResource logs denied resource access and writes 403 response
+func denyResource(c *gin.Context, requestID string, result *auth.AuthResult, action, resource, resourceID string) bool {
+	logger.Warn("Resource access denied",
+		logger.String("request_id", requestID),
+		logger.String("path", c.Request.URL.Path),
+		logger.String("action", action),
+		logger.String("resource", resource),
+		logger.String("resource_id", resourceID),
+		logger.String("api_key_name", result.APIKeyName))
+
+	apiErr := models.ForbiddenError(fmt.Sprintf("Insufficient permissions to %s %s %s", action, resource, resourceID))
+	c.JSON(http.StatusForbidden, models.ErrorResponse(apiErr, requestID))
+	return false
+}
+
+// authorizeInstanceRequest checks action on resource owned by process instance,
+// resource ID is BPMN process ID of instance, tenant is tenant of instance
+func authorizeInstanceRequest(
+	c *gin.Context,
+	requestID string,
+	core interface{},
+	action, resource, instanceID string,
+) bool {
+	result, ok := middleware.GetAuthResult(c)
+	if !ok || (result.Authorize(action, resource, "") && result.AllTenants()) {
+		return true
+	}
+	processID, tenantID := lookupInstance(core, instanceID)
+	return authorizeTenantRequest(c, requestID, action, resource, tenantID, processID)
+}
+
+// authorizeOwnedRequest checks action on resource stamped with tenant and owned by process instance,
+// instance may be empty for resources created outside of processes
+func authorizeOwnedRequest(
+	c *gin.Context,
+	requestID string,
+	core interface{},
+	action, resource, tenantID, instanceID string,
+) bool {
+	if !authorizeTenant(c, requestID, tenantID) {
+		return false
+	}
+	result, ok := middleware.GetAuthResult(c)
+	if !ok || result.Authorize(action, resource, "") {
+		return true
+	}
+	processID, _ := lookupInstance(core, instanceID)
+	return authorizeTenantRequest(c, requestID, action, resource, tenantID, processID)
+}
+
+// listFilter selects list items caller may see
+type listFilter struct {
+	result       *auth.AuthResult
+	core         interface{}
+	action       string
+	resource     string
+	unrestricted bool
+	instances    map[string]instanceOwner
+}
+
+// instanceOwner caches BPMN process ID and tenant of process instance
+type instanceOwner struct {
+	processID string
+	tenantID  string
+}
+
+// newListFilter returns filter for list results, nil when every item is allowed
+func newListFilter(c *gin.Context, core interface{}, action, resource string) *listFilter {
+	result, ok := middleware.GetAuthResult(c)
+	if !ok {
+		return nil
+	}
+	unrestricted := result.Authorize(action, resource, "")
+	if unrestricted && result.AllTenants() {
+		return nil
+	}
+	return &listFilter{
+		result:       result,
+		core:         core,
+		action:       action,
+		resource:     resource,
+		unrestricted: unrestricted,
+		instances:    make(map[string]instanceOwner),
+	}
+}
+
+// Allows checks item shared by all tenants, such as worker, by resource ID
+func (f *listFilter) Allows(resourceID string) bool {
+	return f == nil || f.unrestricted || f.result.Authorize(f.action, f.resource, resourceID)
+}
+
+// AllowsIn checks item by tenant and resource ID
+func (f *listFilter) AllowsIn(tenantID, resourceID string) bool {
+	if f == nil {
+		return true
+	}
+	return f.result.AllowsTenant(tenantID) && f.Allows(resourceID)
+}
+
+// AllowsInstance checks item owned by process instance
+func (f *listFilter) AllowsInstance(instanceID string) bool {
+	if f == nil {
+		return true
+	}
+	owner := f.owner(instanceID)
+	return f.AllowsIn(owner.tenantID, owner.processID)
+}
+
+// AllowsOwned checks item stamped with tenant and owned by process instance
+func (f *listFilter) AllowsOwned(tenantID, instanceID string) bool {
+	if f == nil {
+		return true
+	}
+	if !f.result.AllowsTenant(tenantID) {
+		return false
+	}
+	return f.unrestricted || f.Allows(f.owner(instanceID).processID)
+}
+
+// owner returns cached BPMN process ID and tenant of instance
+func (f *listFilter) owner(instanceID string) instanceOwner {
+	owner, ok := f.instances[instanceID]
+	if !ok {
+		owner.processID, owner.tenantID = lookupInstance(f.core, instanceID)
+		f.instances[instanceID] = owner
+	}
+	return owner
+}
+
+// lookupInstance returns BPMN process ID and tenant of instance, empty when unknown
+func lookupInstance(core interface{}, instanceID string) (string, string) {
+	lookup, ok := core.(instanceLookup)
+	if !ok || instanceID == "" {
+		return "", coremodels.DefaultTenantID
+	}
+	processComp := lookup.GetProcessComponent()
+	if processComp == nil {
+		return "", coremodels.DefaultTenantID
+	}
+	instance, err := processComp.GetProcessInstanceStatus(instanceID)
+	if err != nil || instance == nil {
+		return "", coremodels.DefaultTenantID
+	}
+	return instance.ProcessID, instance.TenantID
+}
+
+// processIDFromKey strips tenant prefix and version suffix from "processID:version" start key
+func processIDFromKey(processKey string) string {
+	_, processKey = coremodels.SplitTenantScopedKey(processKey)
+	processID, _, _ := strings.Cut(processKey, ":")
+	return processID
+}
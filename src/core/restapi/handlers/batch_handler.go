@@ -0,0 +1,365 @@
+/*
+This file is part of the AtomBPMN (R) project.
+Copyright (c) 2025 Matreska Market LLC (ООО «Matreska Market»).
+Authors: Matreska Team.
+
+This project is dual-licensed under AGPL-3.0 and AtomBPMN Commercial License.
+*/
+
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"atom-engine/src/batch"
+	"atom-engine/src/core/auth"
+	"atom-engine/src/core/logger"
+	coremodels "atom-engine/src/core/models"
+	"atom-engine/src/core/restapi/middleware"
+	"atom-engine/src/core/restapi/models"
+	"atom-engine/src/core/restapi/utils"
+)
+
+// BatchHandler handles batch operation HTTP requests
+type BatchHandler struct {
+	coreInterface BatchCoreInterface
+	converter     *utils.Converter
+}
+
+// BatchCoreInterface defines methods needed for batch operations
+type BatchCoreInterface interface {
+	GetBatchComponent() interface{}
+}
+
+// BatchComponentInterface defines batch operations component methods
+type BatchComponentInterface interface {
+	Create(ctx context.Context, request *batch.CreateRequest) (*batch.Batch, error)
+	Get(batchID string) (*batch.Batch, error)
+	List(filter *batch.ListFilter) []*batch.Batch
+	Pause(batchID string) (*batch.Batch, error)
+	Resume(batchID string) (*batch.Batch, error)
+	Cancel(batchID string) (*batch.Batch, error)
+	Delete(batchID string) error
+}
+
+// NewBatchHandler creates new batch operations handler
+func NewBatchHandler(coreInterface BatchCoreInterface) *BatchHandler {
+	return &BatchHandler{
+		coreInterface: coreInterface,
+		converter:     utils.NewConverter(),
+	}
+}
+
+// RegisterRoutes registers batch operation routes
+func (h *BatchHandler) RegisterRoutes(router *gin.RouterGroup, authMiddleware *middleware.AuthMiddleware) {
+	batches := router.Group("/batches")
+
+	// Apply auth middleware with required permissions, changes require admin
+	if authMiddleware != nil {
+		batches.Use(authMiddleware.RequirePermission("process"))
+	}
+
+	{
+		batches.GET("", h.ListBatches)
+		batches.GET("/:id", h.GetBatch)
+		if authMiddleware != nil {
+			admin := authMiddleware.RequirePermission("admin")
+			batches.POST("", admin, h.CreateBatch)
+			batches.POST("/:id/pause", admin, h.PauseBatch)
+			batches.POST("/:id/resume", admin, h.ResumeBatch)
+			batches.POST("/:id/cancel", admin, h.CancelBatch)
+			batches.DELETE("/:id", admin, h.DeleteBatch)
+		} else {
+			batches.POST("", h.CreateBatch)
+			batches.POST("/:id/pause", h.PauseBatch)
+			batches.POST("/:id/resume", h.ResumeBatch)
+			batches.POST("/:id/cancel", h.CancelBatch)
+			batches.DELETE("/:id", h.DeleteBatch)
+		}
+	}
+}
+
+// CreateBatch handles POST /api/v1/batches
+// @Summary Create batch operation
+// @Description Select process instances, or their open incidents, by filter and execute
+// @Description operation asynchronously in chunks. Requires admin permission
+// @Tags batches
+// @Accept json
+// @Produce json
+// @Param request body batch.CreateRequest true "Batch operation"
+// @Success 202 {object} models.APIResponse{data=batch.Batch}
+// @Failure 400 {object} models.APIResponse{error=models.APIError}
+// @Failure 401 {object} models.APIResponse{error=models.APIError}
+// @Failure 403 {object} models.APIResponse{error=models.APIError}
+// @Failure 500 {object} models.APIResponse{error=models.APIError}
+// @Security ApiKeyAuth
+// @Router /api/v1/batches [post]
+func (h *BatchHandler) CreateBatch(c *gin.Context) {
+	requestID := h.getRequestID(c)
+
+	var request batch.CreateRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		apiErr := models.BadRequestError("Invalid request body: " + err.Error())
+		c.JSON(http.StatusBadRequest, models.ErrorResponse(apiErr, requestID))
+		return
+	}
+
+	if authResult, ok := middleware.GetAuthResult(c); ok && authResult != nil {
+		request.CreatedBy = authResult.APIKeyName
+	}
+	if !scopeBatchTenant(c, requestID, &request.Filter) {
+		return
+	}
+
+	batchComp, ok := h.getBatchComponent(c, requestID)
+	if !ok {
+		return
+	}
+
+	logger.Info("Creating batch operation",
+		logger.String("request_id", requestID),
+		logger.String("type", string(request.Type)))
+
+	created, err := batchComp.Create(c.Request.Context(), &request)
+	if err != nil {
+		h.writeError(c, requestID, err)
+		return
+	}
+
+	c.JSON(http.StatusAccepted, models.SuccessResponse(created, requestID))
+}
+
+// ListBatches handles GET /api/v1/batches
+// @Summary List batch operations
+// @Description List batch operations newest first, without item failures
+// @Tags batches
+// @Produce json
+// @Param type query string false "Operation type: CANCEL, RESOLVE, RETRY, MIGRATE, MODIFY"
+// @Param state query string false "State: PENDING, RUNNING, PAUSED, COMPLETED, CANCELED, FAILED"
+// @Success 200 {object} models.APIResponse{data=models.ListResponse}
+// @Failure 400 {object} models.APIResponse{error=models.APIError}
+// @Failure 401 {object} models.APIResponse{error=models.APIError}
+// @Failure 403 {object} models.APIResponse{error=models.APIError}
+// @Security ApiKeyAuth
+// @Router /api/v1/batches [get]
+func (h *BatchHandler) ListBatches(c *gin.Context) {
+	requestID := h.getRequestID(c)
+
+	filter := &batch.ListFilter{State: batch.State(strings.ToUpper(c.Query("state")))}
+	if operationType := c.Query("type"); operationType != "" {
+		operation, err := batch.ParseOperationType(operationType)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, models.ErrorResponse(models.BadRequestError(err.Error()), requestID))
+			return
+		}
+		filter.Type = operation
+	}
+
+	batchComp, ok := h.getBatchComponent(c, requestID)
+	if !ok {
+		return
+	}
+
+	batches := batchComp.List(filter)
+	if readFilter := newListFilter(c, nil, auth.ActionRead, auth.PermissionProcess); readFilter != nil {
+		visible := make([]*batch.Batch, 0, len(batches))
+		for _, item := range batches {
+			if readFilter.AllowsIn(batchTenant(item), processIDFromKey(item.Filter.ProcessKey)) {
+				visible = append(visible, item)
+			}
+		}
+		batches = visible
+	}
+	c.JSON(http.StatusOK, models.SuccessResponse(&models.ListResponse{
+		Items:      batches,
+		TotalCount: len(batches),
+	}, requestID))
+}
+
+// GetBatch handles GET /api/v1/batches/:id
+// @Summary Get batch operation
+// @Description Get batch operation with progress and item failures
+// @Tags batches
+// @Produce json
+// @Param id path string true "Batch operation ID"
+// @Success 200 {object} models.APIResponse{data=batch.Batch}
+// @Failure 401 {object} models.APIResponse{error=models.APIError}
+// @Failure 403 {object} models.APIResponse{error=models.APIError}
+// @Failure 404 {object} models.APIResponse{error=models.APIError}
+// @Security ApiKeyAuth
+// @Router /api/v1/batches/{id} [get]
+func (h *BatchHandler) GetBatch(c *gin.Context) {
+	requestID := h.getRequestID(c)
+
+	batchComp, ok := h.getBatchComponent(c, requestID)
+	if !ok {
+		return
+	}
+
+	found, err := batchComp.Get(c.Param("id"))
+	if err != nil {
+		h.writeError(c, requestID, err)
+		return
+	}
+
+	// Batches are scoped by tenant and process of their filter
+	if !authorizeTenantRequest(
+		c, requestID, auth.ActionRead, auth.PermissionProcess,
+		batchTenant(found), processIDFromKey(found.Filter.ProcessKey),
+	) {
+		return
+	}
+
+	c.JSON(http.StatusOK, models.SuccessResponse(found, requestID))
+}
+
+// PauseBatch handles POST /api/v1/batches/:id/pause
+// @Summary Pause batch operation
+// @Description Pause batch operation after current item, requires admin permission
+// @Tags batches
+// @Produce json
+// @Param id path string true "Batch operation ID"
+// @Success 200 {object} models.APIResponse{data=batch.Batch}
+// @Failure 404 {object} models.APIResponse{error=models.APIError}
+// @Failure 409 {object} models.APIResponse{error=models.APIError}
+// @Security ApiKeyAuth
+// @Router /api/v1/batches/{id}/pause [post]
+func (h *BatchHandler) PauseBatch(c *gin.Context) {
+	h.changeState(c, BatchComponentInterface.Pause)
+}
+
+// ResumeBatch handles POST /api/v1/batches/:id/resume
+// @Summary Resume batch operation
+// @Description Resume paused batch operation from saved progress, requires admin permission
+// @Tags batches
+// @Produce json
+// @Param id path string true "Batch operation ID"
+// @Success 200 {object} models.APIResponse{data=batch.Batch}
+// @Failure 404 {object} models.APIResponse{error=models.APIError}
+// @Failure 409 {object} models.APIResponse{error=models.APIError}
+// @Security ApiKeyAuth
+// @Router /api/v1/batches/{id}/resume [post]
+func (h *BatchHandler) ResumeBatch(c *gin.Context) {
+	h.changeState(c, BatchComponentInterface.Resume)
+}
+
+// CancelBatch handles POST /api/v1/batches/:id/cancel
+// @Summary Cancel batch operation
+// @Description Cancel batch operation after current item, remaining items are skipped.
+// @Description Requires admin permission
+// @Tags batches
+// @Produce json
+// @Param id path string true "Batch operation ID"
+// @Success 200 {object} models.APIResponse{data=batch.Batch}
+// @Failure 404 {object} models.APIResponse{error=models.APIError}
+// @Failure 409 {object} models.APIResponse{error=models.APIError}
+// @Security ApiKeyAuth
+// @Router /api/v1/batches/{id}/cancel [post]
+func (h *BatchHandler) CancelBatch(c *gin.Context) {
+	h.changeState(c, BatchComponentInterface.Cancel)
+}
+
+// DeleteBatch handles DELETE /api/v1/batches/:id
+// @Summary Delete batch operation
+// @Description Delete finished batch operation, requires admin permission
+// @Tags batches
+// @Produce json
+// @Param id path string true "Batch operation ID"
+// @Success 200 {object} models.APIResponse{data=models.DeleteResponse}
+// @Failure 404 {object} models.APIResponse{error=models.APIError}
+// @Failure 409 {object} models.APIResponse{error=models.APIError}
+// @Security ApiKeyAuth
+// @Router /api/v1/batches/{id} [delete]
+func (h *BatchHandler) DeleteBatch(c *gin.Context) {
+	requestID := h.getRequestID(c)
+	batchID := c.Param("id")
+
+	batchComp, ok := h.getBatchComponent(c, requestID)
+	if !ok {
+		return
+	}
+
+	if err := batchComp.Delete(batchID); err != nil {
+		h.writeError(c, requestID, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, models.SuccessResponse(&models.DeleteResponse{
+		ID:      batchID,
+		Message: "Batch operation deleted",
+	}, requestID))
+}
+
+// Helper methods
+
+func (h *BatchHandler) changeState(
+	c *gin.Context,
+	change func(BatchComponentInterface, string) (*batch.Batch, error),
+) {
+	requestID := h.getRequestID(c)
+
+	batchComp, ok := h.getBatchComponent(c, requestID)
+	if !ok {
+		return
+	}
+
+	updated, err := change(batchComp, c.Param("id"))
+	if err != nil {
+		h.writeError(c, requestID, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, models.SuccessResponse(updated, requestID))
+}
+
+// scopeBatchTenant checks tenant of batch filter, batch of tenant restricted caller
+// without tenant is limited to its tenant instead of spanning every tenant
+func scopeBatchTenant(c *gin.Context, requestID string, filter *batch.Filter) bool {
+	if tenantID, scoped := filter.Tenant(); scoped {
+		return authorizeTenant(c, requestID, tenantID)
+	}
+	result, ok := middleware.GetAuthResult(c)
+	if !ok || result.AllTenants() {
+		return true
+	}
+	tenantID, allowed := resolveTenantRequest(c, requestID, "")
+	if allowed {
+		filter.TenantID = coremodels.TenantLabel(tenantID)
+	}
+	return allowed
+}
+
+// batchTenant returns tenant batch is scoped to, AllTenants for batch spanning every tenant
+func batchTenant(b *batch.Batch) string {
+	if tenantID, scoped := b.Filter.Tenant(); scoped {
+		return tenantID
+	}
+	return coremodels.AllTenants
+}
+
+func (h *BatchHandler) getBatchComponent(c *gin.Context, requestID string) (BatchComponentInterface, bool) {
+	batchComp, ok := h.coreInterface.GetBatchComponent().(BatchComponentInterface)
+	if !ok {
+		apiErr := models.InternalServerError("Batch component not available")
+		c.JSON(http.StatusInternalServerError, models.ErrorResponse(apiErr, requestID))
+		return nil, false
+	}
+	return batchComp, true
+}
+
+func (h *BatchHandler) writeError(c *gin.Context, requestID string, err error) {
+	apiErr := h.converter.GRPCErrorToAPIError(err)
+	statusCode := models.HTTPStatusFromErrorCode(apiErr.Code)
+	c.JSON(statusCode, models.ErrorResponse(apiErr, requestID))
+}
+
+func (h *BatchHandler) getRequestID(c *gin.Context) string {
+	if requestID := c.GetHeader("X-Request-ID"); requestID != "" {
+		return requestID
+	}
+	return utils.GenerateSecureRequestID("batch")
+}
@@ -0,0 +1,323 @@
+/*
+This file is part of the AtomBPMN (R) project.
+Copyright (c) 2025 Matreska Market LLC (ООО «Matreska Market»).
+Authors: Matreska Team.
+
+This project is dual-licensed under AGPL-3.0 and AtomBPMN Commercial License.
+*/
+
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+
+	"atom-engine/src/core/auth"
+	"atom-engine/src/core/events"
+	"atom-engine/src/core/logger"
+	"atom-engine/src/core/restapi/middleware"
+	"atom-engine/src/core/restapi/models"
+	"atom-engine/src/core/restapi/utils"
+)
+
+// eventsWriteTimeout bounds single write to stream client
+const eventsWriteTimeout = 10 * time.Second
+
+// EventsHandler handles engine event stream HTTP requests.
+// Events carry their tenant and process, so no core access is needed.
+type EventsHandler struct {
+	upgrader websocket.Upgrader
+}
+
+// NewEventsHandler creates new event stream handler
+func NewEventsHandler() *EventsHandler {
+	return &EventsHandler{
+		// Default origin check accepts same origin and non-browser clients only
+		upgrader: websocket.Upgrader{
+			ReadBufferSize:  1024,
+			WriteBufferSize: 4096,
+		},
+	}
+}
+
+// RegisterRoutes registers event stream routes
+func (h *EventsHandler) RegisterRoutes(router *gin.RouterGroup, authMiddleware *middleware.AuthMiddleware) {
+	eventsGroup := router.Group("/events")
+
+	// Events of other resource types are filtered per event by caller permissions
+	if authMiddleware != nil {
+		eventsGroup.Use(authMiddleware.RequirePermission("process"))
+	}
+
+	{
+		eventsGroup.GET("/stream", h.StreamEvents)
+		eventsGroup.GET("/ws", h.StreamEventsWebSocket)
+	}
+}
+
+// StreamEvents handles GET /api/v1/events/stream
+// @Summary Stream engine events
+// @Description Push engine events as server-sent events. Each event carries its sequence as SSE id,
+// @Description reconnecting clients resume by Last-Event-ID header or from_sequence
+// @Tags events
+// @Produce text/event-stream
+// @Param process_key query string false "BPMN process ID or process definition key"
+// @Param instance_id query string false "Process instance ID"
+// @Param type query string false "Comma separated event types or categories"
+// @Param tenant_id query string false "Tenant ID, <default> selects default tenant"
+// @Param from_sequence query int false "Replay retained events starting with this sequence"
+// @Success 200 {object} events.Event
+// @Failure 400 {object} models.APIResponse{error=models.APIError}
+// @Failure 401 {object} models.APIResponse{error=models.APIError}
+// @Failure 403 {object} models.APIResponse{error=models.APIError}
+// @Failure 410 {object} models.APIResponse{error=models.APIError}
+// @Security ApiKeyAuth
+// @Router /api/v1/events/stream [get]
+func (h *EventsHandler) StreamEvents(c *gin.Context) {
+	requestID := h.getRequestID(c)
+
+	subscription, ok := h.subscribe(c, requestID, c.GetHeader("Last-Event-ID"))
+	if !ok {
+		return
+	}
+	defer subscription.Close()
+
+	// Stream outlives server timeouts, every write sets own deadline
+	controller := http.NewResponseController(c.Writer)
+	_ = controller.SetReadDeadline(time.Time{})
+	header := c.Writer.Header()
+	header.Set("Content-Type", "text/event-stream")
+	header.Set("Cache-Control", "no-cache")
+	header.Set("Connection", "keep-alive")
+	header.Set("X-Accel-Buffering", "no")
+	c.Status(http.StatusOK)
+
+	access := newEventAccess(c)
+	ctx := c.Request.Context()
+	frame := []byte(": connected\n\n")
+	for {
+		if frame != nil {
+			_ = controller.SetWriteDeadline(time.Now().Add(eventsWriteTimeout))
+			if _, err := c.Writer.Write(frame); err != nil {
+				return
+			}
+			c.Writer.Flush()
+		}
+
+		event, err := subscription.Next(ctx, events.HeartbeatInterval())
+		switch {
+		case err != nil:
+			if ctx.Err() == nil {
+				data, _ := json.Marshal(gin.H{"error": err.Error()})
+				_ = controller.SetWriteDeadline(time.Now().Add(eventsWriteTimeout))
+				_, _ = fmt.Fprintf(c.Writer, "event: error\ndata: %s\n\n", data)
+				c.Writer.Flush()
+			}
+			return
+		case event == nil:
+			frame = []byte(": heartbeat\n\n")
+		case !access.Allows(event):
+			frame = nil
+		default:
+			data, err := json.Marshal(event)
+			if err != nil {
+				frame = nil
+				continue
+			}
+			frame = []byte(fmt.Sprintf("id: %d\nevent: %s\ndata: %s\n\n", event.Sequence, event.Type, data))
+		}
+	}
+}
+
+// StreamEventsWebSocket handles GET /api/v1/events/ws
+// @Summary Stream engine events over WebSocket
+// @Description Push engine events as JSON text messages over WebSocket. Server pings idle connection,
+// @Description stream end is reported by close frame with reason
+// @Tags events
+// @Param process_key query string false "BPMN process ID or process definition key"
+// @Param instance_id query string false "Process instance ID"
+// @Param type query string false "Comma separated event types or categories"
+// @Param tenant_id query string false "Tenant ID, <default> selects default tenant"
+// @Param from_sequence query int false "Replay retained events starting with this sequence"
+// @Success 101 "Switching Protocols"
+// @Failure 400 {object} models.APIResponse{error=models.APIError}
+// @Failure 401 {object} models.APIResponse{error=models.APIError}
+// @Failure 403 {object} models.APIResponse{error=models.APIError}
+// @Failure 410 {object} models.APIResponse{error=models.APIError}
+// @Security ApiKeyAuth
+// @Router /api/v1/events/ws [get]
+func (h *EventsHandler) StreamEventsWebSocket(c *gin.Context) {
+	requestID := h.getRequestID(c)
+
+	if !websocket.IsWebSocketUpgrade(c.Request) {
+		apiErr := models.BadRequestError("WebSocket upgrade required")
+		c.JSON(http.StatusBadRequest, models.ErrorResponse(apiErr, requestID))
+		return
+	}
+
+	subscription, ok := h.subscribe(c, requestID, "")
+	if !ok {
+		return
+	}
+	defer subscription.Close()
+
+	conn, err := h.upgrader.Upgrade(c.Writer, c.Request, nil)
+	if err != nil {
+		// Upgrader has already written error response
+		logger.Warn("WebSocket upgrade failed",
+			logger.String("request_id", requestID),
+			logger.String("error", err.Error()))
+		return
+	}
+	defer conn.Close()
+
+	// Hijacked connection keeps deadlines of HTTP server
+	_ = conn.NetConn().SetDeadline(time.Time{})
+
+	// Reader handles control frames and ends stream when client goes away
+	ctx, cancel := context.WithCancel(c.Request.Context())
+	defer cancel()
+	go func() {
+		defer cancel()
+		for {
+			if _, _, err := conn.NextReader(); err != nil {
+				return
+			}
+		}
+	}()
+
+	access := newEventAccess(c)
+	for {
+		event, err := subscription.Next(ctx, events.HeartbeatInterval())
+		switch {
+		case err != nil:
+			if ctx.Err() == nil {
+				code := websocket.CloseGoingAway
+				if errors.Is(err, events.ErrSubscriberLagged) {
+					code = websocket.CloseTryAgainLater
+				}
+				_ = conn.WriteControl(websocket.CloseMessage, websocket.FormatCloseMessage(code, err.Error()),
+					time.Now().Add(eventsWriteTimeout))
+			}
+			return
+		case event == nil:
+			err = conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(eventsWriteTimeout))
+		case access.Allows(event):
+			_ = conn.SetWriteDeadline(time.Now().Add(eventsWriteTimeout))
+			err = conn.WriteJSON(event)
+		}
+		if err != nil {
+			return
+		}
+	}
+}
+
+// subscribe validates stream query and subscribes to engine events, writing
+// error response on failure. lastEventID resumes after event when from_sequence is absent.
+func (h *EventsHandler) subscribe(c *gin.Context, requestID, lastEventID string) (*events.Subscription, bool) {
+	filter := events.Filter{
+		ProcessKey:        c.Query("process_key"),
+		ProcessInstanceID: c.Query("instance_id"),
+		Types:             events.ParseTypes(c.Query("type")),
+		TenantID:          c.Query("tenant_id"),
+	}
+
+	var fromSequence uint64
+	if value := c.Query("from_sequence"); value != "" {
+		sequence, err := strconv.ParseUint(value, 10, 64)
+		if err != nil {
+			apiErr := models.BadRequestError("Invalid from_sequence: " + value)
+			c.JSON(http.StatusBadRequest, models.ErrorResponse(apiErr, requestID))
+			return nil, false
+		}
+		fromSequence = sequence
+	} else if lastEventID != "" {
+		sequence, err := strconv.ParseUint(lastEventID, 10, 64)
+		if err != nil {
+			apiErr := models.BadRequestError("Invalid Last-Event-ID: " + lastEventID)
+			c.JSON(http.StatusBadRequest, models.ErrorResponse(apiErr, requestID))
+			return nil, false
+		}
+		fromSequence = sequence + 1
+	}
+
+	if filter.TenantID != "" && !authorizeTenant(c, requestID, filter.TenantID) {
+		return nil, false
+	}
+
+	subscription, err := events.Subscribe(filter, fromSequence)
+	if err != nil {
+		switch {
+		case errors.Is(err, events.ErrSequenceUnavailable):
+			apiErr := models.SequenceUnavailableError(err.Error())
+			c.JSON(http.StatusGone, models.ErrorResponse(apiErr, requestID))
+		case errors.Is(err, events.ErrClosed):
+			apiErr := models.InternalServerError(err.Error())
+			c.JSON(http.StatusServiceUnavailable, models.ErrorResponse(apiErr, requestID))
+		default:
+			c.JSON(http.StatusBadRequest, models.ErrorResponse(models.BadRequestError(err.Error()), requestID))
+		}
+		return nil, false
+	}
+
+	logger.Info("Event stream subscribed",
+		logger.String("request_id", requestID),
+		logger.String("path", c.Request.URL.Path),
+		logger.String("process_key", filter.ProcessKey),
+		logger.String("instance_id", filter.ProcessInstanceID),
+		logger.String("tenant_id", filter.TenantID),
+		logger.Any("from_sequence", fromSequence))
+	return subscription, true
+}
+
+// eventAccess selects stream events caller may read by resource type of event category
+type eventAccess struct {
+	process    *listFilter
+	job        *listFilter
+	incident   *listFilter
+	message    *listFilter
+	deployment *listFilter
+}
+
+// newEventAccess builds read filters of stream caller
+func newEventAccess(c *gin.Context) *eventAccess {
+	return &eventAccess{
+		process:    newListFilter(c, nil, auth.ActionRead, auth.PermissionProcess),
+		job:        newListFilter(c, nil, auth.ActionRead, auth.PermissionJob),
+		incident:   newListFilter(c, nil, auth.ActionRead, auth.PermissionIncident),
+		message:    newListFilter(c, nil, auth.ActionRead, auth.PermissionMessage),
+		deployment: newListFilter(c, nil, auth.ActionRead, auth.PermissionBPMN),
+	}
+}
+
+// Allows checks event by its tenant and resource ID of its category
+func (a *eventAccess) Allows(event *events.Event) bool {
+	switch event.Category() {
+	case events.CategoryJob:
+		return a.job.AllowsIn(event.TenantID, event.JobType)
+	case events.CategoryIncident:
+		return a.incident.AllowsIn(event.TenantID, event.ProcessID)
+	case events.CategoryMessage:
+		return a.message.AllowsIn(event.TenantID, event.MessageName)
+	case events.CategoryDeployment:
+		return a.deployment.AllowsIn(event.TenantID, event.ProcessID)
+	default:
+		return a.process.AllowsIn(event.TenantID, event.ProcessID)
+	}
+}
+
+// getRequestID extracts or generates request ID
+func (h *EventsHandler) getRequestID(c *gin.Context) string {
+	if requestID := c.GetHeader("X-Request-ID"); requestID != "" {
+		return requestID
+	}
+	return utils.GenerateSecureRequestID("events")
+}
@@ -0,0 +1,140 @@
+/*
+This file is part of the AtomBPMN (R) project.
+Copyright (c) 2025 Matreska Market LLC (ООО «Matreska Market»).
+Authors: Matreska Team.
+
+This project is dual-licensed under AGPL-3.0 and AtomBPMN Commercial License.
+*/
+
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"atom-engine/src/core/logger"
+	"atom-engine/src/core/restapi/middleware"
+	"atom-engine/src/core/restapi/models"
+	"atom-engine/src/core/restapi/utils"
+	"atom-engine/src/exporters"
+)
+
+// ExportersHandler handles export log HTTP requests
+type ExportersHandler struct {
+	coreInterface ExportersCoreInterface
+}
+
+// ExportersCoreInterface defines methods needed for export log operations
+type ExportersCoreInterface interface {
+	GetExportersComponent() interface{}
+}
+
+// ExportersComponentInterface defines exporters component methods
+type ExportersComponentInterface interface {
+	Status() (*exporters.Status, error)
+	Compact() (*exporters.CompactionResult, error)
+}
+
+// NewExportersHandler creates new exporters handler
+func NewExportersHandler(coreInterface ExportersCoreInterface) *ExportersHandler {
+	return &ExportersHandler{
+		coreInterface: coreInterface,
+	}
+}
+
+// RegisterRoutes registers export log routes
+func (h *ExportersHandler) RegisterRoutes(router *gin.RouterGroup, authMiddleware *middleware.AuthMiddleware) {
+	exportersGroup := router.Group("/exporters")
+
+	// Export log spans all tenants, so every route requires admin
+	if authMiddleware != nil {
+		exportersGroup.Use(authMiddleware.RequirePermission("admin"))
+	}
+
+	{
+		exportersGroup.GET("", h.GetExportStatus)
+		exportersGroup.POST("/compact", h.CompactExportLog)
+	}
+}
+
+// GetExportStatus handles GET /api/v1/exporters
+// @Summary Get export status
+// @Description Get export log positions and progress, lag and last error of each exporter.
+// @Description Requires admin permission
+// @Tags exporters
+// @Produce json
+// @Success 200 {object} models.APIResponse{data=exporters.Status}
+// @Failure 401 {object} models.APIResponse{error=models.APIError}
+// @Failure 403 {object} models.APIResponse{error=models.APIError}
+// @Failure 500 {object} models.APIResponse{error=models.APIError}
+// @Security ApiKeyAuth
+// @Router /api/v1/exporters [get]
+func (h *ExportersHandler) GetExportStatus(c *gin.Context) {
+	requestID := h.getRequestID(c)
+
+	exportersComp, ok := h.getExportersComponent(c, requestID)
+	if !ok {
+		return
+	}
+
+	status, err := exportersComp.Status()
+	if err != nil {
+		apiErr := models.InternalServerError("Failed to load export status: " + err.Error())
+		c.JSON(http.StatusInternalServerError, models.ErrorResponse(apiErr, requestID))
+		return
+	}
+
+	c.JSON(http.StatusOK, models.SuccessResponse(status, requestID))
+}
+
+// CompactExportLog handles POST /api/v1/exporters/compact
+// @Summary Compact export log
+// @Description Remove export log records acknowledged by every exporter. Requires admin permission
+// @Tags exporters
+// @Produce json
+// @Success 200 {object} models.APIResponse{data=exporters.CompactionResult}
+// @Failure 401 {object} models.APIResponse{error=models.APIError}
+// @Failure 403 {object} models.APIResponse{error=models.APIError}
+// @Failure 500 {object} models.APIResponse{error=models.APIError}
+// @Security ApiKeyAuth
+// @Router /api/v1/exporters/compact [post]
+func (h *ExportersHandler) CompactExportLog(c *gin.Context) {
+	requestID := h.getRequestID(c)
+
+	exportersComp, ok := h.getExportersComponent(c, requestID)
+	if !ok {
+		return
+	}
+
+	logger.Info("Compacting export log", logger.String("request_id", requestID))
+
+	result, err := exportersComp.Compact()
+	if err != nil {
+		apiErr := models.InternalServerError(err.Error())
+		c.JSON(http.StatusInternalServerError, models.ErrorResponse(apiErr, requestID))
+		return
+	}
+
+	c.JSON(http.StatusOK, models.SuccessResponse(result, requestID))
+}
+
+func (h *ExportersHandler) getExportersComponent(
+	c *gin.Context,
+	requestID string,
+) (ExportersComponentInterface, bool) {
+	exportersComp, ok := h.coreInterface.GetExportersComponent().(ExportersComponentInterface)
+	if !ok {
+		apiErr := models.InternalServerError("Exporters component not available")
+		c.JSON(http.StatusInternalServerError, models.ErrorResponse(apiErr, requestID))
+		return nil, false
+	}
+	return exportersComp, true
+}
+
+func (h *ExportersHandler) getRequestID(c *gin.Context) string {
+	if requestID := c.GetHeader("X-Request-ID"); requestID != "" {
+		return requestID
+	}
+	return utils.GenerateSecureRequestID("exporters")
+}
@@ -864,6 +864,30 @@ func (h *ExpressionHandler) getSupportedFunctions(category string) *SupportedFun
 			ReturnType:  "datetime",
 			Examples:    []string{"add(datetime, duration(\"P1D\"))", "add(\"2025-12-13T12:18:19.675Z\", duration(\"P1D\"))"},
 		},
+		{
+			Name:        "add_working_time",
+			Category:    "date",
+			Description: "Add working time of business calendar to datetime, days count business days",
+			Signature:   "add_working_time(datetime, duration, calendar) -> datetime",
+			ReturnType:  "datetime",
+			Examples:    []string{"add_working_time(now(), \"PT8H\", \"support\")"},
+		},
+		{
+			Name:        "next_business_day",
+			Category:    "date",
+			Description: "Next business day of calendar after datetime at given time",
+			Signature:   "next_business_day(datetime, calendar, time?) -> datetime",
+			ReturnType:  "datetime",
+			Examples:    []string{"next_business_day(now(), \"support\", \"09:00\")"},
+		},
+		{
+			Name:        "is_working_time",
+			Category:    "date",
+			Description: "Check whether datetime is working time of business calendar",
+			Signature:   "is_working_time(datetime, calendar) -> boolean",
+			ReturnType:  "boolean",
+			Examples:    []string{"is_working_time(now(), \"support\")"},
+		},
 	}
 
 	if category != "" {
@@ -882,7 +906,10 @@ func (h *ExpressionHandler) getSupportedFunctions(category string) *SupportedFun
 		"list":    {"count"},
 		"numeric": {"add"},
 		"boolean": {"and"},
-		"date":    {"now", "duration", "subtract", "add"},
+		"date": {
+			"now", "duration", "subtract", "add",
+			"add_working_time", "next_business_day", "is_working_time",
+		},
 	}
 
 	return &SupportedFunctions{
@@ -9,7 +9,9 @@ This project is dual-licensed under AGPL-3.0 and AtomBPMN Commercial License.
 package handlers
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"sort"
@@ -17,10 +19,13 @@ import (
 
 	"github.com/gin-gonic/gin"
 
+	"atom-engine/src/core/auth"
 	"atom-engine/src/core/logger"
+	coremodels "atom-engine/src/core/models"
 	"atom-engine/src/core/restapi/middleware"
 	"atom-engine/src/core/restapi/models"
 	"atom-engine/src/core/restapi/utils"
+	"atom-engine/src/incidents"
 )
 
 // IncidentsHandler handles incident management HTTP requests
@@ -46,6 +51,7 @@ type CreateIncidentRequest struct {
 	MessageName       string                 `json:"message_name,omitempty"`
 	CorrelationKey    string                 `json:"correlation_key,omitempty"`
 	OriginalRetries   int32                  `json:"original_retries,omitempty"`
+	TenantID          string                 `json:"tenant_id,omitempty"` // Tenant of incident without process instance
 	Metadata          map[string]interface{} `json:"metadata,omitempty"`
 }
 
@@ -57,6 +63,27 @@ type IncidentsCoreInterface interface {
 	GetIncidentsComponent() interface{}
 }
 
+// IncidentResolverInterface defines direct incident resolution
+type IncidentResolverInterface interface {
+	GetIncident(ctx context.Context, incidentID string) (*incidents.Incident, error)
+	ResolveIncident(ctx context.Context, request *incidents.ResolveIncidentRequest) (*incidents.Incident, error)
+}
+
+// RetryRuleComponentInterface defines automatic retry rule operations of incidents component
+type RetryRuleComponentInterface interface {
+	ListRetryRules() ([]*incidents.RetryRule, error)
+	GetRetryRule(name string) (*incidents.RetryRule, error)
+	PutRetryRule(ctx context.Context, rule *incidents.RetryRule) (*incidents.RetryRule, int, error)
+	DeleteRetryRule(name string) error
+	PreviewRetryRules(ctx context.Context, candidate *incidents.RetryRule) ([]*incidents.RetryPreview, error)
+}
+
+// PutRetryRuleResponse represents saved retry rule with open incidents it picked up
+type PutRetryRuleResponse struct {
+	Rule               *incidents.RetryRule `json:"rule"`
+	ScheduledIncidents int                  `json:"scheduled_incidents"`
+}
+
 // Incident data types
 type Incident struct {
 	ID                string                 `json:"id"`
@@ -68,8 +95,10 @@ type Incident struct {
 	UpdatedAt         int64                  `json:"updated_at"`
 	ProcessInstanceID string                 `json:"process_instance_id"`
 	ProcessKey        string                 `json:"process_key"`
+	TenantID          string                 `json:"tenant_id,omitempty"`
 	ElementID         string                 `json:"element_id"`
 	ElementType       string                 `json:"element_type"`
+	TokenID           string                 `json:"token_id,omitempty"`
 	JobKey            string                 `json:"job_key,omitempty"`
 	JobType           string                 `json:"job_type,omitempty"`
 	WorkerID          string                 `json:"worker_id,omitempty"`
@@ -119,6 +148,16 @@ func (h *IncidentsHandler) RegisterRoutes(router *gin.RouterGroup, authMiddlewar
 		incidents.GET("/:id", h.GetIncident)
 		incidents.PUT("/:id/resolve", h.ResolveIncident)
 		incidents.GET("/stats", h.GetStats)
+		incidents.GET("/retry-rules", h.ListRetryRules)
+		incidents.GET("/retry-rules/:name", h.GetRetryRule)
+		incidents.POST("/retry-rules/preview", h.PreviewRetryRules)
+		if authMiddleware != nil {
+			incidents.PUT("/retry-rules/:name", authMiddleware.RequirePermission("admin"), h.PutRetryRule)
+			incidents.DELETE("/retry-rules/:name", authMiddleware.RequirePermission("admin"), h.DeleteRetryRule)
+		} else {
+			incidents.PUT("/retry-rules/:name", h.PutRetryRule)
+			incidents.DELETE("/retry-rules/:name", h.DeleteRetryRule)
+		}
 	}
 }
 
@@ -183,6 +222,24 @@ func (h *IncidentsHandler) CreateIncident(c *gin.Context) {
 		logger.String("message", req.Message),
 		logger.String("process_instance_id", req.ProcessInstanceID))
 
+	// Incident of process instance belongs to tenant of instance
+	tenantID := coremodels.DefaultTenantID
+	if req.ProcessInstanceID != "" {
+		if !authorizeInstanceRequest(
+			c, requestID, h.coreInterface, auth.ActionCreate, auth.PermissionIncident, req.ProcessInstanceID,
+		) {
+			return
+		}
+	} else {
+		var allowed bool
+		if tenantID, allowed = resolveTenantRequest(c, requestID, req.TenantID); !allowed {
+			return
+		}
+		if !authorizeTenantRequest(c, requestID, auth.ActionCreate, auth.PermissionIncident, tenantID, "") {
+			return
+		}
+	}
+
 	// Create incident request message
 	incidentReq := map[string]interface{}{
 		"operation":           "create",
@@ -200,6 +257,7 @@ func (h *IncidentsHandler) CreateIncident(c *gin.Context) {
 		"message_name":        req.MessageName,
 		"correlation_key":     req.CorrelationKey,
 		"original_retries":    req.OriginalRetries,
+		"tenant_id":           tenantID,
 		"metadata":            req.Metadata,
 	}
 
@@ -260,6 +318,7 @@ func (h *IncidentsHandler) CreateIncident(c *gin.Context) {
 // @Param element_id query string false "Element ID filter"
 // @Param job_key query string false "Job key filter"
 // @Param worker_id query string false "Worker ID filter"
+// @Param tenant_id query string false "Tenant ID filter"
 // @Success 200 {object} models.PaginatedResponse{data=[]Incident}
 // @Failure 401 {object} models.APIResponse{error=models.APIError}
 // @Failure 403 {object} models.APIResponse{error=models.APIError}
@@ -279,6 +338,7 @@ func (h *IncidentsHandler) ListIncidents(c *gin.Context) {
 	elementID := c.Query("element_id")
 	jobKey := c.Query("job_key")
 	workerID := c.Query("worker_id")
+	tenantID := c.Query("tenant_id")
 
 	// Parse and validate pagination
 	paginationHelper := utils.NewPaginationHelper()
@@ -330,6 +390,7 @@ func (h *IncidentsHandler) ListIncidents(c *gin.Context) {
 		"element_id":          elementID,
 		"job_key":             jobKey,
 		"worker_id":           workerID,
+		"tenant_id":           tenantID,
 		"limit":               0, // Load all for sorting
 		"offset":              0,
 	}
@@ -345,6 +406,15 @@ func (h *IncidentsHandler) ListIncidents(c *gin.Context) {
 
 	// Parse incidents from response
 	incidents := h.parseIncidentsFromResponse(response)
+	if readFilter := newListFilter(c, h.coreInterface, auth.ActionRead, auth.PermissionIncident); readFilter != nil {
+		visible := make([]Incident, 0, len(incidents))
+		for _, incident := range incidents {
+			if readFilter.AllowsOwned(incident.TenantID, incident.ProcessInstanceID) {
+				visible = append(visible, incident)
+			}
+		}
+		incidents = visible
+	}
 	totalCount := len(incidents)
 
 	// Apply sorting by created_at DESC (consistent with gRPC/CLI behavior)
@@ -427,6 +497,12 @@ func (h *IncidentsHandler) GetIncident(c *gin.Context) {
 		return
 	}
 
+	if !authorizeInstanceRequest(
+		c, requestID, h.coreInterface, auth.ActionRead, auth.PermissionIncident, incident.ProcessInstanceID,
+	) {
+		return
+	}
+
 	logger.Info("Incident details retrieved",
 		logger.String("request_id", requestID),
 		logger.String("incident_id", incidentID),
@@ -503,20 +579,40 @@ func (h *IncidentsHandler) ResolveIncident(c *gin.Context) {
 		logger.String("action", req.Action),
 		logger.String("comment", req.Comment))
 
-	// Create resolve request
-	resolveReq := map[string]interface{}{
-		"operation":   "resolve",
-		"incident_id": incidentID,
-		"action":      req.Action,
-		"comment":     req.Comment,
-		"resolved_by": req.ResolvedBy,
-		"new_retries": req.NewRetries,
+	resolver, ok := h.coreInterface.GetIncidentsComponent().(IncidentResolverInterface)
+	if !ok {
+		apiErr := models.InternalServerError("Incidents component not available")
+		c.JSON(http.StatusInternalServerError, models.ErrorResponse(apiErr, requestID))
+		return
 	}
 
-	// Send to incidents component and get response
-	response, err := h.sendIncidentsRequest(resolveReq, requestID)
+	if !h.authorizeIncident(c, requestID, resolver, auth.ActionResolve, incidentID) {
+		return
+	}
+
+	resolvedBy := req.ResolvedBy
+	if resolvedBy == "" {
+		resolvedBy = "api"
+	}
+
+	// Resolve directly so retry outcome is returned to caller
+	_, err := resolver.ResolveIncident(c.Request.Context(), &incidents.ResolveIncidentRequest{
+		IncidentID: incidentID,
+		Action:     incidents.ResolveAction(strings.ToUpper(req.Action)),
+		Comment:    req.Comment,
+		ResolvedBy: resolvedBy,
+		NewRetries: int(req.NewRetries),
+		Variables:  req.Variables,
+	})
 	if err != nil {
-		if strings.Contains(err.Error(), "not found") {
+		if errors.Is(err, incidents.ErrRetryFailed) {
+			apiErr := models.NewAPIErrorWithDetails(
+				models.ErrorCodeConflict,
+				err.Error(),
+				map[string]interface{}{"incident_id": incidentID},
+			)
+			c.JSON(http.StatusConflict, models.ErrorResponse(apiErr, requestID))
+		} else if strings.Contains(err.Error(), "not found") {
 			apiErr := models.NewAPIErrorWithDetails(
 				models.ErrorCodeResourceNotFound,
 				"Incident not found",
@@ -531,19 +627,6 @@ func (h *IncidentsHandler) ResolveIncident(c *gin.Context) {
 		return
 	}
 
-	// Check if resolution was successful
-	success, _ := response["success"].(bool)
-	if !success {
-		errorMsg, _ := response["error"].(string)
-		if errorMsg == "" {
-			errorMsg = "Incident resolution failed"
-		}
-
-		apiErr := models.InternalServerError(errorMsg)
-		c.JSON(http.StatusInternalServerError, models.ErrorResponse(apiErr, requestID))
-		return
-	}
-
 	updateResp := &models.UpdateResponse{
 		ID:      incidentID,
 		Message: fmt.Sprintf("Incident %s successfully", req.Action),
@@ -574,6 +657,10 @@ func (h *IncidentsHandler) GetStats(c *gin.Context) {
 	logger.Debug("Getting incident statistics",
 		logger.String("request_id", requestID))
 
+	if !authorizeRequest(c, requestID, auth.ActionRead, auth.PermissionIncident, "") {
+		return
+	}
+
 	// Create stats request
 	statsReq := map[string]interface{}{
 		"operation": "stats",
@@ -599,8 +686,269 @@ func (h *IncidentsHandler) GetStats(c *gin.Context) {
 	c.JSON(http.StatusOK, models.SuccessResponse(stats, requestID))
 }
 
+// ListRetryRules handles GET /api/v1/incidents/retry-rules
+// @Summary List incident retry rules
+// @Description List automatic incident retry rules in evaluation order
+// @Tags incidents
+// @Produce json
+// @Success 200 {object} models.APIResponse{data=models.ListResponse}
+// @Failure 401 {object} models.APIResponse{error=models.APIError}
+// @Failure 403 {object} models.APIResponse{error=models.APIError}
+// @Failure 500 {object} models.APIResponse{error=models.APIError}
+// @Security ApiKeyAuth
+// @Router /api/v1/incidents/retry-rules [get]
+func (h *IncidentsHandler) ListRetryRules(c *gin.Context) {
+	requestID := h.getRequestID(c)
+
+	if !authorizeRequest(c, requestID, auth.ActionRead, auth.PermissionIncident, "") {
+		return
+	}
+
+	ruleComp, ok := h.coreInterface.GetIncidentsComponent().(RetryRuleComponentInterface)
+	if !ok {
+		apiErr := models.InternalServerError("Incidents component not available")
+		c.JSON(http.StatusInternalServerError, models.ErrorResponse(apiErr, requestID))
+		return
+	}
+
+	rules, err := ruleComp.ListRetryRules()
+	if err != nil {
+		apiErr := h.converter.GRPCErrorToAPIError(err)
+		statusCode := models.HTTPStatusFromErrorCode(apiErr.Code)
+		c.JSON(statusCode, models.ErrorResponse(apiErr, requestID))
+		return
+	}
+
+	c.JSON(http.StatusOK, models.SuccessResponse(&models.ListResponse{
+		Items:      rules,
+		TotalCount: len(rules),
+	}, requestID))
+}
+
+// GetRetryRule handles GET /api/v1/incidents/retry-rules/:name
+// @Summary Get incident retry rule
+// @Description Get automatic incident retry rule by name
+// @Tags incidents
+// @Produce json
+// @Param name path string true "Rule name"
+// @Success 200 {object} models.APIResponse{data=incidents.RetryRule}
+// @Failure 401 {object} models.APIResponse{error=models.APIError}
+// @Failure 403 {object} models.APIResponse{error=models.APIError}
+// @Failure 404 {object} models.APIResponse{error=models.APIError}
+// @Security ApiKeyAuth
+// @Router /api/v1/incidents/retry-rules/{name} [get]
+func (h *IncidentsHandler) GetRetryRule(c *gin.Context) {
+	requestID := h.getRequestID(c)
+
+	if !authorizeRequest(c, requestID, auth.ActionRead, auth.PermissionIncident, "") {
+		return
+	}
+
+	ruleComp, ok := h.coreInterface.GetIncidentsComponent().(RetryRuleComponentInterface)
+	if !ok {
+		apiErr := models.InternalServerError("Incidents component not available")
+		c.JSON(http.StatusInternalServerError, models.ErrorResponse(apiErr, requestID))
+		return
+	}
+
+	rule, err := ruleComp.GetRetryRule(c.Param("name"))
+	if err != nil {
+		apiErr := h.converter.GRPCErrorToAPIError(err)
+		statusCode := models.HTTPStatusFromErrorCode(apiErr.Code)
+		c.JSON(statusCode, models.ErrorResponse(apiErr, requestID))
+		return
+	}
+
+	c.JSON(http.StatusOK, models.SuccessResponse(rule, requestID))
+}
+
+// PutRetryRule handles PUT /api/v1/incidents/retry-rules/:name
+// @Summary Create or replace incident retry rule
+// @Description Create or replace automatic incident retry rule and schedule open incidents it matches.
+// @Description Rules from configuration are read-only, requires admin permission
+// @Tags incidents
+// @Accept json
+// @Produce json
+// @Param name path string true "Rule name"
+// @Param request body incidents.RetryRule true "Retry rule definition"
+// @Success 200 {object} models.APIResponse{data=PutRetryRuleResponse}
+// @Failure 400 {object} models.APIResponse{error=models.APIError}
+// @Failure 401 {object} models.APIResponse{error=models.APIError}
+// @Failure 403 {object} models.APIResponse{error=models.APIError}
+// @Failure 409 {object} models.APIResponse{error=models.APIError}
+// @Failure 500 {object} models.APIResponse{error=models.APIError}
+// @Security ApiKeyAuth
+// @Router /api/v1/incidents/retry-rules/{name} [put]
+func (h *IncidentsHandler) PutRetryRule(c *gin.Context) {
+	requestID := h.getRequestID(c)
+
+	rule, ok := h.bindRetryRule(c, requestID, c.Param("name"))
+	if !ok {
+		return
+	}
+
+	ruleComp, ok := h.coreInterface.GetIncidentsComponent().(RetryRuleComponentInterface)
+	if !ok {
+		apiErr := models.InternalServerError("Incidents component not available")
+		c.JSON(http.StatusInternalServerError, models.ErrorResponse(apiErr, requestID))
+		return
+	}
+
+	logger.Info("Saving incident retry rule",
+		logger.String("request_id", requestID),
+		logger.String("rule", rule.Name))
+
+	saved, scheduled, err := ruleComp.PutRetryRule(c.Request.Context(), rule)
+	if err != nil {
+		apiErr := h.converter.GRPCErrorToAPIError(err)
+		statusCode := models.HTTPStatusFromErrorCode(apiErr.Code)
+		c.JSON(statusCode, models.ErrorResponse(apiErr, requestID))
+		return
+	}
+
+	c.JSON(http.StatusOK, models.SuccessResponse(&PutRetryRuleResponse{
+		Rule:               saved,
+		ScheduledIncidents: scheduled,
+	}, requestID))
+}
+
+// DeleteRetryRule handles DELETE /api/v1/incidents/retry-rules/:name
+// @Summary Delete incident retry rule
+// @Description Delete automatic incident retry rule created through API, requires admin permission.
+// @Description Pending retries of the rule are canceled
+// @Tags incidents
+// @Produce json
+// @Param name path string true "Rule name"
+// @Success 200 {object} models.APIResponse{data=models.DeleteResponse}
+// @Failure 401 {object} models.APIResponse{error=models.APIError}
+// @Failure 403 {object} models.APIResponse{error=models.APIError}
+// @Failure 404 {object} models.APIResponse{error=models.APIError}
+// @Failure 409 {object} models.APIResponse{error=models.APIError}
+// @Security ApiKeyAuth
+// @Router /api/v1/incidents/retry-rules/{name} [delete]
+func (h *IncidentsHandler) DeleteRetryRule(c *gin.Context) {
+	requestID := h.getRequestID(c)
+	name := c.Param("name")
+
+	ruleComp, ok := h.coreInterface.GetIncidentsComponent().(RetryRuleComponentInterface)
+	if !ok {
+		apiErr := models.InternalServerError("Incidents component not available")
+		c.JSON(http.StatusInternalServerError, models.ErrorResponse(apiErr, requestID))
+		return
+	}
+
+	if err := ruleComp.DeleteRetryRule(name); err != nil {
+		apiErr := h.converter.GRPCErrorToAPIError(err)
+		statusCode := models.HTTPStatusFromErrorCode(apiErr.Code)
+		c.JSON(statusCode, models.ErrorResponse(apiErr, requestID))
+		return
+	}
+
+	c.JSON(http.StatusOK, models.SuccessResponse(&models.DeleteResponse{
+		ID:      name,
+		Message: "Incident retry rule deleted",
+	}, requestID))
+}
+
+// PreviewRetryRules handles POST /api/v1/incidents/retry-rules/preview
+// @Summary Preview incident retry rules
+// @Description Dry run: report what retry rules would do with open incidents without acting.
+// @Description With rule in body only incidents the candidate rule would pick up are reported
+// @Tags incidents
+// @Accept json
+// @Produce json
+// @Param request body incidents.RetryRule false "Candidate retry rule"
+// @Success 200 {object} models.APIResponse{data=models.ListResponse}
+// @Failure 400 {object} models.APIResponse{error=models.APIError}
+// @Failure 401 {object} models.APIResponse{error=models.APIError}
+// @Failure 403 {object} models.APIResponse{error=models.APIError}
+// @Failure 409 {object} models.APIResponse{error=models.APIError}
+// @Security ApiKeyAuth
+// @Router /api/v1/incidents/retry-rules/preview [post]
+func (h *IncidentsHandler) PreviewRetryRules(c *gin.Context) {
+	requestID := h.getRequestID(c)
+
+	if !authorizeRequest(c, requestID, auth.ActionRead, auth.PermissionIncident, "") {
+		return
+	}
+
+	var candidate *incidents.RetryRule
+	if c.Request.ContentLength != 0 {
+		rule, ok := h.bindRetryRule(c, requestID, "")
+		if !ok {
+			return
+		}
+		candidate = rule
+	}
+
+	ruleComp, ok := h.coreInterface.GetIncidentsComponent().(RetryRuleComponentInterface)
+	if !ok {
+		apiErr := models.InternalServerError("Incidents component not available")
+		c.JSON(http.StatusInternalServerError, models.ErrorResponse(apiErr, requestID))
+		return
+	}
+
+	previews, err := ruleComp.PreviewRetryRules(c.Request.Context(), candidate)
+	if err != nil {
+		apiErr := h.converter.GRPCErrorToAPIError(err)
+		statusCode := models.HTTPStatusFromErrorCode(apiErr.Code)
+		c.JSON(statusCode, models.ErrorResponse(apiErr, requestID))
+		return
+	}
+
+	c.JSON(http.StatusOK, models.SuccessResponse(&models.ListResponse{
+		Items:      previews,
+		TotalCount: len(previews),
+	}, requestID))
+}
+
 // Helper methods
 
+// authorizeIncident checks action on incident by its tenant and process of its instance
+func (h *IncidentsHandler) authorizeIncident(
+	c *gin.Context,
+	requestID string,
+	resolver IncidentResolverInterface,
+	action, incidentID string,
+) bool {
+	if _, ok := middleware.GetAuthResult(c); !ok {
+		return true
+	}
+
+	instanceID, tenantID := "", coremodels.DefaultTenantID
+	if incident, err := resolver.GetIncident(c.Request.Context(), incidentID); err == nil && incident != nil {
+		instanceID, tenantID = incident.ProcessInstanceID, incident.TenantID
+	}
+	return authorizeOwnedRequest(c, requestID, h.coreInterface, action, auth.PermissionIncident, tenantID, instanceID)
+}
+
+// bindRetryRule parses and validates retry rule from request body,
+// name from path must match name in body when both are set
+func (h *IncidentsHandler) bindRetryRule(c *gin.Context, requestID, name string) (*incidents.RetryRule, bool) {
+	var rule incidents.RetryRule
+	if err := c.ShouldBindJSON(&rule); err != nil {
+		apiErr := models.BadRequestError("Invalid request body: " + err.Error())
+		c.JSON(http.StatusBadRequest, models.ErrorResponse(apiErr, requestID))
+		return nil, false
+	}
+
+	if name != "" {
+		if rule.Name != "" && rule.Name != name {
+			apiErr := models.BadRequestError("rule name in body does not match path")
+			c.JSON(http.StatusBadRequest, models.ErrorResponse(apiErr, requestID))
+			return nil, false
+		}
+		rule.Name = name
+	}
+
+	if err := rule.Compile(); err != nil {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse(models.BadRequestError(err.Error()), requestID))
+		return nil, false
+	}
+
+	return &rule, true
+}
+
 func (h *IncidentsHandler) sendIncidentsRequest(
 	req map[string]interface{},
 	requestID string,
@@ -17,7 +17,9 @@ import (
 
 	"github.com/gin-gonic/gin"
 
+	"atom-engine/src/core/auth"
 	"atom-engine/src/core/logger"
+	coremodels "atom-engine/src/core/models"
 	"atom-engine/src/core/restapi/middleware"
 	"atom-engine/src/core/restapi/models"
 	"atom-engine/src/core/restapi/utils"
@@ -44,11 +46,13 @@ type Job struct {
 	Type                string                 `json:"type"`
 	ProcessInstanceID   string                 `json:"process_instance_id"`
 	ProcessDefinitionID string                 `json:"process_definition_id"`
+	TenantID            string                 `json:"tenant_id,omitempty"`
 	ElementID           string                 `json:"element_id"`
 	ElementInstanceID   string                 `json:"element_instance_id"`
 	CustomHeaders       map[string]string      `json:"custom_headers"`
 	Variables           map[string]interface{} `json:"variables"`
 	Retries             int32                  `json:"retries"`
+	Priority            int32                  `json:"priority"`
 	Deadline            int64                  `json:"deadline"`
 	Worker              string                 `json:"worker,omitempty"`
 	State               string                 `json:"state"`
@@ -56,6 +60,32 @@ type Job struct {
 	UpdatedAt           int64                  `json:"updated_at"`
 }
 
+// WorkerLease describes job held by worker until lease expiry
+type WorkerLease struct {
+	JobKey            string `json:"job_key"`
+	Type              string `json:"type"`
+	ProcessInstanceID string `json:"process_instance_id"`
+	ActivatedAt       int64  `json:"activated_at"`
+	LeaseExpiry       int64  `json:"lease_expiry"`
+}
+
+// WorkerInfo describes worker and its active job leases
+type WorkerInfo struct {
+	Worker     string        `json:"worker"`
+	Registered bool          `json:"registered"`
+	JobType    string        `json:"job_type,omitempty"`
+	LastSeen   int64         `json:"last_seen,omitempty"`
+	MaxJobs    int           `json:"max_jobs,omitempty"`
+	TimeoutMs  int64         `json:"timeout_ms,omitempty"`
+	Leases     []WorkerLease `json:"leases"`
+}
+
+// WorkerListResponse represents worker listing response
+type WorkerListResponse struct {
+	Workers []WorkerInfo `json:"workers"`
+	Total   int          `json:"total"`
+}
+
 type JobActivationResponse struct {
 	Jobs []Job `json:"jobs"`
 }
@@ -101,6 +131,7 @@ func (h *JobsHandler) RegisterRoutes(router *gin.RouterGroup, authMiddleware *mi
 		jobs.DELETE("/:key", h.CancelJob)
 		jobs.PUT("/:key/timeout", h.UpdateJobTimeout)
 		jobs.GET("/stats", h.GetJobStats)
+		jobs.GET("/workers", h.ListWorkers)
 	}
 }
 
@@ -164,6 +195,12 @@ func (h *JobsHandler) CreateJob(c *gin.Context) {
 		logger.String("process_instance_id", req.ProcessInstanceID),
 		logger.String("element_id", req.ElementID))
 
+	// Job belongs to tenant of its process instance
+	_, tenantID := lookupInstance(h.coreInterface, req.ProcessInstanceID)
+	if !authorizeTenantRequest(c, requestID, auth.ActionCreate, auth.PermissionJob, tenantID, req.Type) {
+		return
+	}
+
 	// Create job request message
 	jobReq := map[string]interface{}{
 		"type":       "create_job",
@@ -177,6 +214,7 @@ func (h *JobsHandler) CreateJob(c *gin.Context) {
 			"variables":           req.Variables,
 			"retries":             req.Retries,
 			"timeout_ms":          req.TimeoutMs,
+			"priority":            req.Priority,
 		},
 	}
 
@@ -269,17 +307,28 @@ func (h *JobsHandler) ActivateJobs(c *gin.Context) {
 		logger.String("request_id", requestID),
 		logger.String("type", req.Type),
 		logger.String("worker", req.Worker),
-		logger.Any("max_jobs", req.MaxJobs))
+		logger.Any("max_jobs", req.MaxJobs),
+		logger.Any("tenant_ids", req.TenantIDs))
+
+	if !authorizeRequest(c, requestID, auth.ActionComplete, auth.PermissionJob, req.Type) {
+		return
+	}
+	tenantIDs, ok := activationTenants(c, requestID, req.TenantIDs)
+	if !ok {
+		return
+	}
 
 	// Create activation request
 	activateReq := map[string]interface{}{
 		"type":       "activate_jobs",
 		"request_id": requestID,
 		"payload": map[string]interface{}{
-			"job_type":    req.Type,
-			"worker_name": req.Worker,
-			"max_jobs":    req.MaxJobs,
-			"timeout_ms":  req.TimeoutMs,
+			"job_type":        req.Type,
+			"worker_name":     req.Worker,
+			"max_jobs":        req.MaxJobs,
+			"timeout_ms":      req.TimeoutMs,
+			"fetch_variables": req.FetchVariables,
+			"tenant_ids":      tenantIDs,
 		},
 	}
 
@@ -317,6 +366,7 @@ func (h *JobsHandler) ActivateJobs(c *gin.Context) {
 // @Param type query string false "Job type filter"
 // @Param worker query string false "Worker filter"
 // @Param state query string false "State filter (activatable, activated, completed, failed)"
+// @Param tenant_id query string false "Tenant ID filter"
 // @Success 200 {object} models.PaginatedResponse{data=[]Job}
 // @Failure 401 {object} models.APIResponse{error=models.APIError}
 // @Failure 403 {object} models.APIResponse{error=models.APIError}
@@ -332,6 +382,7 @@ func (h *JobsHandler) ListJobs(c *gin.Context) {
 	jobType := c.Query("type")
 	worker := c.Query("worker")
 	state := c.Query("state")
+	tenantID := c.Query("tenant_id")
 
 	// Parse and validate pagination
 	paginationHelper := utils.NewPaginationHelper()
@@ -365,11 +416,12 @@ func (h *JobsHandler) ListJobs(c *gin.Context) {
 		"type":       "list_jobs",
 		"request_id": requestID,
 		"payload": map[string]interface{}{
-			"job_type": jobType,
-			"worker":   worker,
-			"state":    state,
-			"limit":    0, // Load all for sorting
-			"offset":   0,
+			"job_type":  jobType,
+			"worker":    worker,
+			"state":     state,
+			"tenant_id": tenantID,
+			"limit":     0, // Load all for sorting
+			"offset":    0,
 		},
 	}
 
@@ -384,6 +436,15 @@ func (h *JobsHandler) ListJobs(c *gin.Context) {
 
 	// Parse jobs from response
 	jobs := h.parseJobsFromResponse(response)
+	if readFilter := newListFilter(c, nil, auth.ActionRead, auth.PermissionJob); readFilter != nil {
+		visible := make([]Job, 0, len(jobs))
+		for _, job := range jobs {
+			if readFilter.AllowsIn(job.TenantID, job.Type) {
+				visible = append(visible, job)
+			}
+		}
+		jobs = visible
+	}
 	totalCount := len(jobs)
 
 	// Apply sorting by created_at DESC (consistent with gRPC/CLI behavior)
@@ -461,6 +522,10 @@ func (h *JobsHandler) GetJob(c *gin.Context) {
 		return
 	}
 
+	if !authorizeTenantRequest(c, requestID, auth.ActionRead, auth.PermissionJob, job.TenantID, job.Type) {
+		return
+	}
+
 	logger.Info("Job details retrieved",
 		logger.String("request_id", requestID),
 		logger.String("job_key", jobKey),
@@ -483,6 +548,7 @@ func (h *JobsHandler) GetJob(c *gin.Context) {
 // @Failure 401 {object} models.APIResponse{error=models.APIError}
 // @Failure 403 {object} models.APIResponse{error=models.APIError}
 // @Failure 404 {object} models.APIResponse{error=models.APIError}
+// @Failure 413 {object} models.APIResponse{error=models.APIError}
 // @Failure 500 {object} models.APIResponse{error=models.APIError}
 // @Security ApiKeyAuth
 // @Router /api/v1/jobs/{key}/complete [put]
@@ -510,6 +576,10 @@ func (h *JobsHandler) CompleteJob(c *gin.Context) {
 		logger.String("request_id", requestID),
 		logger.String("job_key", jobKey))
 
+	if !h.authorizeJob(c, requestID, auth.ActionComplete, jobKey) {
+		return
+	}
+
 	// Create complete request
 	completeReq := map[string]interface{}{
 		"type":       "complete_job",
@@ -593,6 +663,10 @@ func (h *JobsHandler) FailJob(c *gin.Context) {
 		logger.String("job_key", jobKey),
 		logger.Int("retries", int(req.Retries)))
 
+	if !h.authorizeJob(c, requestID, auth.ActionComplete, jobKey) {
+		return
+	}
+
 	// Create fail job request
 	failReq := map[string]interface{}{
 		"type":       "fail_job",
@@ -601,7 +675,7 @@ func (h *JobsHandler) FailJob(c *gin.Context) {
 			"job_key":       jobKey,
 			"retries":       req.Retries,
 			"error_message": req.ErrorMessage,
-			"backoff_ms":    req.BackoffMs,
+			"retry_backoff": req.BackoffMs,
 		},
 	}
 
@@ -685,6 +759,10 @@ func (h *JobsHandler) ThrowError(c *gin.Context) {
 		logger.String("job_key", jobKey),
 		logger.String("error_code", req.ErrorCode))
 
+	if !h.authorizeJob(c, requestID, auth.ActionComplete, jobKey) {
+		return
+	}
+
 	// Create throw error request
 	throwReq := map[string]interface{}{
 		"type":       "throw_error",
@@ -778,6 +856,10 @@ func (h *JobsHandler) UpdateJobRetries(c *gin.Context) {
 		logger.String("job_key", jobKey),
 		logger.Int("retries", int(req.Retries)))
 
+	if !h.authorizeJob(c, requestID, auth.ActionUpdate, jobKey) {
+		return
+	}
+
 	// Create update retries request
 	updateReq := map[string]interface{}{
 		"type":       "update_job_retries",
@@ -852,6 +934,10 @@ func (h *JobsHandler) CancelJob(c *gin.Context) {
 		logger.String("job_key", jobKey),
 		logger.String("reason", req.Reason))
 
+	if !h.authorizeJob(c, requestID, auth.ActionDelete, jobKey) {
+		return
+	}
+
 	// Create cancel job request
 	cancelReq := map[string]interface{}{
 		"type":       "cancel_job",
@@ -942,6 +1028,10 @@ func (h *JobsHandler) UpdateJobTimeout(c *gin.Context) {
 		logger.String("job_key", jobKey),
 		logger.Int64("timeout_ms", req.TimeoutMs))
 
+	if !h.authorizeJob(c, requestID, auth.ActionUpdate, jobKey) {
+		return
+	}
+
 	// Create update timeout request
 	updateReq := map[string]interface{}{
 		"type":       "update_job_timeout",
@@ -1001,6 +1091,10 @@ func (h *JobsHandler) GetJobStats(c *gin.Context) {
 	logger.Debug("Getting job statistics",
 		logger.String("request_id", requestID))
 
+	if !authorizeRequest(c, requestID, auth.ActionRead, auth.PermissionJob, "") {
+		return
+	}
+
 	// Create get stats request
 	statsReq := map[string]interface{}{
 		"type":       "get_stats",
@@ -1054,8 +1148,130 @@ func (h *JobsHandler) GetJobStats(c *gin.Context) {
 	c.JSON(http.StatusOK, models.SuccessResponse(stats, requestID))
 }
 
+// ListWorkers handles GET /api/v1/jobs/workers
+// @Summary List workers
+// @Description List workers with jobs they currently hold and lease expiry of each job
+// @Tags jobs
+// @Produce json
+// @Param type query string false "Filter by job type"
+// @Success 200 {object} models.APIResponse{data=WorkerListResponse}
+// @Failure 401 {object} models.APIResponse{error=models.APIError}
+// @Failure 403 {object} models.APIResponse{error=models.APIError}
+// @Failure 500 {object} models.APIResponse{error=models.APIError}
+// @Security ApiKeyAuth
+// @Router /api/v1/jobs/workers [get]
+func (h *JobsHandler) ListWorkers(c *gin.Context) {
+	requestID := h.getRequestID(c)
+	jobType := c.Query("type")
+
+	logger.Debug("Listing workers",
+		logger.String("request_id", requestID),
+		logger.String("type", jobType))
+
+	workersReq := map[string]interface{}{
+		"type":       "list_workers",
+		"request_id": requestID,
+		"payload": map[string]interface{}{
+			"job_type": jobType,
+		},
+	}
+
+	response, err := h.sendJobsRequest(workersReq, requestID)
+	if err != nil {
+		apiErr := h.converter.GRPCErrorToAPIError(err)
+		statusCode := models.HTTPStatusFromErrorCode(apiErr.Code)
+		c.JSON(statusCode, models.ErrorResponse(apiErr, requestID))
+		return
+	}
+
+	if errMsg, ok := response["error"].(string); ok && errMsg != "" {
+		c.JSON(http.StatusInternalServerError, models.ErrorResponse(models.InternalServerError(errMsg), requestID))
+		return
+	}
+
+	// Result has the same JSON shape as WorkerListResponse
+	result := WorkerListResponse{Workers: []WorkerInfo{}}
+	if resultData, exists := response["result"]; exists {
+		if resultJSON, err := json.Marshal(resultData); err == nil {
+			if err := json.Unmarshal(resultJSON, &result); err != nil {
+				logger.Warn("Failed to parse workers response",
+					logger.String("request_id", requestID),
+					logger.String("error", err.Error()))
+			}
+		}
+	}
+
+	if readFilter := newListFilter(c, nil, auth.ActionRead, auth.PermissionJob); readFilter != nil {
+		visible := make([]WorkerInfo, 0, len(result.Workers))
+		for _, worker := range result.Workers {
+			if readFilter.Allows(worker.JobType) {
+				visible = append(visible, worker)
+			}
+		}
+		result.Workers = visible
+		result.Total = len(visible)
+	}
+
+	logger.Info("Workers listed",
+		logger.String("request_id", requestID),
+		logger.Int("count", len(result.Workers)))
+
+	c.JSON(http.StatusOK, models.SuccessResponse(result, requestID))
+}
+
 // Helper methods
 
+// authorizeJob checks action on job resolving its type and tenant by key
+func (h *JobsHandler) authorizeJob(c *gin.Context, requestID, action, jobKey string) bool {
+	result, ok := middleware.GetAuthResult(c)
+	if !ok || (result.Authorize(action, auth.PermissionJob, "") && result.AllTenants()) {
+		return true
+	}
+
+	jobType, tenantID := "", coremodels.DefaultTenantID
+	getReq := map[string]interface{}{
+		"type":       "get_job",
+		"request_id": requestID,
+		"payload": map[string]interface{}{
+			"job_id": jobKey,
+		},
+	}
+	if response, err := h.sendJobsRequest(getReq, requestID); err == nil {
+		if job := h.parseJobFromResponse(response); job != nil {
+			jobType, tenantID = job.Type, job.TenantID
+		}
+	}
+	return authorizeTenantRequest(c, requestID, action, auth.PermissionJob, tenantID, jobType)
+}
+
+// activationTenants returns tenants whose jobs worker activates, nil for every tenant.
+// Requested tenants must be accessible to caller, empty list falls back to tenants
+// of restricted caller.
+func activationTenants(c *gin.Context, requestID string, requested []string) ([]string, bool) {
+	tenantIDs := coremodels.ParseTenantList(strings.Join(requested, ","))
+	for _, tenantID := range tenantIDs {
+		if tenantID == coremodels.AllTenants {
+			continue
+		}
+		if err := coremodels.ValidateTenantID(tenantID); err != nil {
+			c.JSON(http.StatusBadRequest, models.ErrorResponse(models.BadRequestError(err.Error()), requestID))
+			return nil, false
+		}
+		if !authorizeTenant(c, requestID, tenantID) {
+			return nil, false
+		}
+	}
+
+	result, ok := middleware.GetAuthResult(c)
+	if !ok || result.AllTenants() {
+		return tenantIDs, true
+	}
+	if tenantIDs == nil || coremodels.TenantAllowed(tenantIDs, coremodels.AllTenants) {
+		return result.TenantIDs, true
+	}
+	return tenantIDs, true
+}
+
 func (h *JobsHandler) sendJobsRequest(req map[string]interface{}, requestID string) (map[string]interface{}, error) {
 	reqJSON, err := json.Marshal(req)
 	if err != nil {
@@ -1150,6 +1366,9 @@ func (h *JobsHandler) parseJobFromMap(jobMap map[string]interface{}) *Job {
 	if processInstanceID, ok := jobMap["process_instance_id"].(string); ok {
 		job.ProcessInstanceID = processInstanceID
 	}
+	if tenantID, ok := jobMap["tenant_id"].(string); ok {
+		job.TenantID = tenantID
+	}
 	if worker, ok := jobMap["worker"].(string); ok {
 		job.Worker = worker
 	}
@@ -1161,6 +1380,9 @@ func (h *JobsHandler) parseJobFromMap(jobMap map[string]interface{}) *Job {
 	if retries, ok := jobMap["retries"].(float64); ok {
 		job.Retries = int32(retries)
 	}
+	if priority, ok := jobMap["priority"].(float64); ok {
+		job.Priority = int32(priority)
+	}
 	if createdAt, ok := jobMap["created_at"].(float64); ok {
 		job.CreatedAt = int64(createdAt)
 	}
@@ -1170,6 +1392,14 @@ func (h *JobsHandler) parseJobFromMap(jobMap map[string]interface{}) *Job {
 		job.Variables = variables
 	}
 
+	// Parse custom headers
+	if headers, ok := jobMap["custom_headers"].(map[string]interface{}); ok {
+		job.CustomHeaders = make(map[string]string, len(headers))
+		for key, value := range headers {
+			job.CustomHeaders[key] = fmt.Sprintf("%v", value)
+		}
+	}
+
 	// Initialize empty maps if nil
 	if job.CustomHeaders == nil {
 		job.CustomHeaders = make(map[string]string)
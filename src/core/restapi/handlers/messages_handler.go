@@ -15,6 +15,7 @@ import (
 
 	"github.com/gin-gonic/gin"
 
+	"atom-engine/src/core/auth"
 	"atom-engine/src/core/logger"
 	"atom-engine/src/core/restapi/middleware"
 	"atom-engine/src/core/restapi/models"
@@ -187,12 +188,20 @@ func (h *MessagesHandler) PublishMessage(c *gin.Context) {
 		logger.String("correlation_key", req.CorrelationKey),
 		logger.String("tenant_id", req.TenantID))
 
+	if !authorizeRequest(c, requestID, auth.ActionCreate, auth.PermissionMessage, req.MessageName) {
+		return
+	}
+	tenantID, ok := resolveTenantRequest(c, requestID, req.TenantID)
+	if !ok {
+		return
+	}
+
 	// Create publish request message
 	publishReq := map[string]interface{}{
 		"type":       "publish_message",
 		"request_id": requestID,
 		"payload": map[string]interface{}{
-			"tenant_id":       req.TenantID,
+			"tenant_id":       tenantID,
 			"message_name":    req.MessageName,
 			"correlation_key": req.CorrelationKey,
 			"variables":       req.Variables,
@@ -307,6 +316,16 @@ func (h *MessagesHandler) ListBufferedMessages(c *gin.Context) {
 	// Parse messages and total count from response
 	messages := h.parseBufferedMessagesFromResponse(response)
 	totalCount := h.extractTotalCount(response)
+	if readFilter := newListFilter(c, nil, auth.ActionRead, auth.PermissionMessage); readFilter != nil {
+		visible := make([]BufferedMessage, 0, len(messages))
+		for _, message := range messages {
+			if readFilter.AllowsIn(message.TenantID, message.Name) {
+				visible = append(visible, message)
+			}
+		}
+		messages = visible
+		totalCount = len(visible)
+	}
 
 	logger.Info("Buffered messages listed",
 		logger.String("request_id", requestID),
@@ -376,6 +395,16 @@ func (h *MessagesHandler) ListSubscriptions(c *gin.Context) {
 	// Parse subscriptions and total count from response
 	subscriptions := h.parseSubscriptionsFromResponse(response)
 	totalCount := h.extractTotalCount(response)
+	if readFilter := newListFilter(c, nil, auth.ActionRead, auth.PermissionMessage); readFilter != nil {
+		visible := make([]MessageSubscription, 0, len(subscriptions))
+		for _, subscription := range subscriptions {
+			if readFilter.AllowsIn(subscription.TenantID, subscription.MessageName) {
+				visible = append(visible, subscription)
+			}
+		}
+		subscriptions = visible
+		totalCount = len(visible)
+	}
 
 	logger.Info("Message subscriptions listed",
 		logger.String("request_id", requestID),
@@ -406,6 +435,10 @@ func (h *MessagesHandler) GetStats(c *gin.Context) {
 		logger.String("request_id", requestID),
 		logger.String("tenant_id", tenantID))
 
+	if !authorizeRequest(c, requestID, auth.ActionRead, auth.PermissionMessage, "") {
+		return
+	}
+
 	// Create stats request
 	statsReq := map[string]interface{}{
 		"type":       "get_stats",
@@ -455,6 +488,10 @@ func (h *MessagesHandler) CleanupExpired(c *gin.Context) {
 		logger.String("request_id", requestID),
 		logger.String("tenant_id", tenantID))
 
+	if !authorizeRequest(c, requestID, auth.ActionDelete, auth.PermissionMessage, "") {
+		return
+	}
+
 	// Create cleanup request
 	cleanupReq := map[string]interface{}{
 		"type":       "cleanup_expired",
@@ -528,6 +565,13 @@ func (h *MessagesHandler) TestMessage(c *gin.Context) {
 		logger.String("request_id", requestID),
 		logger.String("message_name", req.MessageName))
 
+	if !authorizeRequest(c, requestID, auth.ActionCreate, auth.PermissionMessage, req.MessageName) {
+		return
+	}
+	if _, ok := resolveTenantRequest(c, requestID, req.TenantID); !ok {
+		return
+	}
+
 	// Create test response
 	testResponse := map[string]interface{}{
 		"message_name":    req.MessageName,
@@ -13,6 +13,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"math"
 	"mime/multipart"
 	"net/http"
 	"strconv"
@@ -23,10 +24,13 @@ import (
 	"google.golang.org/grpc"
 
 	"atom-engine/proto/parser/parserpb"
+	"atom-engine/src/core/auth"
 	"atom-engine/src/core/logger"
+	coremodels "atom-engine/src/core/models"
 	"atom-engine/src/core/restapi/middleware"
 	"atom-engine/src/core/restapi/models"
 	"atom-engine/src/core/restapi/utils"
+	"atom-engine/src/parser"
 )
 
 // ParserHandler handles BPMN parsing HTTP requests
@@ -51,6 +55,7 @@ type BPMNProcess struct {
 	Key          string                 `json:"key"`
 	Name         string                 `json:"name"`
 	Version      int32                  `json:"version"`
+	TenantID     string                 `json:"tenant_id,omitempty"`
 	Description  string                 `json:"description"`
 	CreatedAt    int64                  `json:"created_at"`
 	UpdatedAt    int64                  `json:"updated_at"`
@@ -65,6 +70,7 @@ type BPMNProcessDetails struct {
 	ProcessName    string           `json:"process_name"`
 	Version        string           `json:"version"`
 	ProcessVersion int32            `json:"process_version"`
+	TenantID       string           `json:"tenant_id,omitempty"`
 	Status         string           `json:"status"`
 	TotalElements  int32            `json:"total_elements"`
 	ElementCounts  map[string]int32 `json:"element_counts"`
@@ -122,6 +128,7 @@ func (h *ParserHandler) RegisterRoutes(router *gin.RouterGroup, authMiddleware *
 // @Produce json
 // @Param file formData file true "BPMN file"
 // @Param process_id formData string false "Process ID"
+// @Param tenant_id formData string false "Tenant to deploy process into"
 // @Param force formData boolean false "Force overwrite existing process"
 // @Success 201 {object} models.APIResponse{data=models.CreateResponse}
 // @Failure 400 {object} models.APIResponse{error=models.APIError}
@@ -186,6 +193,19 @@ func (h *ParserHandler) ParseBPMN(c *gin.Context) {
 	processID := c.Request.FormValue("process_id")
 	forceStr := c.Request.FormValue("force")
 	force, _ := strconv.ParseBool(forceStr)
+	tenantID, ok := resolveTenantRequest(c, requestID, c.Request.FormValue("tenant_id"))
+	if !ok {
+		return
+	}
+
+	// Deploy is checked against explicit process ID or the one declared in file
+	deployID := processID
+	if deployID == "" {
+		deployID, _ = parser.ExtractProcessID([]byte(bpmnContent))
+	}
+	if !authorizeTenantRequest(c, requestID, auth.ActionDeploy, auth.PermissionBPMN, tenantID, deployID) {
+		return
+	}
 
 	// Create parse request
 	parseReq := map[string]interface{}{
@@ -194,6 +214,7 @@ func (h *ParserHandler) ParseBPMN(c *gin.Context) {
 		"payload": map[string]interface{}{
 			"bpmn_content": bpmnContent,
 			"process_id":   processID,
+			"tenant_id":    tenantID,
 			"force":        force,
 		},
 	}
@@ -351,6 +372,14 @@ func (h *ParserHandler) ListProcesses(c *gin.Context) {
 		Page:      int32(params.Page),
 		SortBy:    "created_at",
 		SortOrder: "DESC",
+		TenantId:  c.Query("tenant_id"),
+	}
+
+	// Restricted callers are paged over visible processes only
+	readFilter := newListFilter(c, nil, auth.ActionRead, auth.PermissionBPMN)
+	if readFilter != nil {
+		grpcReq.PageSize = math.MaxInt32
+		grpcReq.Page = 1
 	}
 
 	resp, err := client.ListBPMNProcesses(ctx, grpcReq)
@@ -376,15 +405,8 @@ func (h *ParserHandler) ListProcesses(c *gin.Context) {
 		return
 	}
 
-	// Convert gRPC response to REST API format
-	processes := h.convertGRPCProcessesToREST(resp.Processes)
-
-	logger.Info("BPMN processes listed",
-		logger.String("request_id", requestID),
-		logger.Int("count", len(processes)),
-		logger.Int("total", int(resp.TotalCount)))
-
 	// Create pagination info from gRPC response
+	grpcProcesses := resp.Processes
 	paginationInfo := &models.PaginationInfo{
 		Page:    int(resp.Page),
 		Limit:   int(resp.PageSize),
@@ -393,6 +415,17 @@ func (h *ParserHandler) ListProcesses(c *gin.Context) {
 		HasNext: resp.Page < resp.TotalPages,
 		HasPrev: resp.Page > 1,
 	}
+	if readFilter != nil {
+		grpcProcesses, paginationInfo = filterBPMNProcesses(resp.Processes, readFilter, params.Page, params.Limit)
+	}
+
+	// Convert gRPC response to REST API format
+	processes := h.convertGRPCProcessesToREST(grpcProcesses)
+
+	logger.Info("BPMN processes listed",
+		logger.String("request_id", requestID),
+		logger.Int("count", len(processes)),
+		logger.Int("total", paginationInfo.Total))
 
 	paginatedResp := models.PaginatedSuccessResponse(processes, paginationInfo, requestID)
 	c.JSON(http.StatusOK, paginatedResp)
@@ -458,6 +491,12 @@ func (h *ParserHandler) GetProcess(c *gin.Context) {
 		return
 	}
 
+	if !authorizeTenantRequest(
+		c, requestID, auth.ActionRead, auth.PermissionBPMN, resp.Process.GetTenantId(), resp.Process.GetProcessId(),
+	) {
+		return
+	}
+
 	// Convert gRPC response to REST API format
 	processDetails := h.convertGRPCProcessDetailsToREST(resp.Process)
 
@@ -540,6 +579,7 @@ func (h *ParserHandler) convertGRPCProcessesToREST(grpcProcesses []*parserpb.BPM
 			Key:          grpcProcess.ProcessKey,
 			Name:         grpcProcess.ProcessName,
 			Version:      version,
+			TenantID:     grpcProcess.TenantId,
 			Description:  "", // Not available in gRPC summary
 			CreatedAt:    createdAt,
 			UpdatedAt:    updatedAt,
@@ -591,6 +631,10 @@ func (h *ParserHandler) DeleteBPMNProcess(c *gin.Context) {
 		logger.String("request_id", requestID),
 		logger.String("process_id", processID))
 
+	if !h.authorizeBPMN(c, requestID, auth.ActionDelete, processID) {
+		return
+	}
+
 	// Get gRPC client
 	client, conn, err := h.getParserGRPCClient()
 	if err != nil {
@@ -666,6 +710,10 @@ func (h *ParserHandler) GetBPMNStats(c *gin.Context) {
 	logger.Debug("Getting BPMN stats",
 		logger.String("request_id", requestID))
 
+	if !authorizeRequest(c, requestID, auth.ActionRead, auth.PermissionBPMN, "") {
+		return
+	}
+
 	// Get gRPC client
 	client, conn, err := h.getParserGRPCClient()
 	if err != nil {
@@ -766,6 +814,10 @@ func (h *ParserHandler) GetBPMNProcessJSON(c *gin.Context) {
 		logger.String("request_id", requestID),
 		logger.String("process_key", processKey))
 
+	if !h.authorizeBPMN(c, requestID, auth.ActionRead, processKey) {
+		return
+	}
+
 	// Get gRPC client
 	client, conn, err := h.getParserGRPCClient()
 	if err != nil {
@@ -860,6 +912,10 @@ func (h *ParserHandler) GetBPMNProcessXML(c *gin.Context) {
 		logger.String("request_id", requestID),
 		logger.String("process_key", processKey))
 
+	if !h.authorizeBPMN(c, requestID, auth.ActionRead, processKey) {
+		return
+	}
+
 	// Get gRPC client
 	client, conn, err := h.getParserGRPCClient()
 	if err != nil {
@@ -920,6 +976,53 @@ func (h *ParserHandler) GetBPMNProcessXML(c *gin.Context) {
 	c.String(http.StatusOK, resp.XmlData)
 }
 
+// authorizeBPMN checks action on stored BPMN process resolving its tenant and BPMN process ID by key
+func (h *ParserHandler) authorizeBPMN(c *gin.Context, requestID, action, processKey string) bool {
+	result, ok := middleware.GetAuthResult(c)
+	if !ok || (result.Authorize(action, auth.PermissionBPMN, "") && result.AllTenants()) {
+		return true
+	}
+
+	tenantID, _ := coremodels.SplitTenantScopedKey(processKey)
+	processID := ""
+	if client, conn, err := h.getParserGRPCClient(); err == nil {
+		defer conn.Close()
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		resp, err := client.GetBPMNProcess(ctx, &parserpb.GetBPMNProcessRequest{ProcessKey: processKey})
+		if err == nil && resp.Success {
+			tenantID, processID = resp.Process.GetTenantId(), resp.Process.GetProcessId()
+		}
+	}
+	return authorizeTenantRequest(c, requestID, action, auth.PermissionBPMN, tenantID, processID)
+}
+
+// filterBPMNProcesses keeps processes caller may read and pages them
+func filterBPMNProcesses(
+	processes []*parserpb.BPMNProcessSummary,
+	filter *listFilter,
+	page, limit int,
+) ([]*parserpb.BPMNProcessSummary, *models.PaginationInfo) {
+	visible := make([]*parserpb.BPMNProcessSummary, 0, len(processes))
+	for _, process := range processes {
+		if filter.AllowsIn(process.TenantId, process.ProcessId) {
+			visible = append(visible, process)
+		}
+	}
+
+	offset := utils.GetOffset(page, limit)
+	if offset > len(visible) {
+		offset = len(visible)
+	}
+	end := offset + limit
+	if end > len(visible) {
+		end = len(visible)
+	}
+	return visible[offset:end], utils.CalculatePaginationInfo(page, limit, len(visible))
+}
+
 // Helper method to get Parser gRPC client
 func (h *ParserHandler) getParserGRPCClient() (parserpb.ParserServiceClient, *grpc.ClientConn, error) {
 	conn, err := h.coreInterface.GetGRPCConnection()
@@ -948,6 +1051,7 @@ func (h *ParserHandler) convertGRPCProcessDetailsToREST(grpcDetails *parserpb.BP
 		ProcessName:    grpcDetails.ProcessName,
 		Version:        grpcDetails.Version,
 		ProcessVersion: grpcDetails.ProcessVersion,
+		TenantID:       grpcDetails.TenantId,
 		Status:         grpcDetails.Status,
 		TotalElements:  grpcDetails.TotalElements,
 		ElementCounts:  grpcDetails.ElementCounts,
@@ -9,13 +9,16 @@ This project is dual-licensed under AGPL-3.0 and AtomBPMN Commercial License.
 package handlers
 
 import (
+	"context"
 	"fmt"
+	"math"
 	"net/http"
 	"sort"
 	"strconv"
 
 	"github.com/gin-gonic/gin"
 
+	"atom-engine/src/core/auth"
 	"atom-engine/src/core/grpc"
 	"atom-engine/src/core/interfaces"
 	"atom-engine/src/core/logger"
@@ -50,7 +53,11 @@ type ProcessCoreInterface interface {
 
 // ProcessComponentInterface defines process component interface
 type ProcessComponentInterface interface {
-	StartProcessInstance(processKey string, variables map[string]interface{}) (*ProcessInstanceResult, error)
+	StartProcessInstance(
+		ctx context.Context,
+		processKey, tenantID string,
+		variables map[string]interface{},
+	) (*ProcessInstanceResult, error)
 	GetProcessInstanceStatus(instanceID string) (*ProcessInstanceResult, error)
 	CancelProcessInstance(instanceID string, reason string) error
 	ListProcessInstances(statusFilter string, processKeyFilter string, limit int) ([]*ProcessInstanceResult, error)
@@ -168,9 +175,19 @@ func (h *ProcessHandler) StartProcess(c *gin.Context) {
 		return
 	}
 
+	processID := processIDFromKey(req.ProcessKey)
+	if !authorizeRequest(c, requestID, auth.ActionCreate, auth.PermissionProcess, processID) {
+		return
+	}
+	tenantID, ok := resolveTenantRequest(c, requestID, req.TenantID)
+	if !ok {
+		return
+	}
+
 	logger.Debug("Starting process instance",
 		logger.String("request_id", requestID),
 		logger.String("process_key", req.ProcessKey),
+		logger.String("tenant_id", tenantID),
 		logger.String("client_ip", c.ClientIP()))
 
 	// Get process component
@@ -185,7 +202,7 @@ func (h *ProcessHandler) StartProcess(c *gin.Context) {
 	}
 
 	// Start process instance
-	result, err := processComp.StartProcessInstance(req.ProcessKey, req.Variables)
+	result, err := processComp.StartProcessInstance(c.Request.Context(), req.ProcessKey, tenantID, req.Variables)
 	if err != nil {
 		logger.Error("Failed to start process instance",
 			logger.String("request_id", requestID),
@@ -230,6 +247,7 @@ func (h *ProcessHandler) ListProcesses(c *gin.Context) {
 	limitStr := c.DefaultQuery("limit", "20")
 	status := c.Query("status")
 	processKey := c.Query("process_key")
+	tenantFilter := c.Query("tenant_id")
 
 	// Parse and validate pagination
 	paginationHelper := utils.NewPaginationHelper()
@@ -278,6 +296,22 @@ func (h *ProcessHandler) ListProcesses(c *gin.Context) {
 		return
 	}
 
+	// Skip instances of other tenants and of processes caller may not read
+	filter := newListFilter(c, h.coreInterface, auth.ActionRead, auth.PermissionProcess)
+	if filter != nil || tenantFilter != "" {
+		tenantID := models.NormalizeTenantID(tenantFilter)
+		allowed := instances[:0]
+		for _, instance := range instances {
+			if tenantFilter != "" && instance.TenantID != tenantID {
+				continue
+			}
+			if filter.AllowsIn(instance.TenantID, instance.ProcessID) {
+				allowed = append(allowed, instance)
+			}
+		}
+		instances = allowed
+	}
+
 	// Apply sorting by started_at DESC (consistent with gRPC/CLI behavior)
 	sort.Slice(instances, func(i, j int) bool {
 		return instances[i].StartedAt > instances[j].StartedAt
@@ -338,6 +372,10 @@ func (h *ProcessHandler) GetProcessStatus(c *gin.Context) {
 		return
 	}
 
+	if !authorizeInstanceRequest(c, requestID, h.coreInterface, auth.ActionRead, auth.PermissionProcess, instanceID) {
+		return
+	}
+
 	// Get process status
 	result, err := processComp.GetProcessInstanceStatus(instanceID)
 	if err != nil {
@@ -396,6 +434,10 @@ func (h *ProcessHandler) GetProcessInfo(c *gin.Context) {
 		return
 	}
 
+	if !authorizeInstanceRequest(c, requestID, h.coreInterface, auth.ActionRead, auth.PermissionProcess, instanceID) {
+		return
+	}
+
 	logger.Debug("Getting complete process instance information",
 		logger.String("request_id", requestID),
 		logger.String("instance_id", instanceID))
@@ -464,6 +506,10 @@ func (h *ProcessHandler) CancelProcess(c *gin.Context) {
 		return
 	}
 
+	if !authorizeInstanceRequest(c, requestID, h.coreInterface, auth.ActionDelete, auth.PermissionProcess, instanceID) {
+		return
+	}
+
 	// Cancel process instance
 	err := processComp.CancelProcessInstance(instanceID, req.Reason)
 	if err != nil {
@@ -513,6 +559,10 @@ func (h *ProcessHandler) GetProcessTokens(c *gin.Context) {
 		return
 	}
 
+	if !authorizeInstanceRequest(c, requestID, h.coreInterface, auth.ActionRead, auth.PermissionToken, instanceID) {
+		return
+	}
+
 	// Get active tokens for the process instance
 	tokens, err := processComp.GetActiveTokens(instanceID)
 	if err != nil {
@@ -581,6 +631,10 @@ func (h *ProcessHandler) GetTokenTrace(c *gin.Context) {
 		return
 	}
 
+	if !authorizeInstanceRequest(c, requestID, h.coreInterface, auth.ActionRead, auth.PermissionToken, instanceID) {
+		return
+	}
+
 	// Get all tokens for the process instance (for trace)
 	tokens, err := processComp.GetTokensByProcessInstance(instanceID)
 	if err != nil {
@@ -716,12 +770,23 @@ func (h *ProcessHandler) StartProcessTyped(c *gin.Context) {
 		return
 	}
 
+	processID := processIDFromKey(req.ProcessKey)
+	if !authorizeRequest(c, requestID, auth.ActionCreate, auth.PermissionProcess, processID) {
+		return
+	}
+	tenantID, ok := resolveTenantRequest(c, requestID, req.TenantID)
+	if !ok {
+		return
+	}
+
 	logger.Debug("Starting process instance with typed API",
 		logger.String("request_id", requestID),
 		logger.String("process_key", req.ProcessKey),
+		logger.String("tenant_id", tenantID),
 		logger.String("client_ip", c.ClientIP()))
 
 	// Use Core typed method
+	req.TenantID = tenantID
 	result, err := h.coreInterface.StartProcessTyped(&req)
 	if err != nil {
 		logger.Error("Failed to start process instance via typed API",
@@ -804,6 +869,13 @@ func (h *ProcessHandler) ListProcessesTyped(c *gin.Context) {
 		return
 	}
 
+	// Restricted callers get page assembled from instances they may read
+	filter := newListFilter(c, h.coreInterface, auth.ActionRead, auth.PermissionProcess)
+	limit, offset := req.Limit, req.Offset
+	if filter != nil {
+		req.Limit, req.Offset = math.MaxInt32, 0
+	}
+
 	// List process instances using typed method
 	result, err := processComp.ListProcessInstancesTyped(req)
 	if err != nil {
@@ -817,6 +889,10 @@ func (h *ProcessHandler) ListProcessesTyped(c *gin.Context) {
 		return
 	}
 
+	if filter != nil {
+		result = filterProcessList(result, filter, int(limit), int(offset))
+	}
+
 	logger.Debug("Listed process instances via typed API",
 		logger.String("request_id", requestID),
 		logger.Int("count", int(result.TotalCount)))
@@ -824,6 +900,31 @@ func (h *ProcessHandler) ListProcessesTyped(c *gin.Context) {
 	c.JSON(http.StatusOK, restmodels.SuccessResponse(result, requestID))
 }
 
+// filterProcessList drops instances caller may not read and applies pagination
+func filterProcessList(
+	result *types.ProcessListResponse,
+	filter *listFilter,
+	limit, offset int,
+) *types.ProcessListResponse {
+	allowed := make([]types.ProcessInstanceDetails, 0, len(result.Instances))
+	for _, instance := range result.Instances {
+		if filter.AllowsIn(instance.TenantID, instance.ProcessDefinitionID) {
+			allowed = append(allowed, instance)
+		}
+	}
+
+	filtered := &types.ProcessListResponse{TotalCount: int32(len(allowed))}
+	if offset < len(allowed) {
+		end := offset + limit
+		if end > len(allowed) {
+			end = len(allowed)
+		}
+		filtered.Instances = allowed[offset:end]
+		filtered.HasMore = end < len(allowed)
+	}
+	return filtered
+}
+
 // GetProcessStatusTyped handles GET /api/v1/processes/:id/typed
 // @Summary Get process instance status with typed response
 // @Description Get detailed process instance information using strongly typed API
@@ -857,6 +958,10 @@ func (h *ProcessHandler) GetProcessStatusTyped(c *gin.Context) {
 		return
 	}
 
+	if !authorizeInstanceRequest(c, requestID, h.coreInterface, auth.ActionRead, auth.PermissionProcess, instanceID) {
+		return
+	}
+
 	// Get process status using typed method
 	result, err := processComp.GetProcessInstanceStatusTyped(instanceID)
 	if err != nil {
@@ -913,6 +1018,10 @@ func (h *ProcessHandler) CancelProcessTyped(c *gin.Context) {
 		req.InstanceID = instanceID
 	}
 
+	if !authorizeInstanceRequest(c, requestID, h.coreInterface, auth.ActionDelete, auth.PermissionProcess, instanceID) {
+		return
+	}
+
 	logger.Debug("Cancelling process with typed API",
 		logger.String("request_id", requestID),
 		logger.String("instance_id", instanceID),
@@ -996,6 +1105,10 @@ func (h *ProcessHandler) GetProcessTokensTyped(c *gin.Context) {
 		return
 	}
 
+	if !authorizeInstanceRequest(c, requestID, h.coreInterface, auth.ActionRead, auth.PermissionToken, instanceID) {
+		return
+	}
+
 	// Get tokens using typed method
 	result, err := processComp.GetTokensTyped(req)
 	if err != nil {
@@ -1062,6 +1175,10 @@ func (h *ProcessHandler) TraceProcessExecutionTyped(c *gin.Context) {
 		return
 	}
 
+	if !authorizeInstanceRequest(c, requestID, h.coreInterface, auth.ActionRead, auth.PermissionProcess, instanceID) {
+		return
+	}
+
 	// Get execution trace using typed method
 	result, err := processComp.TraceProcessExecution(req)
 	if err != nil {
@@ -1107,6 +1224,10 @@ func (h *ProcessHandler) GetProcessStatsHandler(c *gin.Context) {
 		return
 	}
 
+	if !authorizeRequest(c, requestID, auth.ActionRead, auth.PermissionProcess, "") {
+		return
+	}
+
 	// Get process statistics using typed method
 	result, err := processComp.GetProcessStats()
 	if err != nil {
@@ -9,7 +9,11 @@ This project is dual-licensed under AGPL-3.0 and AtomBPMN Commercial License.
 package handlers
 
 import (
+	"fmt"
+	"io"
 	"net/http"
+	"strconv"
+	"time"
 
 	"github.com/gin-gonic/gin"
 
@@ -18,6 +22,7 @@ import (
 	"atom-engine/src/core/restapi/middleware"
 	"atom-engine/src/core/restapi/models"
 	"atom-engine/src/core/restapi/utils"
+	"atom-engine/src/storage"
 )
 
 // StorageHandler handles storage-related HTTP requests
@@ -30,6 +35,8 @@ type StorageHandler struct {
 type CoreInterface interface {
 	GetStorageStatus() (*interfaces.StorageStatusResponse, error)
 	GetStorageInfo() (*interfaces.StorageInfoResponse, error)
+	BackupStorage(w io.Writer, since uint64) (*storage.BackupMetadata, error)
+	RestoreStorage(r io.Reader, directory string) (*storage.BackupMetadata, error)
 }
 
 // Response types for storage operations
@@ -49,6 +56,12 @@ type StorageInfoResponse struct {
 	Statistics     map[string]string `json:"statistics"`
 }
 
+// StorageRestoreResponse describes restored backup
+type StorageRestoreResponse struct {
+	Directory string `json:"directory"`
+	*storage.BackupMetadata
+}
+
 // NewStorageHandler creates new storage handler
 func NewStorageHandler(coreInterface CoreInterface) *StorageHandler {
 	return &StorageHandler{
@@ -69,6 +82,14 @@ func (h *StorageHandler) RegisterRoutes(router *gin.RouterGroup, authMiddleware
 	{
 		storage.GET("/status", h.GetStatus)
 		storage.GET("/info", h.GetInfo)
+		if authMiddleware != nil {
+			admin := authMiddleware.RequirePermission("admin")
+			storage.GET("/backup", admin, h.Backup)
+			storage.POST("/restore", admin, h.Restore)
+		} else {
+			storage.GET("/backup", h.Backup)
+			storage.POST("/restore", h.Restore)
+		}
 	}
 }
 
@@ -161,6 +182,105 @@ func (h *StorageHandler) GetInfo(c *gin.Context) {
 	c.JSON(http.StatusOK, models.SuccessResponse(response, requestID))
 }
 
+// Backup handles GET /api/v1/storage/backup
+// @Summary Download storage backup
+// @Description Stream online consistent backup file. since = version of previous backup
+// @Description makes it incremental. Requires admin permission
+// @Tags storage
+// @Produce octet-stream
+// @Param since query int false "Version of previous backup, 0 = full backup"
+// @Success 200 {file} binary
+// @Failure 400 {object} models.APIResponse{error=models.APIError}
+// @Failure 401 {object} models.APIResponse{error=models.APIError}
+// @Failure 403 {object} models.APIResponse{error=models.APIError}
+// @Security ApiKeyAuth
+// @Router /api/v1/storage/backup [get]
+func (h *StorageHandler) Backup(c *gin.Context) {
+	requestID := h.getRequestID(c)
+
+	var since uint64
+	if value := c.Query("since"); value != "" {
+		parsed, err := strconv.ParseUint(value, 10, 64)
+		if err != nil {
+			apiErr := models.BadRequestError("since must be a non-negative integer")
+			c.JSON(http.StatusBadRequest, models.ErrorResponse(apiErr, requestID))
+			return
+		}
+		since = parsed
+	}
+
+	logger.Info("Creating storage backup",
+		logger.String("request_id", requestID),
+		logger.Any("since", since))
+
+	// Backup of large database outlives server write timeout
+	controller := http.NewResponseController(c.Writer)
+	_ = controller.SetWriteDeadline(time.Time{})
+
+	filename := fmt.Sprintf("atom-backup-%s.bak", time.Now().UTC().Format("20060102T150405"))
+	c.Header("Content-Type", "application/octet-stream")
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q", filename))
+	c.Status(http.StatusOK)
+
+	// Headers are sent, failure can only cut stream, which breaks backup file
+	if _, err := h.coreInterface.BackupStorage(c.Writer, since); err != nil {
+		logger.Error("Storage backup failed",
+			logger.String("request_id", requestID),
+			logger.String("error", err.Error()))
+		c.Abort()
+	}
+}
+
+// Restore handles POST /api/v1/storage/restore
+// @Summary Restore storage backup
+// @Description Restore backup file from request body into data directory not used by running
+// @Description engine. Full backup needs empty directory. Requires admin permission
+// @Tags storage
+// @Accept octet-stream
+// @Produce json
+// @Param directory query string true "Target data directory"
+// @Success 200 {object} models.APIResponse{data=StorageRestoreResponse}
+// @Failure 400 {object} models.APIResponse{error=models.APIError}
+// @Failure 401 {object} models.APIResponse{error=models.APIError}
+// @Failure 403 {object} models.APIResponse{error=models.APIError}
+// @Failure 409 {object} models.APIResponse{error=models.APIError}
+// @Security ApiKeyAuth
+// @Router /api/v1/storage/restore [post]
+func (h *StorageHandler) Restore(c *gin.Context) {
+	requestID := h.getRequestID(c)
+
+	directory := c.Query("directory")
+	if directory == "" {
+		apiErr := models.BadRequestError("directory is required")
+		c.JSON(http.StatusBadRequest, models.ErrorResponse(apiErr, requestID))
+		return
+	}
+
+	logger.Info("Restoring storage backup",
+		logger.String("request_id", requestID),
+		logger.String("directory", directory))
+
+	// Upload of large backup outlives server read timeout
+	controller := http.NewResponseController(c.Writer)
+	_ = controller.SetReadDeadline(time.Time{})
+
+	metadata, err := h.coreInterface.RestoreStorage(c.Request.Body, directory)
+	if err != nil {
+		logger.Error("Storage restore failed",
+			logger.String("request_id", requestID),
+			logger.String("error", err.Error()))
+		apiErr := models.ConflictError(err.Error())
+		c.JSON(http.StatusConflict, models.ErrorResponse(apiErr, requestID))
+		return
+	}
+
+	response := &StorageRestoreResponse{
+		Directory:      directory,
+		BackupMetadata: metadata,
+	}
+	c.JSON(http.StatusOK, models.SuccessResponse(response, requestID))
+}
+
 // getRequestID extracts request ID from context
 func (h *StorageHandler) getRequestID(c *gin.Context) string {
 	if requestID := c.GetHeader("X-Request-ID"); requestID != "" {
@@ -17,12 +17,17 @@ import (
 	"github.com/gin-gonic/gin"
 
 	"atom-engine/proto/timewheel/timewheelpb"
+	"atom-engine/src/core/auth"
+	"atom-engine/src/core/calendar"
+	"atom-engine/src/core/clock"
 	"atom-engine/src/core/grpc"
 	"atom-engine/src/core/logger"
 	coremodels "atom-engine/src/core/models"
 	"atom-engine/src/core/restapi/middleware"
 	"atom-engine/src/core/restapi/models"
 	"atom-engine/src/core/restapi/utils"
+	"atom-engine/src/storage"
+	"atom-engine/src/timewheel"
 )
 
 // TimerRequest represents timewheel timer request for JSON messaging
@@ -53,6 +58,25 @@ type TimerCoreInterface interface {
 	GetTimersList(statusFilter string, limit int32) (*timewheelpb.ListTimersResponse, error)
 }
 
+// timerStorageLookup defines storage access used to resolve instance that owns timer
+type timerStorageLookup interface {
+	GetStorage() interface{}
+}
+
+// ClockComponentInterface defines engine clock operations of timewheel component
+type ClockComponentInterface interface {
+	GetClockStatus() (timewheel.ClockStatus, error)
+	AdvanceClock(duration string) (*timewheel.ClockAdvanceResult, error)
+}
+
+// CalendarComponentInterface defines business calendar operations of timewheel component
+type CalendarComponentInterface interface {
+	PutCalendar(def *coremodels.BusinessCalendar) (*coremodels.BusinessCalendar, error)
+	GetCalendar(name string) (*coremodels.BusinessCalendar, error)
+	ListCalendars() []*coremodels.BusinessCalendar
+	DeleteCalendar(name string) error
+}
+
 // TimewheelComponentInterface defines timewheel component interface
 type TimewheelComponentInterface interface {
 	ProcessMessage(ctx context.Context, messageJSON string) error
@@ -121,6 +145,33 @@ func (h *TimerHandler) RegisterRoutes(router *gin.RouterGroup, authMiddleware *m
 		timers.DELETE("/:id", h.DeleteTimer)
 		timers.GET("/stats", h.GetStats)
 	}
+
+	clock := router.Group("/clock")
+	if authMiddleware != nil {
+		clock.Use(authMiddleware.RequirePermission("timer"))
+	}
+
+	{
+		clock.GET("", h.GetClock)
+		clock.POST("/advance", h.AdvanceClock)
+	}
+
+	calendars := router.Group("/calendars")
+	if authMiddleware != nil {
+		calendars.Use(authMiddleware.RequirePermission("timer"))
+	}
+
+	{
+		calendars.GET("", h.ListCalendars)
+		calendars.GET("/:name", h.GetCalendar)
+		if authMiddleware != nil {
+			calendars.PUT("/:name", authMiddleware.RequirePermission("admin"), h.PutCalendar)
+			calendars.DELETE("/:name", authMiddleware.RequirePermission("admin"), h.DeleteCalendar)
+		} else {
+			calendars.PUT("/:name", h.PutCalendar)
+			calendars.DELETE("/:name", h.DeleteCalendar)
+		}
+	}
 }
 
 // CreateTimer handles POST /api/v1/timers
@@ -173,7 +224,7 @@ func (h *TimerHandler) CreateTimer(c *gin.Context) {
 		},
 		func() *models.ValidationError {
 			if req.Repeating && req.Interval != "" {
-				return h.validator.ValidateISO8601Duration(req.Interval, "interval")
+				return h.validator.ValidateTimeCycle(req.Interval, "interval")
 			}
 			return nil
 		},
@@ -191,6 +242,16 @@ func (h *TimerHandler) CreateTimer(c *gin.Context) {
 		logger.String("duration", req.Duration),
 		logger.Bool("repeating", req.Repeating))
 
+	// Ad-hoc timers are not bound to process instance, creating them needs unrestricted grant
+	// in caller tenant
+	tenantID, ok := resolveTenantRequest(c, requestID, "")
+	if !ok {
+		return
+	}
+	if !authorizeTenantRequest(c, requestID, auth.ActionCreate, auth.PermissionTimer, tenantID, "") {
+		return
+	}
+
 	// Get timewheel component
 	timewheelComp := h.coreInterface.GetTimewheelComponent()
 	if timewheelComp == nil {
@@ -208,7 +269,9 @@ func (h *TimerHandler) CreateTimer(c *gin.Context) {
 		TokenID:           "rest-token-" + req.TimerID,   // Generate token ID for user timers
 		ProcessInstanceID: "rest-process-" + req.TimerID, // Generate process instance ID for user timers
 		TimerType:         coremodels.TimerTypeEvent,     // Use EVENT for user timers
-		ProcessContext:    nil,                           // No process context for user timers
+		ProcessContext: &coremodels.TimerProcessContext{ // Only tenant for user timers
+			TenantID: tenantID,
+		},
 	}
 
 	// Handle repeating vs one-time timers
@@ -285,6 +348,7 @@ func (h *TimerHandler) CreateTimer(c *gin.Context) {
 // @Param page query int false "Page number" default(1)
 // @Param limit query int false "Items per page" default(20)
 // @Param status query string false "Status filter (scheduled, fired, cancelled)"
+// @Param tenant_id query string false "Tenant ID filter"
 // @Success 200 {object} models.PaginatedResponse{data=[]TimerInfo}
 // @Failure 401 {object} models.APIResponse{error=models.APIError}
 // @Failure 403 {object} models.APIResponse{error=models.APIError}
@@ -298,6 +362,7 @@ func (h *TimerHandler) ListTimers(c *gin.Context) {
 	pageStr := c.DefaultQuery("page", "1")
 	limitStr := c.DefaultQuery("limit", "20")
 	status := c.Query("status")
+	tenantFilter := c.Query("tenant_id")
 
 	// Parse and validate pagination
 	paginationHelper := utils.NewPaginationHelper()
@@ -340,6 +405,21 @@ func (h *TimerHandler) ListTimers(c *gin.Context) {
 	// Apply client-side pagination
 	timers := timersResp.Timers
 	totalCount := int(timersResp.TotalCount)
+	readFilter := newListFilter(c, h.coreInterface, auth.ActionRead, auth.PermissionTimer)
+	if readFilter != nil || tenantFilter != "" {
+		tenantID := coremodels.NormalizeTenantID(tenantFilter)
+		visible := make([]*timewheelpb.TimerInfo, 0, len(timers))
+		for _, timer := range timers {
+			if tenantFilter != "" && timer.TenantId != tenantID {
+				continue
+			}
+			if readFilter.AllowsOwned(timer.TenantId, timer.ProcessInstanceId) {
+				visible = append(visible, timer)
+			}
+		}
+		timers = visible
+		totalCount = len(visible)
+	}
 
 	paginatedTimers, paginationInfo := utils.ApplyPagination(timers, params.Page, params.Limit)
 
@@ -379,6 +459,10 @@ func (h *TimerHandler) GetTimer(c *gin.Context) {
 		logger.String("request_id", requestID),
 		logger.String("timer_id", timerID))
 
+	if !h.authorizeTimer(c, requestID, auth.ActionRead, timerID) {
+		return
+	}
+
 	// Get timewheel component
 	timewheelComp := h.coreInterface.GetTimewheelComponent()
 	if timewheelComp == nil {
@@ -442,6 +526,10 @@ func (h *TimerHandler) DeleteTimer(c *gin.Context) {
 		logger.String("request_id", requestID),
 		logger.String("timer_id", timerID))
 
+	if !h.authorizeTimer(c, requestID, auth.ActionDelete, timerID) {
+		return
+	}
+
 	// Get timewheel component
 	timewheelComp := h.coreInterface.GetTimewheelComponent()
 	if timewheelComp == nil {
@@ -521,6 +609,10 @@ func (h *TimerHandler) GetStats(c *gin.Context) {
 	logger.Debug("Getting timer statistics",
 		logger.String("request_id", requestID))
 
+	if !authorizeRequest(c, requestID, auth.ActionRead, auth.PermissionTimer, "") {
+		return
+	}
+
 	// Get timewheel stats from core
 	stats, err := h.coreInterface.GetTimewheelStats()
 	if err != nil {
@@ -542,8 +634,291 @@ func (h *TimerHandler) GetStats(c *gin.Context) {
 	c.JSON(http.StatusOK, models.SuccessResponse(stats, requestID))
 }
 
+// GetClock handles GET /api/v1/clock
+// @Summary Get engine clock
+// @Description Get engine clock mode (real or manual) and current engine time
+// @Tags timers
+// @Produce json
+// @Success 200 {object} models.APIResponse{data=timewheel.ClockStatus}
+// @Failure 401 {object} models.APIResponse{error=models.APIError}
+// @Failure 403 {object} models.APIResponse{error=models.APIError}
+// @Failure 500 {object} models.APIResponse{error=models.APIError}
+// @Security ApiKeyAuth
+// @Router /api/v1/clock [get]
+func (h *TimerHandler) GetClock(c *gin.Context) {
+	requestID := h.getRequestID(c)
+
+	clockComp, ok := h.coreInterface.GetTimewheelComponent().(ClockComponentInterface)
+	if !ok {
+		apiErr := models.InternalServerError("Timer service not available")
+		c.JSON(http.StatusInternalServerError, models.ErrorResponse(apiErr, requestID))
+		return
+	}
+
+	status, err := clockComp.GetClockStatus()
+	if err != nil {
+		apiErr := h.converter.GRPCErrorToAPIError(err)
+		statusCode := models.HTTPStatusFromErrorCode(apiErr.Code)
+		c.JSON(statusCode, models.ErrorResponse(apiErr, requestID))
+		return
+	}
+
+	c.JSON(http.StatusOK, models.SuccessResponse(status, requestID))
+}
+
+// AdvanceClock handles POST /api/v1/clock/advance
+// @Summary Advance manual engine clock
+// @Description Move manual engine clock forward by ISO 8601 duration, firing every due timer in order.
+// @Description Available only when clock.mode is manual, requires admin permission
+// @Description even when authentication is disabled
+// @Tags timers
+// @Accept json
+// @Produce json
+// @Param request body models.AdvanceClockRequest true "Clock advance request"
+// @Success 200 {object} models.APIResponse{data=timewheel.ClockAdvanceResult}
+// @Failure 400 {object} models.APIResponse{error=models.APIError}
+// @Failure 401 {object} models.APIResponse{error=models.APIError}
+// @Failure 403 {object} models.APIResponse{error=models.APIError}
+// @Failure 409 {object} models.APIResponse{error=models.APIError}
+// @Failure 500 {object} models.APIResponse{error=models.APIError}
+// @Security ApiKeyAuth
+// @Router /api/v1/clock/advance [post]
+func (h *TimerHandler) AdvanceClock(c *gin.Context) {
+	requestID := h.getRequestID(c)
+
+	if _, err := clock.ManualClock(); err != nil {
+		c.JSON(http.StatusConflict, models.ErrorResponse(models.ConflictError(err.Error()), requestID))
+		return
+	}
+	// Advance fires every due timer, so it is never open to anonymous callers
+	if !requireAdmin(c, requestID) {
+		return
+	}
+
+	var req models.AdvanceClockRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		apiErr := models.BadRequestError("Invalid request body: " + err.Error())
+		c.JSON(http.StatusBadRequest, models.ErrorResponse(apiErr, requestID))
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse(models.BadRequestError(err.Error()), requestID))
+		return
+	}
+
+	if validationErr := h.validator.ValidateISO8601Duration(req.Duration, "duration"); validationErr != nil {
+		apiErr := h.validator.CreateValidationError([]models.ValidationError{*validationErr})
+		c.JSON(http.StatusBadRequest, models.ErrorResponse(apiErr, requestID))
+		return
+	}
+
+	clockComp, ok := h.coreInterface.GetTimewheelComponent().(ClockComponentInterface)
+	if !ok {
+		apiErr := models.InternalServerError("Timer service not available")
+		c.JSON(http.StatusInternalServerError, models.ErrorResponse(apiErr, requestID))
+		return
+	}
+
+	logger.Info("Advancing engine clock",
+		logger.String("request_id", requestID),
+		logger.String("duration", req.Duration))
+
+	result, err := clockComp.AdvanceClock(req.Duration)
+	if err != nil {
+		logger.Warn("Failed to advance engine clock",
+			logger.String("request_id", requestID),
+			logger.String("error", err.Error()))
+
+		apiErr := h.converter.GRPCErrorToAPIError(err)
+		statusCode := models.HTTPStatusFromErrorCode(apiErr.Code)
+		c.JSON(statusCode, models.ErrorResponse(apiErr, requestID))
+		return
+	}
+
+	c.JSON(http.StatusOK, models.SuccessResponse(result, requestID))
+}
+
+// ListCalendars handles GET /api/v1/calendars
+// @Summary List business calendars
+// @Description List business calendars defined in configuration and through API
+// @Tags timers
+// @Produce json
+// @Success 200 {object} models.APIResponse{data=models.ListResponse}
+// @Failure 401 {object} models.APIResponse{error=models.APIError}
+// @Failure 403 {object} models.APIResponse{error=models.APIError}
+// @Failure 500 {object} models.APIResponse{error=models.APIError}
+// @Security ApiKeyAuth
+// @Router /api/v1/calendars [get]
+func (h *TimerHandler) ListCalendars(c *gin.Context) {
+	requestID := h.getRequestID(c)
+
+	calendarComp, ok := h.coreInterface.GetTimewheelComponent().(CalendarComponentInterface)
+	if !ok {
+		apiErr := models.InternalServerError("Timer service not available")
+		c.JSON(http.StatusInternalServerError, models.ErrorResponse(apiErr, requestID))
+		return
+	}
+
+	calendars := calendarComp.ListCalendars()
+	c.JSON(http.StatusOK, models.SuccessResponse(&models.ListResponse{
+		Items:      calendars,
+		TotalCount: len(calendars),
+	}, requestID))
+}
+
+// GetCalendar handles GET /api/v1/calendars/:name
+// @Summary Get business calendar
+// @Description Get business calendar definition by name
+// @Tags timers
+// @Produce json
+// @Param name path string true "Calendar name"
+// @Success 200 {object} models.APIResponse{data=coremodels.BusinessCalendar}
+// @Failure 401 {object} models.APIResponse{error=models.APIError}
+// @Failure 403 {object} models.APIResponse{error=models.APIError}
+// @Failure 404 {object} models.APIResponse{error=models.APIError}
+// @Security ApiKeyAuth
+// @Router /api/v1/calendars/{name} [get]
+func (h *TimerHandler) GetCalendar(c *gin.Context) {
+	requestID := h.getRequestID(c)
+
+	calendarComp, ok := h.coreInterface.GetTimewheelComponent().(CalendarComponentInterface)
+	if !ok {
+		apiErr := models.InternalServerError("Timer service not available")
+		c.JSON(http.StatusInternalServerError, models.ErrorResponse(apiErr, requestID))
+		return
+	}
+
+	def, err := calendarComp.GetCalendar(c.Param("name"))
+	if err != nil {
+		apiErr := h.converter.GRPCErrorToAPIError(err)
+		statusCode := models.HTTPStatusFromErrorCode(apiErr.Code)
+		c.JSON(statusCode, models.ErrorResponse(apiErr, requestID))
+		return
+	}
+
+	c.JSON(http.StatusOK, models.SuccessResponse(def, requestID))
+}
+
+// PutCalendar handles PUT /api/v1/calendars/:name
+// @Summary Create or replace business calendar
+// @Description Create or replace business calendar of working hours, weekly schedule and holidays.
+// @Description Calendars from configuration are read-only, requires admin permission
+// @Tags timers
+// @Accept json
+// @Produce json
+// @Param name path string true "Calendar name"
+// @Param request body coremodels.BusinessCalendar true "Business calendar definition"
+// @Success 200 {object} models.APIResponse{data=coremodels.BusinessCalendar}
+// @Failure 400 {object} models.APIResponse{error=models.APIError}
+// @Failure 401 {object} models.APIResponse{error=models.APIError}
+// @Failure 403 {object} models.APIResponse{error=models.APIError}
+// @Failure 409 {object} models.APIResponse{error=models.APIError}
+// @Failure 500 {object} models.APIResponse{error=models.APIError}
+// @Security ApiKeyAuth
+// @Router /api/v1/calendars/{name} [put]
+func (h *TimerHandler) PutCalendar(c *gin.Context) {
+	requestID := h.getRequestID(c)
+
+	var def coremodels.BusinessCalendar
+	if err := c.ShouldBindJSON(&def); err != nil {
+		apiErr := models.BadRequestError("Invalid request body: " + err.Error())
+		c.JSON(http.StatusBadRequest, models.ErrorResponse(apiErr, requestID))
+		return
+	}
+
+	name := c.Param("name")
+	if def.Name != "" && def.Name != name {
+		apiErr := models.BadRequestError("calendar name in body does not match path")
+		c.JSON(http.StatusBadRequest, models.ErrorResponse(apiErr, requestID))
+		return
+	}
+	def.Name = name
+
+	if _, err := calendar.Compile(&def); err != nil {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse(models.BadRequestError(err.Error()), requestID))
+		return
+	}
+
+	calendarComp, ok := h.coreInterface.GetTimewheelComponent().(CalendarComponentInterface)
+	if !ok {
+		apiErr := models.InternalServerError("Timer service not available")
+		c.JSON(http.StatusInternalServerError, models.ErrorResponse(apiErr, requestID))
+		return
+	}
+
+	logger.Info("Saving business calendar",
+		logger.String("request_id", requestID),
+		logger.String("calendar", name))
+
+	saved, err := calendarComp.PutCalendar(&def)
+	if err != nil {
+		apiErr := h.converter.GRPCErrorToAPIError(err)
+		statusCode := models.HTTPStatusFromErrorCode(apiErr.Code)
+		c.JSON(statusCode, models.ErrorResponse(apiErr, requestID))
+		return
+	}
+
+	c.JSON(http.StatusOK, models.SuccessResponse(saved, requestID))
+}
+
+// DeleteCalendar handles DELETE /api/v1/calendars/:name
+// @Summary Delete business calendar
+// @Description Delete business calendar created through API, requires admin permission.
+// @Description Timers already scheduled keep their due dates
+// @Tags timers
+// @Produce json
+// @Param name path string true "Calendar name"
+// @Success 200 {object} models.APIResponse{data=models.DeleteResponse}
+// @Failure 401 {object} models.APIResponse{error=models.APIError}
+// @Failure 403 {object} models.APIResponse{error=models.APIError}
+// @Failure 404 {object} models.APIResponse{error=models.APIError}
+// @Failure 409 {object} models.APIResponse{error=models.APIError}
+// @Security ApiKeyAuth
+// @Router /api/v1/calendars/{name} [delete]
+func (h *TimerHandler) DeleteCalendar(c *gin.Context) {
+	requestID := h.getRequestID(c)
+	name := c.Param("name")
+
+	calendarComp, ok := h.coreInterface.GetTimewheelComponent().(CalendarComponentInterface)
+	if !ok {
+		apiErr := models.InternalServerError("Timer service not available")
+		c.JSON(http.StatusInternalServerError, models.ErrorResponse(apiErr, requestID))
+		return
+	}
+
+	if err := calendarComp.DeleteCalendar(name); err != nil {
+		apiErr := h.converter.GRPCErrorToAPIError(err)
+		statusCode := models.HTTPStatusFromErrorCode(apiErr.Code)
+		c.JSON(statusCode, models.ErrorResponse(apiErr, requestID))
+		return
+	}
+
+	c.JSON(http.StatusOK, models.SuccessResponse(&models.DeleteResponse{
+		ID:      name,
+		Message: "Business calendar deleted",
+	}, requestID))
+}
+
 // Helper methods
 
+// authorizeTimer checks action on timer by its tenant and process of instance that owns it
+func (h *TimerHandler) authorizeTimer(c *gin.Context, requestID, action, timerID string) bool {
+	if _, ok := middleware.GetAuthResult(c); !ok {
+		return true
+	}
+
+	instanceID, tenantID := "", coremodels.DefaultTenantID
+	if lookup, ok := h.coreInterface.(timerStorageLookup); ok {
+		if storageComp, ok := lookup.GetStorage().(storage.Storage); ok {
+			if timerRecord, err := storageComp.LoadTimer(timerID); err == nil && timerRecord != nil {
+				instanceID, tenantID = timerRecord.ProcessInstanceID, timerRecord.TenantID
+			}
+		}
+	}
+	return authorizeOwnedRequest(c, requestID, h.coreInterface, action, auth.PermissionTimer, tenantID, instanceID)
+}
+
 func (h *TimerHandler) getRequestID(c *gin.Context) string {
 	if requestID := c.GetHeader("X-Request-ID"); requestID != "" {
 		return requestID
@@ -18,6 +18,7 @@ import (
 	"google.golang.org/grpc"
 
 	"atom-engine/proto/process/processpb"
+	"atom-engine/src/core/auth"
 	"atom-engine/src/core/logger"
 	"atom-engine/src/core/restapi/middleware"
 	"atom-engine/src/core/restapi/models"
@@ -147,6 +148,12 @@ func (h *TokensHandler) GetTokenStatus(c *gin.Context) {
 		return
 	}
 
+	if !authorizeInstanceRequest(
+		c, requestID, h.coreInterface, auth.ActionRead, auth.PermissionToken, resp.Token.ProcessInstanceId,
+	) {
+		return
+	}
+
 	// Convert gRPC token to REST API format
 	token := &TokenInfo{
 		ID:                resp.Token.TokenId,
@@ -75,6 +75,7 @@ func (am *AuthMiddleware) Authenticate() gin.HandlerFunc {
 			c.Request.URL.Path,
 			authHeader,
 		)
+		authCtx.SetClientCertificate(c.Request.TLS)
 
 		// Validate auth context
 		if err := auth.ValidateAuthContext(authCtx); err != nil {
@@ -125,6 +126,9 @@ func (am *AuthMiddleware) Authenticate() gin.HandlerFunc {
 			case strings.Contains(authResult.Reason, "API key"):
 				apiErr = models.UnauthorizedError("Invalid or missing API key")
 				statusCode = http.StatusUnauthorized
+			case strings.Contains(authResult.Reason, "token"):
+				apiErr = models.UnauthorizedError("Invalid or expired bearer token")
+				statusCode = http.StatusUnauthorized
 			default:
 				apiErr = models.UnauthorizedError("Authentication failed")
 				statusCode = http.StatusUnauthorized
@@ -149,9 +153,16 @@ func (am *AuthMiddleware) Authenticate() gin.HandlerFunc {
 	}
 }
 
-// RequirePermission middleware that checks for specific permission
+// RequirePermission middleware that checks access to resource type. Handlers check
+// action and resource ID, admin routes need all actions on admin for role grants
 func (am *AuthMiddleware) RequirePermission(permission string) gin.HandlerFunc {
 	return func(c *gin.Context) {
+		// Auth disabled - no auth result to check
+		if !am.authComponent.IsEnabled() {
+			c.Next()
+			return
+		}
+
 		// Get auth result from context
 		authResult, exists := c.Get("auth_result")
 		if !exists {
@@ -169,7 +180,12 @@ func (am *AuthMiddleware) RequirePermission(permission string) gin.HandlerFunc {
 			return
 		}
 
-		if !auth.HasPermission(result.Permissions, permission) {
+		allowed := result.HasAccess(permission)
+		if permission == auth.PermissionAdmin {
+			allowed = result.Authorize(auth.ActionAll, permission, "")
+		}
+
+		if !allowed {
 			logger.Warn("Insufficient permissions",
 				logger.String("method", c.Request.Method),
 				logger.String("path", c.Request.URL.Path),
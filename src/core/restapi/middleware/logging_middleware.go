@@ -11,6 +11,7 @@ package middleware
 import (
 	"bytes"
 	"io"
+	"net/http"
 	"time"
 
 	"github.com/gin-gonic/gin"
@@ -331,6 +332,12 @@ func (rw *responseWriter) Write(data []byte) (int, error) {
 	return n, err
 }
 
+// Unwrap returns wrapped writer, so http.ResponseController of streaming
+// handlers reaches connection deadlines
+func (rw *responseWriter) Unwrap() http.ResponseWriter {
+	return rw.ResponseWriter
+}
+
 // GetConfig returns logging configuration
 func (lm *LoggingMiddleware) GetConfig() *LoggingConfig {
 	return lm.config
@@ -0,0 +1,69 @@
+/*
+This file is part of the AtomBPMN (R) project.
+Copyright (c) 2025 Matreska Market LLC (ООО «Matreska Market»).
+Authors: Matreska Team.
+
+This project is dual-licensed under AGPL-3.0 and AtomBPMN Commercial License.
+*/
+
+package middleware
+
+import (
+	"fmt"
+
+	"github.com/gin-gonic/gin"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+
+	"atom-engine/src/core/tracing"
+)
+
+// TracingMiddleware opens server span per REST request, continuing trace from
+// incoming traceparent header. Span is named by route template. Health and
+// scrape endpoints are skipped.
+type TracingMiddleware struct {
+	skipPaths []string
+}
+
+// NewTracingMiddleware creates new tracing middleware
+func NewTracingMiddleware() *TracingMiddleware {
+	return &TracingMiddleware{
+		skipPaths: []string{"/health", "/metrics"},
+	}
+}
+
+// Handler provides Gin middleware for request spans
+func (tm *TracingMiddleware) Handler() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		for _, path := range tm.skipPaths {
+			if c.Request.URL.Path == path {
+				c.Next()
+				return
+			}
+		}
+
+		route := c.FullPath()
+		if route == "" {
+			route = "unmatched"
+		}
+
+		ctx := tracing.ContextWithTraceparent(c.Request.Context(), c.GetHeader(tracing.TraceparentKey))
+		ctx, span := tracing.Tracer().Start(ctx, c.Request.Method+" "+route,
+			trace.WithSpanKind(trace.SpanKindServer),
+			trace.WithAttributes(
+				attribute.String("http.request.method", c.Request.Method),
+				attribute.String("http.route", route),
+			))
+		defer span.End()
+
+		c.Request = c.Request.WithContext(ctx)
+		c.Next()
+
+		status := c.Writer.Status()
+		span.SetAttributes(attribute.Int("http.response.status_code", status))
+		if status >= 500 {
+			span.SetStatus(codes.Error, fmt.Sprintf("HTTP %d", status))
+		}
+	}
+}
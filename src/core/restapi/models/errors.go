@@ -44,9 +44,10 @@ const (
 	ErrorCodeInstanceNotFound = "INSTANCE_NOT_FOUND"
 
 	// Job errors
-	ErrorCodeJobNotFound    = "JOB_NOT_FOUND"
-	ErrorCodeJobFailed      = "JOB_FAILED"
-	ErrorCodeWorkerNotFound = "WORKER_NOT_FOUND"
+	ErrorCodeJobNotFound     = "JOB_NOT_FOUND"
+	ErrorCodeJobFailed       = "JOB_FAILED"
+	ErrorCodeWorkerNotFound  = "WORKER_NOT_FOUND"
+	ErrorCodePayloadTooLarge = "PAYLOAD_TOO_LARGE"
 
 	// Timer errors
 	ErrorCodeTimerNotFound   = "TIMER_NOT_FOUND"
@@ -68,6 +69,9 @@ const (
 	// BPMN errors
 	ErrorCodeBPMNParseError      = "BPMN_PARSE_ERROR"
 	ErrorCodeBPMNValidationError = "BPMN_VALIDATION_ERROR"
+
+	// Event stream errors
+	ErrorCodeSequenceUnavailable = "SEQUENCE_UNAVAILABLE"
 )
 
 // APIError represents API error response
@@ -145,6 +149,12 @@ func HTTPStatusFromErrorCode(code string) int {
 	case ErrorCodeRateLimited:
 		return http.StatusTooManyRequests
 
+	case ErrorCodePayloadTooLarge:
+		return http.StatusRequestEntityTooLarge
+
+	case ErrorCodeSequenceUnavailable:
+		return http.StatusGone
+
 	case ErrorCodeInternalError, ErrorCodeProcessFailed, ErrorCodeJobFailed,
 		ErrorCodeTimerFailed, ErrorCodeMessageFailed, ErrorCodeCorrelationFailed,
 		ErrorCodeExpressionError, ErrorCodeStorageError, ErrorCodeDatabaseError:
@@ -184,6 +194,14 @@ func RateLimitedError(message string) *APIError {
 	return NewAPIError(ErrorCodeRateLimited, message)
 }
 
+func PayloadTooLargeError(message string) *APIError {
+	return NewAPIError(ErrorCodePayloadTooLarge, message)
+}
+
+func SequenceUnavailableError(message string) *APIError {
+	return NewAPIError(ErrorCodeSequenceUnavailable, message)
+}
+
 func ProcessNotFoundError(processID string) *APIError {
 	return NewAPIErrorWithDetails(
 		ErrorCodeProcessNotFound,
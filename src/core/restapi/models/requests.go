@@ -67,6 +67,11 @@ type ListTimersRequest struct {
 	PaginationParams
 }
 
+// AdvanceClockRequest represents manual engine clock advance request
+type AdvanceClockRequest struct {
+	Duration string `json:"duration" binding:"required"` // ISO 8601 duration, e.g. PT2H
+}
+
 // Job Management Requests
 
 // CreateJobRequest represents job creation request
@@ -79,6 +84,7 @@ type CreateJobRequest struct {
 	Variables         map[string]interface{} `json:"variables,omitempty"`
 	Retries           int32                  `json:"retries,omitempty"`
 	TimeoutMs         int64                  `json:"timeout_ms,omitempty"`
+	Priority          int32                  `json:"priority,omitempty"`
 }
 
 // ActivateJobsRequest represents job activation request
@@ -88,6 +94,7 @@ type ActivateJobsRequest struct {
 	MaxJobs        int32    `json:"max_jobs,omitempty"`
 	TimeoutMs      int64    `json:"timeout_ms,omitempty"`
 	FetchVariables []string `json:"fetch_variables,omitempty"`
+	TenantIDs      []string `json:"tenant_ids,omitempty"` // Activate jobs of these tenants, "<default>" = default tenant
 }
 
 // CompleteJobRequest represents job completion request
@@ -207,10 +214,11 @@ type ListIncidentsRequest struct {
 
 // ResolveIncidentRequest represents incident resolution request
 type ResolveIncidentRequest struct {
-	Action     string `json:"action" binding:"required,oneof=retry dismiss"`
-	Comment    string `json:"comment,omitempty"`
-	ResolvedBy string `json:"resolved_by,omitempty"`
-	NewRetries int32  `json:"new_retries,omitempty"`
+	Action     string                 `json:"action" binding:"required,oneof=retry dismiss"`
+	Comment    string                 `json:"comment,omitempty"`
+	ResolvedBy string                 `json:"resolved_by,omitempty"`
+	NewRetries int32                  `json:"new_retries,omitempty"`
+	Variables  map[string]interface{} `json:"variables,omitempty"` // Variable patch applied before retry
 }
 
 // Token Management Requests
@@ -257,6 +265,13 @@ func (r *AddTimerRequest) Validate() error {
 	return nil
 }
 
+func (r *AdvanceClockRequest) Validate() error {
+	if r.Duration == "" {
+		return BadRequestError("duration is required")
+	}
+	return nil
+}
+
 func (r *PublishMessageRequest) Validate() error {
 	if r.MessageName == "" {
 		return BadRequestError("message_name is required")
@@ -18,13 +18,16 @@ import (
 	"github.com/gin-gonic/gin"
 
 	"atom-engine/src/core/auth"
+	"atom-engine/src/core/config"
 	"atom-engine/src/core/interfaces"
 	"atom-engine/src/core/logger"
+	"atom-engine/src/core/metrics"
 	coremodels "atom-engine/src/core/models"
 	"atom-engine/src/core/restapi/handlers"
 	"atom-engine/src/core/restapi/middleware"
 	"atom-engine/src/core/restapi/models"
 	"atom-engine/src/core/restapi/utils"
+	"atom-engine/src/core/tlsconfig"
 )
 
 // Config holds REST API server configuration
@@ -35,6 +38,7 @@ type Config struct {
 	Logging   *middleware.LoggingConfig   `yaml:"logging"`
 	RateLimit *middleware.RateLimitConfig `yaml:"rate_limit"`
 	Swagger   *SwaggerConfig              `yaml:"swagger"`
+	TLS       config.TLSConfig            `yaml:"tls"`
 }
 
 // SwaggerConfig holds Swagger documentation configuration
@@ -66,6 +70,7 @@ func DefaultConfig() *Config {
 type Server struct {
 	config        *Config
 	httpServer    *http.Server
+	tls           *tlsconfig.Manager
 	router        *gin.Engine
 	coreInterface CoreInterface
 	authComponent auth.Component
@@ -75,6 +80,8 @@ type Server struct {
 	corsMiddleware      *middleware.CORSMiddleware
 	loggingMiddleware   *middleware.LoggingMiddleware
 	rateLimitMiddleware *middleware.RateLimitMiddleware
+	metricsMiddleware   *middleware.MetricsMiddleware
+	tracingMiddleware   *middleware.TracingMiddleware
 
 	// Handler instances
 	storageHandler    *handlers.StorageHandler
@@ -86,6 +93,10 @@ type Server struct {
 	messagesHandler   *handlers.MessagesHandler
 	expressionHandler *handlers.ExpressionHandler
 	incidentsHandler  *handlers.IncidentsHandler
+	batchHandler      *handlers.BatchHandler
+	eventsHandler     *handlers.EventsHandler
+	exportersHandler  *handlers.ExportersHandler
+	authHandler       *handlers.AuthHandler
 	systemHandler     *handlers.SystemHandler
 }
 
@@ -153,7 +164,11 @@ type StorageComponentInterface interface {
 }
 
 type ProcessComponentInterface interface {
-	StartProcessInstance(processKey string, variables map[string]interface{}) (*ProcessInstanceResult, error)
+	StartProcessInstance(
+		ctx context.Context,
+		processKey, tenantID string,
+		variables map[string]interface{},
+	) (*ProcessInstanceResult, error)
 	GetProcessInstanceStatus(instanceID string) (*ProcessInstanceResult, error)
 	CancelProcessInstance(instanceID string, reason string) error
 	ListProcessInstances(statusFilter string, processKeyFilter string, limit int) ([]*ProcessInstanceResult, error)
@@ -208,6 +223,10 @@ func (s *Server) setupHandlers() {
 	s.messagesHandler = handlers.NewMessagesHandler(s.coreInterface)
 	s.expressionHandler = handlers.NewExpressionHandler(s.coreInterface)
 	s.incidentsHandler = handlers.NewIncidentsHandler(s.coreInterface)
+	s.batchHandler = handlers.NewBatchHandler(s.coreInterface)
+	s.eventsHandler = handlers.NewEventsHandler()
+	s.exportersHandler = handlers.NewExportersHandler(s.coreInterface)
+	s.authHandler = handlers.NewAuthHandler(s.coreInterface)
 	s.systemHandler = handlers.NewSystemHandler(s.coreInterface)
 }
 
@@ -231,6 +250,14 @@ func (s *Server) setupMiddleware() {
 	// Recovery middleware (built-in)
 	s.router.Use(gin.Recovery())
 
+	// Metrics middleware, before auth and rate limiting so rejections are measured
+	s.metricsMiddleware = middleware.NewMetricsMiddleware()
+	s.router.Use(s.metricsMiddleware.Handler())
+
+	// Tracing middleware, request context carries server span for handlers
+	s.tracingMiddleware = middleware.NewTracingMiddleware()
+	s.router.Use(s.tracingMiddleware.Handler())
+
 	// CORS middleware
 	if s.config.CORS != nil {
 		s.corsMiddleware = middleware.NewCORSMiddleware(s.config.CORS)
@@ -261,6 +288,9 @@ func (s *Server) setupRoutes() {
 	// Health check endpoint (no auth required)
 	s.router.GET("/health", s.healthHandler)
 
+	// Prometheus scrape endpoint (no auth required)
+	s.router.GET("/metrics", s.metricsHandler)
+
 	// API v1 routes
 	v1 := s.router.Group("/api/v1")
 	{
@@ -283,6 +313,10 @@ func (s *Server) setupRoutes() {
 		s.messagesHandler.RegisterRoutes(v1, s.authMiddleware)
 		s.expressionHandler.RegisterRoutes(v1, s.authMiddleware)
 		s.incidentsHandler.RegisterRoutes(v1, s.authMiddleware)
+		s.batchHandler.RegisterRoutes(v1, s.authMiddleware)
+		s.eventsHandler.RegisterRoutes(v1, s.authMiddleware)
+		s.exportersHandler.RegisterRoutes(v1, s.authMiddleware)
+		s.authHandler.RegisterRoutes(v1, s.authMiddleware)
 		s.systemHandler.RegisterRoutes(v1, s.authMiddleware)
 	}
 
@@ -305,12 +339,30 @@ func (s *Server) Start() error {
 		IdleTimeout:  120 * time.Second,
 	}
 
+	if s.config.TLS.Enabled {
+		manager, err := tlsconfig.NewManager(s.config.TLS)
+		if err != nil {
+			return fmt.Errorf("failed to configure REST API TLS: %w", err)
+		}
+		s.tls = manager
+		s.httpServer.TLSConfig = manager.ServerConfig("h2", "http/1.1")
+		manager.Start()
+	}
+
 	logger.Info("Starting REST API server",
 		logger.String("address", addr),
-		logger.Int("port", s.config.Port))
+		logger.Int("port", s.config.Port),
+		logger.Bool("tls", s.tls != nil))
 
 	go func() {
-		if err := s.httpServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+		var err error
+		if s.tls != nil {
+			// Certificate is served by TLS config, files are reloaded by manager
+			err = s.httpServer.ListenAndServeTLS("", "")
+		} else {
+			err = s.httpServer.ListenAndServe()
+		}
+		if err != nil && err != http.ErrServerClosed {
 			logger.Error("REST API server failed", logger.String("error", err.Error()))
 		}
 	}()
@@ -326,6 +378,10 @@ func (s *Server) Stop() error {
 
 	logger.Info("Stopping REST API server")
 
+	if s.tls != nil {
+		s.tls.Stop()
+	}
+
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
 
@@ -352,6 +408,12 @@ func (s *Server) healthHandler(c *gin.Context) {
 	c.JSON(http.StatusOK, models.SuccessResponse(response, "health"))
 }
 
+// metricsHandler handles Prometheus scrape requests, OpenMetrics format is
+// returned when scraper accepts it, Prometheus text format otherwise
+func (s *Server) metricsHandler(c *gin.Context) {
+	metrics.Default.Handler().ServeHTTP(c.Writer, c.Request)
+}
+
 // swaggerHandler serves Swagger documentation
 func (s *Server) swaggerHandler(c *gin.Context) {
 	c.HTML(http.StatusOK, "swagger.html", gin.H{
@@ -190,7 +190,8 @@ func (c *Converter) GRPCErrorToAPIError(err error) *models.APIError {
 	switch {
 	case contains(errMsg, "not found"):
 		return models.NotFoundError(errMsg)
-	case contains(errMsg, "already exists"):
+	case contains(errMsg, "already exists"), contains(errMsg, "not manual"),
+		contains(errMsg, "defined in configuration"), contains(errMsg, "state conflict"):
 		return models.ConflictError(errMsg)
 	case contains(errMsg, "invalid"):
 		return models.BadRequestError(errMsg)
@@ -200,6 +201,8 @@ func (c *Converter) GRPCErrorToAPIError(err error) *models.APIError {
 		return models.ForbiddenError(errMsg)
 	case contains(errMsg, "rate limit"):
 		return models.RateLimitedError(errMsg)
+	case contains(errMsg, "payload too large"):
+		return models.PayloadTooLargeError(errMsg)
 	default:
 		return models.InternalServerError(errMsg)
 	}
@@ -17,6 +17,7 @@ import (
 	"unicode/utf8"
 
 	"atom-engine/src/core/restapi/models"
+	"atom-engine/src/timewheel"
 )
 
 // Validator provides request validation utilities
@@ -140,17 +141,13 @@ func (v *Validator) ValidateISO8601Duration(value, fieldName string) *models.Val
 		return nil // Allow empty for optional fields
 	}
 
-	// Basic ISO 8601 duration pattern
-	patterns := []string{
-		`^P(?:\d+Y)?(?:\d+M)?(?:\d+D)?(?:T(?:\d+H)?(?:\d+M)?(?:\d+(?:\.\d+)?S)?)?$`, // PT30S, P1D, etc.
-		`^R\d*/P.*$`, // R5/PT30S - repeating
-		`^R/P.*$`,    // R/PT30S - infinite repeating
-	}
-
-	for _, pattern := range patterns {
-		if matched, _ := regexp.MatchString(pattern, value); matched {
-			return nil
+	parser := timewheel.NewISO8601DurationParser()
+	if strings.HasPrefix(strings.ToUpper(value), "R") {
+		if _, err := parser.ParseTimeCycle(value); err == nil {
+			return nil // R5/PT30S - repeating
 		}
+	} else if _, err := parser.ParseCalendarDuration(value); err == nil {
+		return nil // PT30S, P1D, etc.
 	}
 
 	return &models.ValidationError{
@@ -160,6 +157,23 @@ func (v *Validator) ValidateISO8601Duration(value, fieldName string) *models.Val
 	}
 }
 
+// ValidateTimeCycle validates timer cycle: ISO 8601 repeating interval or cron expression
+func (v *Validator) ValidateTimeCycle(value, fieldName string) *models.ValidationError {
+	if value == "" {
+		return nil // Allow empty for optional fields
+	}
+
+	if _, err := timewheel.NewISO8601DurationParser().ParseTimeCycle(value); err != nil {
+		return &models.ValidationError{
+			Field:   fieldName,
+			Value:   value,
+			Message: fmt.Sprintf("%s must be ISO 8601 repeating interval or cron expression: %v", fieldName, err),
+		}
+	}
+
+	return nil
+}
+
 // ValidateEmail validates email format
 func (v *Validator) ValidateEmail(value, fieldName string) *models.ValidationError {
 	pattern := `^[a-zA-Z0-9._%+-]+@[a-zA-Z0-9.-]+\.[a-zA-Z]{2,}$`
@@ -20,22 +20,27 @@ import (
 	"sync"
 	"time"
 
+	"atom-engine/src/batch"
 	"atom-engine/src/core/auth"
+	"atom-engine/src/core/clock"
 	"atom-engine/src/core/config"
 	"atom-engine/src/core/grpc"
 	"atom-engine/src/core/interfaces"
 	"atom-engine/src/core/logger"
+	"atom-engine/src/core/metrics"
 	"atom-engine/src/core/models"
 	"atom-engine/src/core/restapi"
 	"atom-engine/src/core/restapi/handlers"
 	"atom-engine/src/core/system"
 	"atom-engine/src/core/types"
+	"atom-engine/src/exporters"
 	"atom-engine/src/expression"
 	"atom-engine/src/incidents"
 	"atom-engine/src/jobs"
 	"atom-engine/src/messages"
 	"atom-engine/src/parser"
 	"atom-engine/src/process"
+	"atom-engine/src/retention"
 	"atom-engine/src/storage"
 	"atom-engine/src/timewheel"
 	"atom-engine/src/version"
@@ -56,11 +61,18 @@ type Core struct {
 	messagesComp   *messages.Component
 	expressionComp *expression.Component
 	incidentsComp  *incidents.Component
+	batchComp      *batch.Component
+	exportersComp  *exporters.Component
+	retentionComp  *retention.Component
 	authComp       auth.Component
 	loggerReady    bool
 	mu             sync.RWMutex
 	running        bool
 
+	// Flushes and closes trace exporter on shutdown
+	// Выгружает и закрывает экспортер трассировки при остановке
+	tracingShutdown func(context.Context) error
+
 	// Additional fields for typed interface implementation
 	// Дополнительные поля для реализации typed интерфейса
 	startTime      time.Time
@@ -97,6 +109,14 @@ func NewCoreWithConfig(cfg *config.Config) (*Core, error) {
 	// Устанавливаем имя инстанса для генерации ID
 	models.SetInstanceName(cfg.InstanceName)
 
+	// Install engine clock before components read time
+	// Устанавливаем часы движка до того как компоненты начнут читать время
+	engineClock, err := clock.New(cfg.Clock.Mode, cfg.Clock.Start)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create engine clock: %w", err)
+	}
+	clock.SetDefault(engineClock)
+
 	storageConfig := &storage.Config{
 		Path:    cfg.Database.Path,
 		Options: convertStorageOptions(&cfg.Storage.Options),
@@ -132,11 +152,23 @@ func NewCoreWithConfig(cfg *config.Config) (*Core, error) {
 	// Инициализируем incidents компонент с storage
 	incidentsComp := incidents.NewComponent(cfg, storageInstance)
 
+	// Initialize batch operations component with config and storage
+	// Инициализируем компонент пакетных операций с конфигурацией и storage
+	batchComp := batch.NewComponent(cfg, storageInstance)
+
+	// Initialize exporters component with config and storage
+	// Инициализируем компонент экспортеров с конфигурацией и storage
+	exportersComp := exporters.NewComponent(cfg, storageInstance)
+
+	// Initialize retention component with config and storage
+	// Инициализируем компонент политик хранения с конфигурацией и storage
+	retentionComp := retention.NewComponent(cfg, storageInstance)
+
 	// Initialize auth component
 	// Инициализируем auth компонент
 	authComp := auth.NewComponent()
 
-	return &Core{
+	core := &Core{
 		config:        cfg,
 		storage:       storageInstance,
 		timewheelComp: timewheelComp,
@@ -147,6 +179,9 @@ func NewCoreWithConfig(cfg *config.Config) (*Core, error) {
 		messagesComp:   messagesComp,
 		expressionComp: expressionComp,
 		incidentsComp:  incidentsComp,
+		batchComp:      batchComp,
+		exportersComp:  exportersComp,
+		retentionComp:  retentionComp,
 		authComp:       authComp,
 		loggerReady:    false,
 		running:        false,
@@ -156,7 +191,13 @@ func NewCoreWithConfig(cfg *config.Config) (*Core, error) {
 		startTime:        time.Now(),
 		isShuttingDown:   false,
 		cpuCacheDuration: 5 * time.Second, // Cache CPU metrics for 5 seconds
-	}, nil
+	}
+
+	// Refresh component gauges on every Prometheus scrape
+	// Обновляем gauge компонентов при каждом сборе Prometheus
+	metrics.Default.OnCollect(core.collectMetrics)
+
+	return core, nil
 }
 
 // GetMessagesComponent returns messages component
@@ -179,6 +220,16 @@ func (c *Core) GetIncidentsComponent() interface{} {
 	return c.incidentsComp
 }
 
+// GetBatchComponent returns batch operations component
+func (c *Core) GetBatchComponent() interface{} {
+	return c.batchComp
+}
+
+// GetExportersComponent returns exporters component
+func (c *Core) GetExportersComponent() interface{} {
+	return c.exportersComp
+}
+
 // GetParserComponent returns parser component
 func (c *Core) GetParserComponent() interface{} {
 	return c.parserComp
@@ -379,6 +430,12 @@ func (c *Core) getComponentByName(componentName string) interface{} {
 		return c.expressionComp
 	case "incidents":
 		return c.incidentsComp
+	case "batch":
+		return c.batchComp
+	case "exporters":
+		return c.exportersComp
+	case "retention":
+		return c.retentionComp
 	case "storage":
 		return c.storage
 	default:
@@ -743,7 +800,7 @@ func (c *Core) StartProcessTyped(req *types.ProcessStartRequest) (*types.Process
 		variables[k] = v
 	}
 
-	result, err := c.processComp.StartProcessInstance(req.ProcessKey, variables)
+	result, err := c.processComp.StartProcessInstance(context.Background(), req.ProcessKey, req.TenantID, variables)
 	if err != nil {
 		return &types.ProcessStartResponse{
 			ProcessKey: req.ProcessKey,
@@ -1038,6 +1095,54 @@ func (c *Core) gatherComponentsInfo() []types.ComponentInfo {
 		components = append(components, comp)
 	}
 
+	// Batch operations component
+	if c.batchComp != nil {
+		comp := types.ComponentInfo{
+			Name:        "batch",
+			Type:        types.ComponentTypeBatch,
+			Status:      types.ComponentStatusRunning,
+			Health:      types.ComponentHealthHealthy,
+			Description: "Batch operations component",
+			IsEnabled:   true,
+			ReadyFlag:   c.batchComp.IsReady(),
+			StartedAt:   &c.startTime,
+			Uptime:      &[]time.Duration{now.Sub(c.startTime)}[0],
+		}
+		components = append(components, comp)
+	}
+
+	// Exporters component
+	if c.exportersComp != nil {
+		comp := types.ComponentInfo{
+			Name:        "exporters",
+			Type:        types.ComponentTypeExporters,
+			Status:      types.ComponentStatusRunning,
+			Health:      types.ComponentHealthHealthy,
+			Description: "Export log and outbound exporters component",
+			IsEnabled:   true,
+			ReadyFlag:   c.exportersComp.IsReady(),
+			StartedAt:   &c.startTime,
+			Uptime:      &[]time.Duration{now.Sub(c.startTime)}[0],
+		}
+		components = append(components, comp)
+	}
+
+	// Retention component
+	if c.retentionComp != nil {
+		comp := types.ComponentInfo{
+			Name:        "retention",
+			Type:        types.ComponentTypeRetention,
+			Status:      types.ComponentStatusRunning,
+			Health:      types.ComponentHealthHealthy,
+			Description: "History retention and cleanup component",
+			IsEnabled:   c.config.Retention.Enabled,
+			ReadyFlag:   c.retentionComp.IsReady(),
+			StartedAt:   &c.startTime,
+			Uptime:      &[]time.Duration{now.Sub(c.startTime)}[0],
+		}
+		components = append(components, comp)
+	}
+
 	return components
 }
 
@@ -20,6 +20,7 @@ import (
 func (c *Core) startGRPCServer() error {
 	grpcConfig := &grpc.Config{
 		Port: c.config.GRPC.Port,
+		TLS:  c.config.GRPC.TLS,
 	}
 
 	if grpcConfig.Port == 0 {
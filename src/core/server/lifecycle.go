@@ -9,10 +9,14 @@ This project is dual-licensed under AGPL-3.0 and AtomBPMN Commercial License.
 package server
 
 import (
+	"context"
 	"fmt"
+	"time"
 
+	"atom-engine/src/core/events"
 	"atom-engine/src/core/logger"
 	"atom-engine/src/core/models"
+	"atom-engine/src/core/tracing"
 )
 
 // Start initializes and starts all components
@@ -33,6 +37,26 @@ func (c *Core) Start() error {
 	c.loggerReady = true
 	logger.Info("Logger initialized successfully")
 
+	// Initialize trace export before components start emitting spans
+	// Инициализируем экспорт трассировки до того как компоненты начнут выпускать спаны
+	c.tracingShutdown, err = tracing.Init(&c.config.Tracing)
+	if err != nil {
+		logger.Error("Failed to initialize tracing", logger.String("error", err.Error()))
+		return fmt.Errorf("failed to initialize tracing: %w", err)
+	}
+	if c.config.Tracing.Enabled {
+		logger.Info("Tracing initialized",
+			logger.String("exporter", c.config.Tracing.Exporter),
+			logger.String("service_name", c.config.Tracing.ServiceName))
+	}
+
+	// Event broker must be configured before components publish events
+	// Брокер событий настраивается до того как компоненты начнут публиковать события
+	if err := events.Init(&c.config.Events); err != nil {
+		logger.Error("Failed to initialize event stream", logger.String("error", err.Error()))
+		return fmt.Errorf("failed to initialize event stream: %w", err)
+	}
+
 	// Create PID file
 	err = c.createPIDFile()
 	if err != nil {
@@ -60,14 +84,45 @@ func (c *Core) Start() error {
 		return fmt.Errorf("storage is not ready")
 	}
 
+	// Exporters record engine events, so they start before components publishing them
+	// Экспортеры записывают события движка, поэтому запускаются до публикующих их компонентов
+	err = c.exportersComp.Init()
+	if err != nil {
+		logger.Error("Failed to initialize exporters component", logger.String("error", err.Error()))
+		return fmt.Errorf("failed to initialize exporters component: %w", err)
+	}
+
+	err = c.exportersComp.Start()
+	if err != nil {
+		logger.Error("Failed to start exporters component", logger.String("error", err.Error()))
+		return fmt.Errorf("failed to start exporters component: %w", err)
+	}
+
 	// Initialize and start timewheel component
 	// Инициализируем и запускаем timewheel компонент
-	err = c.timewheelComp.Initialize("") // Use default config
+	wheelConfig, err := c.timewheelConfigJSON()
+	if err != nil {
+		logger.Error("Failed to build timewheel config", logger.String("error", err.Error()))
+		return fmt.Errorf("failed to build timewheel config: %w", err)
+	}
+	err = c.timewheelComp.Initialize(wheelConfig)
 	if err != nil {
 		logger.Error("Failed to initialize timewheel", logger.String("error", err.Error()))
 		return fmt.Errorf("failed to initialize timewheel: %w", err)
 	}
 
+	// Business calendars must be known before timers are scheduled or restored
+	// Бизнес-календари должны быть известны до планирования и восстановления таймеров
+	calendars := make([]*models.BusinessCalendar, 0, len(c.config.Calendars))
+	for _, calendarConfig := range c.config.Calendars {
+		calendars = append(calendars, calendarConfig.Definition())
+	}
+	err = c.timewheelComp.LoadCalendars(calendars)
+	if err != nil {
+		logger.Error("Failed to load business calendars", logger.String("error", err.Error()))
+		return fmt.Errorf("failed to load business calendars: %w", err)
+	}
+
 	err = c.timewheelComp.Start()
 	if err != nil {
 		logger.Error("Failed to start timewheel", logger.String("error", err.Error()))
@@ -111,6 +166,11 @@ func (c *Core) Start() error {
 
 	// Initialize and start parser component
 	// Инициализируем и запускаем parser компонент
+
+	// Process component schedules timer start events of deployed definitions
+	// Process компонент планирует таймерные стартовые события развернутых определений
+	c.parserComp.SetDeploymentListener(c.processComp)
+
 	err = c.parserComp.Init()
 	if err != nil {
 		logger.Error("Failed to initialize parser component", logger.String("error", err.Error()))
@@ -158,6 +218,7 @@ func (c *Core) Start() error {
 	// Set core interface for job retries management
 	// Устанавливаем интерфейс core для управления retries работ
 	c.incidentsComp.SetCore(c)
+	c.incidentsComp.SetMailSender(sendIncidentEmail)
 
 	err = c.incidentsComp.Init()
 	if err != nil {
@@ -171,6 +232,37 @@ func (c *Core) Start() error {
 		return fmt.Errorf("failed to start incidents component: %w", err)
 	}
 
+	// Initialize and start batch operations component
+	// Инициализируем и запускаем компонент пакетных операций
+	c.batchComp.SetProcessComponent(c.processComp)
+	c.batchComp.SetIncidentsComponent(c.incidentsComp)
+
+	err = c.batchComp.Init()
+	if err != nil {
+		logger.Error("Failed to initialize batch component", logger.String("error", err.Error()))
+		return fmt.Errorf("failed to initialize batch component: %w", err)
+	}
+
+	err = c.batchComp.Start()
+	if err != nil {
+		logger.Error("Failed to start batch component", logger.String("error", err.Error()))
+		return fmt.Errorf("failed to start batch component: %w", err)
+	}
+
+	// Initialize and start retention component
+	// Инициализируем и запускаем компонент политик хранения
+	err = c.retentionComp.Init()
+	if err != nil {
+		logger.Error("Failed to initialize retention component", logger.String("error", err.Error()))
+		return fmt.Errorf("failed to initialize retention component: %w", err)
+	}
+
+	err = c.retentionComp.Start()
+	if err != nil {
+		logger.Error("Failed to start retention component", logger.String("error", err.Error()))
+		return fmt.Errorf("failed to start retention component: %w", err)
+	}
+
 	// Initialize and start auth component
 	// Инициализируем и запускаем auth компонент
 	err = c.authComp.Initialize(&c.config.Auth)
@@ -273,6 +365,10 @@ func (c *Core) Stop() error {
 		logger.Warn("Failed to log shutdown event to storage", logger.String("error", err.Error()))
 	}
 
+	// End event streams first, servers wait for open streams on graceful stop
+	// Сначала завершаем потоки событий, серверы ждут открытые потоки при остановке
+	events.Close()
+
 	// Stop gRPC server
 	c.stopGRPCServer()
 
@@ -290,6 +386,28 @@ func (c *Core) Stop() error {
 		}
 	}
 
+	// Stop retention component
+	// Останавливаем компонент политик хранения
+	if c.retentionComp != nil {
+		err := c.retentionComp.Stop()
+		if err != nil {
+			logger.Error("Failed to stop retention component", logger.String("error", err.Error()))
+		} else {
+			logger.Info("Retention component stopped")
+		}
+	}
+
+	// Stop batch operations component
+	// Останавливаем компонент пакетных операций
+	if c.batchComp != nil {
+		err := c.batchComp.Stop()
+		if err != nil {
+			logger.Error("Failed to stop batch component", logger.String("error", err.Error()))
+		} else {
+			logger.Info("Batch component stopped")
+		}
+	}
+
 	// Stop incidents component
 	// Останавливаем incidents компонент
 	if c.incidentsComp != nil {
@@ -374,6 +492,17 @@ func (c *Core) Stop() error {
 		}
 	}
 
+	// Stop exporters after every component publishing events
+	// Останавливаем экспортеры после всех компонентов, публикующих события
+	if c.exportersComp != nil {
+		err := c.exportersComp.Stop()
+		if err != nil {
+			logger.Error("Failed to stop exporters component", logger.String("error", err.Error()))
+		} else {
+			logger.Info("Exporters component stopped")
+		}
+	}
+
 	// Stop storage
 	err = c.storage.Stop()
 	if err != nil {
@@ -382,6 +511,16 @@ func (c *Core) Stop() error {
 		return fmt.Errorf("failed to stop storage: %w", err)
 	}
 
+	// Flush spans of stopped components
+	// Выгружаем спаны остановленных компонентов
+	if c.tracingShutdown != nil {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		if err := c.tracingShutdown(ctx); err != nil {
+			logger.Warn("Failed to flush traces", logger.String("error", err.Error()))
+		}
+		cancel()
+	}
+
 	c.running = false
 	logger.Info("Atom Engine shutdown completed")
 
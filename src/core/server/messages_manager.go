@@ -51,6 +51,7 @@ func (c *Core) handleMessagesResponse(response string) {
 		MessageName       string                 `json:"message_name"`
 		CorrelationKey    string                 `json:"correlation_key"`
 		TokenID           string                 `json:"token_id"`
+		TenantID          string                 `json:"tenant_id"`
 		ProcessInstanceID string                 `json:"process_instance_id"`
 		Variables         map[string]interface{} `json:"variables"`
 		CorrelatedAt      string                 `json:"correlated_at"`
@@ -89,6 +90,7 @@ func (c *Core) handleMessagesResponse(response string) {
 				messageResp.MessageName,
 				messageResp.CorrelationKey,
 				messageResp.TokenID,
+				messageResp.TenantID,
 				messageResp.Variables,
 			); err != nil {
 				logger.Error("Failed to handle message callback in process component",
@@ -0,0 +1,87 @@
+/*
+This file is part of the AtomBPMN (R) project.
+Copyright (c) 2025 Matreska Market LLC (ООО «Matreska Market»).
+Authors: Matreska Team.
+
+This project is dual-licensed under AGPL-3.0 and AtomBPMN Commercial License.
+*/
+
+package server
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"atom-engine/src/core/logger"
+	"atom-engine/src/core/metrics"
+	"atom-engine/src/core/models"
+	"atom-engine/src/incidents"
+)
+
+// metricsCollectTimeout bounds component queries of single scrape
+const metricsCollectTimeout = 5 * time.Second
+
+// collectMetrics refreshes gauges read from component state before scrape
+// Обновляет gauge из состояния компонентов перед сбором метрик
+func (c *Core) collectMetrics() {
+	ctx, cancel := context.WithTimeout(context.Background(), metricsCollectTimeout)
+	defer cancel()
+
+	if c.storage == nil || !c.storage.IsReady() {
+		return
+	}
+
+	lsmBytes, vlogBytes := c.storage.GetDiskUsage()
+	metrics.BadgerLSMSize.WithLabelValues().Set(float64(lsmBytes))
+	metrics.BadgerVlogSize.WithLabelValues().Set(float64(vlogBytes))
+
+	if pending, err := c.storage.ListJobsByType(ctx, "", models.JobStatusPending, 0); err == nil {
+		metrics.JobQueueDepth.Reset()
+		for _, job := range pending {
+			metrics.JobQueueDepth.WithLabelValues(job.Type).Add(1)
+		}
+	} else {
+		logger.Debug("Failed to collect job queue metrics", logger.String("error", err.Error()))
+	}
+
+	if buffered, err := c.storage.ListBufferedMessages(ctx, "", 0, 0); err == nil {
+		metrics.MessagesBuffered.WithLabelValues().Set(float64(len(buffered)))
+	} else {
+		logger.Debug("Failed to collect buffered message metrics", logger.String("error", err.Error()))
+	}
+
+	if c.timewheelComp != nil {
+		if stats, err := c.timewheelComp.GetStats(); err == nil {
+			metrics.TimewheelTimers.Reset()
+			for _, level := range stats.LevelStats {
+				metrics.TimewheelTimers.WithLabelValues(strconv.Itoa(level.LevelID)).Set(float64(level.TotalTimers))
+			}
+		}
+	}
+
+	if c.incidentsComp != nil && c.incidentsComp.IsReady() {
+		open, _, err := c.incidentsComp.ListIncidents(ctx, &incidents.IncidentFilter{
+			Status: []incidents.IncidentStatus{incidents.IncidentStatusOpen},
+		})
+		if err == nil {
+			metrics.IncidentsOpen.Reset()
+			for _, incident := range open {
+				metrics.IncidentsOpen.WithLabelValues(string(incident.Type)).Add(1)
+			}
+		} else {
+			logger.Debug("Failed to collect incident metrics", logger.String("error", err.Error()))
+		}
+	}
+
+	if c.exportersComp != nil && c.exportersComp.IsReady() {
+		if status, err := c.exportersComp.Status(); err == nil {
+			metrics.ExporterLag.Reset()
+			for _, exporter := range status.Exporters {
+				metrics.ExporterLag.WithLabelValues(exporter.ID).Set(float64(exporter.Lag))
+			}
+		} else {
+			logger.Debug("Failed to collect exporter metrics", logger.String("error", err.Error()))
+		}
+	}
+}
@@ -9,13 +9,16 @@ This project is dual-licensed under AGPL-3.0 and AtomBPMN Commercial License.
 package server
 
 import (
+	"context"
 	"fmt"
 	"time"
 
+	"atom-engine/src/core/clock"
 	"atom-engine/src/core/grpc"
 	"atom-engine/src/core/interfaces"
 	"atom-engine/src/core/models"
 	"atom-engine/src/core/types"
+	"atom-engine/src/incidents"
 	"atom-engine/src/process"
 )
 
@@ -28,6 +31,49 @@ func (c *Core) GetProcessComponent() grpc.ProcessComponentInterface {
 	return &processComponentAdapter{comp: c.processComp}
 }
 
+// RetryIncidentElement re-executes element of failed token for incident retry
+// Повторно выполняет элемент проваленного токена для повтора инцидента
+func (c *Core) RetryIncidentElement(
+	processInstanceID, tokenID, elementID string,
+	variables map[string]interface{},
+) error {
+	if c.processComp == nil {
+		return fmt.Errorf("process component not available")
+	}
+	return c.processComp.RetryElement(processInstanceID, tokenID, elementID, variables)
+}
+
+// sendIncidentEmail sends incident notification email through email connector sender
+// Отправляет письмо уведомления об инциденте через отправителя email коннектора
+func sendIncidentEmail(email *incidents.NotificationEmail) error {
+	authType := ""
+	if email.Username != "" {
+		authType = "simple"
+	}
+
+	_, err := process.SendEmail(&process.EmailConnectorConfig{
+		Authentication: process.EmailAuthConfig{
+			Type:     authType,
+			Username: email.Username,
+			Password: email.Password,
+		},
+		Protocol: "smtp",
+		SMTPConfig: process.SMTPConfig{
+			Host:                  email.Host,
+			Port:                  email.Port,
+			CryptographicProtocol: email.Protocol,
+		},
+		Action: process.EmailMessage{
+			From:        email.From,
+			To:          email.To,
+			Subject:     email.Subject,
+			ContentType: "PLAIN",
+			Body:        email.Body,
+		},
+	})
+	return err
+}
+
 // processComponentAdapter adapts process component to gRPC interface
 // Адаптирует process компонент к gRPC интерфейсу
 type processComponentAdapter struct {
@@ -37,10 +83,11 @@ type processComponentAdapter struct {
 // StartProcessInstance starts new process instance
 // Запускает новый экземпляр процесса
 func (a *processComponentAdapter) StartProcessInstance(
-	processKey string,
+	ctx context.Context,
+	processKey, tenantID string,
 	variables map[string]interface{},
 ) (*interfaces.ProcessInstanceResult, error) {
-	instance, err := a.comp.StartProcessInstance(processKey, variables)
+	instance, err := a.comp.StartProcessInstance(ctx, processKey, tenantID, variables)
 	if err != nil {
 		return nil, err
 	}
@@ -49,6 +96,7 @@ func (a *processComponentAdapter) StartProcessInstance(
 		InstanceID:  instance.InstanceID,
 		ProcessID:   instance.ProcessID,
 		ProcessName: instance.ProcessName,
+		TenantID:    instance.TenantID,
 		State:       string(instance.State),
 		StartedAt:   instance.StartedAt.Unix(),
 		Variables:   instance.Variables,
@@ -74,6 +122,7 @@ func (a *processComponentAdapter) GetProcessInstanceStatus(
 		InstanceID:      instance.InstanceID,
 		ProcessID:       instance.ProcessID,
 		ProcessName:     instance.ProcessName,
+		TenantID:        instance.TenantID,
 		Status:          string(instance.State),
 		State:           string(instance.State),
 		CurrentActivity: instance.CurrentActivity,
@@ -114,6 +163,7 @@ func (a *processComponentAdapter) ListProcessInstances(
 			InstanceID:      instance.InstanceID,
 			ProcessID:       instance.ProcessID,
 			ProcessName:     instance.ProcessName,
+			TenantID:        instance.TenantID,
 			Status:          string(instance.State),
 			State:           string(instance.State),
 			CurrentActivity: instance.CurrentActivity,
@@ -156,13 +206,13 @@ func (a *processComponentAdapter) StartProcessInstanceTyped(
 		legacyVars[k] = v
 	}
 
-	instance, err := a.comp.StartProcessInstance(processKey, legacyVars)
+	instance, err := a.comp.StartProcessInstance(context.Background(), processKey, models.DefaultTenantID, legacyVars)
 	if err != nil {
 		return nil, err
 	}
 
 	// Convert to typed response
-	now := time.Now()
+	now := clock.Now()
 	// Get active tokens for this instance
 	activeTokens, err := a.comp.GetActiveTokens(instance.InstanceID)
 	if err != nil {
@@ -194,6 +244,7 @@ func (a *processComponentAdapter) StartProcessInstanceTyped(
 		ProcessKey:          instance.ProcessKey, // Use actual process key from instance
 		ProcessDefinitionID: instance.ProcessID,
 		Version:             int32(instance.ProcessVersion), // Use actual version from instance
+		TenantID:            instance.TenantID,
 		Status:              types.ProcessStatus(instance.State),
 		Variables:           variables,
 		StartedAt:           instance.StartedAt,
@@ -258,6 +309,7 @@ func (a *processComponentAdapter) GetProcessInstanceStatusTyped(
 		ProcessKey:          instance.ProcessKey, // Use actual process key from instance
 		ProcessDefinitionID: instance.ProcessID,
 		Version:             int32(instance.ProcessVersion), // Use actual version from instance
+		TenantID:            instance.TenantID,
 		Status:              types.ProcessStatus(instance.State),
 		Variables:           variables,
 		StartedAt:           instance.StartedAt,
@@ -297,6 +349,19 @@ func (a *processComponentAdapter) ListProcessInstancesTyped(
 		return nil, err
 	}
 
+	// Tenant filter, default tenant is selected by DefaultTenantName
+	// Фильтр по арендатору
+	if req.TenantID != nil && *req.TenantID != "" {
+		tenantID := models.NormalizeTenantID(*req.TenantID)
+		filtered := instances[:0]
+		for _, instance := range instances {
+			if instance.TenantID == tenantID {
+				filtered = append(filtered, instance)
+			}
+		}
+		instances = filtered
+	}
+
 	// Store total count before pagination
 	totalCount := len(instances)
 
@@ -340,6 +405,7 @@ func (a *processComponentAdapter) ListProcessInstancesTyped(
 			ProcessKey:          instance.ProcessKey, // Use actual process key from instance
 			ProcessDefinitionID: instance.ProcessID,
 			Version:             int32(instance.ProcessVersion), // Use actual version from instance
+			TenantID:            instance.TenantID,
 			Status:              types.ProcessStatus(instance.State),
 			Variables:           variables,
 			StartedAt:           instance.StartedAt,
@@ -605,7 +671,7 @@ func (a *processComponentAdapter) TraceProcessExecution(
 			Status:            types.ProcessStatusActive,
 			Tokens:            tokenInfos,
 			ExecutionPath:     executionPath,
-			StartedAt:         time.Now(),
+			StartedAt:         clock.Now(),
 			CompletedAt:       nil,
 			Duration:          totalDuration,
 			TotalTokens:       int32(len(tokenInfos)),
@@ -11,6 +11,7 @@ package server
 import (
 	"fmt"
 
+	"atom-engine/src/core/models"
 	"atom-engine/src/core/restapi/handlers"
 	"atom-engine/src/jobs"
 	"atom-engine/src/parser"
@@ -97,10 +98,10 @@ func (c *Core) GetProcessInfoForREST(instanceID string) (map[string]interface{},
 			}); ok {
 				// Get all BPMN processes and find matching one
 				if processes, err := typedParserComp.ListBPMNProcesses(100); err == nil {
-					// Find the latest version for this process ID
+					// Find the latest version for this process ID in instance tenant
 					var latestProcess *parser.ProcessInfo
 					for _, process := range processes {
-						if process.ProcessID == processID {
+						if process.ProcessID == processID && process.TenantID == processStatus.TenantID {
 							if latestProcess == nil || process.ProcessVersion > latestProcess.ProcessVersion {
 								latestProcess = process
 							}
@@ -108,7 +109,8 @@ func (c *Core) GetProcessInfoForREST(instanceID string) (map[string]interface{},
 					}
 					if latestProcess != nil {
 						bpmnProcessKey = latestProcess.BPMNID
-						processKey = fmt.Sprintf("%s:v%d", latestProcess.ProcessID, latestProcess.ProcessVersion)
+						processKey = models.TenantScopedKey(latestProcess.TenantID,
+							fmt.Sprintf("%s:v%d", latestProcess.ProcessID, latestProcess.ProcessVersion))
 					}
 				}
 			}
@@ -121,6 +123,7 @@ func (c *Core) GetProcessInfoForREST(instanceID string) (map[string]interface{},
 		"process_key":       processKey,
 		"bpmn_process_key":  bpmnProcessKey,
 		"process_name":      processStatus.ProcessName,
+		"tenant_id":         processStatus.TenantID,
 		"state":             processStatus.State,
 		"created_at":        processStatus.CreatedAt,
 		"updated_at":        processStatus.UpdatedAt,
@@ -164,7 +167,7 @@ func (c *Core) buildExternalServicesForREST(instanceID, processKey string) map[s
 
 	// Get jobs using jobs component - cast to jobs.Component
 	if jobsComp, ok := c.GetJobsComponent().(*jobs.Component); jobsComp != nil && ok {
-		if jobInfos, _, err := jobsComp.ListJobs("", "", instanceID, "", 1000, 0); err == nil {
+		if jobInfos, _, err := jobsComp.ListJobs("", "", instanceID, "", "", 1000, 0); err == nil {
 			var jobsList []map[string]interface{}
 			for _, jobInfo := range jobInfos {
 				jobMap := map[string]interface{}{
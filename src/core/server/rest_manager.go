@@ -21,6 +21,7 @@ func (c *Core) startRESTServer() error {
 	restConfig := &restapi.Config{
 		Host: c.config.RestAPI.Host,
 		Port: c.config.RestAPI.Port,
+		TLS:  c.config.RestAPI.TLS,
 	}
 
 	if restConfig.Port == 0 {
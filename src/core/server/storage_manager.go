@@ -10,9 +10,12 @@ package server
 
 import (
 	"fmt"
+	"io"
+	"path/filepath"
 	"strconv"
 
 	"atom-engine/src/core/grpc"
+	"atom-engine/src/storage"
 )
 
 // GetStorageStatus returns storage status for gRPC
@@ -72,3 +75,32 @@ func (c *Core) GetStorageInfo() (*grpc.StorageInfoResponse, error) {
 		Statistics:     statistics,
 	}, nil
 }
+
+// BackupStorage writes online backup of storage, since > 0 makes it incremental
+// Записывает резервную копию storage без остановки
+func (c *Core) BackupStorage(w io.Writer, since uint64) (*storage.BackupMetadata, error) {
+	if c.storage == nil {
+		return nil, fmt.Errorf("storage not initialized")
+	}
+
+	return c.storage.Backup(w, since)
+}
+
+// RestoreStorage restores backup into data directory other than the one in use
+// Восстанавливает резервную копию в каталог, отличный от используемого
+func (c *Core) RestoreStorage(r io.Reader, directory string) (*storage.BackupMetadata, error) {
+	if directory == "" {
+		return nil, fmt.Errorf("directory is required")
+	}
+
+	target, err := filepath.Abs(directory)
+	if err != nil {
+		return nil, fmt.Errorf("invalid directory: %w", err)
+	}
+	current, err := filepath.Abs(c.config.Database.Path)
+	if err == nil && target == current {
+		return nil, fmt.Errorf("directory %s is used by running engine, restore into other directory", directory)
+	}
+
+	return storage.RestoreBackup(r, target)
+}
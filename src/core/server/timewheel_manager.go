@@ -14,6 +14,7 @@ import (
 	"time"
 
 	"atom-engine/proto/timewheel/timewheelpb"
+	"atom-engine/src/core/clock"
 	"atom-engine/src/core/grpc"
 	"atom-engine/src/core/logger"
 	"atom-engine/src/core/models"
@@ -69,17 +70,59 @@ func (c *Core) GetTimewheelStats() (*timewheelpb.GetTimeWheelStatsResponse, erro
 		}
 	}
 
+	// Per-level wheel statistics
+	// Статистика по уровням колеса
+	var slotsCount int32
+	var levelStats []*timewheelpb.LevelStats
+	if wheelStats, err := c.timewheelComp.GetStats(); err == nil {
+		for _, level := range wheelStats.LevelStats {
+			levelStats = append(levelStats, &timewheelpb.LevelStats{
+				Level:         int32(level.LevelID),
+				TickMs:        level.Tick.Milliseconds(),
+				Size:          int32(level.Size),
+				Timers:        int32(level.TotalTimers),
+				FiredTimers:   level.FiredTimers,
+				AvgLatenessMs: float64(level.AvgLateness) / float64(time.Millisecond),
+				MaxLatenessMs: float64(level.MaxLateness) / float64(time.Millisecond),
+			})
+		}
+		if len(wheelStats.LevelStats) > 0 {
+			slotsCount = int32(wheelStats.LevelStats[0].Size)
+		}
+	}
+
 	return &timewheelpb.GetTimeWheelStatsResponse{
 		TotalTimers:     totalTimers,
 		PendingTimers:   pendingTimers,
 		FiredTimers:     firedTimers,
 		CancelledTimers: cancelledTimers,
-		CurrentTick:     time.Now().Unix(),
-		SlotsCount:      60, // Default first level slots
+		CurrentTick:     clock.Now().Unix(),
+		SlotsCount:      slotsCount,
 		TimerTypes:      timerTypes,
+		LevelStats:      levelStats,
 	}, nil
 }
 
+// timewheelConfigJSON builds timing wheel configuration from engine config
+// Строит конфигурацию timing wheel из конфигурации движка
+func (c *Core) timewheelConfigJSON() (string, error) {
+	resolution, err := time.ParseDuration(c.config.Timewheel.Resolution)
+	if err != nil {
+		return "", fmt.Errorf("invalid timewheel resolution: %w", err)
+	}
+
+	wheelConfig, err := timewheel.ConfigWithResolution(resolution)
+	if err != nil {
+		return "", err
+	}
+
+	data, err := json.Marshal(wheelConfig)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal timewheel config: %w", err)
+	}
+	return string(data), nil
+}
+
 // GetTimersList returns list of timers for gRPC
 // Возвращает список таймеров для gRPC
 func (c *Core) GetTimersList(statusFilter string, limit int32) (*timewheelpb.ListTimersResponse, error) {
@@ -153,6 +196,7 @@ func (c *Core) GetTimersListInternal(statusFilter string, limit int32) (*timewhe
 			TimerId:           timer.ID,
 			ElementId:         timer.ElementID,
 			ProcessInstanceId: timer.ProcessInstanceID,
+			TenantId:          timer.TenantID,
 			TimerType:         timer.TimerType,
 			Status:            timer.State,
 			ScheduledAt:       scheduledAt,
@@ -194,36 +238,7 @@ func (c *Core) calculateTimerDueTime(timer *storage.TimerRecord) (time.Time, err
 		return time.Time{}, fmt.Errorf("timewheel component not available")
 	}
 
-	// Use ScheduledAt as base time for calculation
-	// Используем ScheduledAt как базовое время для расчета
-	baseTime := timer.ScheduledAt
-
-	if timer.TimeDate != nil {
-		// Absolute date timer - parse the date string
-		// Абсолютный таймер даты - парсим строку даты
-		parser := timewheel.NewISO8601DurationParser()
-		return parser.ParseDate(*timer.TimeDate)
-	} else if timer.TimeDuration != nil {
-		// Duration-based timer
-		// Таймер на основе длительности
-		parser := timewheel.NewISO8601DurationParser()
-		duration, err := parser.ParseDuration(*timer.TimeDuration)
-		if err != nil {
-			return time.Time{}, err
-		}
-		return baseTime.Add(duration), nil
-	} else if timer.TimeCycle != nil {
-		// Cycle-based timer - get first execution time
-		// Циклический таймер - получаем время первого выполнения
-		parser := timewheel.NewISO8601DurationParser()
-		_, interval, err := parser.ParseRepeatingInterval(*timer.TimeCycle)
-		if err != nil {
-			return time.Time{}, err
-		}
-		return baseTime.Add(interval), nil
-	}
-
-	return time.Time{}, fmt.Errorf("no timer definition found")
+	return timewheel.RecordDueDate(timer)
 }
 
 // processTimewheelResponses processes timewheel responses in background
@@ -253,10 +268,35 @@ func (c *Core) handleTimewheelResponse(response string) {
 		ElementID         string `json:"element_id"`
 		TokenID           string `json:"token_id"`
 		ProcessInstanceID string `json:"process_instance_id"`
+		TimerType         string `json:"timer_type"`
 		FiredAt           string `json:"fired_at"`
 	}
 
-	if err := json.Unmarshal([]byte(response), &timerResp); err == nil {
+	if err := json.Unmarshal([]byte(response), &timerResp); err == nil && isJobTimer(timerResp.TimerType) {
+		// Job timers belong to jobs component, token_id carries job key
+		// Таймеры job'ов принадлежат jobs компоненту, token_id содержит ключ job'а
+		if c.jobsComp != nil {
+			if err := c.jobsComp.HandleJobTimer(
+				timerResp.TimerID, models.TimerType(timerResp.TimerType), timerResp.TokenID,
+			); err != nil {
+				logger.Error("Failed to handle job timer",
+					logger.String("timer_id", timerResp.TimerID),
+					logger.String("job_key", timerResp.TokenID),
+					logger.String("error", err.Error()))
+			}
+		}
+	} else if err == nil && models.TimerType(timerResp.TimerType) == models.TimerTypeIncidentRetry {
+		// Incident retry timers belong to incidents component, token_id carries incident ID
+		// Таймеры повтора инцидентов принадлежат компоненту инцидентов, token_id содержит ID инцидента
+		if c.incidentsComp != nil {
+			if err := c.incidentsComp.HandleRetryTimer(timerResp.TimerID, timerResp.TokenID); err != nil {
+				logger.Error("Failed to handle incident retry timer",
+					logger.String("timer_id", timerResp.TimerID),
+					logger.String("incident_id", timerResp.TokenID),
+					logger.String("error", err.Error()))
+			}
+		}
+	} else if err == nil {
 		logger.Info("CLI Timer Callback",
 			logger.String("element_id", timerResp.ElementID),
 			logger.String("timer_id", timerResp.TimerID),
@@ -293,3 +333,13 @@ func (c *Core) handleTimewheelResponse(response string) {
 		logger.Warn("Failed to log timer response to storage", logger.String("error", err.Error()))
 	}
 }
+
+// isJobTimer reports whether timer type belongs to jobs component
+// Проверяет принадлежит ли тип таймера jobs компоненту
+func isJobTimer(timerType string) bool {
+	switch models.TimerType(timerType) {
+	case models.TimerTypeJobRetry, models.TimerTypeJobLease:
+		return true
+	}
+	return false
+}
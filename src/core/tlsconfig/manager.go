@@ -0,0 +1,261 @@
+/*
+This file is part of the AtomBPMN (R) project.
+Copyright (c) 2025 Matreska Market LLC (ООО «Matreska Market»).
+Authors: Matreska Team.
+
+This project is dual-licensed under AGPL-3.0 and AtomBPMN Commercial License.
+*/
+
+package tlsconfig
+
+import (
+	"bytes"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"atom-engine/src/core/config"
+	"atom-engine/src/core/logger"
+)
+
+// Client certificate modes of listener
+const (
+	ClientAuthNone     = "none"
+	ClientAuthOptional = "optional"
+	ClientAuthRequire  = "require"
+)
+
+// material is certificate and client CA pool loaded from disk
+type material struct {
+	certificate *tls.Certificate
+	clientCAs   *x509.CertPool
+	modTimes    map[string]time.Time
+}
+
+// Manager serves listener TLS configuration and reloads rotated files from disk
+// Предоставляет TLS конфигурацию слушателя и перечитывает обновленные файлы с диска
+type Manager struct {
+	config       config.TLSConfig
+	minVersion   uint16
+	cipherSuites []uint16
+	interval     time.Duration
+	current      atomic.Pointer[material]
+	stopCh       chan struct{}
+	wg           sync.WaitGroup
+}
+
+// NewManager creates manager and loads certificate, key and client CA bundle
+// Создает менеджер и загружает сертификат, ключ и CA клиентских сертификатов
+func NewManager(cfg config.TLSConfig) (*Manager, error) {
+	minVersion, err := ParseVersion(cfg.MinVersion)
+	if err != nil {
+		return nil, err
+	}
+	cipherSuites, err := ParseCipherSuites(cfg.CipherSuites)
+	if err != nil {
+		return nil, err
+	}
+
+	m := &Manager{
+		config:       cfg,
+		minVersion:   minVersion,
+		cipherSuites: cipherSuites,
+		interval:     time.Minute,
+		stopCh:       make(chan struct{}),
+	}
+	if interval, err := time.ParseDuration(cfg.ReloadInterval); err == nil && interval > 0 {
+		m.interval = interval
+	}
+
+	loaded, err := m.load()
+	if err != nil {
+		return nil, err
+	}
+	m.current.Store(loaded)
+	return m, nil
+}
+
+// Start starts periodic check of certificate files
+func (m *Manager) Start() {
+	m.wg.Add(1)
+	go func() {
+		defer m.wg.Done()
+		ticker := time.NewTicker(m.interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				if _, err := m.Reload(); err != nil {
+					// Keep serving previous material, rotation may be in progress
+					logger.Warn("Failed to reload TLS certificate",
+						logger.String("cert_file", m.config.CertFile),
+						logger.String("error", err.Error()))
+				}
+			case <-m.stopCh:
+				return
+			}
+		}
+	}()
+
+	logger.Info("TLS certificate reload started",
+		logger.String("cert_file", m.config.CertFile),
+		logger.String("client_auth", m.config.ClientAuth),
+		logger.String("interval", m.interval.String()))
+}
+
+// Stop stops periodic check of certificate files
+func (m *Manager) Stop() {
+	select {
+	case <-m.stopCh:
+		return
+	default:
+		close(m.stopCh)
+	}
+	m.wg.Wait()
+}
+
+// Reload loads files again when any of them changed, reports whether material was replaced
+// Перечитывает файлы при изменении любого из них, сообщает о замене
+func (m *Manager) Reload() (bool, error) {
+	previous := m.current.Load()
+	modTimes, err := m.statFiles()
+	if err != nil {
+		return false, err
+	}
+	if !changed(previous.modTimes, modTimes) {
+		return false, nil
+	}
+
+	loaded, err := m.load()
+	if err != nil {
+		return false, err
+	}
+	m.current.Store(loaded)
+
+	logger.Info("TLS certificate reloaded",
+		logger.String("cert_file", m.config.CertFile),
+		logger.String("not_after", loaded.certificate.Leaf.NotAfter.Format(time.RFC3339)))
+	return true, nil
+}
+
+// ServerConfig returns listener config resolving current material on every handshake.
+// Loopback clients are not required to present certificate, same as they bypass auth.
+// Возвращает конфигурацию слушателя, использующую актуальные файлы при каждом рукопожатии
+func (m *Manager) ServerConfig(nextProtos ...string) *tls.Config {
+	return &tls.Config{
+		MinVersion: m.minVersion,
+		NextProtos: nextProtos,
+		GetCertificate: func(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+			return m.current.Load().certificate, nil
+		},
+		GetConfigForClient: func(hello *tls.ClientHelloInfo) (*tls.Config, error) {
+			return m.configForClient(hello, nextProtos), nil
+		},
+	}
+}
+
+// LoopbackClientConfig returns client config for in-process connections to own listener,
+// server is trusted when it presents currently loaded certificate
+// Возвращает клиентскую конфигурацию для внутренних подключений к собственному слушателю
+func (m *Manager) LoopbackClientConfig() *tls.Config {
+	return &tls.Config{
+		MinVersion: m.minVersion,
+		// Host name is not checked, certificate is compared with own one below
+		InsecureSkipVerify: true,
+		VerifyPeerCertificate: func(rawCerts [][]byte, _ [][]*x509.Certificate) error {
+			own := m.current.Load().certificate
+			if len(rawCerts) == 0 || len(own.Certificate) == 0 || !bytes.Equal(rawCerts[0], own.Certificate[0]) {
+				return errors.New("loopback server certificate does not match listener certificate")
+			}
+			return nil
+		},
+	}
+}
+
+// configForClient builds handshake config from current material
+func (m *Manager) configForClient(hello *tls.ClientHelloInfo, nextProtos []string) *tls.Config {
+	current := m.current.Load()
+	cfg := &tls.Config{
+		MinVersion:   m.minVersion,
+		CipherSuites: m.cipherSuites,
+		NextProtos:   nextProtos,
+		Certificates: []tls.Certificate{*current.certificate},
+		ClientCAs:    current.clientCAs,
+	}
+
+	switch m.config.ClientAuth {
+	case ClientAuthRequire:
+		cfg.ClientAuth = tls.RequireAndVerifyClientCert
+		if hello != nil && hello.Conn != nil && isLoopback(hello.Conn.RemoteAddr()) {
+			cfg.ClientAuth = tls.VerifyClientCertIfGiven
+		}
+	case ClientAuthOptional:
+		cfg.ClientAuth = tls.VerifyClientCertIfGiven
+	default:
+		cfg.ClientAuth = tls.NoClientCert
+	}
+	return cfg
+}
+
+// load reads certificate, key and client CA bundle
+func (m *Manager) load() (*material, error) {
+	modTimes, err := m.statFiles()
+	if err != nil {
+		return nil, err
+	}
+
+	certificate, err := tls.LoadX509KeyPair(m.config.CertFile, m.config.KeyFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load TLS key pair: %w", err)
+	}
+
+	loaded := &material{certificate: &certificate, modTimes: modTimes}
+	if m.config.ClientCAFile != "" && m.config.ClientAuth != ClientAuthNone {
+		loaded.clientCAs, err = LoadCertPool(m.config.ClientCAFile)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return loaded, nil
+}
+
+// statFiles returns modification times of configured files
+func (m *Manager) statFiles() (map[string]time.Time, error) {
+	modTimes := make(map[string]time.Time, 3)
+	for _, path := range []string{m.config.CertFile, m.config.KeyFile, m.config.ClientCAFile} {
+		if path == "" {
+			continue
+		}
+		info, err := os.Stat(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to stat TLS file: %w", err)
+		}
+		modTimes[path] = info.ModTime()
+	}
+	return modTimes, nil
+}
+
+// changed reports whether any file modification time differs
+func changed(previous, current map[string]time.Time) bool {
+	if len(previous) != len(current) {
+		return true
+	}
+	for path, modTime := range current {
+		if !previous[path].Equal(modTime) {
+			return true
+		}
+	}
+	return false
+}
+
+// isLoopback reports whether address is loopback IP
+func isLoopback(addr net.Addr) bool {
+	tcpAddr, ok := addr.(*net.TCPAddr)
+	return ok && tcpAddr.IP.IsLoopback()
+}
@@ -0,0 +1,139 @@
+/*
+This file is part of the AtomBPMN (R) project.
+Copyright (c) 2025 Matreska Market LLC (ООО «Matreska Market»).
+Authors: Matreska Team.
+
+This project is dual-licensed under AGPL-3.0 and AtomBPMN Commercial License.
+*/
+
+package tracing
+
+import (
+	"context"
+	"crypto/rand"
+	"net/http"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	// TraceparentKey is W3C header, job custom header and metadata key of span context
+	TraceparentKey = "traceparent"
+	// ParentKey is metadata key of parent span context of long-lived span
+	ParentKey = "traceparent_parent"
+)
+
+var propagator = propagation.TraceContext{}
+
+// Traceparent formats span context of ctx as W3C traceparent, empty when ctx has none
+// Форматирует контекст спана из ctx как W3C traceparent, пусто если его нет
+func Traceparent(ctx context.Context) string {
+	carrier := propagation.MapCarrier{}
+	propagator.Inject(ctx, carrier)
+	return carrier[TraceparentKey]
+}
+
+// ContextWithTraceparent returns ctx carrying remote span context parsed from
+// traceparent, invalid or empty traceparent leaves ctx unchanged
+// Возвращает ctx с удаленным контекстом спана из traceparent
+func ContextWithTraceparent(ctx context.Context, traceparent string) context.Context {
+	if traceparent == "" {
+		return ctx
+	}
+	return propagator.Extract(ctx, propagation.MapCarrier{TraceparentKey: traceparent})
+}
+
+// InjectHTTP sets traceparent header of outgoing request from span context of ctx
+// Устанавливает заголовок traceparent исходящего запроса из контекста спана
+func InjectHTTP(ctx context.Context, header http.Header) {
+	propagator.Inject(ctx, propagation.HeaderCarrier(header))
+}
+
+// End finishes span recording err as span error status
+// Завершает спан, записывая err как статус ошибки
+func End(span trace.Span, err error, opts ...trace.SpanEndOption) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End(opts...)
+}
+
+// BeginSpan opens long-lived span under span context of ctx and returns its
+// traceparent, empty when tracing is disabled. Span outlives daemon restarts:
+// nothing is kept in memory and EndSpan emits it with the same IDs once done.
+// Открывает долгоживущий спан и возвращает его traceparent. Спан переживает
+// перезапуск демона: в памяти ничего не хранится, EndSpan выпускает его с теми
+// же идентификаторами по завершении.
+func BeginSpan(ctx context.Context, name string) string {
+	if !Enabled() {
+		return ""
+	}
+	// Started only to obtain IDs and sampling decision, never ended nor exported
+	// Запускается только ради ID и решения о сэмплировании, не завершается
+	_, span := Tracer().Start(ctx, name)
+	return Traceparent(trace.ContextWithSpanContext(ctx, span.SpanContext()))
+}
+
+// EndSpan emits long-lived span opened by BeginSpan with given parent, start
+// and end time. Span without traceparent was not traced and is skipped.
+// Выпускает долгоживущий спан, открытый BeginSpan, с указанными родителем и временем
+func EndSpan(
+	name, traceparent, parent string,
+	start, end time.Time,
+	err error,
+	attrs ...attribute.KeyValue,
+) {
+	if !Enabled() || traceparent == "" {
+		return
+	}
+	spanContext := trace.SpanContextFromContext(ContextWithTraceparent(context.Background(), traceparent))
+	if !spanContext.IsValid() {
+		return
+	}
+
+	ctx := ContextWithTraceparent(context.Background(), parent)
+	ctx = context.WithValue(ctx, presetIDsKey{}, spanContext)
+	_, span := Tracer().Start(ctx, name, trace.WithTimestamp(start), trace.WithAttributes(attrs...))
+	End(span, err, trace.WithTimestamp(end))
+}
+
+// presetIDsKey is context key of span context whose IDs next span must reuse
+type presetIDsKey struct{}
+
+// presetIDGenerator generates random IDs unless context presets them, which
+// lets EndSpan reproduce span announced earlier by BeginSpan
+// Генерирует случайные ID, если контекст не задает их заранее
+type presetIDGenerator struct{}
+
+// NewIDs returns trace and span IDs of new root span
+// Возвращает ID трассы и спана нового корневого спана
+func (presetIDGenerator) NewIDs(ctx context.Context) (trace.TraceID, trace.SpanID) {
+	if preset, ok := ctx.Value(presetIDsKey{}).(trace.SpanContext); ok {
+		return preset.TraceID(), preset.SpanID()
+	}
+	var traceID trace.TraceID
+	_, _ = rand.Read(traceID[:])
+	return traceID, randomSpanID()
+}
+
+// NewSpanID returns span ID of new child span
+// Возвращает ID нового дочернего спана
+func (presetIDGenerator) NewSpanID(ctx context.Context, _ trace.TraceID) trace.SpanID {
+	if preset, ok := ctx.Value(presetIDsKey{}).(trace.SpanContext); ok {
+		return preset.SpanID()
+	}
+	return randomSpanID()
+}
+
+// randomSpanID returns random span ID
+// Возвращает случайный ID спана
+func randomSpanID() trace.SpanID {
+	var spanID trace.SpanID
+	_, _ = rand.Read(spanID[:])
+	return spanID
+}
@@ -36,6 +36,9 @@ const (
 	ComponentTypeTimewheel  ComponentType = "TIMEWHEEL"
 	ComponentTypeExpression ComponentType = "EXPRESSION"
 	ComponentTypeIncidents  ComponentType = "INCIDENTS"
+	ComponentTypeBatch      ComponentType = "BATCH"
+	ComponentTypeExporters  ComponentType = "EXPORTERS"
+	ComponentTypeRetention  ComponentType = "RETENTION"
 )
 
 // ComponentHealth represents the health status of a component
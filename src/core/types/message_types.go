@@ -2,6 +2,8 @@ package types
 
 import (
 	"time"
+
+	"atom-engine/src/core/clock"
 )
 
 // MessageStatus represents the status of a message
@@ -269,7 +271,7 @@ func (mi *MessageInfo) HasTTL() bool {
 }
 
 func (mi *MessageInfo) IsExpiredNow() bool {
-	return mi.ExpiresAt != nil && time.Now().After(*mi.ExpiresAt)
+	return mi.ExpiresAt != nil && clock.Now().After(*mi.ExpiresAt)
 }
 
 // Helper methods for MessageStats
@@ -0,0 +1,294 @@
+/*
+This file is part of the AtomBPMN (R) project.
+Copyright (c) 2025 Matreska Market LLC (ООО «Matreska Market»).
+Authors: Matreska Team.
+
+This project is dual-licensed under AGPL-3.0 and AtomBPMN Commercial License.
+*/
+
+package exporters
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"atom-engine/src/core/config"
+	"atom-engine/src/core/events"
+	"atom-engine/src/core/logger"
+	"atom-engine/src/storage"
+)
+
+// Status describes export log and its exporters
+// Описывает журнал экспорта и его экспортеры
+type Status struct {
+	LastPosition      uint64            `json:"last_position"`      // Latest appended record
+	CompactedPosition uint64            `json:"compacted_position"` // Records up to here are removed
+	Exporters         []*ExporterStatus `json:"exporters"`
+}
+
+// ExporterStatus describes progress of single exporter
+// Описывает прогресс одного экспортера
+type ExporterStatus struct {
+	ID                  string     `json:"id"`
+	Type                string     `json:"type"`
+	Position            uint64     `json:"position"` // Last acknowledged record
+	Lag                 uint64     `json:"lag"`      // Records waiting for export
+	Exported            uint64     `json:"exported"` // Records exported since start
+	LastExportAt        *time.Time `json:"last_export_at,omitempty"`
+	LastError           string     `json:"last_error,omitempty"`
+	LastErrorAt         *time.Time `json:"last_error_at,omitempty"`
+	ConsecutiveFailures int        `json:"consecutive_failures"`
+}
+
+// CompactionResult describes removal of acknowledged records
+// Описывает удаление подтвержденных записей
+type CompactionResult struct {
+	Position uint64 `json:"position"` // Records up to here are removed
+	Deleted  int    `json:"deleted"`
+}
+
+// Component appends engine events to persisted export log and runs
+// configured exporters from their acknowledged positions
+// Добавляет события движка в журнал экспорта и запускает экспортеры
+type Component struct {
+	storage storage.Storage
+	logger  logger.ComponentLogger
+
+	configs            []config.ExporterConfig
+	compactionInterval time.Duration
+
+	mu           sync.Mutex // Serializes appends so positions follow publish order
+	lastPosition uint64
+
+	runners []*runner
+	ready   bool
+	ctx     context.Context
+	cancel  context.CancelFunc
+	wg      sync.WaitGroup
+}
+
+// NewComponent creates new exporters component
+// Создает новый компонент экспортеров
+func NewComponent(cfg *config.Config, storage storage.Storage) *Component {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	c := &Component{
+		storage:            storage,
+		logger:             logger.NewComponentLogger("exporters"),
+		compactionInterval: 5 * time.Minute,
+		ctx:                ctx,
+		cancel:             cancel,
+	}
+
+	if cfg != nil {
+		c.configs = cfg.Export.Exporters
+		if interval, err := time.ParseDuration(cfg.Export.CompactionInterval); err == nil && interval >= 0 {
+			c.compactionInterval = interval
+		}
+	}
+
+	return c
+}
+
+// Init creates configured exporters and loads export log positions
+// Создает настроенные экспортеры и загружает позиции журнала экспорта
+func (c *Component) Init() error {
+	c.logger.Info("Initializing exporters component")
+
+	if c.storage == nil {
+		return fmt.Errorf("storage is required for exporters component")
+	}
+
+	_, last, err := c.storage.LoadExportLogBounds()
+	if err != nil {
+		return fmt.Errorf("failed to load export log: %w", err)
+	}
+	c.lastPosition = last
+
+	positions, err := c.storage.LoadExporterPositions()
+	if err != nil {
+		return fmt.Errorf("failed to load exporter positions: %w", err)
+	}
+
+	for _, exporterConfig := range c.configs {
+		exporter, err := newExporter(exporterConfig)
+		if err != nil {
+			return fmt.Errorf("exporter %s: %w", exporterConfig.ID, err)
+		}
+		r, err := newRunner(c, exporterConfig, exporter, positions[exporterConfig.ID])
+		if err != nil {
+			return fmt.Errorf("exporter %s: %w", exporterConfig.ID, err)
+		}
+		c.runners = append(c.runners, r)
+	}
+
+	c.logger.Info("Exporters component initialized",
+		logger.Int("exporters", len(c.runners)),
+		logger.Any("last_position", c.lastPosition))
+	return nil
+}
+
+// Start records engine events and starts exporters
+// Начинает запись событий движка и запускает экспортеры
+func (c *Component) Start() error {
+	// Without exporters nothing would acknowledge records, so none are kept
+	if len(c.runners) > 0 {
+		events.SetRecorder(c)
+	}
+
+	for _, r := range c.runners {
+		c.wg.Add(1)
+		go r.run(c.ctx)
+	}
+
+	c.wg.Add(1)
+	go c.compactionLoop()
+
+	c.ready = true
+	c.logger.Info("Exporters component started")
+	return nil
+}
+
+// Stop stops recording and exporters, unacknowledged records are exported after restart
+// Останавливает запись и экспортеры
+func (c *Component) Stop() error {
+	events.SetRecorder(nil)
+	c.ready = false
+	c.cancel()
+	c.wg.Wait()
+
+	for _, r := range c.runners {
+		if err := r.exporter.Close(); err != nil {
+			c.logger.Warn("Failed to close exporter",
+				logger.String("exporter", r.id),
+				logger.String("error", err.Error()))
+		}
+	}
+
+	c.logger.Info("Exporters component stopped")
+	return nil
+}
+
+// IsReady returns component readiness
+// Возвращает готовность компонента
+func (c *Component) IsReady() bool {
+	return c.ready
+}
+
+// Record appends event to export log and wakes exporters
+// Добавляет событие в журнал экспорта и будит экспортеры
+func (c *Component) Record(event *events.Event) {
+	c.mu.Lock()
+	record := &Record{Position: c.lastPosition + 1, Event: *event}
+	record.Sequence = 0
+	data, err := json.Marshal(record)
+	if err == nil {
+		err = c.storage.SaveExportRecord(record.Position, data)
+	}
+	if err != nil {
+		c.mu.Unlock()
+		c.logger.Error("Failed to append export record",
+			logger.String("type", event.Type),
+			logger.String("error", err.Error()))
+		return
+	}
+	c.lastPosition = record.Position
+	c.mu.Unlock()
+
+	for _, r := range c.runners {
+		r.signal()
+	}
+}
+
+// Status returns export log positions and exporter progress
+// Возвращает позиции журнала экспорта и прогресс экспортеров
+func (c *Component) Status() (*Status, error) {
+	compacted, _, err := c.storage.LoadExportLogBounds()
+	if err != nil {
+		return nil, err
+	}
+
+	c.mu.Lock()
+	last := c.lastPosition
+	c.mu.Unlock()
+
+	status := &Status{
+		LastPosition:      last,
+		CompactedPosition: compacted,
+		Exporters:         make([]*ExporterStatus, 0, len(c.runners)),
+	}
+	for _, r := range c.runners {
+		status.Exporters = append(status.Exporters, r.status(last))
+	}
+	return status, nil
+}
+
+// Compact removes records acknowledged by every exporter
+// Удаляет записи, подтвержденные всеми экспортерами
+func (c *Component) Compact() (*CompactionResult, error) {
+	c.mu.Lock()
+	position := c.lastPosition
+	c.mu.Unlock()
+
+	for _, r := range c.runners {
+		if acknowledged := r.Position(); acknowledged < position {
+			position = acknowledged
+		}
+	}
+
+	deleted, err := c.storage.CompactExportRecords(position)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compact export log: %w", err)
+	}
+
+	if deleted > 0 {
+		c.logger.Info("Export log compacted",
+			logger.Any("position", position),
+			logger.Int("deleted", deleted))
+	}
+	return &CompactionResult{Position: position, Deleted: deleted}, nil
+}
+
+// compactionLoop compacts export log periodically
+func (c *Component) compactionLoop() {
+	defer c.wg.Done()
+
+	if c.compactionInterval <= 0 {
+		return
+	}
+
+	ticker := time.NewTicker(c.compactionInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-c.ctx.Done():
+			return
+		case <-ticker.C:
+			if _, err := c.Compact(); err != nil {
+				c.logger.Warn("Export log compaction failed", logger.String("error", err.Error()))
+			}
+		}
+	}
+}
+
+// loadRecords loads up to limit records following position
+func (c *Component) loadRecords(position uint64, limit int) ([]*Record, error) {
+	data, err := c.storage.LoadExportRecords(position, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	records := make([]*Record, 0, len(data))
+	for _, item := range data {
+		var record Record
+		if err := json.Unmarshal(item, &record); err != nil {
+			return nil, fmt.Errorf("corrupted export record after position %d: %w", position, err)
+		}
+		records = append(records, &record)
+	}
+	return records, nil
+}
@@ -0,0 +1,99 @@
+/*
+This file is part of the AtomBPMN (R) project.
+Copyright (c) 2025 Matreska Market LLC (ООО «Matreska Market»).
+Authors: Matreska Team.
+
+This project is dual-licensed under AGPL-3.0 and AtomBPMN Commercial License.
+*/
+
+package exporters
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+
+	"atom-engine/src/core/config"
+	"atom-engine/src/core/events"
+)
+
+// Record is engine event persisted in export log. Position orders records
+// across restarts, unlike in-memory stream sequence which is omitted.
+// Событие движка, сохраненное в журнале экспорта
+type Record struct {
+	Position uint64 `json:"position"`
+	events.Event
+}
+
+// Exporter delivers export log records to external system, modelled on
+// Zeebe exporters. Records of each Export call follow previous call in
+// position order. Returning nil acknowledges records, so Export must hand
+// them over durably first; error makes runner retry same records after
+// backoff. Delivery is at least once, receivers deduplicate by position.
+// Доставляет записи журнала экспорта во внешнюю систему
+type Exporter interface {
+	// Open connects exporter, error is retried with backoff
+	Open(ctx context.Context) error
+	// Export delivers records in position order
+	Export(ctx context.Context, records []*Record) error
+	// Close releases exporter resources
+	Close() error
+}
+
+// Factory creates exporter from its configuration
+// Создает экспортер из его конфигурации
+type Factory func(cfg config.ExporterConfig) (Exporter, error)
+
+var (
+	factoriesMu sync.RWMutex
+	factories   = map[string]Factory{
+		"file":  NewFileExporter,
+		"http":  NewHTTPExporter,
+		"kafka": NewKafkaExporter,
+	}
+)
+
+// RegisterFactory makes exporter type available to export configuration.
+// Custom exporters register from init of their package, settings are read
+// from exporter args.
+// Регистрирует тип экспортера для конфигурации экспорта
+func RegisterFactory(exporterType string, factory Factory) error {
+	factoriesMu.Lock()
+	defer factoriesMu.Unlock()
+
+	if exporterType == "" || factory == nil {
+		return fmt.Errorf("exporter type and factory are required")
+	}
+	if _, exists := factories[exporterType]; exists {
+		return fmt.Errorf("exporter type %s is already registered", exporterType)
+	}
+	factories[exporterType] = factory
+	return nil
+}
+
+// Types returns registered exporter types
+// Возвращает зарегистрированные типы экспортеров
+func Types() []string {
+	factoriesMu.RLock()
+	defer factoriesMu.RUnlock()
+
+	types := make([]string, 0, len(factories))
+	for exporterType := range factories {
+		types = append(types, exporterType)
+	}
+	sort.Strings(types)
+	return types
+}
+
+// newExporter creates exporter of configured type
+func newExporter(cfg config.ExporterConfig) (Exporter, error) {
+	factoriesMu.RLock()
+	factory, ok := factories[cfg.Type]
+	factoriesMu.RUnlock()
+
+	if !ok {
+		return nil, fmt.Errorf("unknown exporter type %s, registered types: %v", cfg.Type, Types())
+	}
+	return factory(cfg)
+}
@@ -0,0 +1,210 @@
+/*
+This file is part of the AtomBPMN (R) project.
+Copyright (c) 2025 Matreska Market LLC (ООО «Matreska Market»).
+Authors: Matreska Team.
+
+This project is dual-licensed under AGPL-3.0 and AtomBPMN Commercial License.
+*/
+
+package exporters
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"atom-engine/src/core/config"
+)
+
+// rotatedFileTimeFormat names rotated files, sorts in rotation order
+const rotatedFileTimeFormat = "20060102T150405.000"
+
+// FileExporter appends records as JSON lines to rotated file
+// Записывает записи в файл построчно в формате JSON с ротацией
+type FileExporter struct {
+	file *JSONLinesFile
+}
+
+// NewFileExporter creates JSON lines file exporter from configuration
+// Создает файловый экспортер JSON lines из конфигурации
+func NewFileExporter(cfg config.ExporterConfig) (Exporter, error) {
+	if cfg.File.Path == "" {
+		return nil, fmt.Errorf("file path is required")
+	}
+	return &FileExporter{file: NewJSONLinesFile(cfg.File)}, nil
+}
+
+// Open creates directory and opens active file for appending
+// Создает каталог и открывает активный файл для дозаписи
+func (f *FileExporter) Open(ctx context.Context) error {
+	return f.file.Open()
+}
+
+// Export writes records and syncs file before acknowledging them
+// Записывает записи и синхронизирует файл до их подтверждения
+func (f *FileExporter) Export(ctx context.Context, records []*Record) error {
+	lines := make([][]byte, 0, len(records))
+	for _, record := range records {
+		line, err := json.Marshal(record)
+		if err != nil {
+			return fmt.Errorf("failed to marshal record %d: %w", record.Position, err)
+		}
+		lines = append(lines, line)
+	}
+	return f.file.WriteLines(lines)
+}
+
+// Close closes active file
+// Закрывает активный файл
+func (f *FileExporter) Close() error {
+	return f.file.Close()
+}
+
+// JSONLinesFile appends JSON lines to file. File reaching max size is
+// renamed with timestamp suffix and oldest rotated files beyond max files
+// are removed.
+// Дописывает строки JSON в файл с ротацией
+type JSONLinesFile struct {
+	path     string
+	maxSize  int64
+	maxFiles int
+
+	file *os.File
+	size int64
+}
+
+// NewJSONLinesFile creates rotated JSON lines file, max size is in MB
+// Создает файл JSON lines с ротацией, максимальный размер в MB
+func NewJSONLinesFile(cfg config.FileExporterConfig) *JSONLinesFile {
+	return &JSONLinesFile{
+		path:     cfg.Path,
+		maxSize:  cfg.MaxSize * 1024 * 1024,
+		maxFiles: cfg.MaxFiles,
+	}
+}
+
+// Open creates directory and opens active file for appending
+// Создает каталог и открывает активный файл для дозаписи
+func (f *JSONLinesFile) Open() error {
+	if err := os.MkdirAll(filepath.Dir(f.path), 0755); err != nil {
+		return fmt.Errorf("failed to create directory: %w", err)
+	}
+	return f.openFile()
+}
+
+// WriteLines appends lines, rotating file between lines, and syncs file
+// Дописывает строки с ротацией файла между ними и синхронизирует файл
+func (f *JSONLinesFile) WriteLines(lines [][]byte) error {
+	if f.file == nil {
+		if err := f.Open(); err != nil {
+			return err
+		}
+	}
+
+	var buffer []byte
+	for _, line := range lines {
+		pending := f.size + int64(len(buffer))
+		if pending > 0 && pending+int64(len(line))+1 > f.maxSize {
+			if err := f.write(buffer); err != nil {
+				return err
+			}
+			buffer = buffer[:0]
+			if err := f.rotate(); err != nil {
+				return err
+			}
+		}
+		buffer = append(buffer, line...)
+		buffer = append(buffer, '\n')
+	}
+
+	return f.write(buffer)
+}
+
+// Close closes active file
+// Закрывает активный файл
+func (f *JSONLinesFile) Close() error {
+	if f.file == nil {
+		return nil
+	}
+	err := f.file.Close()
+	f.file = nil
+	return err
+}
+
+// openFile opens active file for appending and reads its size
+func (f *JSONLinesFile) openFile() error {
+	file, err := os.OpenFile(f.path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		return fmt.Errorf("failed to open file: %w", err)
+	}
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return fmt.Errorf("failed to stat file: %w", err)
+	}
+	f.file = file
+	f.size = info.Size()
+	return nil
+}
+
+// write appends data to active file and syncs it
+func (f *JSONLinesFile) write(data []byte) error {
+	if len(data) == 0 {
+		return nil
+	}
+	n, err := f.file.Write(data)
+	f.size += int64(n)
+	if err != nil {
+		return fmt.Errorf("failed to write file: %w", err)
+	}
+	if err := f.file.Sync(); err != nil {
+		return fmt.Errorf("failed to sync file: %w", err)
+	}
+	return nil
+}
+
+// rotate renames active file with timestamp suffix and opens new one
+func (f *JSONLinesFile) rotate() error {
+	if err := f.Close(); err != nil {
+		return fmt.Errorf("failed to close file: %w", err)
+	}
+
+	ext := filepath.Ext(f.path)
+	base := strings.TrimSuffix(f.path, ext)
+	rotated := fmt.Sprintf("%s-%s%s", base, time.Now().UTC().Format(rotatedFileTimeFormat), ext)
+	for i := 1; ; i++ {
+		if _, err := os.Stat(rotated); os.IsNotExist(err) {
+			break
+		}
+		rotated = fmt.Sprintf("%s-%s-%d%s", base, time.Now().UTC().Format(rotatedFileTimeFormat), i, ext)
+	}
+	if err := os.Rename(f.path, rotated); err != nil {
+		return fmt.Errorf("failed to rotate file: %w", err)
+	}
+
+	if err := f.openFile(); err != nil {
+		return err
+	}
+	f.pruneRotated(base, ext)
+	return nil
+}
+
+// pruneRotated removes oldest rotated files beyond max files
+func (f *JSONLinesFile) pruneRotated(base, ext string) {
+	if f.maxFiles <= 0 {
+		return
+	}
+	rotated, err := filepath.Glob(base + "-*" + ext)
+	if err != nil || len(rotated) <= f.maxFiles {
+		return
+	}
+	sort.Strings(rotated)
+	for _, path := range rotated[:len(rotated)-f.maxFiles] {
+		_ = os.Remove(path)
+	}
+}
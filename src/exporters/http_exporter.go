@@ -0,0 +1,177 @@
+/*
+This file is part of the AtomBPMN (R) project.
+Copyright (c) 2025 Matreska Market LLC (ООО «Matreska Market»).
+Authors: Matreska Team.
+
+This project is dual-licensed under AGPL-3.0 and AtomBPMN Commercial License.
+*/
+
+package exporters
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"atom-engine/src/core/config"
+)
+
+// HTTP exporter request headers
+// Заголовки запроса HTTP экспортера
+const (
+	HTTPHeaderExporter  = "X-Atom-Exporter"
+	HTTPHeaderPositions = "X-Atom-Positions"
+	HTTPHeaderTimestamp = "X-Atom-Timestamp"
+	HTTPHeaderSignature = "X-Atom-Signature"
+)
+
+// httpErrorBodyLimit limits response body included in export error
+const httpErrorBodyLimit = 256
+
+// HTTPExporter posts record batches as JSON array. X-Atom-Positions header
+// carries "<first>-<last>" position range usable as idempotency key. With
+// secret configured request carries X-Atom-Signature header with
+// "sha256=" + hex HMAC-SHA256 of "<timestamp>.<body>". Network errors,
+// 408, 429 and 5xx responses are retried up to max attempts.
+// Отправляет пакеты записей в виде JSON массива на HTTP endpoint
+type HTTPExporter struct {
+	id          string
+	url         string
+	secret      []byte
+	headers     map[string]string
+	maxAttempts int
+	retryDelay  time.Duration
+	client      *http.Client
+}
+
+// NewHTTPExporter creates HTTP batch exporter from configuration
+// Создает HTTP экспортер пакетов из конфигурации
+func NewHTTPExporter(cfg config.ExporterConfig) (Exporter, error) {
+	if cfg.HTTP.URL == "" {
+		return nil, fmt.Errorf("http url is required")
+	}
+	timeout, err := time.ParseDuration(cfg.HTTP.Timeout)
+	if err != nil {
+		return nil, fmt.Errorf("invalid http timeout: %w", err)
+	}
+	retryDelay, err := time.ParseDuration(cfg.HTTP.RetryDelay)
+	if err != nil {
+		return nil, fmt.Errorf("invalid http retry_delay: %w", err)
+	}
+
+	maxAttempts := cfg.HTTP.MaxAttempts
+	if maxAttempts < 1 {
+		maxAttempts = 1
+	}
+
+	return &HTTPExporter{
+		id:          cfg.ID,
+		url:         cfg.HTTP.URL,
+		secret:      []byte(cfg.HTTP.Secret),
+		headers:     cfg.HTTP.Headers,
+		maxAttempts: maxAttempts,
+		retryDelay:  retryDelay,
+		client:      &http.Client{Timeout: timeout},
+	}, nil
+}
+
+// Open has nothing to connect, requests use pooled connections
+// Открытие не требуется, запросы используют пул соединений
+func (h *HTTPExporter) Open(ctx context.Context) error {
+	return nil
+}
+
+// Export posts records, retrying transient failures with growing delay
+// Отправляет записи, повторяя временные ошибки с растущей задержкой
+func (h *HTTPExporter) Export(ctx context.Context, records []*Record) error {
+	body, err := json.Marshal(records)
+	if err != nil {
+		return fmt.Errorf("failed to marshal records: %w", err)
+	}
+	positions := fmt.Sprintf("%d-%d", records[0].Position, records[len(records)-1].Position)
+
+	delay := h.retryDelay
+	for attempt := 1; ; attempt++ {
+		retryable, err := h.post(ctx, body, positions)
+		if err == nil {
+			return nil
+		}
+		if !retryable || attempt >= h.maxAttempts {
+			return fmt.Errorf("attempt %d: %w", attempt, err)
+		}
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+		delay *= 2
+	}
+}
+
+// Close releases idle connections
+// Освобождает простаивающие соединения
+func (h *HTTPExporter) Close() error {
+	h.client.CloseIdleConnections()
+	return nil
+}
+
+// post sends single request, reports whether failure is worth retrying
+func (h *HTTPExporter) post(ctx context.Context, body []byte, positions string) (bool, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, h.url, bytes.NewReader(body))
+	if err != nil {
+		return false, fmt.Errorf("failed to create export request: %w", err)
+	}
+
+	for key, value := range h.headers {
+		req.Header.Set(key, value)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(HTTPHeaderExporter, h.id)
+	req.Header.Set(HTTPHeaderPositions, positions)
+
+	// Wall clock time so receivers can reject stale replays against their own clock
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+	req.Header.Set(HTTPHeaderTimestamp, timestamp)
+	if len(h.secret) > 0 {
+		req.Header.Set(HTTPHeaderSignature, "sha256="+signPayload(h.secret, timestamp, body))
+	}
+
+	resp, err := h.client.Do(req)
+	if err != nil {
+		return ctx.Err() == nil, fmt.Errorf("export request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		data, _ := io.ReadAll(io.LimitReader(resp.Body, httpErrorBodyLimit))
+		retryable := resp.StatusCode == http.StatusRequestTimeout ||
+			resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500
+		return retryable, fmt.Errorf("export endpoint returned status %d: %s",
+			resp.StatusCode, strings.TrimSpace(string(data)))
+	}
+
+	// Drain body so connection can be reused
+	_, _ = io.Copy(io.Discard, resp.Body)
+	return false, nil
+}
+
+// signPayload returns hex HMAC-SHA256 of "<timestamp>.<body>"
+func signPayload(secret []byte, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,189 @@
+/*
+This file is part of the AtomBPMN (R) project.
+Copyright (c) 2025 Matreska Market LLC (ООО «Matreska Market»).
+Authors: Matreska Team.
+
+This project is dual-licensed under AGPL-3.0 and AtomBPMN Commercial License.
+*/
+
+package exporters
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/segmentio/kafka-go"
+
+	"atom-engine/src/core/config"
+)
+
+// kafkaBatchTimeout bounds wait of producer for partial batch, runner
+// already collects batches
+const kafkaBatchTimeout = 10 * time.Millisecond
+
+// messageWriter produces messages to Kafka, implemented by kafka.Writer
+type messageWriter interface {
+	WriteMessages(ctx context.Context, msgs ...kafka.Message) error
+	Close() error
+}
+
+// topicCheck returns error when topic is missing or brokers are unreachable
+type topicCheck func(ctx context.Context, topic string) error
+
+// KafkaExporter produces records as JSON messages to Kafka topic. Messages
+// are keyed by process instance ID so records of instance keep order
+// within partition, records without instance are keyed by position.
+// Отправляет записи в топик Kafka в виде JSON сообщений
+type KafkaExporter struct {
+	topic      string
+	writer     messageWriter
+	checkTopic topicCheck
+	transport  *kafka.Transport
+}
+
+// NewKafkaExporter creates Kafka producer exporter from configuration
+// Создает экспортер Kafka producer из конфигурации
+func NewKafkaExporter(cfg config.ExporterConfig) (Exporter, error) {
+	if len(cfg.Kafka.Brokers) == 0 || cfg.Kafka.Topic == "" {
+		return nil, fmt.Errorf("kafka brokers and topic are required")
+	}
+	timeout, err := time.ParseDuration(cfg.Kafka.Timeout)
+	if err != nil {
+		return nil, fmt.Errorf("invalid kafka timeout: %w", err)
+	}
+
+	var acks kafka.RequiredAcks
+	switch cfg.Kafka.RequiredAcks {
+	case "all", "":
+		acks = kafka.RequireAll
+	case "one":
+		acks = kafka.RequireOne
+	case "none":
+		acks = kafka.RequireNone
+	default:
+		return nil, fmt.Errorf("unknown kafka required_acks %s", cfg.Kafka.RequiredAcks)
+	}
+
+	var compression kafka.Compression
+	switch cfg.Kafka.Compression {
+	case "none", "":
+	case "gzip":
+		compression = kafka.Gzip
+	case "snappy":
+		compression = kafka.Snappy
+	case "lz4":
+		compression = kafka.Lz4
+	case "zstd":
+		compression = kafka.Zstd
+	default:
+		return nil, fmt.Errorf("unknown kafka compression %s", cfg.Kafka.Compression)
+	}
+
+	transport := &kafka.Transport{
+		ClientID:    cfg.Kafka.ClientID,
+		DialTimeout: timeout,
+	}
+
+	client := &kafka.Client{
+		Addr:      kafka.TCP(cfg.Kafka.Brokers...),
+		Timeout:   timeout,
+		Transport: transport,
+	}
+
+	exporter := newKafkaExporter(cfg.Kafka.Topic,
+		&kafka.Writer{
+			Addr:         kafka.TCP(cfg.Kafka.Brokers...),
+			Topic:        cfg.Kafka.Topic,
+			Balancer:     &kafka.Hash{},
+			RequiredAcks: acks,
+			Compression:  compression,
+			BatchSize:    cfg.BatchSize,
+			BatchTimeout: kafkaBatchTimeout,
+			ReadTimeout:  timeout,
+			WriteTimeout: timeout,
+			// Runner retries failed batches with its own backoff
+			MaxAttempts: 1,
+			Transport:   transport,
+		},
+		func(ctx context.Context, topic string) error {
+			return checkKafkaTopic(ctx, client, topic)
+		})
+	exporter.transport = transport
+	return exporter, nil
+}
+
+// newKafkaExporter creates exporter producing through writer after topic check
+func newKafkaExporter(topic string, writer messageWriter, checkTopic topicCheck) *KafkaExporter {
+	return &KafkaExporter{
+		topic:      topic,
+		writer:     writer,
+		checkTopic: checkTopic,
+	}
+}
+
+// checkKafkaTopic loads metadata of topic from brokers
+func checkKafkaTopic(ctx context.Context, client *kafka.Client, name string) error {
+	metadata, err := client.Metadata(ctx, &kafka.MetadataRequest{Topics: []string{name}})
+	if err != nil {
+		return fmt.Errorf("failed to load kafka metadata: %w", err)
+	}
+	for _, topic := range metadata.Topics {
+		if topic.Name == name {
+			if topic.Error != nil {
+				return fmt.Errorf("kafka topic %s: %w", name, topic.Error)
+			}
+			return nil
+		}
+	}
+	return fmt.Errorf("kafka topic %s not found", name)
+}
+
+// Open checks that brokers are reachable and topic exists
+// Проверяет доступность брокеров и наличие топика
+func (k *KafkaExporter) Open(ctx context.Context) error {
+	return k.checkTopic(ctx, k.topic)
+}
+
+// Export produces records and waits for configured acknowledgements
+// Отправляет записи и ожидает настроенные подтверждения
+func (k *KafkaExporter) Export(ctx context.Context, records []*Record) error {
+	messages := make([]kafka.Message, 0, len(records))
+	for _, record := range records {
+		value, err := json.Marshal(record)
+		if err != nil {
+			return fmt.Errorf("failed to marshal record %d: %w", record.Position, err)
+		}
+
+		position := strconv.FormatUint(record.Position, 10)
+		key := record.ProcessInstanceID
+		if key == "" {
+			key = position
+		}
+		messages = append(messages, kafka.Message{
+			Key:   []byte(key),
+			Value: value,
+			Headers: []kafka.Header{
+				{Key: "type", Value: []byte(record.Type)},
+				{Key: "position", Value: []byte(position)},
+			},
+		})
+	}
+
+	if err := k.writer.WriteMessages(ctx, messages...); err != nil {
+		return fmt.Errorf("failed to produce kafka messages: %w", err)
+	}
+	return nil
+}
+
+// Close flushes and closes producer
+// Выгружает и закрывает producer
+func (k *KafkaExporter) Close() error {
+	err := k.writer.Close()
+	if k.transport != nil {
+		k.transport.CloseIdleConnections()
+	}
+	return err
+}
@@ -0,0 +1,206 @@
+/*
+This file is part of the AtomBPMN (R) project.
+Copyright (c) 2025 Matreska Market LLC (ООО «Matreska Market»).
+Authors: Matreska Team.
+
+This project is dual-licensed under AGPL-3.0 and AtomBPMN Commercial License.
+*/
+
+package exporters
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/segmentio/kafka-go"
+
+	"atom-engine/src/core/config"
+	"atom-engine/src/core/events"
+	"atom-engine/src/core/logger"
+	"atom-engine/src/storage"
+)
+
+// fakeWriter stands in for kafka.Writer, failing first writes on request
+type fakeWriter struct {
+	mu       sync.Mutex
+	failures int
+	attempts [][]kafka.Message
+	written  []kafka.Message
+	closed   bool
+}
+
+func (w *fakeWriter) WriteMessages(_ context.Context, msgs ...kafka.Message) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	w.attempts = append(w.attempts, append([]kafka.Message{}, msgs...))
+	if w.failures > 0 {
+		w.failures--
+		return errors.New("broker not available")
+	}
+	w.written = append(w.written, msgs...)
+	return nil
+}
+
+func (w *fakeWriter) Close() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.closed = true
+	return nil
+}
+
+func (w *fakeWriter) snapshot() (attempts [][]kafka.Message, written []kafka.Message) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return append([][]kafka.Message{}, w.attempts...), append([]kafka.Message{}, w.written...)
+}
+
+func header(msg kafka.Message, key string) string {
+	for _, h := range msg.Headers {
+		if h.Key == key {
+			return string(h.Value)
+		}
+	}
+	return ""
+}
+
+func TestKafkaExporterKeysMessagesByInstance(t *testing.T) {
+	writer := &fakeWriter{}
+	exporter := newKafkaExporter("engine-events", writer, func(context.Context, string) error { return nil })
+
+	records := []*Record{
+		{Position: 7, Event: events.Event{Type: events.TypeJobCreated, ProcessInstanceID: "inst-1"}},
+		{Position: 8, Event: events.Event{Type: events.TypeInstanceStarted, ProcessInstanceID: "inst-2"}},
+		{Position: 9, Event: events.Event{Type: "deployment.created"}},
+	}
+	if err := exporter.Export(context.Background(), records); err != nil {
+		t.Fatalf("export: %v", err)
+	}
+
+	_, written := writer.snapshot()
+	if len(written) != len(records) {
+		t.Fatalf("written %d messages, want %d", len(written), len(records))
+	}
+
+	wantKeys := []string{"inst-1", "inst-2", "9"}
+	wantPositions := []string{"7", "8", "9"}
+	for i, msg := range written {
+		if string(msg.Key) != wantKeys[i] {
+			t.Errorf("message %d key %q, want %q", i, msg.Key, wantKeys[i])
+		}
+		if got := header(msg, "type"); got != records[i].Type {
+			t.Errorf("message %d type header %q, want %q", i, got, records[i].Type)
+		}
+		if got := header(msg, "position"); got != wantPositions[i] {
+			t.Errorf("message %d position header %q, want %q", i, got, wantPositions[i])
+		}
+
+		var record Record
+		if err := json.Unmarshal(msg.Value, &record); err != nil {
+			t.Fatalf("message %d value: %v", i, err)
+		}
+		if record.Position != records[i].Position || record.Type != records[i].Type {
+			t.Errorf("message %d value %+v, want position %d type %s",
+				i, record, records[i].Position, records[i].Type)
+		}
+	}
+}
+
+func TestKafkaExporterErrorsAreRetriedByRunner(t *testing.T) {
+	if err := logger.Init(&config.LoggerConfig{Level: "error", Directory: t.TempDir()}); err != nil {
+		t.Fatalf("logger: %v", err)
+	}
+
+	st := storage.NewStorage(&storage.Config{Path: filepath.Join(t.TempDir(), "db")})
+	if err := st.Init(); err != nil {
+		t.Fatalf("storage init: %v", err)
+	}
+	if err := st.Start(); err != nil {
+		t.Fatalf("storage start: %v", err)
+	}
+	defer st.Stop()
+
+	component := NewComponent(nil, st)
+	if err := component.Init(); err != nil {
+		t.Fatalf("component init: %v", err)
+	}
+
+	var openMu sync.Mutex
+	openFailures := 1
+	writer := &fakeWriter{failures: 2}
+	exporter := newKafkaExporter("engine-events", writer, func(context.Context, string) error {
+		openMu.Lock()
+		defer openMu.Unlock()
+		if openFailures > 0 {
+			openFailures--
+			return errors.New("kafka topic engine-events not found")
+		}
+		return nil
+	})
+
+	r, err := newRunner(component, config.ExporterConfig{
+		ID:              "kafka-test",
+		Type:            "kafka",
+		BatchSize:       10,
+		FlushInterval:   "0s",
+		RetryBackoff:    "5ms",
+		MaxRetryBackoff: "20ms",
+	}, exporter, 0)
+	if err != nil {
+		t.Fatalf("runner: %v", err)
+	}
+	component.runners = append(component.runners, r)
+
+	component.Record(&events.Event{Type: events.TypeInstanceStarted, ProcessInstanceID: "inst-1"})
+	component.Record(&events.Event{Type: events.TypeJobCreated, ProcessInstanceID: "inst-1"})
+
+	if err := component.Start(); err != nil {
+		t.Fatalf("component start: %v", err)
+	}
+
+	deadline := time.Now().Add(5 * time.Second)
+	for r.Position() < 2 && time.Now().Before(deadline) {
+		time.Sleep(5 * time.Millisecond)
+	}
+	status := r.status(2)
+	if err := component.Stop(); err != nil {
+		t.Fatalf("component stop: %v", err)
+	}
+
+	if status.Position != 2 || status.Exported != 2 {
+		t.Fatalf("position %d exported %d, want 2 and 2", status.Position, status.Exported)
+	}
+	if status.LastError == "" || status.ConsecutiveFailures != 0 {
+		t.Errorf("last error %q failures %d, want recorded error and reset failures",
+			status.LastError, status.ConsecutiveFailures)
+	}
+
+	attempts, written := writer.snapshot()
+	if len(attempts) != 3 {
+		t.Fatalf("%d write attempts, want 2 failed and 1 successful", len(attempts))
+	}
+	for i, attempt := range attempts {
+		if len(attempt) != 2 || header(attempt[0], "position") != "1" || header(attempt[1], "position") != "2" {
+			t.Errorf("attempt %d did not retry records 1 and 2", i)
+		}
+	}
+	if len(written) != 2 {
+		t.Errorf("written %d messages, want 2", len(written))
+	}
+	if !writer.closed {
+		t.Error("writer not closed on stop")
+	}
+
+	positions, err := st.LoadExporterPositions()
+	if err != nil {
+		t.Fatalf("load positions: %v", err)
+	}
+	if positions["kafka-test"] != 2 {
+		t.Errorf("saved position %d, want 2", positions["kafka-test"])
+	}
+}
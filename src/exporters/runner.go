@@ -0,0 +1,252 @@
+/*
+This file is part of the AtomBPMN (R) project.
+Copyright (c) 2025 Matreska Market LLC (ООО «Matreska Market»).
+Authors: Matreska Team.
+
+This project is dual-licensed under AGPL-3.0 and AtomBPMN Commercial License.
+*/
+
+package exporters
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"atom-engine/src/core/config"
+	"atom-engine/src/core/logger"
+	"atom-engine/src/core/metrics"
+)
+
+// runner feeds export log records to single exporter in batches and
+// persists acknowledged position after every successful export
+// Передает записи журнала экспорта одному экспортеру пакетами
+type runner struct {
+	component *Component
+	id        string
+	kind      string
+	exporter  Exporter
+
+	batchSize       int
+	flushInterval   time.Duration
+	retryBackoff    time.Duration
+	maxRetryBackoff time.Duration
+
+	wake chan struct{}
+
+	mu           sync.Mutex
+	position     uint64
+	exported     uint64
+	lastExportAt time.Time
+	lastError    string
+	lastErrorAt  time.Time
+	failures     int
+}
+
+// newRunner creates runner continuing after acknowledged position
+func newRunner(component *Component, cfg config.ExporterConfig, exporter Exporter, position uint64) (*runner, error) {
+	r := &runner{
+		component: component,
+		id:        cfg.ID,
+		kind:      cfg.Type,
+		exporter:  exporter,
+		batchSize: cfg.BatchSize,
+		position:  position,
+		wake:      make(chan struct{}, 1),
+	}
+
+	var err error
+	if r.flushInterval, err = time.ParseDuration(cfg.FlushInterval); err != nil {
+		return nil, fmt.Errorf("invalid flush_interval: %w", err)
+	}
+	if r.retryBackoff, err = time.ParseDuration(cfg.RetryBackoff); err != nil {
+		return nil, fmt.Errorf("invalid retry_backoff: %w", err)
+	}
+	if r.maxRetryBackoff, err = time.ParseDuration(cfg.MaxRetryBackoff); err != nil {
+		return nil, fmt.Errorf("invalid max_retry_backoff: %w", err)
+	}
+	if r.batchSize < 1 {
+		r.batchSize = 1
+	}
+	return r, nil
+}
+
+// Position returns last acknowledged position
+func (r *runner) Position() uint64 {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.position
+}
+
+// signal wakes runner without blocking
+func (r *runner) signal() {
+	select {
+	case r.wake <- struct{}{}:
+	default:
+	}
+}
+
+// run opens exporter and exports records until context is canceled
+func (r *runner) run(ctx context.Context) {
+	defer r.component.wg.Done()
+
+	backoff := r.retryBackoff
+	for {
+		err := r.exporter.Open(ctx)
+		if err == nil {
+			break
+		}
+		r.fail("open", err)
+		if !r.wait(ctx, backoff) {
+			return
+		}
+		backoff = r.nextBackoff(backoff)
+	}
+
+	backoff = r.retryBackoff
+	var pendingSince time.Time
+	for {
+		records, err := r.component.loadRecords(r.Position(), r.batchSize)
+		if err != nil {
+			r.fail("load", err)
+			if !r.wait(ctx, backoff) {
+				return
+			}
+			backoff = r.nextBackoff(backoff)
+			continue
+		}
+
+		if len(records) == 0 {
+			pendingSince = time.Time{}
+			if !r.wait(ctx, 0) {
+				return
+			}
+			continue
+		}
+
+		// Partial batch waits for more records up to flush interval
+		if len(records) < r.batchSize && r.flushInterval > 0 {
+			if pendingSince.IsZero() {
+				pendingSince = time.Now()
+			}
+			if remaining := r.flushInterval - time.Since(pendingSince); remaining > 0 {
+				if !r.wait(ctx, remaining) {
+					return
+				}
+				continue
+			}
+		}
+
+		if err := r.exporter.Export(ctx, records); err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+			r.fail("export", err)
+			if !r.wait(ctx, backoff) {
+				return
+			}
+			backoff = r.nextBackoff(backoff)
+			continue
+		}
+
+		backoff = r.retryBackoff
+		pendingSince = time.Time{}
+		r.acknowledge(records[len(records)-1].Position, len(records))
+	}
+}
+
+// acknowledge persists position of exported records
+func (r *runner) acknowledge(position uint64, count int) {
+	r.mu.Lock()
+	r.position = position
+	r.exported += uint64(count)
+	r.lastExportAt = time.Now()
+	r.failures = 0
+	r.mu.Unlock()
+
+	metrics.ExporterRecordsExported.WithLabelValues(r.id).Add(float64(count))
+
+	// Lost position only repeats records after restart
+	if err := r.component.storage.SaveExporterPosition(r.id, position); err != nil {
+		r.component.logger.Warn("Failed to save exporter position",
+			logger.String("exporter", r.id),
+			logger.Any("position", position),
+			logger.String("error", err.Error()))
+	}
+}
+
+// fail records failed step of exporter
+func (r *runner) fail(step string, err error) {
+	r.mu.Lock()
+	r.lastError = fmt.Sprintf("%s: %s", step, err.Error())
+	r.lastErrorAt = time.Now()
+	r.failures++
+	failures := r.failures
+	position := r.position
+	r.mu.Unlock()
+
+	metrics.ExporterFailures.WithLabelValues(r.id).Inc()
+	r.component.logger.Warn("Exporter failed",
+		logger.String("exporter", r.id),
+		logger.String("step", step),
+		logger.Any("position", position),
+		logger.Int("consecutive_failures", failures),
+		logger.String("error", err.Error()))
+}
+
+// wait blocks until runner is woken, timeout elapses or context ends.
+// Zero timeout waits for wake only. Returns false when context ended.
+func (r *runner) wait(ctx context.Context, timeout time.Duration) bool {
+	var timer <-chan time.Time
+	if timeout > 0 {
+		t := time.NewTimer(timeout)
+		defer t.Stop()
+		timer = t.C
+	}
+
+	select {
+	case <-ctx.Done():
+		return false
+	case <-r.wake:
+		return true
+	case <-timer:
+		return true
+	}
+}
+
+// nextBackoff doubles retry delay up to maximum
+func (r *runner) nextBackoff(backoff time.Duration) time.Duration {
+	backoff *= 2
+	if backoff > r.maxRetryBackoff {
+		return r.maxRetryBackoff
+	}
+	return backoff
+}
+
+// status returns exporter progress relative to last log position
+func (r *runner) status(last uint64) *ExporterStatus {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	status := &ExporterStatus{
+		ID:                  r.id,
+		Type:                r.kind,
+		Position:            r.position,
+		Exported:            r.exported,
+		LastError:           r.lastError,
+		ConsecutiveFailures: r.failures,
+	}
+	if last > r.position {
+		status.Lag = last - r.position
+	}
+	if !r.lastExportAt.IsZero() {
+		lastExportAt := r.lastExportAt
+		status.LastExportAt = &lastExportAt
+	}
+	if !r.lastErrorAt.IsZero() {
+		lastErrorAt := r.lastErrorAt
+		status.LastErrorAt = &lastErrorAt
+	}
+	return status
+}
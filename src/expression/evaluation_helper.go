@@ -10,8 +10,8 @@ package expression
 
 import (
 	"strconv"
-	"time"
 
+	"atom-engine/src/core/clock"
 	"atom-engine/src/core/logger"
 	"atom-engine/src/core/models"
 )
@@ -155,7 +155,7 @@ func (eh *EvaluationHelper) EvaluateTokenVariables(token *models.Token) error {
 	// Update token variables with evaluated values
 	// Обновляем переменные токена оцененными значениями
 	token.Variables = evaluatedVariables
-	token.UpdatedAt = time.Now()
+	token.UpdatedAt = clock.Now()
 
 	eh.logger.Debug("Token variables evaluated successfully",
 		logger.String("token_id", token.TokenID))
@@ -14,6 +14,8 @@ import (
 	"strings"
 	"time"
 
+	"atom-engine/src/core/calendar"
+	"atom-engine/src/core/clock"
 	"atom-engine/src/core/logger"
 	"atom-engine/src/timewheel"
 )
@@ -32,7 +34,7 @@ func NewFunctionEvaluator(logger logger.ComponentLogger) *FunctionEvaluator {
 	return &FunctionEvaluator{
 		logger:            logger,
 		durationParser:    timewheel.NewISO8601DurationParser(),
-		functionCallRegex: regexp.MustCompile(`^([a-z]+)\((.*)\)$`),
+		functionCallRegex: regexp.MustCompile(`^([a-z][a-z_]*)\((.*)\)$`),
 	}
 }
 
@@ -99,6 +101,14 @@ func (fe *FunctionEvaluator) EvaluateFunction(
 		return fe.executeSubtract(evaluatedArgs)
 	case "add":
 		return fe.executeAdd(evaluatedArgs)
+	case "now":
+		return fe.executeNow(evaluatedArgs)
+	case "add_working_time":
+		return fe.executeAddWorkingTime(evaluatedArgs)
+	case "next_business_day":
+		return fe.executeNextBusinessDay(evaluatedArgs)
+	case "is_working_time":
+		return fe.executeIsWorkingTime(evaluatedArgs)
 	default:
 		return nil, fmt.Errorf("unknown function: %s", funcName)
 	}
@@ -316,6 +326,140 @@ func (fe *FunctionEvaluator) executeAdd(args []interface{}) (interface{}, error)
 	return resultStr, nil
 }
 
+// executeNow executes now() function returning engine time
+// Выполняет функцию now() возвращающую время движка
+func (fe *FunctionEvaluator) executeNow(args []interface{}) (interface{}, error) {
+	if len(args) != 0 {
+		return nil, fmt.Errorf("now() takes no arguments, got %d", len(args))
+	}
+	return fe.formatISO8601DateTime(clock.Now()), nil
+}
+
+// executeAddWorkingTime executes add_working_time(datetime, duration, calendar).
+// Days count business days, time part counts working time of calendar.
+// Выполняет функцию add_working_time(datetime, duration, calendar)
+func (fe *FunctionEvaluator) executeAddWorkingTime(args []interface{}) (interface{}, error) {
+	if len(args) != 3 {
+		return nil, fmt.Errorf("add_working_time() requires exactly 3 arguments, got %d", len(args))
+	}
+
+	datetime, err := fe.dateTimeArgument("add_working_time", args[0])
+	if err != nil {
+		return nil, err
+	}
+
+	durationStr, ok := args[1].(string)
+	if !ok {
+		return nil, fmt.Errorf("add_working_time() second argument must be duration string, got %T", args[1])
+	}
+
+	cal, err := fe.calendarArgument("add_working_time", args[2])
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := timewheel.AddWorkingDuration(cal, datetime, durationStr)
+	if err != nil {
+		return nil, fmt.Errorf("add_working_time() failed: %w", err)
+	}
+
+	return fe.formatISO8601DateTime(result.In(datetime.Location())), nil
+}
+
+// executeNextBusinessDay executes next_business_day(datetime, calendar[, "HH:MM"]).
+// Without time returns start of first working period of that day.
+// Выполняет функцию next_business_day(datetime, calendar[, "HH:MM"])
+func (fe *FunctionEvaluator) executeNextBusinessDay(args []interface{}) (interface{}, error) {
+	if len(args) != 2 && len(args) != 3 {
+		return nil, fmt.Errorf("next_business_day() requires 2 or 3 arguments, got %d", len(args))
+	}
+
+	datetime, err := fe.dateTimeArgument("next_business_day", args[0])
+	if err != nil {
+		return nil, err
+	}
+
+	cal, err := fe.calendarArgument("next_business_day", args[1])
+	if err != nil {
+		return nil, err
+	}
+
+	clockTime := ""
+	if len(args) == 3 {
+		if clockTime, err = fe.stringArgument("next_business_day", args[2]); err != nil {
+			return nil, err
+		}
+	}
+
+	result, err := cal.NextBusinessDay(datetime, clockTime)
+	if err != nil {
+		return nil, fmt.Errorf("next_business_day() failed: %w", err)
+	}
+
+	return fe.formatISO8601DateTime(result), nil
+}
+
+// executeIsWorkingTime executes is_working_time(datetime, calendar)
+// Выполняет функцию is_working_time(datetime, calendar)
+func (fe *FunctionEvaluator) executeIsWorkingTime(args []interface{}) (interface{}, error) {
+	if len(args) != 2 {
+		return nil, fmt.Errorf("is_working_time() requires exactly 2 arguments, got %d", len(args))
+	}
+
+	datetime, err := fe.dateTimeArgument("is_working_time", args[0])
+	if err != nil {
+		return nil, err
+	}
+
+	cal, err := fe.calendarArgument("is_working_time", args[1])
+	if err != nil {
+		return nil, err
+	}
+
+	return cal.IsWorkingTime(datetime), nil
+}
+
+// dateTimeArgument converts function argument to time
+// Преобразует аргумент функции во время
+func (fe *FunctionEvaluator) dateTimeArgument(funcName string, arg interface{}) (time.Time, error) {
+	switch value := arg.(type) {
+	case time.Time:
+		return value, nil
+	case string:
+		datetime, err := fe.parseISO8601DateTime(value)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("%s() invalid datetime: %w", funcName, err)
+		}
+		return datetime, nil
+	default:
+		return time.Time{}, fmt.Errorf("%s() first argument must be datetime string, got %T", funcName, arg)
+	}
+}
+
+// stringArgument converts function argument to string
+// Преобразует аргумент функции в строку
+func (fe *FunctionEvaluator) stringArgument(funcName string, arg interface{}) (string, error) {
+	value, ok := arg.(string)
+	if !ok {
+		return "", fmt.Errorf("%s() argument must be string, got %T", funcName, arg)
+	}
+	return value, nil
+}
+
+// calendarArgument resolves business calendar by name argument
+// Находит бизнес-календарь по аргументу с именем
+func (fe *FunctionEvaluator) calendarArgument(funcName string, arg interface{}) (*calendar.Calendar, error) {
+	name, err := fe.stringArgument(funcName, arg)
+	if err != nil {
+		return nil, err
+	}
+	cal, err := calendar.Get(name)
+	if err != nil {
+		return nil, fmt.Errorf("%s() %w", funcName, err)
+	}
+	return cal, nil
+}
+
 // parseISO8601DateTime parses ISO 8601 datetime string
 // Парсит строку даты-времени ISO 8601
 func (fe *FunctionEvaluator) parseISO8601DateTime(dateStr string) (time.Time, error) {
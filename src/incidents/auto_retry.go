@@ -0,0 +1,585 @@
+/*
+This file is part of the AtomBPMN (R) project.
+Copyright (c) 2025 Matreska Market LLC (ООО «Matreska Market»).
+Authors: Matreska Team.
+
+This project is dual-licensed under AGPL-3.0 and AtomBPMN Commercial License.
+*/
+
+package incidents
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"atom-engine/src/core/clock"
+	"atom-engine/src/core/config"
+	"atom-engine/src/core/logger"
+	"atom-engine/src/core/models"
+	"atom-engine/src/storage"
+	"atom-engine/src/timewheel"
+)
+
+// AutoRetryResolvedBy marks incidents resolved by automatic retry
+// Отмечает инциденты решенные автоматическим повтором
+const AutoRetryResolvedBy = "auto-retry"
+
+// incidentTimerSource marks timers owned by incidents component
+const incidentTimerSource = "incidents"
+
+// AutoRetryScheduler retries incidents matching retry rules through
+// timewheel timers and escalates them when attempts are exhausted
+// Повторяет инциденты подходящие под правила через таймеры timewheel
+type AutoRetryScheduler struct {
+	manager *IncidentManager
+	storage storage.Storage
+	logger  logger.ComponentLogger
+
+	mu         sync.RWMutex
+	configured []*RetryRule
+	stored     map[string]*RetryRule
+}
+
+// NewAutoRetryScheduler creates automatic retry scheduler
+// Создает планировщик автоматических повторов
+func NewAutoRetryScheduler(manager *IncidentManager, storage storage.Storage) *AutoRetryScheduler {
+	return &AutoRetryScheduler{
+		manager: manager,
+		storage: storage,
+		logger:  logger.NewComponentLogger("incident-auto-retry"),
+		stored:  make(map[string]*RetryRule),
+	}
+}
+
+// LoadRules registers rules from configuration and then rules created
+// through API. Stored rule cannot shadow configured one.
+// Регистрирует правила из конфигурации, затем созданные через API
+func (s *AutoRetryScheduler) LoadRules(configured []config.IncidentRetryRuleConfig) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	names := make(map[string]bool, len(configured))
+	for _, ruleConfig := range configured {
+		rule := RetryRuleFromConfig(ruleConfig)
+		if err := rule.Compile(); err != nil {
+			return err
+		}
+		s.configured = append(s.configured, rule)
+		names[rule.Name] = true
+	}
+
+	records, err := s.storage.LoadIncidentRetryRules()
+	if err != nil {
+		return fmt.Errorf("failed to load retry rules: %w", err)
+	}
+
+	for _, data := range records {
+		var rule RetryRule
+		if err := json.Unmarshal(data, &rule); err != nil {
+			s.logger.Error("Skipping unreadable stored retry rule", logger.String("error", err.Error()))
+			continue
+		}
+		if names[rule.Name] {
+			s.logger.Warn("Stored retry rule is shadowed by configuration", logger.String("rule", rule.Name))
+			continue
+		}
+		if err := rule.Compile(); err != nil {
+			s.logger.Error("Skipping invalid stored retry rule",
+				logger.String("rule", rule.Name),
+				logger.String("error", err.Error()))
+			continue
+		}
+		s.stored[rule.Name] = &rule
+	}
+
+	s.logger.Info("Incident retry rules loaded",
+		logger.Int("configured", len(s.configured)),
+		logger.Int("stored", len(s.stored)))
+	return nil
+}
+
+// Rules returns rules in evaluation order: configured rules as listed,
+// then rules created through API by name
+// Возвращает правила в порядке проверки
+func (s *AutoRetryScheduler) Rules() []*RetryRule {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.orderedRules(nil)
+}
+
+// orderedRules returns rules in evaluation order with optional candidate
+// API rule added or replacing stored rule of same name, caller holds lock
+// Возвращает правила в порядке проверки с необязательным правилом-кандидатом
+func (s *AutoRetryScheduler) orderedRules(candidate *RetryRule) []*RetryRule {
+	rules := make([]*RetryRule, 0, len(s.configured)+len(s.stored)+1)
+	rules = append(rules, s.configured...)
+
+	stored := make([]*RetryRule, 0, len(s.stored)+1)
+	for name, rule := range s.stored {
+		if candidate == nil || name != candidate.Name {
+			stored = append(stored, rule)
+		}
+	}
+	if candidate != nil {
+		stored = append(stored, candidate)
+	}
+	sort.Slice(stored, func(i, j int) bool {
+		return stored[i].Name < stored[j].Name
+	})
+
+	return append(rules, stored...)
+}
+
+// Rule returns rule by name
+// Возвращает правило по имени
+func (s *AutoRetryScheduler) Rule(name string) (*RetryRule, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.rule(name)
+}
+
+// rule returns rule by name, caller holds lock
+// Возвращает правило по имени, вызывающий держит блокировку
+func (s *AutoRetryScheduler) rule(name string) (*RetryRule, error) {
+	for _, rule := range s.configured {
+		if rule.Name == name {
+			return rule, nil
+		}
+	}
+	if rule, exists := s.stored[name]; exists {
+		return rule, nil
+	}
+	return nil, fmt.Errorf("%w: %s", ErrRetryRuleNotFound, name)
+}
+
+// PutRule creates or replaces rule defined through API and schedules open
+// incidents it matches, returns saved rule and number of scheduled incidents
+// Создает или заменяет правило заданное через API и планирует открытые инциденты
+func (s *AutoRetryScheduler) PutRule(ctx context.Context, rule *RetryRule) (*RetryRule, int, error) {
+	if err := rule.Compile(); err != nil {
+		return nil, 0, err
+	}
+
+	s.mu.Lock()
+	existing, err := s.rule(rule.Name)
+	if err == nil && existing.Source == RetryRuleSourceConfig {
+		s.mu.Unlock()
+		return nil, 0, fmt.Errorf("%w: %s", ErrRetryRuleReadOnly, rule.Name)
+	}
+
+	now := clock.Now()
+	rule.Source = RetryRuleSourceAPI
+	rule.CreatedAt = &now
+	rule.UpdatedAt = &now
+	if existing != nil && existing.CreatedAt != nil {
+		rule.CreatedAt = existing.CreatedAt
+	}
+
+	data, err := json.Marshal(rule)
+	if err != nil {
+		s.mu.Unlock()
+		return nil, 0, fmt.Errorf("failed to marshal retry rule: %w", err)
+	}
+	if err := s.storage.SaveIncidentRetryRule(rule.Name, data); err != nil {
+		s.mu.Unlock()
+		return nil, 0, fmt.Errorf("failed to save retry rule: %w", err)
+	}
+	s.stored[rule.Name] = rule
+	s.mu.Unlock()
+
+	s.logger.Info("Incident retry rule saved", logger.String("rule", rule.Name))
+
+	return rule, s.TrackOpen(ctx), nil
+}
+
+// DeleteRule deletes rule defined through API. Pending retries of the
+// rule are canceled when their timers fire.
+// Удаляет правило заданное через API
+func (s *AutoRetryScheduler) DeleteRule(name string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	rule, err := s.rule(name)
+	if err != nil {
+		return err
+	}
+	if rule.Source == RetryRuleSourceConfig {
+		return fmt.Errorf("%w: %s", ErrRetryRuleReadOnly, name)
+	}
+
+	if err := s.storage.DeleteIncidentRetryRule(name); err != nil {
+		return fmt.Errorf("failed to delete retry rule: %w", err)
+	}
+	delete(s.stored, name)
+
+	s.logger.Info("Incident retry rule deleted", logger.String("rule", name))
+	return nil
+}
+
+// Track starts automatic retry of new incident when a rule matches
+// Запускает автоматический повтор нового инцидента если правило подходит
+func (s *AutoRetryScheduler) Track(ctx context.Context, incident *Incident) bool {
+	rule := s.match(incident, nil)
+	if rule == nil {
+		return false
+	}
+
+	state := s.previousState(ctx, incident, rule)
+	if state == nil {
+		state = &AutoRetryState{Rule: rule.Name}
+	}
+	state.MaxAttempts = rule.MaxAttempts
+
+	if state.Attempts >= rule.MaxAttempts {
+		s.escalate(incident, state)
+		return true
+	}
+
+	if err := s.schedule(incident, state, rule); err != nil {
+		s.logger.Error("Failed to schedule incident retry",
+			logger.String("incident_id", incident.ID),
+			logger.String("rule", rule.Name),
+			logger.String("error", err.Error()))
+		return false
+	}
+	return true
+}
+
+// TrackOpen starts automatic retry of open incidents not tracked yet,
+// returns number of incidents picked up
+// Запускает автоматический повтор еще не отслеживаемых открытых инцидентов
+func (s *AutoRetryScheduler) TrackOpen(ctx context.Context) int {
+	open, err := s.openIncidents(ctx)
+	if err != nil {
+		s.logger.Error("Failed to list open incidents for retry rules", logger.String("error", err.Error()))
+		return 0
+	}
+
+	tracked := 0
+	for _, incident := range open {
+		if incident.AutoRetryState() != nil {
+			continue
+		}
+		if s.Track(ctx, incident) {
+			tracked++
+		}
+	}
+
+	if tracked > 0 {
+		s.logger.Info("Open incidents picked up by retry rules", logger.Int("incidents", tracked))
+	}
+	return tracked
+}
+
+// HandleRetryTimer performs retry attempt of incident when its timer fires
+// Выполняет попытку повтора инцидента при срабатывании его таймера
+func (s *AutoRetryScheduler) HandleRetryTimer(ctx context.Context, timerID, incidentID string) error {
+	incident, err := s.manager.GetIncident(ctx, incidentID)
+	if err != nil {
+		return err
+	}
+
+	state := incident.AutoRetryState()
+	if state == nil || state.TimerID != timerID || !incident.IsOpen() {
+		// Incident was resolved by hand or rescheduled meanwhile
+		s.logger.Debug("Ignoring stale incident retry timer",
+			logger.String("incident_id", incidentID),
+			logger.String("timer_id", timerID))
+		return nil
+	}
+
+	now := clock.Now()
+	state.NextAttemptAt = nil
+	state.TimerID = ""
+
+	rule, err := s.Rule(state.Rule)
+	if err != nil {
+		state.History = append(state.History, AutoRetryAttempt{
+			Attempt:    state.Attempts + 1,
+			IncidentID: incident.ID,
+			At:         now,
+			Result:     AutoRetryResultCanceled,
+			Error:      err.Error(),
+		})
+		incident.SetAutoRetryState(state)
+		return s.save(incident)
+	}
+
+	state.Attempts++
+	state.MaxAttempts = rule.MaxAttempts
+	state.History = append(state.History, AutoRetryAttempt{
+		Attempt:    state.Attempts,
+		IncidentID: incident.ID,
+		At:         now,
+		Result:     AutoRetryResultRetried,
+	})
+
+	// Resolution reloads incident from storage, persist attempt first
+	incident.SetAutoRetryState(state)
+	if err := s.save(incident); err != nil {
+		return err
+	}
+
+	request := &ResolveIncidentRequest{
+		IncidentID: incident.ID,
+		Action:     ResolveActionRetry,
+		ResolvedBy: AutoRetryResolvedBy,
+		Comment:    fmt.Sprintf("automatic retry %d/%d by rule %s", state.Attempts, rule.MaxAttempts, rule.Name),
+	}
+	if incident.IsJobFailure() {
+		request.NewRetries = rule.jobRetries()
+	}
+
+	_, err = s.manager.ResolveIncident(ctx, request)
+	if err == nil {
+		s.logger.Info("Incident retried automatically",
+			logger.String("incident_id", incident.ID),
+			logger.String("rule", rule.Name),
+			logger.Int("attempt", state.Attempts))
+		return nil
+	}
+
+	state.History[len(state.History)-1].Result = AutoRetryResultFailed
+	state.History[len(state.History)-1].Error = err.Error()
+
+	s.logger.Warn("Automatic incident retry failed",
+		logger.String("incident_id", incident.ID),
+		logger.String("rule", rule.Name),
+		logger.Int("attempt", state.Attempts),
+		logger.String("error", err.Error()))
+
+	if state.Attempts >= rule.MaxAttempts {
+		s.escalate(incident, state)
+		return nil
+	}
+	return s.schedule(incident, state, rule)
+}
+
+// Preview reports what rules would do with open incidents without acting.
+// With candidate rule only incidents it would pick up are reported.
+// Показывает действие правил для открытых инцидентов без выполнения
+func (s *AutoRetryScheduler) Preview(ctx context.Context, candidate *RetryRule) ([]*RetryPreview, error) {
+	if candidate != nil {
+		if err := candidate.Compile(); err != nil {
+			return nil, err
+		}
+		if existing, err := s.Rule(candidate.Name); err == nil && existing.Source == RetryRuleSourceConfig {
+			return nil, fmt.Errorf("%w: %s", ErrRetryRuleReadOnly, candidate.Name)
+		}
+	}
+
+	open, err := s.openIncidents(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	now := clock.Now()
+	previews := make([]*RetryPreview, 0)
+	for _, incident := range open {
+		preview := &RetryPreview{
+			IncidentID: incident.ID,
+			Type:       incident.Type,
+			ProcessKey: incident.ProcessKey,
+			ElementID:  incident.ElementID,
+			JobType:    incident.JobType,
+			Message:    incident.Message,
+		}
+
+		if state := incident.AutoRetryState(); state != nil {
+			if candidate != nil {
+				continue
+			}
+			preview.Rule = state.Rule
+			preview.Attempts = state.Attempts
+			preview.MaxAttempts = state.MaxAttempts
+			preview.NextAttemptAt = state.NextAttemptAt
+			preview.Action = RetryPreviewScheduled
+			if state.Escalated {
+				preview.Action = RetryPreviewEscalated
+			}
+			previews = append(previews, preview)
+			continue
+		}
+
+		rule := s.match(incident, candidate)
+		if rule == nil || (candidate != nil && rule != candidate) {
+			continue
+		}
+
+		preview.Rule = rule.Name
+		preview.MaxAttempts = rule.MaxAttempts
+		if previous := s.previousState(ctx, incident, rule); previous != nil {
+			preview.Attempts = previous.Attempts
+		}
+		if preview.Attempts >= rule.MaxAttempts {
+			preview.Action = RetryPreviewEscalate
+		} else {
+			next := now.Add(rule.IntervalDuration())
+			preview.Action = RetryPreviewSchedule
+			preview.NextAttemptAt = &next
+		}
+		previews = append(previews, preview)
+	}
+
+	return previews, nil
+}
+
+// match returns first rule matching incident
+// Возвращает первое правило подходящее под инцидент
+func (s *AutoRetryScheduler) match(incident *Incident, candidate *RetryRule) *RetryRule {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	for _, rule := range s.orderedRules(candidate) {
+		if rule.Matches(incident) {
+			return rule
+		}
+	}
+	return nil
+}
+
+// previousState returns retry state of earlier incident of same job resolved
+// by the rule, so job failing again after retry continues attempt count
+// Возвращает состояние повтора предыдущего инцидента того же job'а
+func (s *AutoRetryScheduler) previousState(ctx context.Context, incident *Incident, rule *RetryRule) *AutoRetryState {
+	if incident.JobKey == "" {
+		return nil
+	}
+
+	previous, _, err := s.manager.ListIncidents(ctx, &IncidentFilter{
+		Status: []IncidentStatus{IncidentStatusResolved},
+		JobKey: incident.JobKey,
+	})
+	if err != nil {
+		return nil
+	}
+
+	var latest *Incident
+	var state *AutoRetryState
+	for _, candidate := range previous {
+		if candidate.ID == incident.ID || candidate.ResolvedBy != AutoRetryResolvedBy {
+			continue
+		}
+		candidateState := candidate.AutoRetryState()
+		if candidateState == nil || candidateState.Rule != rule.Name {
+			continue
+		}
+		if latest == nil || candidate.UpdatedAt.After(latest.UpdatedAt) {
+			latest = candidate
+			state = candidateState
+		}
+	}
+
+	if state != nil {
+		state.NextAttemptAt = nil
+		state.TimerID = ""
+		state.Escalated = false
+		state.EscalatedAt = nil
+	}
+	return state
+}
+
+// schedule records next attempt in incident and schedules timewheel timer for it
+// Записывает следующую попытку в инцидент и планирует для нее таймер timewheel
+func (s *AutoRetryScheduler) schedule(incident *Incident, state *AutoRetryState, rule *RetryRule) error {
+	core := s.manager.core
+	if core == nil {
+		return fmt.Errorf("core not available for timer scheduling")
+	}
+
+	nextAttemptAt := clock.Now().Add(rule.IntervalDuration())
+	state.NextAttemptAt = &nextAttemptAt
+	state.TimerID = models.GenerateID()
+	incident.SetAutoRetryState(state)
+
+	if err := s.save(incident); err != nil {
+		return err
+	}
+
+	// Timer requests require element and process instance, system incidents may not have them
+	elementID := incident.ElementID
+	if elementID == "" {
+		elementID = incident.ID
+	}
+	processInstanceID := incident.ProcessInstanceID
+	if processInstanceID == "" {
+		processInstanceID = incident.ID
+	}
+
+	timerID := state.TimerID
+	timeDate := nextAttemptAt.UTC().Format(time.RFC3339Nano)
+	message, err := timewheel.CreateScheduleTimerMessage(timewheel.TimerRequest{
+		ElementID:         elementID,
+		TokenID:           incident.ID,
+		ProcessInstanceID: processInstanceID,
+		TimerType:         models.TimerTypeIncidentRetry,
+		ProcessContext: &models.TimerProcessContext{
+			ProcessKey:      incident.ProcessKey,
+			TenantID:        incident.TenantID,
+			ComponentSource: incidentTimerSource,
+		},
+		TimeDate: &timeDate,
+		TimerID:  &timerID,
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := core.SendMessage("timewheel", message); err != nil {
+		return fmt.Errorf("failed to schedule incident retry timer: %w", err)
+	}
+
+	s.logger.Info("Incident retry scheduled",
+		logger.String("incident_id", incident.ID),
+		logger.String("rule", rule.Name),
+		logger.Int("attempt", state.Attempts+1),
+		logger.String("next_attempt_at", nextAttemptAt.Format(time.RFC3339)))
+	return nil
+}
+
+// escalate marks incident escalated after retries are exhausted and notifies
+// Отмечает инцидент эскалированным после исчерпания повторов и уведомляет
+func (s *AutoRetryScheduler) escalate(incident *Incident, state *AutoRetryState) {
+	now := clock.Now()
+	state.Escalated = true
+	state.EscalatedAt = &now
+	state.NextAttemptAt = nil
+	state.TimerID = ""
+	incident.SetAutoRetryState(state)
+
+	if err := s.save(incident); err != nil {
+		s.logger.Error("Failed to save escalated incident",
+			logger.String("incident_id", incident.ID),
+			logger.String("error", err.Error()))
+	}
+
+	s.logger.Warn("Incident escalated after automatic retries",
+		logger.String("incident_id", incident.ID),
+		logger.String("rule", state.Rule),
+		logger.Int("attempts", state.Attempts))
+
+	s.manager.notify(NotificationEventEscalated, incident)
+}
+
+// openIncidents lists all open incidents
+// Получает все открытые инциденты
+func (s *AutoRetryScheduler) openIncidents(ctx context.Context) ([]*Incident, error) {
+	open, _, err := s.manager.ListIncidents(ctx, &IncidentFilter{
+		Status: []IncidentStatus{IncidentStatusOpen},
+	})
+	return open, err
+}
+
+// save persists incident with updated retry state
+// Сохраняет инцидент с обновленным состоянием повтора
+func (s *AutoRetryScheduler) save(incident *Incident) error {
+	incident.UpdatedAt = clock.Now()
+	if err := s.storage.SaveIncident(incident); err != nil {
+		return fmt.Errorf("failed to save incident retry state: %w", err)
+	}
+	return nil
+}
@@ -12,6 +12,7 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"sync"
 
 	"atom-engine/src/core/config"
 	"atom-engine/src/core/logger"
@@ -66,6 +67,8 @@ type ComponentInterface interface {
 // Определяет методы core необходимые incidents компоненту
 type CoreInterface interface {
 	SendMessage(componentName, messageJSON string) error
+	// RetryIncidentElement applies variable patch and re-executes element of failed token
+	RetryIncidentElement(processInstanceID, tokenID, elementID string, variables map[string]interface{}) error
 }
 
 // Component represents the incidents component
@@ -89,6 +92,16 @@ type Component struct {
 
 	// Core interface for communicating with other components
 	core CoreInterface
+
+	// Incident notifications
+	notifier   *NotificationDispatcher
+	mailSender MailSender
+
+	// Automatic retry by retry rules
+	autoRetry *AutoRetryScheduler
+
+	// Serializes incident outbox draining
+	outboxMu sync.Mutex
 }
 
 // NewComponent creates new incidents component
@@ -121,6 +134,31 @@ func (c *Component) Init() error {
 		return fmt.Errorf("storage is not ready")
 	}
 
+	var notificationsConfig config.IncidentNotificationsConfig
+	if c.config != nil {
+		notificationsConfig = c.config.Incidents.Notifications
+	}
+	notifier, err := NewNotificationDispatcher(notificationsConfig, c.storage)
+	if err != nil {
+		return fmt.Errorf("failed to create incident notifier: %w", err)
+	}
+	notifier.SetMailSender(c.mailSender)
+	c.notifier = notifier
+	if im, ok := c.manager.(*IncidentManager); ok {
+		im.SetNotifier(notifier)
+
+		var retryRules []config.IncidentRetryRuleConfig
+		if c.config != nil {
+			retryRules = c.config.Incidents.AutoRetry
+		}
+		autoRetry := NewAutoRetryScheduler(im, c.storage)
+		if err := autoRetry.LoadRules(retryRules); err != nil {
+			return fmt.Errorf("failed to load incident retry rules: %w", err)
+		}
+		c.autoRetry = autoRetry
+		im.SetAutoRetry(autoRetry)
+	}
+
 	c.logger.Info("Incidents component initialized successfully")
 	return nil
 }
@@ -130,10 +168,27 @@ func (c *Component) Init() error {
 func (c *Component) Start() error {
 	c.logger.Info("Starting incidents component")
 
+	if c.notifier != nil {
+		if err := c.notifier.Start(); err != nil {
+			return fmt.Errorf("failed to start incident notifier: %w", err)
+		}
+	}
+
 	// Start JSON message processing goroutine
 	go c.processMessages()
 
 	c.ready = true
+
+	// Create incidents raised before start and pick up open incidents
+	// asynchronously, scheduling timers sends messages through core which
+	// is locked while components start
+	go func() {
+		c.DrainOutbox(c.ctx)
+		if c.autoRetry != nil {
+			c.autoRetry.TrackOpen(c.ctx)
+		}
+	}()
+
 	c.logger.Info("Incidents component started successfully")
 	return nil
 }
@@ -148,6 +203,21 @@ func (c *Component) SetCore(core CoreInterface) {
 	}
 }
 
+// SetMailSender sets SMTP sender for email notifiers, must be called before Init
+// Устанавливает SMTP отправителя для email получателей, вызывается до Init
+func (c *Component) SetMailSender(sender MailSender) {
+	c.mailSender = sender
+}
+
+// RegisterNotifier adds custom incident notifier, must be called after Init
+// Добавляет пользовательского получателя уведомлений, вызывается после Init
+func (c *Component) RegisterNotifier(notifier Notifier) error {
+	if c.notifier == nil {
+		return fmt.Errorf("incidents component not initialized")
+	}
+	return c.notifier.Register(notifier)
+}
+
 // Stop stops incidents component
 // Останавливает компонент инцидентов
 func (c *Component) Stop() error {
@@ -156,6 +226,10 @@ func (c *Component) Stop() error {
 	c.ready = false
 	c.cancel()
 
+	if c.notifier != nil {
+		c.notifier.Stop()
+	}
+
 	// Close channels
 	close(c.requestChannel)
 	close(c.responseChannel)
@@ -292,3 +292,79 @@ func (c *Component) ResolveByProcessInstance(
 		comment,
 	)
 }
+
+// Automatic retry rules
+// Правила автоматического повтора
+
+// autoRetryScheduler returns retry scheduler when component is ready
+// Возвращает планировщик повторов если компонент готов
+func (c *Component) autoRetryScheduler() (*AutoRetryScheduler, error) {
+	if err := c.checkReady(); err != nil {
+		return nil, err
+	}
+	if c.autoRetry == nil {
+		return nil, fmt.Errorf("incident auto retry not available")
+	}
+	return c.autoRetry, nil
+}
+
+// ListRetryRules returns retry rules in evaluation order
+// Возвращает правила повтора в порядке проверки
+func (c *Component) ListRetryRules() ([]*RetryRule, error) {
+	scheduler, err := c.autoRetryScheduler()
+	if err != nil {
+		return nil, err
+	}
+	return scheduler.Rules(), nil
+}
+
+// GetRetryRule returns retry rule by name
+// Возвращает правило повтора по имени
+func (c *Component) GetRetryRule(name string) (*RetryRule, error) {
+	scheduler, err := c.autoRetryScheduler()
+	if err != nil {
+		return nil, err
+	}
+	return scheduler.Rule(name)
+}
+
+// PutRetryRule creates or replaces retry rule, returns number of open incidents scheduled
+// Создает или заменяет правило повтора, возвращает число запланированных инцидентов
+func (c *Component) PutRetryRule(ctx context.Context, rule *RetryRule) (*RetryRule, int, error) {
+	scheduler, err := c.autoRetryScheduler()
+	if err != nil {
+		return nil, 0, err
+	}
+	return scheduler.PutRule(ctx, rule)
+}
+
+// DeleteRetryRule deletes retry rule created through API
+// Удаляет правило повтора созданное через API
+func (c *Component) DeleteRetryRule(name string) error {
+	scheduler, err := c.autoRetryScheduler()
+	if err != nil {
+		return err
+	}
+	return scheduler.DeleteRule(name)
+}
+
+// PreviewRetryRules reports what retry rules would do with open incidents,
+// with candidate rule only incidents it would pick up
+// Показывает действие правил повтора для открытых инцидентов
+func (c *Component) PreviewRetryRules(ctx context.Context, candidate *RetryRule) ([]*RetryPreview, error) {
+	scheduler, err := c.autoRetryScheduler()
+	if err != nil {
+		return nil, err
+	}
+	return scheduler.Preview(ctx, candidate)
+}
+
+// HandleRetryTimer performs automatic retry attempt when incident retry timer fires
+// Выполняет попытку автоматического повтора при срабатывании таймера
+func (c *Component) HandleRetryTimer(timerID, incidentID string) error {
+	scheduler, err := c.autoRetryScheduler()
+	if err != nil {
+		return err
+	}
+	return scheduler.HandleRetryTimer(c.ctx, timerID, incidentID)
+}
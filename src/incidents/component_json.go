@@ -88,26 +88,7 @@ func (c *Component) handleCreateIncident(ctx context.Context, request IncidentRe
 		return
 	}
 
-	// Convert to create request
-	createRequest := &CreateIncidentRequest{
-		Type:              IncidentType(payload.Type),
-		Message:           payload.Message,
-		ErrorCode:         payload.ErrorCode,
-		ProcessInstanceID: payload.ProcessInstanceID,
-		ProcessKey:        payload.ProcessKey,
-		ElementID:         payload.ElementID,
-		ElementType:       payload.ElementType,
-		JobKey:            payload.JobKey,
-		JobType:           payload.JobType,
-		WorkerID:          payload.WorkerID,
-		TimerID:           payload.TimerID,
-		MessageName:       payload.MessageName,
-		CorrelationKey:    payload.CorrelationKey,
-		OriginalRetries:   payload.OriginalRetries,
-		Metadata:          payload.Metadata,
-	}
-
-	incident, err := c.manager.CreateIncident(ctx, createRequest)
+	incident, err := c.manager.CreateIncident(ctx, payload.ToRequest())
 	if err != nil {
 		response := CreateIncidentErrorResponse("create_incident_response", request.RequestID, err.Error())
 		c.sendResponse(response)
@@ -139,6 +120,7 @@ func (c *Component) handleResolveIncident(ctx context.Context, request IncidentR
 		Comment:    payload.Comment,
 		ResolvedBy: payload.ResolvedBy,
 		NewRetries: payload.NewRetries,
+		Variables:  payload.Variables,
 	}
 
 	incident, err := c.manager.ResolveIncident(ctx, resolveRequest)
@@ -195,6 +177,7 @@ func (c *Component) handleListIncidents(ctx context.Context, request IncidentReq
 	filter := &IncidentFilter{
 		ProcessInstanceID: payload.ProcessInstanceID,
 		ProcessKey:        payload.ProcessKey,
+		TenantID:          payload.TenantID,
 		ElementID:         payload.ElementID,
 		JobKey:            payload.JobKey,
 		WorkerID:          payload.WorkerID,
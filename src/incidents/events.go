@@ -0,0 +1,42 @@
+/*
+This file is part of the AtomBPMN (R) project.
+Copyright (c) 2025 Matreska Market LLC (ООО «Matreska Market»).
+Authors: Matreska Team.
+
+This project is dual-licensed under AGPL-3.0 and AtomBPMN Commercial License.
+*/
+
+package incidents
+
+import (
+	"atom-engine/src/core/events"
+	"atom-engine/src/core/models"
+)
+
+// publishEvent publishes incident lifecycle event to engine event stream,
+// dismissed incidents are reported as resolved with their status
+// Публикует событие жизненного цикла инцидента в поток событий движка
+func (im *IncidentManager) publishEvent(eventType string, incident *Incident) {
+	event := &events.Event{
+		Type:              eventType,
+		TenantID:          models.NormalizeTenantID(incident.TenantID),
+		ProcessKey:        incident.ProcessKey,
+		ProcessInstanceID: incident.ProcessInstanceID,
+		ElementID:         incident.ElementID,
+		JobKey:            incident.JobKey,
+		JobType:           incident.JobType,
+		IncidentID:        incident.ID,
+		MessageName:       incident.MessageName,
+		Attributes: map[string]string{
+			"incident_type": string(incident.Type),
+			"status":        string(incident.Status),
+		},
+	}
+	if incident.ProcessInstanceID != "" {
+		instance, err := im.storage.LoadProcessInstance(incident.ProcessInstanceID)
+		if err == nil && instance != nil {
+			event.ProcessID = instance.ProcessID
+		}
+	}
+	events.Publish(event)
+}
@@ -39,8 +39,10 @@ type CreateIncidentPayload struct {
 	ErrorCode         string                 `json:"error_code,omitempty"`
 	ProcessInstanceID string                 `json:"process_instance_id,omitempty"`
 	ProcessKey        string                 `json:"process_key,omitempty"`
+	TenantID          string                 `json:"tenant_id,omitempty"`
 	ElementID         string                 `json:"element_id,omitempty"`
 	ElementType       string                 `json:"element_type,omitempty"`
+	TokenID           string                 `json:"token_id,omitempty"`
 	JobKey            string                 `json:"job_key,omitempty"`
 	JobType           string                 `json:"job_type,omitempty"`
 	WorkerID          string                 `json:"worker_id,omitempty"`
@@ -51,14 +53,39 @@ type CreateIncidentPayload struct {
 	Metadata          map[string]interface{} `json:"metadata,omitempty"`
 }
 
+// ToRequest converts payload to incident creation request
+// Преобразует полезную нагрузку в запрос создания инцидента
+func (p CreateIncidentPayload) ToRequest() *CreateIncidentRequest {
+	return &CreateIncidentRequest{
+		Type:              IncidentType(p.Type),
+		Message:           p.Message,
+		ErrorCode:         p.ErrorCode,
+		ProcessInstanceID: p.ProcessInstanceID,
+		ProcessKey:        p.ProcessKey,
+		TenantID:          p.TenantID,
+		ElementID:         p.ElementID,
+		ElementType:       p.ElementType,
+		TokenID:           p.TokenID,
+		JobKey:            p.JobKey,
+		JobType:           p.JobType,
+		WorkerID:          p.WorkerID,
+		TimerID:           p.TimerID,
+		MessageName:       p.MessageName,
+		CorrelationKey:    p.CorrelationKey,
+		OriginalRetries:   p.OriginalRetries,
+		Metadata:          p.Metadata,
+	}
+}
+
 // ResolveIncidentPayload represents payload for incident resolution
 // Представляет полезную нагрузку для разрешения инцидента
 type ResolveIncidentPayload struct {
-	IncidentID string `json:"incident_id"`
-	Action     string `json:"action"`
-	Comment    string `json:"comment,omitempty"`
-	ResolvedBy string `json:"resolved_by,omitempty"`
-	NewRetries int    `json:"new_retries,omitempty"`
+	IncidentID string                 `json:"incident_id"`
+	Action     string                 `json:"action"`
+	Comment    string                 `json:"comment,omitempty"`
+	ResolvedBy string                 `json:"resolved_by,omitempty"`
+	NewRetries int                    `json:"new_retries,omitempty"`
+	Variables  map[string]interface{} `json:"variables,omitempty"`
 }
 
 // GetIncidentPayload represents payload for getting incident
@@ -74,6 +101,7 @@ type ListIncidentsPayload struct {
 	Type              []string `json:"type,omitempty"`
 	ProcessInstanceID string   `json:"process_instance_id,omitempty"`
 	ProcessKey        string   `json:"process_key,omitempty"`
+	TenantID          string   `json:"tenant_id,omitempty"`
 	ElementID         string   `json:"element_id,omitempty"`
 	JobKey            string   `json:"job_key,omitempty"`
 	WorkerID          string   `json:"worker_id,omitempty"`
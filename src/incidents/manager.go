@@ -13,6 +13,8 @@ import (
 	"fmt"
 	"time"
 
+	"atom-engine/src/core/clock"
+	"atom-engine/src/core/events"
 	"atom-engine/src/core/logger"
 	"atom-engine/src/core/models"
 	"atom-engine/src/storage"
@@ -103,9 +105,11 @@ type IncidentManagerInterface interface {
 // IncidentManager implements incident management operations
 // Реализует операции управления инцидентами
 type IncidentManager struct {
-	storage storage.Storage
-	logger  logger.ComponentLogger
-	core    CoreInterface
+	storage   storage.Storage
+	logger    logger.ComponentLogger
+	core      CoreInterface
+	notifier  *NotificationDispatcher
+	autoRetry *AutoRetryScheduler
 }
 
 // NewIncidentManager creates new incident manager
@@ -123,6 +127,18 @@ func (im *IncidentManager) SetCore(core CoreInterface) {
 	im.core = core
 }
 
+// SetNotifier sets dispatcher notified about incident lifecycle events
+// Устанавливает диспетчер уведомлений о событиях жизненного цикла инцидентов
+func (im *IncidentManager) SetNotifier(notifier *NotificationDispatcher) {
+	im.notifier = notifier
+}
+
+// SetAutoRetry sets scheduler retrying new incidents by retry rules
+// Устанавливает планировщик повторяющий новые инциденты по правилам
+func (im *IncidentManager) SetAutoRetry(autoRetry *AutoRetryScheduler) {
+	im.autoRetry = autoRetry
+}
+
 // CreateIncident creates a new incident
 // Создает новый инцидент
 func (im *IncidentManager) CreateIncident(ctx context.Context, request *CreateIncidentRequest) (*Incident, error) {
@@ -146,8 +162,10 @@ func (im *IncidentManager) CreateIncident(ctx context.Context, request *CreateIn
 	incident.ErrorCode = request.ErrorCode
 	incident.ProcessInstanceID = request.ProcessInstanceID
 	incident.ProcessKey = request.ProcessKey
+	incident.TenantID = request.TenantID
 	incident.ElementID = request.ElementID
 	incident.ElementType = request.ElementType
+	incident.TokenID = request.TokenID
 	incident.JobKey = request.JobKey
 	incident.JobType = request.JobType
 	incident.WorkerID = request.WorkerID
@@ -182,6 +200,13 @@ func (im *IncidentManager) CreateIncident(ctx context.Context, request *CreateIn
 		logger.String("incident_id", incidentID),
 		logger.String("type", string(request.Type)))
 
+	im.notify(NotificationEventCreated, incident)
+	im.publishEvent(events.TypeIncidentOpened, incident)
+
+	if im.autoRetry != nil {
+		im.autoRetry.Track(ctx, incident)
+	}
+
 	return incident, nil
 }
 
@@ -221,11 +246,13 @@ func (im *IncidentManager) ResolveIncident(ctx context.Context, request *Resolve
 	// Resolve incident based on action
 	switch request.Action {
 	case ResolveActionRetry:
-		incident.Resolve(ResolveActionRetry, request.ResolvedBy, request.Comment)
-		incident.NewRetries = request.NewRetries
-
-		// If this is a job failure incident, update job retries
-		if incident.Type == IncidentTypeJobFailure && incident.JobKey != "" {
+		// If this is a job failure incident, update job retries,
+		// otherwise re-execute failed element and keep incident open on failure
+		// Для инцидента отказа job обновляем retries, иначе повторно выполняем элемент
+		if incident.IsJobFailure() {
+			if len(request.Variables) > 0 {
+				return nil, fmt.Errorf("invalid resolve request: variables are not supported for job failure incidents")
+			}
 			if err := im.updateJobRetries(ctx, incident.JobKey, request.NewRetries); err != nil {
 				im.logger.Warn("Failed to update job retries",
 					logger.String("job_key", incident.JobKey),
@@ -233,6 +260,21 @@ func (im *IncidentManager) ResolveIncident(ctx context.Context, request *Resolve
 					logger.String("error", err.Error()))
 				// Continue with incident resolution even if job update fails
 			}
+		} else if err := im.retryElement(incident, request.Variables); err != nil {
+			im.logger.Warn("Incident retry failed, incident stays open",
+				logger.String("incident_id", incident.ID),
+				logger.String("element_id", incident.ElementID),
+				logger.String("error", err.Error()))
+			return nil, fmt.Errorf("%w: %v", ErrRetryFailed, err)
+		}
+
+		incident.Resolve(ResolveActionRetry, request.ResolvedBy, request.Comment)
+		incident.NewRetries = request.NewRetries
+		if len(request.Variables) > 0 {
+			if incident.Metadata == nil {
+				incident.Metadata = make(map[string]interface{})
+			}
+			incident.Metadata["retry_variables"] = request.Variables
 		}
 
 	case ResolveActionDismiss:
@@ -251,6 +293,13 @@ func (im *IncidentManager) ResolveIncident(ctx context.Context, request *Resolve
 		logger.String("incident_id", request.IncidentID),
 		logger.String("action", string(request.Action)))
 
+	if incident.IsDismissed() {
+		im.notify(NotificationEventDismissed, incident)
+	} else {
+		im.notify(NotificationEventResolved, incident)
+	}
+	im.publishEvent(events.TypeIncidentResolved, incident)
+
 	return incident, nil
 }
 
@@ -307,7 +356,7 @@ func (im *IncidentManager) GetIncidentStats(ctx context.Context) (*IncidentStats
 	}
 
 	// Calculate stats
-	recentThreshold := time.Now().Add(-24 * time.Hour)
+	recentThreshold := clock.Now().Add(-24 * time.Hour)
 
 	for _, incident := range incidents {
 		// Count by status
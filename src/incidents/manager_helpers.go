@@ -12,6 +12,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"strings"
+
+	"atom-engine/src/core/models"
 )
 
 // Helper functions for type conversion and data manipulation
@@ -114,6 +116,10 @@ func (im *IncidentManager) validateIncidentRequest(request *CreateIncidentReques
 		return fmt.Errorf("incident message is required")
 	}
 
+	if err := models.ValidateTenantID(request.TenantID); err != nil {
+		return err
+	}
+
 	// Type-specific validation
 	switch request.Type {
 	case IncidentTypeJobFailure:
@@ -178,6 +184,19 @@ func (im *IncidentManager) enrichIncidentMetadata(incident *Incident) {
 		incident.Metadata = make(map[string]interface{})
 	}
 
+	// Resolve process key and tenant so incidents can be filtered and notified by process
+	if (incident.ProcessKey == "" || incident.TenantID == "") && incident.ProcessInstanceID != "" {
+		instance, err := im.storage.LoadProcessInstance(incident.ProcessInstanceID)
+		if err == nil && instance != nil {
+			if incident.ProcessKey == "" {
+				incident.ProcessKey = instance.ProcessKey
+			}
+			if incident.TenantID == "" {
+				incident.TenantID = instance.TenantID
+			}
+		}
+	}
+
 	// Add creation timestamp as string
 	incident.Metadata["created_at_string"] = incident.CreatedAt.Format("2006-01-02 15:04:05")
 
@@ -257,3 +276,11 @@ func (im *IncidentManager) sanitizeIncidentData(incident *Incident) {
 		incident.ErrorCode = strings.TrimSpace(incident.ErrorCode)
 	}
 }
+
+// notify passes incident lifecycle event to notification dispatcher
+// Передает событие жизненного цикла инцидента диспетчеру уведомлений
+func (im *IncidentManager) notify(event NotificationEvent, incident *Incident) {
+	if im.notifier != nil {
+		im.notifier.Notify(event, incident)
+	}
+}
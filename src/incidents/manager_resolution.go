@@ -11,11 +11,17 @@ package incidents
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 
 	"atom-engine/src/core/logger"
 )
 
+// ErrRetryFailed is returned when failed element could not be re-executed.
+// Incident stays open in that case.
+// Возвращается когда элемент не удалось выполнить повторно
+var ErrRetryFailed = errors.New("incident retry failed")
+
 // Incident resolution operations
 // Операции разрешения инцидентов
 
@@ -276,3 +282,28 @@ func (im *IncidentManager) updateJobRetries(ctx context.Context, jobKey string,
 
 	return nil
 }
+
+// retryElement re-executes element of incident token through core.
+// Incidents without process context have nothing to re-execute.
+// Повторно выполняет элемент токена инцидента через core
+func (im *IncidentManager) retryElement(incident *Incident, variables map[string]interface{}) error {
+	if incident.ProcessInstanceID == "" || (incident.TokenID == "" && incident.ElementID == "") {
+		if len(variables) > 0 {
+			return fmt.Errorf("incident %s has no process context to apply variables to", incident.ID)
+		}
+		return nil
+	}
+
+	if im.core == nil {
+		return fmt.Errorf("core interface not set")
+	}
+
+	im.logger.Info("Retrying incident element",
+		logger.String("incident_id", incident.ID),
+		logger.String("process_instance_id", incident.ProcessInstanceID),
+		logger.String("token_id", incident.TokenID),
+		logger.String("element_id", incident.ElementID),
+		logger.Int("variables", len(variables)))
+
+	return im.core.RetryIncidentElement(incident.ProcessInstanceID, incident.TokenID, incident.ElementID, variables)
+}
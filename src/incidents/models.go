@@ -9,7 +9,10 @@ This project is dual-licensed under AGPL-3.0 and AtomBPMN Commercial License.
 package incidents
 
 import (
+	"strings"
 	"time"
+
+	"atom-engine/src/core/clock"
 )
 
 // IncidentType represents the type of incident
@@ -73,8 +76,10 @@ type Incident struct {
 	// Process context
 	ProcessInstanceID string `json:"process_instance_id,omitempty"`
 	ProcessKey        string `json:"process_key,omitempty"`
+	TenantID          string `json:"tenant_id,omitempty"`
 	ElementID         string `json:"element_id,omitempty"`
 	ElementType       string `json:"element_type,omitempty"`
+	TokenID           string `json:"token_id,omitempty"`
 
 	// Job context (for job-related incidents)
 	JobKey   string `json:"job_key,omitempty"`
@@ -109,6 +114,7 @@ type IncidentFilter struct {
 	Type              []IncidentType   `json:"type,omitempty"`
 	ProcessInstanceID string           `json:"process_instance_id,omitempty"`
 	ProcessKey        string           `json:"process_key,omitempty"`
+	TenantID          string           `json:"tenant_id,omitempty"`
 	ElementID         string           `json:"element_id,omitempty"`
 	JobKey            string           `json:"job_key,omitempty"`
 	WorkerID          string           `json:"worker_id,omitempty"`
@@ -138,8 +144,10 @@ type CreateIncidentRequest struct {
 	ErrorCode         string                 `json:"error_code,omitempty"`
 	ProcessInstanceID string                 `json:"process_instance_id,omitempty"`
 	ProcessKey        string                 `json:"process_key,omitempty"`
+	TenantID          string                 `json:"tenant_id,omitempty"` // Defaults to tenant of process instance
 	ElementID         string                 `json:"element_id,omitempty"`
 	ElementType       string                 `json:"element_type,omitempty"`
+	TokenID           string                 `json:"token_id,omitempty"`
 	JobKey            string                 `json:"job_key,omitempty"`
 	JobType           string                 `json:"job_type,omitempty"`
 	WorkerID          string                 `json:"worker_id,omitempty"`
@@ -153,17 +161,18 @@ type CreateIncidentRequest struct {
 // ResolveIncidentRequest represents a request to resolve an incident
 // Представляет запрос на разрешение инцидента
 type ResolveIncidentRequest struct {
-	IncidentID string        `json:"incident_id"`
-	Action     ResolveAction `json:"action"`
-	Comment    string        `json:"comment,omitempty"`
-	ResolvedBy string        `json:"resolved_by,omitempty"`
-	NewRetries int           `json:"new_retries,omitempty"` // For retry action
+	IncidentID string                 `json:"incident_id"`
+	Action     ResolveAction          `json:"action"`
+	Comment    string                 `json:"comment,omitempty"`
+	ResolvedBy string                 `json:"resolved_by,omitempty"`
+	NewRetries int                    `json:"new_retries,omitempty"` // For retry action
+	Variables  map[string]interface{} `json:"variables,omitempty"`   // Variable patch applied before retry
 }
 
 // NewIncident creates a new incident with default values
 // Создает новый инцидент со значениями по умолчанию
 func NewIncident(incidentType IncidentType, message string) *Incident {
-	now := time.Now()
+	now := clock.Now()
 	return &Incident{
 		Type:      incidentType,
 		Status:    IncidentStatusOpen,
@@ -195,7 +204,7 @@ func (i *Incident) IsDismissed() bool {
 // Resolve marks the incident as resolved
 // Отмечает инцидент как разрешенный
 func (i *Incident) Resolve(action ResolveAction, resolvedBy, comment string) {
-	now := time.Now()
+	now := clock.Now()
 	i.Status = IncidentStatusResolved
 	i.ResolvedAt = &now
 	i.ResolvedBy = resolvedBy
@@ -207,7 +216,7 @@ func (i *Incident) Resolve(action ResolveAction, resolvedBy, comment string) {
 // Dismiss marks the incident as dismissed
 // Отмечает инцидент как отклоненный
 func (i *Incident) Dismiss(resolvedBy, comment string) {
-	now := time.Now()
+	now := clock.Now()
 	i.Status = IncidentStatusDismissed
 	i.ResolvedAt = &now
 	i.ResolvedBy = resolvedBy
@@ -222,6 +231,13 @@ func (i *Incident) IsJobRelated() bool {
 	return i.JobKey != ""
 }
 
+// IsJobFailure returns true if the incident is retried by updating job retries.
+// Incidents stored before type names were normalized use lower case type.
+// Возвращает true если инцидент повторяется обновлением retries job
+func (i *Incident) IsJobFailure() bool {
+	return strings.EqualFold(string(i.Type), string(IncidentTypeJobFailure)) && i.JobKey != ""
+}
+
 // IsProcessRelated returns true if the incident is related to a process
 // Возвращает true если инцидент связан с процессом
 func (i *Incident) IsProcessRelated() bool {
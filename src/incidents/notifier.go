@@ -0,0 +1,595 @@
+/*
+This file is part of the AtomBPMN (R) project.
+Copyright (c) 2025 Matreska Market LLC (ООО «Matreska Market»).
+Authors: Matreska Team.
+
+This project is dual-licensed under AGPL-3.0 and AtomBPMN Commercial License.
+*/
+
+package incidents
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"atom-engine/src/core/clock"
+	"atom-engine/src/core/config"
+	"atom-engine/src/core/logger"
+	"atom-engine/src/core/models"
+	"atom-engine/src/storage"
+)
+
+// NotificationEvent represents incident lifecycle event delivered to notifiers
+// Представляет событие жизненного цикла инцидента доставляемое получателям
+type NotificationEvent string
+
+const (
+	NotificationEventCreated   NotificationEvent = "incident.created"
+	NotificationEventResolved  NotificationEvent = "incident.resolved"
+	NotificationEventDismissed NotificationEvent = "incident.dismissed"
+	NotificationEventEscalated NotificationEvent = "incident.escalated"
+)
+
+// notificationPollInterval is how often outbox is checked for due deliveries
+// Как часто очередь проверяется на доставки к отправке
+const notificationPollInterval = time.Second
+
+// IncidentNotification is payload delivered to notifiers
+// Полезная нагрузка доставляемая получателям уведомлений
+type IncidentNotification struct {
+	ID        string            `json:"id"`
+	Event     NotificationEvent `json:"event"`
+	Timestamp time.Time         `json:"timestamp"`
+	Repeated  int               `json:"repeated,omitempty"` // Similar notifications suppressed since previous one
+	Incident  *Incident         `json:"incident"`
+}
+
+// Notifier delivers incident notifications to external target
+// Доставляет уведомления об инцидентах во внешнюю систему
+type Notifier interface {
+	// Name returns unique notifier name used in outbox
+	Name() string
+	// Accepts reports whether notification passes notifier filter
+	Accepts(notification *IncidentNotification) bool
+	// Notify delivers notification, error schedules redelivery
+	Notify(ctx context.Context, notification *IncidentNotification) error
+}
+
+// NotificationFilter selects notifications by event, incident type, process key
+// and error code, empty list matches everything
+// Выбирает уведомления по событию, типу инцидента, ключу процесса и коду ошибки
+type NotificationFilter struct {
+	Events      []NotificationEvent
+	Types       []IncidentType
+	ProcessKeys []string
+	ErrorCodes  []string
+}
+
+// NewNotificationFilter creates notification filter from notifier configuration
+// Создает фильтр уведомлений из конфигурации получателя
+func NewNotificationFilter(cfg config.IncidentNotifierFilterConfig) NotificationFilter {
+	filter := NotificationFilter{
+		ProcessKeys: cfg.ProcessKeys,
+		ErrorCodes:  cfg.ErrorCodes,
+	}
+	for _, event := range cfg.Events {
+		filter.Events = append(filter.Events, NotificationEvent("incident."+strings.ToLower(event)))
+	}
+	for _, incidentType := range cfg.Types {
+		filter.Types = append(filter.Types, IncidentType(strings.ToUpper(incidentType)))
+	}
+	return filter
+}
+
+// Matches reports whether notification passes filter
+// Проверяет проходит ли уведомление фильтр
+func (f NotificationFilter) Matches(notification *IncidentNotification) bool {
+	incident := notification.Incident
+
+	if len(f.Events) > 0 && !containsValue(f.Events, notification.Event) {
+		return false
+	}
+	if len(f.Types) > 0 && !containsValue(f.Types, incident.Type) {
+		return false
+	}
+	if len(f.ProcessKeys) > 0 && !containsValue(f.ProcessKeys, incident.ProcessKey) &&
+		!containsValue(f.ProcessKeys, processIDFromKey(incident.ProcessKey)) {
+		return false
+	}
+	if len(f.ErrorCodes) > 0 && !containsValue(f.ErrorCodes, incident.ErrorCode) {
+		return false
+	}
+	return true
+}
+
+// processIDFromKey strips tenant prefix and version suffix from "<process_id>:v<version>" process key
+// Удаляет префикс арендатора и суффикс версии из ключа процесса "<process_id>:v<version>"
+func processIDFromKey(processKey string) string {
+	_, processKey = models.SplitTenantScopedKey(processKey)
+	if index := strings.Index(processKey, ":v"); index >= 0 {
+		return processKey[:index]
+	}
+	return processKey
+}
+
+// containsValue reports whether value is in list
+// Проверяет содержится ли значение в списке
+func containsValue[T comparable](list []T, value T) bool {
+	for _, item := range list {
+		if item == value {
+			return true
+		}
+	}
+	return false
+}
+
+// notificationDelivery is outbox entry of notification for single notifier
+// Запись очереди с уведомлением для одного получателя
+type notificationDelivery struct {
+	ID            string                `json:"id"`
+	Notifier      string                `json:"notifier"`
+	Notification  *IncidentNotification `json:"notification"`
+	Attempts      int                   `json:"attempts"`
+	NextAttemptAt time.Time             `json:"next_attempt_at"`
+	LastError     string                `json:"last_error,omitempty"`
+}
+
+// recentNotification tracks deduplication state of notification key
+// Отслеживает состояние дедупликации ключа уведомления
+type recentNotification struct {
+	sentAt     time.Time
+	suppressed int
+}
+
+// rateWindow tracks notifications sent by notifier within current minute
+// Отслеживает уведомления отправленные получателем за текущую минуту
+type rateWindow struct {
+	start time.Time
+	count int
+}
+
+// NotificationDispatcher fans incident events out to notifiers through
+// persisted outbox with redelivery, throttling and deduplication
+// Рассылает события инцидентов получателям через сохраняемую очередь
+type NotificationDispatcher struct {
+	storage storage.Storage
+	logger  logger.ComponentLogger
+
+	maxAttempts  int
+	initialDelay time.Duration
+	maxDelay     time.Duration
+	dedupWindow  time.Duration
+	rateLimit    int
+
+	mu        sync.Mutex
+	notifiers map[string]Notifier
+	order     []string
+	pending   map[string]*notificationDelivery
+	recent    map[string]*recentNotification
+	windows   map[string]*rateWindow
+
+	wake   chan struct{}
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// NewNotificationDispatcher creates dispatcher with notifiers from configuration
+// Создает диспетчер с получателями из конфигурации
+func NewNotificationDispatcher(
+	cfg config.IncidentNotificationsConfig,
+	storage storage.Storage,
+) (*NotificationDispatcher, error) {
+	d := &NotificationDispatcher{
+		storage:     storage,
+		logger:      logger.NewComponentLogger("incident-notifier"),
+		maxAttempts: cfg.MaxAttempts,
+		rateLimit:   cfg.RateLimit,
+		notifiers:   make(map[string]Notifier),
+		pending:     make(map[string]*notificationDelivery),
+		recent:      make(map[string]*recentNotification),
+		windows:     make(map[string]*rateWindow),
+		wake:        make(chan struct{}, 1),
+	}
+	if d.maxAttempts < 1 {
+		d.maxAttempts = 1
+	}
+
+	var err error
+	if d.initialDelay, err = parseNotificationDuration(cfg.InitialDelay); err != nil {
+		return nil, fmt.Errorf("invalid initial_delay: %w", err)
+	}
+	if d.maxDelay, err = parseNotificationDuration(cfg.MaxDelay); err != nil {
+		return nil, fmt.Errorf("invalid max_delay: %w", err)
+	}
+	if d.dedupWindow, err = parseNotificationDuration(cfg.DedupWindow); err != nil {
+		return nil, fmt.Errorf("invalid dedup_window: %w", err)
+	}
+
+	for _, webhookConfig := range cfg.Webhooks {
+		webhook, err := NewWebhookNotifier(webhookConfig)
+		if err != nil {
+			return nil, err
+		}
+		if err := d.Register(webhook); err != nil {
+			return nil, err
+		}
+	}
+	for _, emailConfig := range cfg.Email {
+		if err := d.Register(NewEmailNotifier(emailConfig)); err != nil {
+			return nil, err
+		}
+	}
+
+	return d, nil
+}
+
+// parseNotificationDuration parses optional Go duration, empty means zero
+// Парсит необязательную длительность Go, пустая строка означает ноль
+func parseNotificationDuration(value string) (time.Duration, error) {
+	if value == "" {
+		return 0, nil
+	}
+	return time.ParseDuration(value)
+}
+
+// Register adds notifier, name must be unique
+// Добавляет получателя, имя должно быть уникальным
+func (d *NotificationDispatcher) Register(notifier Notifier) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	name := notifier.Name()
+	if _, exists := d.notifiers[name]; exists {
+		return fmt.Errorf("notifier already registered: %s", name)
+	}
+	d.notifiers[name] = notifier
+	d.order = append(d.order, name)
+	return nil
+}
+
+// SetMailSender sets sender used by email notifiers
+// Устанавливает отправителя для email получателей
+func (d *NotificationDispatcher) SetMailSender(sender MailSender) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	for _, notifier := range d.notifiers {
+		if email, ok := notifier.(*EmailNotifier); ok {
+			email.SetSender(sender)
+		}
+	}
+}
+
+// Start restores outbox from storage and starts delivery loop
+// Восстанавливает очередь из storage и запускает цикл доставки
+func (d *NotificationDispatcher) Start() error {
+	records, err := d.storage.LoadIncidentNotifications()
+	if err != nil {
+		return fmt.Errorf("failed to load notification outbox: %w", err)
+	}
+
+	d.mu.Lock()
+	for _, data := range records {
+		var delivery notificationDelivery
+		if err := json.Unmarshal(data, &delivery); err != nil {
+			d.logger.Error("Failed to restore notification delivery", logger.String("error", err.Error()))
+			continue
+		}
+		if _, ok := d.notifiers[delivery.Notifier]; !ok {
+			d.logger.Warn("Dropping notification for unknown notifier",
+				logger.String("delivery_id", delivery.ID),
+				logger.String("notifier", delivery.Notifier))
+			d.deleteDelivery(delivery.ID)
+			continue
+		}
+		d.pending[delivery.ID] = &delivery
+	}
+	restored := len(d.pending)
+	d.mu.Unlock()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	d.cancel = cancel
+	d.done = make(chan struct{})
+	go d.run(ctx)
+
+	d.logger.Info("Incident notification dispatcher started",
+		logger.Int("notifiers", len(d.order)),
+		logger.Int("restored_deliveries", restored))
+	return nil
+}
+
+// Stop stops delivery loop, pending deliveries stay in outbox
+// Останавливает цикл доставки, ожидающие доставки остаются в очереди
+func (d *NotificationDispatcher) Stop() {
+	if d.cancel == nil {
+		return
+	}
+	d.cancel()
+	<-d.done
+	d.cancel = nil
+}
+
+// Notify queues notification about incident event for matching notifiers
+// Ставит в очередь уведомление о событии инцидента для подходящих получателей
+func (d *NotificationDispatcher) Notify(event NotificationEvent, incident *Incident) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if len(d.notifiers) == 0 {
+		return
+	}
+
+	now := clock.Now()
+	snapshot := *incident
+	notification := &IncidentNotification{
+		ID:        models.GenerateID(),
+		Event:     event,
+		Timestamp: now,
+		Incident:  &snapshot,
+	}
+
+	if !d.deduplicate(notification, now) {
+		return
+	}
+
+	queued := 0
+	for _, name := range d.order {
+		if !d.notifiers[name].Accepts(notification) {
+			continue
+		}
+
+		delivery := &notificationDelivery{
+			ID:            models.GenerateID(),
+			Notifier:      name,
+			Notification:  notification,
+			NextAttemptAt: now,
+		}
+		if err := d.saveDelivery(delivery); err != nil {
+			d.logger.Error("Failed to queue incident notification",
+				logger.String("incident_id", incident.ID),
+				logger.String("notifier", name),
+				logger.String("error", err.Error()))
+			continue
+		}
+		d.pending[delivery.ID] = delivery
+		queued++
+	}
+
+	if queued > 0 {
+		select {
+		case d.wake <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// deduplicate suppresses repeated notifications of same event for same element
+// within dedup window, returns false when notification is suppressed
+// Подавляет повторные уведомления одного события для одного элемента
+func (d *NotificationDispatcher) deduplicate(notification *IncidentNotification, now time.Time) bool {
+	if d.dedupWindow <= 0 {
+		return true
+	}
+
+	key := notificationDedupKey(notification)
+	if key == "" {
+		return true
+	}
+
+	recent, exists := d.recent[key]
+	if exists && now.Sub(recent.sentAt) < d.dedupWindow {
+		recent.suppressed++
+		d.logger.Debug("Suppressed repeated incident notification",
+			logger.String("incident_id", notification.Incident.ID),
+			logger.String("key", key),
+			logger.Int("suppressed", recent.suppressed))
+		return false
+	}
+
+	if exists {
+		notification.Repeated = recent.suppressed
+	}
+	d.recent[key] = &recentNotification{sentAt: now}
+	return true
+}
+
+// notificationDedupKey builds deduplication key, incidents without element are not deduplicated
+// Строит ключ дедупликации, инциденты без элемента не дедуплицируются
+func notificationDedupKey(notification *IncidentNotification) string {
+	incident := notification.Incident
+	if incident.ElementID == "" {
+		return ""
+	}
+	return strings.Join([]string{
+		string(notification.Event),
+		incident.ProcessKey,
+		incident.ElementID,
+		string(incident.Type),
+		incident.ErrorCode,
+	}, "|")
+}
+
+// run delivers due notifications until context is canceled
+// Доставляет уведомления к отправке до отмены контекста
+func (d *NotificationDispatcher) run(ctx context.Context) {
+	defer close(d.done)
+
+	ticker := time.NewTicker(notificationPollInterval)
+	defer ticker.Stop()
+
+	for {
+		d.deliverDue(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		case <-d.wake:
+		}
+	}
+}
+
+// deliverDue attempts all deliveries whose next attempt time has come
+// Выполняет все доставки время следующей попытки которых наступило
+func (d *NotificationDispatcher) deliverDue(ctx context.Context) {
+	now := clock.Now()
+
+	d.mu.Lock()
+	due := make([]*notificationDelivery, 0)
+	for _, delivery := range d.pending {
+		if !delivery.NextAttemptAt.After(now) {
+			due = append(due, delivery)
+		}
+	}
+	d.pruneRecent(now)
+	d.mu.Unlock()
+
+	sort.Slice(due, func(i, j int) bool {
+		if due[i].NextAttemptAt.Equal(due[j].NextAttemptAt) {
+			return due[i].Notification.Timestamp.Before(due[j].Notification.Timestamp)
+		}
+		return due[i].NextAttemptAt.Before(due[j].NextAttemptAt)
+	})
+
+	for _, delivery := range due {
+		if ctx.Err() != nil {
+			return
+		}
+		d.attempt(ctx, delivery)
+	}
+}
+
+// attempt delivers single notification and reschedules it on failure
+// Доставляет одно уведомление и переназначает его при ошибке
+func (d *NotificationDispatcher) attempt(ctx context.Context, delivery *notificationDelivery) {
+	d.mu.Lock()
+	notifier, ok := d.notifiers[delivery.Notifier]
+	if !ok {
+		delete(d.pending, delivery.ID)
+		d.deleteDelivery(delivery.ID)
+		d.mu.Unlock()
+		return
+	}
+	if allowed, retryAt := d.allow(delivery.Notifier, clock.Now()); !allowed {
+		// Throttled deliveries wait for next window without spending an attempt
+		delivery.NextAttemptAt = retryAt
+		d.mu.Unlock()
+		return
+	}
+	d.mu.Unlock()
+
+	err := notifier.Notify(ctx, delivery.Notification)
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if err == nil {
+		delete(d.pending, delivery.ID)
+		d.deleteDelivery(delivery.ID)
+		d.logger.Info("Incident notification delivered",
+			logger.String("incident_id", delivery.Notification.Incident.ID),
+			logger.String("event", string(delivery.Notification.Event)),
+			logger.String("notifier", delivery.Notifier))
+		return
+	}
+
+	if ctx.Err() != nil {
+		// Canceled on shutdown, delivery stays in outbox for next start
+		return
+	}
+
+	delivery.Attempts++
+	delivery.LastError = err.Error()
+
+	if delivery.Attempts >= d.maxAttempts {
+		delete(d.pending, delivery.ID)
+		d.deleteDelivery(delivery.ID)
+		d.logger.Error("Incident notification dropped after max attempts",
+			logger.String("incident_id", delivery.Notification.Incident.ID),
+			logger.String("notifier", delivery.Notifier),
+			logger.Int("attempts", delivery.Attempts),
+			logger.String("error", err.Error()))
+		return
+	}
+
+	delivery.NextAttemptAt = clock.Now().Add(d.redeliveryDelay(delivery.Attempts))
+	if saveErr := d.saveDelivery(delivery); saveErr != nil {
+		d.logger.Error("Failed to update notification delivery",
+			logger.String("delivery_id", delivery.ID),
+			logger.String("error", saveErr.Error()))
+	}
+
+	d.logger.Warn("Incident notification failed, will retry",
+		logger.String("incident_id", delivery.Notification.Incident.ID),
+		logger.String("notifier", delivery.Notifier),
+		logger.Int("attempts", delivery.Attempts),
+		logger.String("next_attempt_at", delivery.NextAttemptAt.Format(time.RFC3339)),
+		logger.String("error", err.Error()))
+}
+
+// allow applies per notifier rate limit, returns time of next window when throttled
+// Применяет ограничение частоты получателя, возвращает время следующего окна
+func (d *NotificationDispatcher) allow(notifier string, now time.Time) (bool, time.Time) {
+	if d.rateLimit <= 0 {
+		return true, now
+	}
+
+	window, exists := d.windows[notifier]
+	if !exists || now.Sub(window.start) >= time.Minute {
+		window = &rateWindow{start: now}
+		d.windows[notifier] = window
+	}
+	if window.count >= d.rateLimit {
+		return false, window.start.Add(time.Minute)
+	}
+	window.count++
+	return true, now
+}
+
+// redeliveryDelay returns exponential delay after given failed attempt (1-based)
+// Возвращает экспоненциальную задержку после указанной неудачной попытки
+func (d *NotificationDispatcher) redeliveryDelay(attempt int) time.Duration {
+	delay := d.initialDelay
+	for i := 1; i < attempt && delay < d.maxDelay; i++ {
+		delay *= 2
+	}
+	if d.maxDelay > 0 && delay > d.maxDelay {
+		return d.maxDelay
+	}
+	return delay
+}
+
+// pruneRecent drops deduplication entries older than dedup window
+// Удаляет записи дедупликации старше окна дедупликации
+func (d *NotificationDispatcher) pruneRecent(now time.Time) {
+	for key, recent := range d.recent {
+		if now.Sub(recent.sentAt) >= d.dedupWindow && recent.suppressed == 0 {
+			delete(d.recent, key)
+		}
+	}
+}
+
+// saveDelivery persists delivery to outbox
+// Сохраняет доставку в очередь
+func (d *NotificationDispatcher) saveDelivery(delivery *notificationDelivery) error {
+	data, err := json.Marshal(delivery)
+	if err != nil {
+		return fmt.Errorf("failed to marshal notification delivery: %w", err)
+	}
+	return d.storage.SaveIncidentNotification(delivery.ID, data)
+}
+
+// deleteDelivery removes delivery from outbox
+// Удаляет доставку из очереди
+func (d *NotificationDispatcher) deleteDelivery(deliveryID string) {
+	if err := d.storage.DeleteIncidentNotification(deliveryID); err != nil {
+		d.logger.Error("Failed to delete notification delivery",
+			logger.String("delivery_id", deliveryID),
+			logger.String("error", err.Error()))
+	}
+}
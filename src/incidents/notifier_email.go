@@ -0,0 +1,150 @@
+/*
+This file is part of the AtomBPMN (R) project.
+Copyright (c) 2025 Matreska Market LLC (ООО «Matreska Market»).
+Authors: Matreska Team.
+
+This project is dual-licensed under AGPL-3.0 and AtomBPMN Commercial License.
+*/
+
+package incidents
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"atom-engine/src/core/config"
+)
+
+// NotificationEmail is plain text email sent by email notifier
+// Текстовое письмо отправляемое email получателем
+type NotificationEmail struct {
+	Host     string
+	Port     int
+	Protocol string // NONE, TLS, SSL, STARTTLS
+	Username string // Empty = no authentication
+	Password string
+	From     string
+	To       string
+	Subject  string
+	Body     string
+}
+
+// MailSender sends notification email over SMTP
+// Отправляет письмо уведомления через SMTP
+type MailSender func(email *NotificationEmail) error
+
+// EmailNotifier sends incident notifications as plain text email
+// Отправляет уведомления об инцидентах текстовыми письмами
+type EmailNotifier struct {
+	cfg    config.IncidentEmailConfig
+	filter NotificationFilter
+
+	mu     sync.RWMutex
+	sender MailSender
+}
+
+// NewEmailNotifier creates email notifier from configuration
+// Создает email получателя из конфигурации
+func NewEmailNotifier(cfg config.IncidentEmailConfig) *EmailNotifier {
+	return &EmailNotifier{
+		cfg:    cfg,
+		filter: NewNotificationFilter(cfg.Filter),
+	}
+}
+
+// SetSender sets SMTP sender
+// Устанавливает SMTP отправителя
+func (e *EmailNotifier) SetSender(sender MailSender) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.sender = sender
+}
+
+// Name returns notifier name
+// Возвращает имя получателя
+func (e *EmailNotifier) Name() string {
+	return e.cfg.Name
+}
+
+// Accepts reports whether notification passes email filter
+// Проверяет проходит ли уведомление фильтр email
+func (e *EmailNotifier) Accepts(notification *IncidentNotification) bool {
+	return e.filter.Matches(notification)
+}
+
+// Notify sends notification email
+// Отправляет письмо уведомления
+func (e *EmailNotifier) Notify(ctx context.Context, notification *IncidentNotification) error {
+	e.mu.RLock()
+	sender := e.sender
+	e.mu.RUnlock()
+
+	if sender == nil {
+		return fmt.Errorf("mail sender not configured")
+	}
+
+	return sender(&NotificationEmail{
+		Host:     e.cfg.Host,
+		Port:     e.cfg.Port,
+		Protocol: strings.ToUpper(e.cfg.Protocol),
+		Username: e.cfg.Username,
+		Password: e.cfg.Password,
+		From:     e.cfg.From,
+		To:       e.cfg.To,
+		Subject:  notificationSubject(notification),
+		Body:     notificationBody(notification),
+	})
+}
+
+// notificationSubject builds email subject for notification
+// Формирует тему письма для уведомления
+func notificationSubject(notification *IncidentNotification) string {
+	incident := notification.Incident
+	action := strings.TrimPrefix(string(notification.Event), "incident.")
+
+	subject := fmt.Sprintf("[AtomBPMN] Incident %s: %s", action, incident.GetDisplayName())
+	if incident.ProcessKey != "" {
+		subject += " in " + incident.ProcessKey
+	}
+	return subject
+}
+
+// notificationBody builds plain text email body for notification
+// Формирует текст письма для уведомления
+func notificationBody(notification *IncidentNotification) string {
+	incident := notification.Incident
+	var builder strings.Builder
+
+	writeLine := func(label, value string) {
+		if value != "" {
+			builder.WriteString(fmt.Sprintf("%-18s %s\n", label+":", value))
+		}
+	}
+
+	writeLine("Event", string(notification.Event))
+	writeLine("Incident", incident.ID)
+	writeLine("Type", string(incident.Type))
+	writeLine("Status", string(incident.Status))
+	writeLine("Message", incident.Message)
+	writeLine("Error code", incident.ErrorCode)
+	writeLine("Process", incident.ProcessKey)
+	writeLine("Process instance", incident.ProcessInstanceID)
+	writeLine("Element", incident.ElementID)
+	writeLine("Token", incident.TokenID)
+	writeLine("Job", incident.JobKey)
+	writeLine("Created", incident.CreatedAt.Format(time.RFC3339))
+	if incident.ResolvedAt != nil {
+		writeLine("Resolved", incident.ResolvedAt.Format(time.RFC3339))
+		writeLine("Resolved by", incident.ResolvedBy)
+		writeLine("Comment", incident.ResolveComment)
+	}
+	if notification.Repeated > 0 {
+		builder.WriteString(fmt.Sprintf("\n%d similar notification(s) suppressed since previous one.\n",
+			notification.Repeated))
+	}
+
+	return builder.String()
+}
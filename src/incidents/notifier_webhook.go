@@ -0,0 +1,135 @@
+/*
+This file is part of the AtomBPMN (R) project.
+Copyright (c) 2025 Matreska Market LLC (ООО «Matreska Market»).
+Authors: Matreska Team.
+
+This project is dual-licensed under AGPL-3.0 and AtomBPMN Commercial License.
+*/
+
+package incidents
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"atom-engine/src/core/config"
+)
+
+// Webhook request headers
+// Заголовки запроса webhook
+const (
+	WebhookHeaderEvent        = "X-Atom-Event"
+	WebhookHeaderNotification = "X-Atom-Notification-Id"
+	WebhookHeaderTimestamp    = "X-Atom-Timestamp"
+	WebhookHeaderSignature    = "X-Atom-Signature"
+)
+
+// webhookErrorBodyLimit limits response body included in delivery error
+// Ограничивает тело ответа включаемое в ошибку доставки
+const webhookErrorBodyLimit = 256
+
+// WebhookNotifier posts incident notifications as JSON to HTTP endpoint.
+// With secret configured request carries X-Atom-Signature header with
+// "sha256=" + hex HMAC-SHA256 of "<timestamp>.<body>".
+// Отправляет уведомления об инцидентах в виде JSON на HTTP endpoint
+type WebhookNotifier struct {
+	name    string
+	url     string
+	secret  []byte
+	headers map[string]string
+	filter  NotificationFilter
+	client  *http.Client
+}
+
+// NewWebhookNotifier creates webhook notifier from configuration
+// Создает webhook получателя из конфигурации
+func NewWebhookNotifier(cfg config.IncidentWebhookConfig) (*WebhookNotifier, error) {
+	timeout, err := parseNotificationDuration(cfg.Timeout)
+	if err != nil {
+		return nil, fmt.Errorf("webhook %s: invalid timeout: %w", cfg.Name, err)
+	}
+
+	return &WebhookNotifier{
+		name:    cfg.Name,
+		url:     cfg.URL,
+		secret:  []byte(cfg.Secret),
+		headers: cfg.Headers,
+		filter:  NewNotificationFilter(cfg.Filter),
+		client:  &http.Client{Timeout: timeout},
+	}, nil
+}
+
+// Name returns notifier name
+// Возвращает имя получателя
+func (w *WebhookNotifier) Name() string {
+	return w.name
+}
+
+// Accepts reports whether notification passes webhook filter
+// Проверяет проходит ли уведомление фильтр webhook
+func (w *WebhookNotifier) Accepts(notification *IncidentNotification) bool {
+	return w.filter.Matches(notification)
+}
+
+// Notify posts notification, non-2xx response is delivery failure
+// Отправляет уведомление, ответ не 2xx считается ошибкой доставки
+func (w *WebhookNotifier) Notify(ctx context.Context, notification *IncidentNotification) error {
+	body, err := json.Marshal(notification)
+	if err != nil {
+		return fmt.Errorf("failed to marshal notification: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create webhook request: %w", err)
+	}
+
+	for key, value := range w.headers {
+		req.Header.Set(key, value)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(WebhookHeaderEvent, string(notification.Event))
+	req.Header.Set(WebhookHeaderNotification, notification.ID)
+
+	// Wall clock time so receivers can reject stale replays against their own clock
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+	req.Header.Set(WebhookHeaderTimestamp, timestamp)
+	if len(w.secret) > 0 {
+		req.Header.Set(WebhookHeaderSignature, "sha256="+SignWebhookPayload(w.secret, timestamp, body))
+	}
+
+	resp, err := w.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("webhook request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		data, _ := io.ReadAll(io.LimitReader(resp.Body, webhookErrorBodyLimit))
+		return fmt.Errorf("webhook returned status %d: %s", resp.StatusCode, strings.TrimSpace(string(data)))
+	}
+
+	// Drain body so connection can be reused
+	_, _ = io.Copy(io.Discard, resp.Body)
+	return nil
+}
+
+// SignWebhookPayload returns hex HMAC-SHA256 of "<timestamp>.<body>"
+// Возвращает hex HMAC-SHA256 от "<timestamp>.<body>"
+func SignWebhookPayload(secret []byte, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,97 @@
+/*
+This file is part of the AtomBPMN (R) project.
+Copyright (c) 2025 Matreska Market LLC (ООО «Matreska Market»).
+Authors: Matreska Team.
+
+This project is dual-licensed under AGPL-3.0 and AtomBPMN Commercial License.
+*/
+
+package incidents
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"atom-engine/src/core/clock"
+	"atom-engine/src/core/logger"
+	"atom-engine/src/core/models"
+	"atom-engine/src/storage"
+)
+
+// outboxEntry is incident persisted for creation by incidents component
+// Инцидент сохраненный для создания компонентом инцидентов
+type outboxEntry struct {
+	ID        string                `json:"id"`
+	Payload   CreateIncidentPayload `json:"payload"`
+	CreatedAt time.Time             `json:"created_at"`
+}
+
+// EnqueueIncident persists incident to outbox. Entry survives restart and is
+// created by DrainOutbox once incidents component is running
+// Сохраняет инцидент в очередь, он создается DrainOutbox после запуска компонента
+func EnqueueIncident(st storage.Storage, payload CreateIncidentPayload) (string, error) {
+	entry := outboxEntry{
+		ID:        models.GenerateID(),
+		Payload:   payload,
+		CreatedAt: clock.Now(),
+	}
+
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal incident outbox entry: %w", err)
+	}
+
+	if err := st.SaveIncidentOutboxEntry(entry.ID, data); err != nil {
+		return "", fmt.Errorf("failed to save incident outbox entry: %w", err)
+	}
+
+	return entry.ID, nil
+}
+
+// DrainOutbox creates incidents waiting in outbox and returns number created.
+// Entries stay in outbox while component is not ready or creation fails
+// Создает ожидающие в очереди инциденты и возвращает их количество
+func (c *Component) DrainOutbox(ctx context.Context) int {
+	if !c.IsReady() {
+		return 0
+	}
+
+	c.outboxMu.Lock()
+	defer c.outboxMu.Unlock()
+
+	entries, err := c.storage.LoadIncidentOutbox()
+	if err != nil {
+		c.logger.Error("Failed to load incident outbox", logger.String("error", err.Error()))
+		return 0
+	}
+
+	created := 0
+	for _, data := range entries {
+		var entry outboxEntry
+		if err := json.Unmarshal(data, &entry); err != nil {
+			c.logger.Error("Failed to unmarshal incident outbox entry", logger.String("error", err.Error()))
+			continue
+		}
+
+		incident, err := c.manager.CreateIncident(ctx, entry.Payload.ToRequest())
+		if err != nil {
+			c.logger.Error("Failed to create incident from outbox",
+				logger.String("entry_id", entry.ID),
+				logger.String("token_id", entry.Payload.TokenID),
+				logger.String("error", err.Error()))
+			continue
+		}
+
+		if err := c.storage.DeleteIncidentOutboxEntry(entry.ID); err != nil {
+			c.logger.Error("Failed to delete incident outbox entry",
+				logger.String("entry_id", entry.ID),
+				logger.String("incident_id", incident.ID),
+				logger.String("error", err.Error()))
+		}
+		created++
+	}
+
+	return created
+}
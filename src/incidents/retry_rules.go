@@ -0,0 +1,247 @@
+/*
+This file is part of the AtomBPMN (R) project.
+Copyright (c) 2025 Matreska Market LLC (ООО «Matreska Market»).
+Authors: Matreska Team.
+
+This project is dual-licensed under AGPL-3.0 and AtomBPMN Commercial License.
+*/
+
+package incidents
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"atom-engine/src/core/config"
+	"atom-engine/src/timewheel"
+)
+
+// Retry rule sources
+// Источники правил повтора
+const (
+	RetryRuleSourceConfig = "config"
+	RetryRuleSourceAPI    = "api"
+)
+
+// Retry rule errors
+// Ошибки правил повтора
+var (
+	ErrRetryRuleNotFound = errors.New("retry rule not found")
+	ErrRetryRuleReadOnly = errors.New("retry rule is defined in configuration and cannot be changed")
+)
+
+// RetryRule declares automatic retry of matching incidents, every
+// Interval up to MaxAttempts times, after that incident is escalated
+// Объявляет автоматический повтор подходящих инцидентов с эскалацией
+type RetryRule struct {
+	Name         string         `json:"name"`
+	Types        []IncidentType `json:"types,omitempty"`
+	JobTypes     []string       `json:"job_types,omitempty"`
+	ProcessKeys  []string       `json:"process_keys,omitempty"`
+	ElementIDs   []string       `json:"element_ids,omitempty"`
+	ErrorCodes   []string       `json:"error_codes,omitempty"`
+	ErrorPattern string         `json:"error_pattern,omitempty"` // Regular expression over error code and message
+	MaxAttempts  int            `json:"max_attempts"`
+	Interval     string         `json:"interval"`              // Go ("5m") or ISO-8601 ("PT5M") duration
+	JobRetries   int            `json:"job_retries,omitempty"` // Job retries granted per attempt, default 1
+	Source       string         `json:"source,omitempty"`
+	CreatedAt    *time.Time     `json:"created_at,omitempty"`
+	UpdatedAt    *time.Time     `json:"updated_at,omitempty"`
+
+	interval time.Duration
+	pattern  *regexp.Regexp
+}
+
+// RetryRuleFromConfig creates retry rule from configuration
+// Создает правило повтора из конфигурации
+func RetryRuleFromConfig(cfg config.IncidentRetryRuleConfig) *RetryRule {
+	rule := &RetryRule{
+		Name:         cfg.Name,
+		JobTypes:     cfg.JobTypes,
+		ProcessKeys:  cfg.ProcessKeys,
+		ElementIDs:   cfg.ElementIDs,
+		ErrorCodes:   cfg.ErrorCodes,
+		ErrorPattern: cfg.ErrorPattern,
+		MaxAttempts:  cfg.MaxAttempts,
+		Interval:     cfg.Interval,
+		JobRetries:   cfg.JobRetries,
+		Source:       RetryRuleSourceConfig,
+	}
+	for _, incidentType := range cfg.Types {
+		rule.Types = append(rule.Types, IncidentType(incidentType))
+	}
+	return rule
+}
+
+// Compile validates rule and prepares interval and error pattern
+// Проверяет правило и подготавливает интервал и шаблон ошибки
+func (r *RetryRule) Compile() error {
+	if r.Name == "" {
+		return fmt.Errorf("retry rule name is required")
+	}
+	if r.MaxAttempts < 1 {
+		return fmt.Errorf("retry rule %s: max_attempts must be positive", r.Name)
+	}
+	if r.JobRetries < 0 {
+		return fmt.Errorf("retry rule %s: job_retries cannot be negative", r.Name)
+	}
+
+	interval, err := parseRetryInterval(r.Interval)
+	if err != nil {
+		return fmt.Errorf("retry rule %s: invalid interval %q: %w", r.Name, r.Interval, err)
+	}
+	if interval <= 0 {
+		return fmt.Errorf("retry rule %s: interval must be positive", r.Name)
+	}
+	r.interval = interval
+
+	r.pattern = nil
+	if r.ErrorPattern != "" {
+		if r.pattern, err = regexp.Compile(r.ErrorPattern); err != nil {
+			return fmt.Errorf("retry rule %s: invalid error_pattern: %w", r.Name, err)
+		}
+	}
+
+	for i, incidentType := range r.Types {
+		r.Types[i] = IncidentType(strings.ToUpper(string(incidentType)))
+	}
+
+	return nil
+}
+
+// Matches reports whether incident falls under rule
+// Проверяет подпадает ли инцидент под правило
+func (r *RetryRule) Matches(incident *Incident) bool {
+	if len(r.Types) > 0 && !containsValue(r.Types, incident.Type) {
+		return false
+	}
+	if len(r.JobTypes) > 0 && !containsValue(r.JobTypes, incident.JobType) {
+		return false
+	}
+	if len(r.ProcessKeys) > 0 && !containsValue(r.ProcessKeys, incident.ProcessKey) &&
+		!containsValue(r.ProcessKeys, processIDFromKey(incident.ProcessKey)) {
+		return false
+	}
+	if len(r.ElementIDs) > 0 && !containsValue(r.ElementIDs, incident.ElementID) {
+		return false
+	}
+	if len(r.ErrorCodes) > 0 && !containsValue(r.ErrorCodes, incident.ErrorCode) {
+		return false
+	}
+	if r.pattern != nil && !r.pattern.MatchString(incident.ErrorCode) && !r.pattern.MatchString(incident.Message) {
+		return false
+	}
+	return true
+}
+
+// IntervalDuration returns delay before each retry
+// Возвращает задержку перед каждым повтором
+func (r *RetryRule) IntervalDuration() time.Duration {
+	return r.interval
+}
+
+// jobRetries returns job retries granted per attempt
+// Возвращает количество повторов job'а выдаваемых за попытку
+func (r *RetryRule) jobRetries() int {
+	if r.JobRetries > 0 {
+		return r.JobRetries
+	}
+	return 1
+}
+
+// parseRetryInterval parses Go ("5m") or ISO-8601 ("PT5M") duration
+// Парсит длительность в формате Go ("5m") или ISO-8601 ("PT5M")
+func parseRetryInterval(value string) (time.Duration, error) {
+	if strings.HasPrefix(strings.ToUpper(value), "P") {
+		return timewheel.NewISO8601DurationParser().ParseDuration(strings.ToUpper(value))
+	}
+	return time.ParseDuration(value)
+}
+
+// AutoRetryAttempt records single automatic retry attempt
+// Фиксирует одну попытку автоматического повтора
+type AutoRetryAttempt struct {
+	Attempt    int       `json:"attempt"`
+	IncidentID string    `json:"incident_id"`
+	At         time.Time `json:"at"`
+	Result     string    `json:"result"` // retried, failed, canceled
+	Error      string    `json:"error,omitempty"`
+}
+
+// Auto retry attempt results
+// Результаты попыток автоматического повтора
+const (
+	AutoRetryResultRetried  = "retried"
+	AutoRetryResultFailed   = "failed"
+	AutoRetryResultCanceled = "canceled"
+)
+
+// AutoRetryState is automatic retry progress kept in incident metadata under
+// "auto_retry". Job incidents raised again after retry continue the count.
+// Состояние автоматического повтора в метаданных инцидента под ключом "auto_retry"
+type AutoRetryState struct {
+	Rule          string             `json:"rule"`
+	Attempts      int                `json:"attempts"`
+	MaxAttempts   int                `json:"max_attempts"`
+	NextAttemptAt *time.Time         `json:"next_attempt_at,omitempty"`
+	TimerID       string             `json:"timer_id,omitempty"`
+	Escalated     bool               `json:"escalated,omitempty"`
+	EscalatedAt   *time.Time         `json:"escalated_at,omitempty"`
+	History       []AutoRetryAttempt `json:"history,omitempty"`
+}
+
+// autoRetryMetadataKey is incident metadata key of automatic retry state
+// Ключ метаданных инцидента для состояния автоматического повтора
+const autoRetryMetadataKey = "auto_retry"
+
+// AutoRetryState returns automatic retry state of incident, nil when not tracked
+// Возвращает состояние автоматического повтора инцидента
+func (i *Incident) AutoRetryState() *AutoRetryState {
+	raw, exists := i.Metadata[autoRetryMetadataKey]
+	if !exists || raw == nil {
+		return nil
+	}
+
+	var state AutoRetryState
+	if err := mapToStruct(structToMap(raw), &state); err != nil {
+		return nil
+	}
+	return &state
+}
+
+// SetAutoRetryState stores automatic retry state in incident metadata
+// Сохраняет состояние автоматического повтора в метаданных инцидента
+func (i *Incident) SetAutoRetryState(state *AutoRetryState) {
+	if i.Metadata == nil {
+		i.Metadata = make(map[string]interface{})
+	}
+	i.Metadata[autoRetryMetadataKey] = structToMap(state)
+}
+
+// RetryPreview describes what retry rules would do with open incident
+// Описывает действие правил повтора для открытого инцидента
+type RetryPreview struct {
+	IncidentID    string       `json:"incident_id"`
+	Type          IncidentType `json:"type"`
+	ProcessKey    string       `json:"process_key,omitempty"`
+	ElementID     string       `json:"element_id,omitempty"`
+	JobType       string       `json:"job_type,omitempty"`
+	Message       string       `json:"message"`
+	Rule          string       `json:"rule"`
+	Attempts      int          `json:"attempts"`
+	MaxAttempts   int          `json:"max_attempts"`
+	Action        string       `json:"action"` // schedule, scheduled, escalate, escalated
+	NextAttemptAt *time.Time   `json:"next_attempt_at,omitempty"`
+}
+
+// Retry preview actions
+// Действия предпросмотра повтора
+const (
+	RetryPreviewSchedule  = "schedule"
+	RetryPreviewScheduled = "scheduled"
+	RetryPreviewEscalate  = "escalate"
+	RetryPreviewEscalated = "escalated"
+)
@@ -0,0 +1,321 @@
+/*
+This file is part of the AtomBPMN (R) project.
+Copyright (c) 2025 Matreska Market LLC (ООО «Matreska Market»).
+Authors: Matreska Team.
+
+This project is dual-licensed under AGPL-3.0 and AtomBPMN Commercial License.
+*/
+
+package cli
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"atom-engine/proto/auth/authpb"
+	"atom-engine/src/core/logger"
+)
+
+// AuthKeyCreate issues managed API key via gRPC and prints secret once
+// Выпускает управляемый API ключ через gRPC и однократно выводит секрет
+func (d *DaemonCommand) AuthKeyCreate() error {
+	if len(os.Args) < 5 {
+		logger.Error("Invalid auth key create arguments", logger.Int("args_count", len(os.Args)))
+		return fmt.Errorf("usage: atomd auth key create <name> --permissions <p1,p2> " +
+			"[--hosts <h1,h2>] [--tenants <t1,t2>] [--expires-in <duration>] [--expires-at <RFC3339>]")
+	}
+
+	request, err := parseAuthKeyCreateArgs(os.Args[4], os.Args[5:])
+	if err != nil {
+		return err
+	}
+
+	conn, err := d.grpcClient.Connect()
+	if err != nil {
+		logger.Error("Failed to connect for auth key create", logger.String("error", err.Error()))
+		return fmt.Errorf("daemon is not running: %w", err)
+	}
+	defer conn.Close()
+
+	client := authpb.NewAuthServiceClient(conn)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	resp, err := client.CreateAPIKey(ctx, request)
+	if err != nil {
+		logger.Error("Failed to create API key via gRPC",
+			logger.String("name", request.Name),
+			logger.String("error", err.Error()))
+		return fmt.Errorf("failed to create API key: %w", err)
+	}
+
+	fmt.Printf("API key created: %s\n", resp.ApiKey.Id)
+	printAPIKeyDetails(resp.ApiKey)
+	fmt.Printf("\nKey: %s\n", resp.Key)
+	fmt.Println("Store this key now, it cannot be shown again.")
+	return nil
+}
+
+// AuthKeyList lists API keys via gRPC
+// Выводит список API ключей через gRPC
+func (d *DaemonCommand) AuthKeyList() error {
+	request := &authpb.ListAPIKeysRequest{}
+	for _, arg := range os.Args[4:] {
+		switch arg {
+		case "--all", "-a":
+			request.IncludeInactive = true
+		default:
+			return fmt.Errorf("unknown flag: %s", arg)
+		}
+	}
+
+	conn, err := d.grpcClient.Connect()
+	if err != nil {
+		logger.Error("Failed to connect for auth key list", logger.String("error", err.Error()))
+		return fmt.Errorf("daemon is not running: %w", err)
+	}
+	defer conn.Close()
+
+	client := authpb.NewAuthServiceClient(conn)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	resp, err := client.ListAPIKeys(ctx, request)
+	if err != nil {
+		logger.Error("Failed to list API keys via gRPC", logger.String("error", err.Error()))
+		return fmt.Errorf("failed to list API keys: %w", err)
+	}
+
+	if len(resp.ApiKeys) == 0 {
+		fmt.Println("No API keys found")
+		return nil
+	}
+
+	fmt.Printf("%-20s %-20s %-7s %-8s %-24s %-19s %-19s\n",
+		"ID", "NAME", "SOURCE", "STATUS", "PERMISSIONS", "EXPIRES", "LAST USED")
+	fmt.Println(strings.Repeat("-", 123))
+	for _, key := range resp.ApiKeys {
+		fmt.Printf("%-20s %-20s %-7s %-8s %-24s %-19s %-19s\n",
+			key.Id,
+			truncateString(key.Name, 20),
+			key.Source,
+			key.Status,
+			truncateString(strings.Join(key.Permissions, ","), 24),
+			formatAPIKeyTime(key.ExpiresAt),
+			formatAPIKeyTime(key.LastUsedAt))
+	}
+	fmt.Printf("\nTotal: %d\n", len(resp.ApiKeys))
+
+	return nil
+}
+
+// AuthKeyShow shows API key details via gRPC
+// Показывает детали API ключа через gRPC
+func (d *DaemonCommand) AuthKeyShow() error {
+	if len(os.Args) < 5 {
+		logger.Error("Invalid auth key show arguments", logger.Int("args_count", len(os.Args)))
+		return fmt.Errorf("usage: atomd auth key show <key_id>")
+	}
+
+	conn, err := d.grpcClient.Connect()
+	if err != nil {
+		logger.Error("Failed to connect for auth key show", logger.String("error", err.Error()))
+		return fmt.Errorf("daemon is not running: %w", err)
+	}
+	defer conn.Close()
+
+	client := authpb.NewAuthServiceClient(conn)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	resp, err := client.GetAPIKey(ctx, &authpb.GetAPIKeyRequest{KeyId: os.Args[4]})
+	if err != nil {
+		logger.Error("Failed to get API key via gRPC",
+			logger.String("key_id", os.Args[4]),
+			logger.String("error", err.Error()))
+		return fmt.Errorf("failed to get API key: %w", err)
+	}
+
+	fmt.Printf("API key: %s\n", resp.ApiKey.Id)
+	printAPIKeyDetails(resp.ApiKey)
+	return nil
+}
+
+// AuthKeyRevoke revokes managed API key via gRPC
+// Отзывает управляемый API ключ через gRPC
+func (d *DaemonCommand) AuthKeyRevoke() error {
+	if len(os.Args) < 5 {
+		logger.Error("Invalid auth key revoke arguments", logger.Int("args_count", len(os.Args)))
+		return fmt.Errorf("usage: atomd auth key revoke <key_id>")
+	}
+
+	conn, err := d.grpcClient.Connect()
+	if err != nil {
+		logger.Error("Failed to connect for auth key revoke", logger.String("error", err.Error()))
+		return fmt.Errorf("daemon is not running: %w", err)
+	}
+	defer conn.Close()
+
+	client := authpb.NewAuthServiceClient(conn)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	resp, err := client.RevokeAPIKey(ctx, &authpb.RevokeAPIKeyRequest{KeyId: os.Args[4]})
+	if err != nil {
+		logger.Error("Failed to revoke API key via gRPC",
+			logger.String("key_id", os.Args[4]),
+			logger.String("error", err.Error()))
+		return fmt.Errorf("failed to revoke API key: %w", err)
+	}
+
+	fmt.Printf("API key revoked: %s (%s)\n", resp.ApiKey.Id, resp.ApiKey.Name)
+	return nil
+}
+
+// AuthKeyRotate issues successor API key via gRPC and prints its secret once
+// Выпускает ключ-преемник через gRPC и однократно выводит его секрет
+func (d *DaemonCommand) AuthKeyRotate() error {
+	if len(os.Args) < 5 {
+		logger.Error("Invalid auth key rotate arguments", logger.Int("args_count", len(os.Args)))
+		return fmt.Errorf("usage: atomd auth key rotate <key_id> [--overlap <duration>]")
+	}
+
+	request := &authpb.RotateAPIKeyRequest{KeyId: os.Args[4]}
+	args := os.Args[5:]
+	for i := 0; i < len(args); i++ {
+		switch args[i] {
+		case "--overlap":
+			if i+1 >= len(args) {
+				return fmt.Errorf("flag --overlap requires value")
+			}
+			request.Overlap = args[i+1]
+			i++
+		default:
+			return fmt.Errorf("unknown flag: %s", args[i])
+		}
+	}
+
+	conn, err := d.grpcClient.Connect()
+	if err != nil {
+		logger.Error("Failed to connect for auth key rotate", logger.String("error", err.Error()))
+		return fmt.Errorf("daemon is not running: %w", err)
+	}
+	defer conn.Close()
+
+	client := authpb.NewAuthServiceClient(conn)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	resp, err := client.RotateAPIKey(ctx, request)
+	if err != nil {
+		logger.Error("Failed to rotate API key via gRPC",
+			logger.String("key_id", request.KeyId),
+			logger.String("error", err.Error()))
+		return fmt.Errorf("failed to rotate API key: %w", err)
+	}
+
+	fmt.Printf("API key rotated: %s -> %s\n", request.KeyId, resp.ApiKey.Id)
+	printAPIKeyDetails(resp.ApiKey)
+	fmt.Printf("\nKey: %s\n", resp.Key)
+	fmt.Println("Store this key now, it cannot be shown again.")
+	return nil
+}
+
+// parseAuthKeyCreateArgs builds create request from command line flags
+// Формирует запрос создания ключа из флагов командной строки
+func parseAuthKeyCreateArgs(name string, args []string) (*authpb.CreateAPIKeyRequest, error) {
+	request := &authpb.CreateAPIKeyRequest{Name: name}
+
+	for i := 0; i < len(args); i++ {
+		flag := args[i]
+		if i+1 >= len(args) {
+			return nil, fmt.Errorf("flag %s requires value", flag)
+		}
+		value := args[i+1]
+		i++
+
+		switch flag {
+		case "--permissions", "-p":
+			request.Permissions = append(request.Permissions, splitCommaList(value)...)
+		case "--hosts":
+			request.AllowedHosts = append(request.AllowedHosts, splitCommaList(value)...)
+		case "--tenants":
+			request.Tenants = append(request.Tenants, splitCommaList(value)...)
+		case "--expires-in":
+			request.ExpiresIn = value
+		case "--expires-at":
+			expiresAt, err := time.Parse(time.RFC3339, value)
+			if err != nil {
+				return nil, fmt.Errorf("invalid --expires-at value, expected RFC3339: %s", value)
+			}
+			request.ExpiresAt = timestamppb.New(expiresAt)
+		default:
+			return nil, fmt.Errorf("unknown flag: %s", flag)
+		}
+	}
+
+	if len(request.Permissions) == 0 {
+		return nil, fmt.Errorf("--permissions is required")
+	}
+
+	return request, nil
+}
+
+// splitCommaList splits comma separated value dropping empty entries
+// Разделяет значение по запятым, пропуская пустые элементы
+func splitCommaList(value string) []string {
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// printAPIKeyDetails prints API key attributes without secret
+// Выводит атрибуты API ключа без секрета
+func printAPIKeyDetails(key *authpb.APIKey) {
+	fmt.Printf("Name:          %s\n", key.Name)
+	fmt.Printf("Source:        %s\n", key.Source)
+	fmt.Printf("Status:        %s\n", key.Status)
+	fmt.Printf("Prefix:        %s\n", key.Prefix)
+	fmt.Printf("Permissions:   %s\n", strings.Join(key.Permissions, ", "))
+	if len(key.AllowedHosts) > 0 {
+		fmt.Printf("Allowed hosts: %s\n", strings.Join(key.AllowedHosts, ", "))
+	}
+	if len(key.Tenants) > 0 {
+		fmt.Printf("Tenants:       %s\n", strings.Join(key.Tenants, ", "))
+	}
+	fmt.Printf("Created:       %s\n", formatAPIKeyTime(key.CreatedAt))
+	fmt.Printf("Expires:       %s\n", formatAPIKeyTime(key.ExpiresAt))
+	fmt.Printf("Last used:     %s\n", formatAPIKeyTime(key.LastUsedAt))
+	if key.RevokedAt != nil {
+		fmt.Printf("Revoked:       %s\n", formatAPIKeyTime(key.RevokedAt))
+	}
+	if key.RotatedFrom != "" {
+		fmt.Printf("Rotated from:  %s\n", key.RotatedFrom)
+	}
+	if key.RotatedTo != "" {
+		fmt.Printf("Rotated to:    %s\n", key.RotatedTo)
+	}
+}
+
+// formatAPIKeyTime formats optional timestamp for API key output
+// Форматирует необязательную метку времени для вывода API ключа
+func formatAPIKeyTime(ts *timestamppb.Timestamp) string {
+	if ts == nil {
+		return "-"
+	}
+	return ts.AsTime().Local().Format("2006-01-02 15:04:05")
+}
@@ -0,0 +1,438 @@
+/*
+This file is part of the AtomBPMN (R) project.
+Copyright (c) 2025 Matreska Market LLC (ООО «Matreska Market»).
+Authors: Matreska Team.
+
+This project is dual-licensed under AGPL-3.0 and AtomBPMN Commercial License.
+*/
+
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"atom-engine/proto/batch/batchpb"
+	"atom-engine/src/batch"
+	"atom-engine/src/core/logger"
+)
+
+// BatchCreate submits batch operation from flags or JSON file via gRPC
+// Создает пакетную операцию из флагов или JSON файла через gRPC
+func (d *DaemonCommand) BatchCreate() error {
+	if len(os.Args) < 4 {
+		logger.Error("Invalid batch create arguments", logger.Int("args_count", len(os.Args)))
+		return fmt.Errorf("usage: atomd batch create <type> [options] | atomd batch create <file.json>")
+	}
+
+	var request *batchpb.CreateBatchRequest
+	var err error
+	if strings.HasSuffix(strings.ToLower(os.Args[3]), ".json") {
+		request, err = readBatchFile(os.Args[3])
+	} else {
+		request, err = parseBatchCreateArgs(os.Args[3], os.Args[4:])
+	}
+	if err != nil {
+		return err
+	}
+
+	conn, err := d.grpcClient.Connect()
+	if err != nil {
+		logger.Error("Failed to connect for batch create", logger.String("error", err.Error()))
+		return fmt.Errorf("daemon is not running: %w", err)
+	}
+	defer conn.Close()
+
+	client := batchpb.NewBatchServiceClient(conn)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	resp, err := client.CreateBatch(ctx, request)
+	if err != nil {
+		logger.Error("Failed to create batch operation via gRPC",
+			logger.String("type", request.Type),
+			logger.String("error", err.Error()))
+		return fmt.Errorf("failed to create batch operation: %w", err)
+	}
+
+	fmt.Printf("Batch operation created: %s\n", resp.Batch.Id)
+	fmt.Printf("Type:  %s\n", resp.Batch.Type)
+	fmt.Printf("Items: %d\n", resp.Batch.Total)
+	fmt.Printf("\nTrack progress with: atomd batch status %s\n", resp.Batch.Id)
+	return nil
+}
+
+// BatchList lists batch operations via gRPC
+// Выводит список пакетных операций через gRPC
+func (d *DaemonCommand) BatchList() error {
+	request := &batchpb.ListBatchesRequest{}
+	if len(os.Args) >= 4 {
+		request.State = strings.ToUpper(os.Args[3])
+	}
+
+	conn, err := d.grpcClient.Connect()
+	if err != nil {
+		logger.Error("Failed to connect for batch list", logger.String("error", err.Error()))
+		return fmt.Errorf("daemon is not running: %w", err)
+	}
+	defer conn.Close()
+
+	client := batchpb.NewBatchServiceClient(conn)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	resp, err := client.ListBatches(ctx, request)
+	if err != nil {
+		logger.Error("Failed to list batch operations via gRPC", logger.String("error", err.Error()))
+		return fmt.Errorf("failed to list batch operations: %w", err)
+	}
+
+	if len(resp.Batches) == 0 {
+		fmt.Println("No batch operations found")
+		return nil
+	}
+
+	fmt.Printf("%-24s %-8s %-10s %-16s %-8s %-19s\n", "ID", "TYPE", "STATE", "PROGRESS", "FAILED", "CREATED")
+	fmt.Println(strings.Repeat("-", 90))
+	for _, b := range resp.Batches {
+		fmt.Printf("%-24s %-8s %-10s %-16s %-8d %-19s\n",
+			b.Id,
+			b.Type,
+			b.State,
+			fmt.Sprintf("%d/%d", b.Processed, b.Total),
+			b.Failed,
+			b.CreatedAt.AsTime().Format("2006-01-02 15:04:05"))
+	}
+	fmt.Printf("\nTotal: %d\n", len(resp.Batches))
+
+	return nil
+}
+
+// BatchStatus shows batch operation progress and failures via gRPC
+// Показывает прогресс и ошибки пакетной операции через gRPC
+func (d *DaemonCommand) BatchStatus() error {
+	if len(os.Args) < 4 {
+		logger.Error("Invalid batch status arguments", logger.Int("args_count", len(os.Args)))
+		return fmt.Errorf("usage: atomd batch status <batch_id>")
+	}
+
+	batchID := os.Args[3]
+
+	conn, err := d.grpcClient.Connect()
+	if err != nil {
+		logger.Error("Failed to connect for batch status", logger.String("error", err.Error()))
+		return fmt.Errorf("daemon is not running: %w", err)
+	}
+	defer conn.Close()
+
+	client := batchpb.NewBatchServiceClient(conn)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	resp, err := client.GetBatch(ctx, &batchpb.GetBatchRequest{BatchId: batchID})
+	if err != nil {
+		logger.Error("Failed to get batch operation via gRPC",
+			logger.String("batch_id", batchID),
+			logger.String("error", err.Error()))
+		return fmt.Errorf("failed to get batch operation: %w", err)
+	}
+
+	b := resp.Batch
+	fmt.Printf("Batch Operation Details\n")
+	fmt.Printf("=======================\n\n")
+
+	fmt.Printf("ID:           %s\n", b.Id)
+	fmt.Printf("Type:         %s\n", b.Type)
+	fmt.Printf("State:        %s\n", b.State)
+	fmt.Printf("Progress:     %d/%d\n", b.Processed, b.Total)
+	fmt.Printf("Succeeded:    %d\n", b.Succeeded)
+	fmt.Printf("Failed:       %d\n", b.Failed)
+	fmt.Printf("Chunk Size:   %d\n", b.ChunkSize)
+
+	if b.CreatedBy != "" {
+		fmt.Printf("Created By:   %s\n", b.CreatedBy)
+	}
+	if b.Error != "" {
+		fmt.Printf("Error:        %s\n", b.Error)
+	}
+
+	fmt.Printf("Created:      %s\n", b.CreatedAt.AsTime().Format("2006-01-02 15:04:05"))
+	if b.StartedAt != nil {
+		fmt.Printf("Started:      %s\n", b.StartedAt.AsTime().Format("2006-01-02 15:04:05"))
+	}
+	if b.CompletedAt != nil {
+		fmt.Printf("Completed:    %s\n", b.CompletedAt.AsTime().Format("2006-01-02 15:04:05"))
+	}
+
+	if len(b.Failures) > 0 {
+		fmt.Printf("\nFailures:\n")
+		for _, failure := range b.Failures {
+			fmt.Printf("  %-24s %s\n", failure.ItemId, failure.Error)
+		}
+		if int(b.Failed) > len(b.Failures) {
+			fmt.Printf("  ... %d more not recorded\n", int(b.Failed)-len(b.Failures))
+		}
+	}
+
+	return nil
+}
+
+// BatchPause pauses batch operation via gRPC
+// Приостанавливает пакетную операцию через gRPC
+func (d *DaemonCommand) BatchPause() error {
+	return d.changeBatchState("pause",
+		func(ctx context.Context, client batchpb.BatchServiceClient, batchID string) (*batchpb.Batch, error) {
+			resp, err := client.PauseBatch(ctx, &batchpb.PauseBatchRequest{BatchId: batchID})
+			if err != nil {
+				return nil, err
+			}
+			return resp.Batch, nil
+		})
+}
+
+// BatchResume resumes paused batch operation via gRPC
+// Возобновляет приостановленную пакетную операцию через gRPC
+func (d *DaemonCommand) BatchResume() error {
+	return d.changeBatchState("resume",
+		func(ctx context.Context, client batchpb.BatchServiceClient, batchID string) (*batchpb.Batch, error) {
+			resp, err := client.ResumeBatch(ctx, &batchpb.ResumeBatchRequest{BatchId: batchID})
+			if err != nil {
+				return nil, err
+			}
+			return resp.Batch, nil
+		})
+}
+
+// BatchCancel cancels batch operation via gRPC
+// Отменяет пакетную операцию через gRPC
+func (d *DaemonCommand) BatchCancel() error {
+	return d.changeBatchState("cancel",
+		func(ctx context.Context, client batchpb.BatchServiceClient, batchID string) (*batchpb.Batch, error) {
+			resp, err := client.CancelBatch(ctx, &batchpb.CancelBatchRequest{BatchId: batchID})
+			if err != nil {
+				return nil, err
+			}
+			return resp.Batch, nil
+		})
+}
+
+// BatchDelete deletes finished batch operation via gRPC
+// Удаляет завершенную пакетную операцию через gRPC
+func (d *DaemonCommand) BatchDelete() error {
+	if len(os.Args) < 4 {
+		logger.Error("Invalid batch delete arguments", logger.Int("args_count", len(os.Args)))
+		return fmt.Errorf("usage: atomd batch delete <batch_id>")
+	}
+
+	batchID := os.Args[3]
+
+	conn, err := d.grpcClient.Connect()
+	if err != nil {
+		logger.Error("Failed to connect for batch delete", logger.String("error", err.Error()))
+		return fmt.Errorf("daemon is not running: %w", err)
+	}
+	defer conn.Close()
+
+	client := batchpb.NewBatchServiceClient(conn)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	resp, err := client.DeleteBatch(ctx, &batchpb.DeleteBatchRequest{BatchId: batchID})
+	if err != nil {
+		logger.Error("Failed to delete batch operation via gRPC",
+			logger.String("batch_id", batchID),
+			logger.String("error", err.Error()))
+		return fmt.Errorf("failed to delete batch operation: %w", err)
+	}
+
+	fmt.Println(resp.Message)
+	return nil
+}
+
+// changeBatchState runs state change call for batch ID argument
+// Выполняет вызов смены состояния для ID пакета из аргументов
+func (d *DaemonCommand) changeBatchState(
+	action string,
+	change func(context.Context, batchpb.BatchServiceClient, string) (*batchpb.Batch, error),
+) error {
+	if len(os.Args) < 4 {
+		logger.Error("Invalid batch "+action+" arguments", logger.Int("args_count", len(os.Args)))
+		return fmt.Errorf("usage: atomd batch %s <batch_id>", action)
+	}
+
+	batchID := os.Args[3]
+
+	conn, err := d.grpcClient.Connect()
+	if err != nil {
+		logger.Error("Failed to connect for batch "+action, logger.String("error", err.Error()))
+		return fmt.Errorf("daemon is not running: %w", err)
+	}
+	defer conn.Close()
+
+	client := batchpb.NewBatchServiceClient(conn)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	updated, err := change(ctx, client, batchID)
+	if err != nil {
+		logger.Error("Failed to "+action+" batch operation via gRPC",
+			logger.String("batch_id", batchID),
+			logger.String("error", err.Error()))
+		return fmt.Errorf("failed to %s batch operation: %w", action, err)
+	}
+
+	fmt.Printf("Batch operation %s: %s (%d/%d)\n", updated.Id, updated.State, updated.Processed, updated.Total)
+	return nil
+}
+
+// parseBatchCreateArgs builds create request from operation type and flags
+// Формирует запрос создания из типа операции и флагов
+func parseBatchCreateArgs(operationType string, args []string) (*batchpb.CreateBatchRequest, error) {
+	request := &batchpb.CreateBatchRequest{
+		Type:   strings.ToUpper(operationType),
+		Filter: &batchpb.BatchFilter{},
+		Params: &batchpb.BatchParams{},
+	}
+
+	for i := 0; i < len(args); i++ {
+		flag := args[i]
+		if i+1 >= len(args) {
+			return nil, fmt.Errorf("flag %s requires value", flag)
+		}
+		value := args[i+1]
+		i++
+
+		switch flag {
+		case "--instance":
+			request.Filter.InstanceIds = append(request.Filter.InstanceIds, value)
+		case "--process-key":
+			request.Filter.ProcessKey = value
+		case "--version":
+			version, err := strconv.Atoi(value)
+			if err != nil {
+				return nil, fmt.Errorf("invalid --version value: %s", value)
+			}
+			request.Filter.Version = int32(version)
+		case "--state":
+			request.Filter.States = append(request.Filter.States, strings.ToUpper(value))
+		case "--element":
+			request.Filter.ElementId = value
+		case "--tenant":
+			request.Filter.TenantId = value
+		case "--variables":
+			request.Filter.Variables = value
+		case "--started-after", "--started-before":
+			startedAt, err := time.Parse(time.RFC3339, value)
+			if err != nil {
+				return nil, fmt.Errorf("invalid %s value, expected RFC3339: %s", flag, value)
+			}
+			if flag == "--started-after" {
+				request.Filter.StartedAfter = timestamppb.New(startedAt)
+			} else {
+				request.Filter.StartedBefore = timestamppb.New(startedAt)
+			}
+		case "--incident-type":
+			request.Filter.IncidentTypes = append(request.Filter.IncidentTypes, strings.ToUpper(value))
+		case "--reason":
+			request.Params.Reason = value
+		case "--comment":
+			request.Params.Comment = value
+		case "--retries":
+			retries, err := strconv.Atoi(value)
+			if err != nil {
+				return nil, fmt.Errorf("invalid --retries value: %s", value)
+			}
+			request.Params.NewRetries = int32(retries)
+		case "--set":
+			request.Params.Variables = value
+		case "--target-version":
+			targetVersion, err := strconv.Atoi(value)
+			if err != nil {
+				return nil, fmt.Errorf("invalid --target-version value: %s", value)
+			}
+			request.Params.TargetVersion = int32(targetVersion)
+		case "--chunk-size":
+			chunkSize, err := strconv.Atoi(value)
+			if err != nil {
+				return nil, fmt.Errorf("invalid --chunk-size value: %s", value)
+			}
+			request.ChunkSize = int32(chunkSize)
+		default:
+			return nil, fmt.Errorf("unknown batch create flag: %s", flag)
+		}
+	}
+
+	return request, nil
+}
+
+// readBatchFile reads batch operation JSON file into protobuf request
+// Читает JSON файл пакетной операции в protobuf запрос
+func readBatchFile(filePath string) (*batchpb.CreateBatchRequest, error) {
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read batch file: %w", err)
+	}
+
+	var request batch.CreateRequest
+	if err := json.Unmarshal(data, &request); err != nil {
+		return nil, fmt.Errorf("invalid batch file: %w", err)
+	}
+
+	filter := &batchpb.BatchFilter{
+		InstanceIds: request.Filter.InstanceIDs,
+		ProcessKey:  request.Filter.ProcessKey,
+		Version:     int32(request.Filter.Version),
+		States:      request.Filter.States,
+		ElementId:   request.Filter.ElementID,
+		TenantId:    request.Filter.TenantID,
+	}
+	if len(request.Filter.Variables) > 0 {
+		variables, err := json.Marshal(request.Filter.Variables)
+		if err != nil {
+			return nil, fmt.Errorf("invalid filter variables: %w", err)
+		}
+		filter.Variables = string(variables)
+	}
+	if request.Filter.StartedAfter != nil {
+		filter.StartedAfter = timestamppb.New(*request.Filter.StartedAfter)
+	}
+	if request.Filter.StartedBefore != nil {
+		filter.StartedBefore = timestamppb.New(*request.Filter.StartedBefore)
+	}
+	for _, incidentType := range request.Filter.IncidentTypes {
+		filter.IncidentTypes = append(filter.IncidentTypes, string(incidentType))
+	}
+
+	params := &batchpb.BatchParams{
+		Reason:        request.Params.Reason,
+		Comment:       request.Params.Comment,
+		NewRetries:    int32(request.Params.NewRetries),
+		TargetVersion: int32(request.Params.TargetVersion),
+	}
+	if len(request.Params.Variables) > 0 {
+		variables, err := json.Marshal(request.Params.Variables)
+		if err != nil {
+			return nil, fmt.Errorf("invalid params variables: %w", err)
+		}
+		params.Variables = string(variables)
+	}
+
+	return &batchpb.CreateBatchRequest{
+		Type:      strings.ToUpper(string(request.Type)),
+		Filter:    filter,
+		Params:    params,
+		ChunkSize: int32(request.ChunkSize),
+	}, nil
+}
@@ -26,11 +26,12 @@ func (d *DaemonCommand) BPMNParse() error {
 
 	if len(os.Args) < 4 {
 		logger.Error("Invalid BPMN parse arguments", logger.Int("args_count", len(os.Args)))
-		return fmt.Errorf("usage: atomd bpmn parse <file.bpmn> [process_id] [--force|-f]")
+		return fmt.Errorf("usage: atomd bpmn parse <file.bpmn> [process_id] [--force|-f] [--tenant <tenant_id>]")
 	}
 
 	filename := os.Args[3]
 	var processID string
+	var tenantID string
 	var force bool
 
 	// Parse optional arguments
@@ -38,6 +39,9 @@ func (d *DaemonCommand) BPMNParse() error {
 		arg := os.Args[i]
 		if arg == "--force" || arg == "-f" {
 			force = true
+		} else if arg == "--tenant" && i+1 < len(os.Args) {
+			tenantID = os.Args[i+1]
+			i++
 		} else if processID == "" {
 			processID = arg
 		}
@@ -46,6 +50,7 @@ func (d *DaemonCommand) BPMNParse() error {
 	logger.Debug("BPMN parse request",
 		logger.String("filename", filename),
 		logger.String("process_id", processID),
+		logger.String("tenant_id", tenantID),
 		logger.Bool("force", force))
 
 	conn, err := d.grpcClient.Connect()
@@ -64,6 +69,7 @@ func (d *DaemonCommand) BPMNParse() error {
 		FilePath:  filename,
 		ProcessId: processID,
 		Force:     force,
+		TenantId:  tenantID,
 	})
 	if err != nil {
 		logger.Error("Failed to parse BPMN file", logger.String("error", err.Error()))
@@ -84,6 +90,9 @@ func (d *DaemonCommand) BPMNParse() error {
 		fmt.Printf("BPMN ID: %s\n", resp.BpmnId)
 		fmt.Printf("Process ID: %s\n", resp.ProcessId)
 		fmt.Printf("Process Name: %s\n", resp.ProcessName)
+		if resp.TenantId != "" {
+			fmt.Printf("Tenant: %s\n", resp.TenantId)
+		}
 		fmt.Printf("Total Elements: %d\n", resp.TotalElements)
 		fmt.Printf("Successful: %d\n", resp.SuccessfulElements)
 		fmt.Printf("Generic: %d\n", resp.GenericElements)
@@ -100,6 +109,7 @@ func (d *DaemonCommand) BPMNList() error {
 
 	// Parse arguments for pagination
 	var pageSize, page int32 = 20, 1 // Default values
+	var tenantID string
 
 	args := os.Args[3:] // Skip "atomd bpmn list"
 
@@ -121,6 +131,10 @@ func (d *DaemonCommand) BPMNList() error {
 					continue
 				}
 			}
+		} else if arg == "--tenant" && i+1 < len(args) {
+			tenantID = args[i+1]
+			i++
+			continue
 		}
 		// Note: No positional arguments for BPMN list currently
 	}
@@ -147,6 +161,7 @@ func (d *DaemonCommand) BPMNList() error {
 		Page:      page,
 		SortBy:    "created_at",
 		SortOrder: "DESC",
+		TenantId:  tenantID,
 	})
 	if err != nil {
 		logger.Error("Failed to list BPMN processes", logger.String("error", err.Error()))
@@ -0,0 +1,244 @@
+/*
+This file is part of the AtomBPMN (R) project.
+Copyright (c) 2025 Matreska Market LLC (ООО «Matreska Market»).
+Authors: Matreska Team.
+
+This project is dual-licensed under AGPL-3.0 and AtomBPMN Commercial License.
+*/
+
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"atom-engine/proto/timewheel/timewheelpb"
+	"atom-engine/src/core/logger"
+	"atom-engine/src/core/models"
+)
+
+// CalendarList lists business calendars via gRPC
+// Выводит список бизнес-календарей через gRPC
+func (d *DaemonCommand) CalendarList() error {
+	conn, err := d.grpcClient.Connect()
+	if err != nil {
+		logger.Error("Failed to connect for calendar list", logger.String("error", err.Error()))
+		return fmt.Errorf("daemon is not running: %w", err)
+	}
+	defer conn.Close()
+
+	client := timewheelpb.NewTimeWheelServiceClient(conn)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	resp, err := client.ListCalendars(ctx, &timewheelpb.ListCalendarsRequest{})
+	if err != nil {
+		logger.Error("Failed to list calendars via gRPC", logger.String("error", err.Error()))
+		return fmt.Errorf("failed to list calendars: %w", err)
+	}
+
+	if len(resp.Calendars) == 0 {
+		fmt.Println("No business calendars defined")
+		return nil
+	}
+
+	fmt.Printf("%-20s %-20s %-8s %-10s\n", "NAME", "TIME ZONE", "SOURCE", "HOLIDAYS")
+	fmt.Println(strings.Repeat("-", 61))
+	for _, cal := range resp.Calendars {
+		timeZone := cal.TimeZone
+		if timeZone == "" {
+			timeZone = "UTC"
+		}
+		fmt.Printf("%-20s %-20s %-8s %-10d\n", cal.Name, timeZone, cal.Source, len(cal.Holidays))
+	}
+	fmt.Printf("\nTotal: %d\n", len(resp.Calendars))
+
+	return nil
+}
+
+// CalendarShow shows business calendar via gRPC
+// Показывает бизнес-календарь через gRPC
+func (d *DaemonCommand) CalendarShow() error {
+	if len(os.Args) < 4 {
+		logger.Error("Invalid calendar show arguments", logger.Int("args_count", len(os.Args)))
+		return fmt.Errorf("usage: atomd calendar show <name>")
+	}
+
+	name := os.Args[3]
+
+	conn, err := d.grpcClient.Connect()
+	if err != nil {
+		logger.Error("Failed to connect for calendar show", logger.String("error", err.Error()))
+		return fmt.Errorf("daemon is not running: %w", err)
+	}
+	defer conn.Close()
+
+	client := timewheelpb.NewTimeWheelServiceClient(conn)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	resp, err := client.GetCalendar(ctx, &timewheelpb.GetCalendarRequest{Name: name})
+	if err != nil {
+		logger.Error("Failed to get calendar via gRPC",
+			logger.String("calendar", name),
+			logger.String("error", err.Error()))
+		return fmt.Errorf("failed to get calendar: %w", err)
+	}
+
+	printCalendar(resp.Calendar)
+	return nil
+}
+
+// CalendarPut creates or replaces business calendar from JSON file via gRPC
+// Создает или заменяет бизнес-календарь из JSON файла через gRPC
+func (d *DaemonCommand) CalendarPut() error {
+	if len(os.Args) < 4 {
+		logger.Error("Invalid calendar put arguments", logger.Int("args_count", len(os.Args)))
+		return fmt.Errorf("usage: atomd calendar put <file.json>")
+	}
+
+	filePath := os.Args[3]
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return fmt.Errorf("failed to read calendar file: %w", err)
+	}
+
+	var def models.BusinessCalendar
+	if err := json.Unmarshal(data, &def); err != nil {
+		return fmt.Errorf("invalid calendar file: %w", err)
+	}
+
+	pb := &timewheelpb.BusinessCalendar{
+		Name:         def.Name,
+		TimeZone:     def.TimeZone,
+		WorkingHours: def.WorkingHours,
+		WorkingDays:  def.WorkingDays,
+		Holidays:     def.Holidays,
+	}
+	if len(def.WeeklySchedule) > 0 {
+		pb.WeeklySchedule = make(map[string]string, len(def.WeeklySchedule))
+		for day, ranges := range def.WeeklySchedule {
+			pb.WeeklySchedule[day] = strings.Join(ranges, ",")
+		}
+	}
+
+	conn, err := d.grpcClient.Connect()
+	if err != nil {
+		logger.Error("Failed to connect for calendar put", logger.String("error", err.Error()))
+		return fmt.Errorf("daemon is not running: %w", err)
+	}
+	defer conn.Close()
+
+	client := timewheelpb.NewTimeWheelServiceClient(conn)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	resp, err := client.PutCalendar(ctx, &timewheelpb.PutCalendarRequest{Calendar: pb})
+	if err != nil {
+		logger.Error("Failed to put calendar via gRPC",
+			logger.String("calendar", def.Name),
+			logger.String("error", err.Error()))
+		return fmt.Errorf("failed to put calendar: %w", err)
+	}
+
+	if !resp.Success {
+		fmt.Printf("Failed to save calendar: %s\n", resp.Message)
+		return nil
+	}
+
+	fmt.Println(resp.Message)
+	printCalendar(resp.Calendar)
+	return nil
+}
+
+// CalendarDelete deletes business calendar via gRPC
+// Удаляет бизнес-календарь через gRPC
+func (d *DaemonCommand) CalendarDelete() error {
+	if len(os.Args) < 4 {
+		logger.Error("Invalid calendar delete arguments", logger.Int("args_count", len(os.Args)))
+		return fmt.Errorf("usage: atomd calendar delete <name>")
+	}
+
+	name := os.Args[3]
+
+	conn, err := d.grpcClient.Connect()
+	if err != nil {
+		logger.Error("Failed to connect for calendar delete", logger.String("error", err.Error()))
+		return fmt.Errorf("daemon is not running: %w", err)
+	}
+	defer conn.Close()
+
+	client := timewheelpb.NewTimeWheelServiceClient(conn)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	resp, err := client.DeleteCalendar(ctx, &timewheelpb.DeleteCalendarRequest{Name: name})
+	if err != nil {
+		logger.Error("Failed to delete calendar via gRPC",
+			logger.String("calendar", name),
+			logger.String("error", err.Error()))
+		return fmt.Errorf("failed to delete calendar: %w", err)
+	}
+
+	if !resp.Success {
+		fmt.Printf("Failed to delete calendar: %s\n", resp.Message)
+		return nil
+	}
+
+	fmt.Println(resp.Message)
+	return nil
+}
+
+// printCalendar prints business calendar details
+// Выводит детали бизнес-календаря
+func printCalendar(cal *timewheelpb.BusinessCalendar) {
+	if cal == nil {
+		return
+	}
+
+	timeZone := cal.TimeZone
+	if timeZone == "" {
+		timeZone = "UTC"
+	}
+
+	fmt.Printf("Name: %s\n", cal.Name)
+	fmt.Printf("Time zone: %s\n", timeZone)
+	fmt.Printf("Source: %s\n", cal.Source)
+	if len(cal.WorkingHours) > 0 {
+		fmt.Printf("Working hours: %s\n", strings.Join(cal.WorkingHours, ", "))
+	}
+	if len(cal.WorkingDays) > 0 {
+		fmt.Printf("Working days: %s\n", strings.Join(cal.WorkingDays, ", "))
+	}
+	if len(cal.WeeklySchedule) > 0 {
+		days := make([]string, 0, len(cal.WeeklySchedule))
+		for day := range cal.WeeklySchedule {
+			days = append(days, day)
+		}
+		sort.Strings(days)
+
+		fmt.Println("Weekly schedule:")
+		for _, day := range days {
+			ranges := cal.WeeklySchedule[day]
+			if ranges == "" {
+				ranges = "day off"
+			}
+			fmt.Printf("  %s: %s\n", day, ranges)
+		}
+	}
+	if len(cal.Holidays) > 0 {
+		fmt.Printf("Holidays: %s\n", strings.Join(cal.Holidays, ", "))
+	}
+	if cal.UpdatedAt > 0 {
+		fmt.Printf("Updated: %s\n", time.UnixMilli(cal.UpdatedAt).Format(time.RFC3339))
+	}
+}
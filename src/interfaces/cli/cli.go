@@ -52,11 +52,15 @@ func (c *CLI) Execute() error {
 	case "status":
 		return c.daemon.Status()
 	case "events":
-		return c.daemon.ShowEvents()
+		return c.handleEventsCommand()
 	case "storage":
 		return c.handleStorageCommand()
 	case "timer":
 		return c.handleTimerCommand()
+	case "clock":
+		return c.handleClockCommand()
+	case "calendar":
+		return c.handleCalendarCommand()
 	case "process":
 		return c.handleProcessCommand()
 	case "token":
@@ -71,6 +75,10 @@ func (c *CLI) Execute() error {
 		return c.handleBPMNCommand()
 	case "incident":
 		return c.handleIncidentCommand()
+	case "batch":
+		return c.handleBatchCommand()
+	case "auth":
+		return c.handleAuthCommand()
 	case "help", "--help", "-h":
 		showHelp()
 		return nil
@@ -111,6 +119,60 @@ func (c *CLI) handleTimerCommand() error {
 	}
 }
 
+// handleClockCommand processes clock sub-commands
+// Обрабатывает под-команды clock
+func (c *CLI) handleClockCommand() error {
+	if len(os.Args) < 3 {
+		showClockHelp()
+		return nil
+	}
+
+	subCommand := os.Args[2]
+	logger.Debug("Executing clock command", logger.String("subcommand", subCommand))
+
+	switch subCommand {
+	case "show":
+		return c.daemon.ClockShow()
+	case "advance":
+		return c.daemon.ClockAdvance()
+	case "help", "--help", "-h":
+		showClockHelp()
+		return nil
+	default:
+		logger.Error("Unknown clock command", logger.String("subcommand", subCommand))
+		return fmt.Errorf("unknown clock command: %s", subCommand)
+	}
+}
+
+// handleCalendarCommand processes calendar sub-commands
+// Обрабатывает под-команды calendar
+func (c *CLI) handleCalendarCommand() error {
+	if len(os.Args) < 3 {
+		showCalendarHelp()
+		return nil
+	}
+
+	subCommand := os.Args[2]
+	logger.Debug("Executing calendar command", logger.String("subcommand", subCommand))
+
+	switch subCommand {
+	case "list":
+		return c.daemon.CalendarList()
+	case "show":
+		return c.daemon.CalendarShow()
+	case "put":
+		return c.daemon.CalendarPut()
+	case "delete":
+		return c.daemon.CalendarDelete()
+	case "help", "--help", "-h":
+		showCalendarHelp()
+		return nil
+	default:
+		logger.Error("Unknown calendar command", logger.String("subcommand", subCommand))
+		return fmt.Errorf("unknown calendar command: %s", subCommand)
+	}
+}
+
 // handleStorageCommand processes storage sub-commands
 // Обрабатывает под-команды storage
 func (c *CLI) handleStorageCommand() error {
@@ -127,6 +189,12 @@ func (c *CLI) handleStorageCommand() error {
 		return c.daemon.StorageStatus()
 	case "info":
 		return c.daemon.StorageInfo()
+	case "backup":
+		return c.daemon.StorageBackup()
+	case "restore":
+		return c.daemon.StorageRestore()
+	case "migrate":
+		return c.daemon.StorageMigrate()
 	case "help", "--help", "-h":
 		showStorageHelp()
 		return nil
@@ -224,6 +292,8 @@ func (c *CLI) handleJobCommand() error {
 		return c.daemon.JobCreate()
 	case "stats":
 		return c.daemon.JobStats()
+	case "workers":
+		return c.daemon.JobWorkers()
 	case "help", "--help", "-h":
 		showJobHelp()
 		return nil
@@ -354,6 +424,8 @@ func (c *CLI) handleIncidentCommand() error {
 		return c.daemon.IncidentResolve()
 	case "stats":
 		return c.daemon.IncidentStats()
+	case "rule":
+		return c.daemon.IncidentRule()
 	case "help", "--help", "-h":
 		showIncidentHelp()
 		return nil
@@ -362,3 +434,114 @@ func (c *CLI) handleIncidentCommand() error {
 		return fmt.Errorf("unknown incident command: %s", subCommand)
 	}
 }
+
+// handleBatchCommand processes batch sub-commands
+// Обрабатывает под-команды batch
+func (c *CLI) handleBatchCommand() error {
+	if len(os.Args) < 3 {
+		showBatchHelp()
+		return nil
+	}
+
+	subCommand := os.Args[2]
+	logger.Debug("Executing batch command", logger.String("subcommand", subCommand))
+
+	switch subCommand {
+	case "create":
+		return c.daemon.BatchCreate()
+	case "list":
+		return c.daemon.BatchList()
+	case "status":
+		return c.daemon.BatchStatus()
+	case "pause":
+		return c.daemon.BatchPause()
+	case "resume":
+		return c.daemon.BatchResume()
+	case "cancel":
+		return c.daemon.BatchCancel()
+	case "delete":
+		return c.daemon.BatchDelete()
+	case "help", "--help", "-h":
+		showBatchHelp()
+		return nil
+	default:
+		logger.Error("Unknown batch command", logger.String("subcommand", subCommand))
+		return fmt.Errorf("unknown batch command: %s", subCommand)
+	}
+}
+
+// handleEventsCommand processes events sub-commands, without one shows system events
+// Обрабатывает под-команды events, без под-команды показывает системные события
+func (c *CLI) handleEventsCommand() error {
+	if len(os.Args) < 3 {
+		return c.daemon.ShowEvents()
+	}
+
+	subCommand := os.Args[2]
+	logger.Debug("Executing events command", logger.String("subcommand", subCommand))
+
+	switch subCommand {
+	case "watch":
+		return c.daemon.EventsWatch()
+	case "help", "--help", "-h":
+		showEventsHelp()
+		return nil
+	default:
+		logger.Error("Unknown events command", logger.String("subcommand", subCommand))
+		return fmt.Errorf("unknown events command: %s", subCommand)
+	}
+}
+
+// handleAuthCommand processes auth sub-commands
+// Обрабатывает под-команды auth
+func (c *CLI) handleAuthCommand() error {
+	if len(os.Args) < 3 {
+		showAuthHelp()
+		return nil
+	}
+
+	subCommand := os.Args[2]
+	logger.Debug("Executing auth command", logger.String("subcommand", subCommand))
+
+	switch subCommand {
+	case "key":
+		return c.handleAuthKeyCommand()
+	case "help", "--help", "-h":
+		showAuthHelp()
+		return nil
+	default:
+		logger.Error("Unknown auth command", logger.String("subcommand", subCommand))
+		return fmt.Errorf("unknown auth command: %s", subCommand)
+	}
+}
+
+// handleAuthKeyCommand processes auth key sub-commands
+// Обрабатывает под-команды auth key
+func (c *CLI) handleAuthKeyCommand() error {
+	if len(os.Args) < 4 {
+		showAuthHelp()
+		return nil
+	}
+
+	keyCommand := os.Args[3]
+	logger.Debug("Executing auth key command", logger.String("subcommand", keyCommand))
+
+	switch keyCommand {
+	case "create":
+		return c.daemon.AuthKeyCreate()
+	case "list":
+		return c.daemon.AuthKeyList()
+	case "show":
+		return c.daemon.AuthKeyShow()
+	case "revoke":
+		return c.daemon.AuthKeyRevoke()
+	case "rotate":
+		return c.daemon.AuthKeyRotate()
+	case "help", "--help", "-h":
+		showAuthHelp()
+		return nil
+	default:
+		logger.Error("Unknown auth key command", logger.String("subcommand", keyCommand))
+		return fmt.Errorf("unknown auth key command: %s", keyCommand)
+	}
+}
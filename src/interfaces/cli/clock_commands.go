@@ -0,0 +1,94 @@
+/*
+This file is part of the AtomBPMN (R) project.
+Copyright (c) 2025 Matreska Market LLC (ООО «Matreska Market»).
+Authors: Matreska Team.
+
+This project is dual-licensed under AGPL-3.0 and AtomBPMN Commercial License.
+*/
+
+package cli
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"atom-engine/proto/timewheel/timewheelpb"
+	"atom-engine/src/core/logger"
+)
+
+// clockAdvanceTimeout bounds advance request, every fired timer waits for engine to settle
+// Ограничивает запрос продвижения, каждый таймер ждет обработки движком
+const clockAdvanceTimeout = 10 * time.Minute
+
+// ClockShow shows engine clock via gRPC
+// Показывает часы движка через gRPC
+func (d *DaemonCommand) ClockShow() error {
+	conn, err := d.grpcClient.Connect()
+	if err != nil {
+		logger.Error("Failed to connect for clock show", logger.String("error", err.Error()))
+		return fmt.Errorf("daemon is not running: %w", err)
+	}
+	defer conn.Close()
+
+	client := timewheelpb.NewTimeWheelServiceClient(conn)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	resp, err := client.GetClock(ctx, &timewheelpb.GetClockRequest{})
+	if err != nil {
+		logger.Error("Failed to get clock via gRPC", logger.String("error", err.Error()))
+		return fmt.Errorf("failed to get clock: %w", err)
+	}
+
+	fmt.Printf("Clock mode: %s\n", resp.Mode)
+	fmt.Printf("Engine time: %s\n", time.UnixMilli(resp.Now).Format(time.RFC3339))
+
+	return nil
+}
+
+// ClockAdvance advances manual engine clock via gRPC
+// Продвигает ручные часы движка через gRPC
+func (d *DaemonCommand) ClockAdvance() error {
+	if len(os.Args) < 4 {
+		logger.Error("Invalid clock advance arguments", logger.Int("args_count", len(os.Args)))
+		return fmt.Errorf("usage: atomd clock advance <duration>")
+	}
+
+	duration := os.Args[3]
+	logger.Debug("Clock advance request", logger.String("duration", duration))
+
+	conn, err := d.grpcClient.Connect()
+	if err != nil {
+		logger.Error("Failed to connect for clock advance", logger.String("error", err.Error()))
+		return fmt.Errorf("daemon is not running: %w", err)
+	}
+	defer conn.Close()
+
+	client := timewheelpb.NewTimeWheelServiceClient(conn)
+
+	ctx, cancel := context.WithTimeout(context.Background(), clockAdvanceTimeout)
+	defer cancel()
+
+	resp, err := client.AdvanceClock(ctx, &timewheelpb.AdvanceClockRequest{Duration: duration})
+	if err != nil {
+		logger.Error("Failed to advance clock via gRPC",
+			logger.String("duration", duration),
+			logger.String("error", err.Error()))
+		return fmt.Errorf("failed to advance clock: %w", err)
+	}
+
+	if !resp.Success {
+		fmt.Printf("Failed to advance clock: %s\n", resp.Message)
+		return nil
+	}
+
+	fmt.Printf("Clock advanced by %s\n", duration)
+	fmt.Printf("From: %s\n", time.UnixMilli(resp.From).Format(time.RFC3339))
+	fmt.Printf("To: %s\n", time.UnixMilli(resp.To).Format(time.RFC3339))
+	fmt.Printf("Fired timers: %d\n", resp.FiredTimers)
+
+	return nil
+}
@@ -0,0 +1,132 @@
+/*
+This file is part of the AtomBPMN (R) project.
+Copyright (c) 2025 Matreska Market LLC (ООО «Matreska Market»).
+Authors: Matreska Team.
+
+This project is dual-licensed under AGPL-3.0 and AtomBPMN Commercial License.
+*/
+
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"strconv"
+	"syscall"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"atom-engine/proto/events/eventspb"
+	"atom-engine/src/core/events"
+	"atom-engine/src/core/logger"
+)
+
+// EventsWatch streams engine events via gRPC until interrupted
+// Выводит поток событий движка через gRPC до прерывания
+func (d *DaemonCommand) EventsWatch() error {
+	request := &eventspb.SubscribeEventsRequest{}
+	asJSON := false
+
+	args := os.Args[3:]
+	for i := 0; i < len(args); i++ {
+		flag := args[i]
+		if flag == "--json" {
+			asJSON = true
+			continue
+		}
+		if i+1 >= len(args) {
+			return fmt.Errorf("flag %s requires value", flag)
+		}
+		value := args[i+1]
+		i++
+
+		switch flag {
+		case "--process-key":
+			request.ProcessKey = value
+		case "--instance":
+			request.ProcessInstanceId = value
+		case "--type":
+			request.Types = append(request.Types, events.ParseTypes(value)...)
+		case "--tenant":
+			request.TenantId = value
+		case "--from":
+			sequence, err := strconv.ParseUint(value, 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid --from value: %s", value)
+			}
+			request.FromSequence = sequence
+		default:
+			return fmt.Errorf("unknown flag: %s", flag)
+		}
+	}
+
+	conn, err := d.grpcClient.Connect()
+	if err != nil {
+		logger.Error("Failed to connect for events watch", logger.String("error", err.Error()))
+		return fmt.Errorf("daemon is not running: %w", err)
+	}
+	defer conn.Close()
+
+	client := eventspb.NewEventsServiceClient(conn)
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	stream, err := client.SubscribeEvents(ctx, request)
+	if err != nil {
+		logger.Error("Failed to subscribe to events via gRPC", logger.String("error", err.Error()))
+		return fmt.Errorf("failed to subscribe to events: %w", err)
+	}
+
+	if !asJSON {
+		fmt.Printf("%-8s %-19s %-19s %-12s %-16s %-24s %s\n",
+			"SEQ", "TIME", "TYPE", "TENANT", "PROCESS", "INSTANCE", "DETAIL")
+	}
+	for {
+		event, err := stream.Recv()
+		if err != nil {
+			if errors.Is(err, io.EOF) || status.Code(err) == codes.Canceled || ctx.Err() != nil {
+				return nil
+			}
+			return fmt.Errorf("event stream ended: %w", err)
+		}
+
+		if asJSON {
+			data, err := json.Marshal(event)
+			if err != nil {
+				return fmt.Errorf("failed to encode event: %w", err)
+			}
+			fmt.Println(string(data))
+			continue
+		}
+		fmt.Printf("%-8d %-19s %-19s %-12s %-16s %-24s %s\n",
+			event.Sequence,
+			event.Timestamp.AsTime().Format("2006-01-02 15:04:05"),
+			event.Type,
+			event.TenantId,
+			event.ProcessId,
+			event.ProcessInstanceId,
+			eventDetail(event))
+	}
+}
+
+// eventDetail returns subject of event besides process and instance
+func eventDetail(event *eventspb.EngineEvent) string {
+	switch {
+	case event.JobKey != "":
+		return fmt.Sprintf("job %s (%s)", event.JobKey, event.JobType)
+	case event.IncidentId != "":
+		return "incident " + event.IncidentId
+	case event.MessageName != "":
+		return "message " + event.MessageName
+	case event.ElementId != "":
+		return fmt.Sprintf("%s (%s)", event.ElementId, event.ElementType)
+	}
+	return ""
+}
@@ -11,19 +11,24 @@ package cli
 import (
 	"context"
 	"fmt"
+	"net"
+	"os"
 	"time"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/insecure"
 
 	"atom-engine/src/core/config"
 	"atom-engine/src/core/logger"
+	"atom-engine/src/core/tlsconfig"
 )
 
 // GRPCClient handles gRPC connections
 // gRPC клиент для подключений к демону
 type GRPCClient struct {
 	address string
+	tls     *tlsconfig.ClientOptions // nil for plaintext connection
 }
 
 // NewGRPCClient creates new gRPC client instance with default configuration
@@ -43,17 +48,99 @@ func NewGRPCClientWithAddress(address string) *GRPCClient {
 // NewGRPCClientFromConfig creates new gRPC client instance from configuration
 // Создает новый экземпляр gRPC клиента из конфигурации
 func NewGRPCClientFromConfig() (*GRPCClient, error) {
+	flags, args, err := parseTLSFlags(os.Args)
+	if err != nil {
+		return nil, err
+	}
+	// Commands read positional arguments, connection flags are removed before dispatch
+	os.Args = args
+
 	cfg, err := config.LoadConfigWithEnv()
 	if err != nil {
 		// Fallback to default address if config loading fails
 		logger.Debug("Failed to load config, using default address", logger.String("error", err.Error()))
-		return NewGRPCClient(), nil
+		client := NewGRPCClient()
+		client.tls = flags.clientOptions(nil)
+		return client, nil
 	}
 
 	address := fmt.Sprintf("%s:%d", cfg.GRPC.Host, cfg.GRPC.Port)
-	logger.Debug("Creating gRPC client from config", logger.String("address", address))
+	logger.Debug("Creating gRPC client from config",
+		logger.String("address", address),
+		logger.Bool("tls", flags.enabled || cfg.GRPC.TLS.Enabled))
+
+	client := NewGRPCClientWithAddress(address)
+	client.tls = flags.clientOptions(&cfg.GRPC)
+	return client, nil
+}
+
+// tlsFlags holds TLS connection flags of command line
+type tlsFlags struct {
+	enabled bool
+	options tlsconfig.ClientOptions
+}
+
+// parseTLSFlags extracts TLS flags from arguments and returns remaining arguments
+// Извлекает TLS флаги из аргументов и возвращает оставшиеся аргументы
+func parseTLSFlags(args []string) (tlsFlags, []string, error) {
+	var flags tlsFlags
+	rest := make([]string, 0, len(args))
+
+	for i := 0; i < len(args); i++ {
+		var target *string
+		switch args[i] {
+		case "--tls":
+			flags.enabled = true
+			continue
+		case "--insecure-skip-verify":
+			flags.enabled = true
+			flags.options.InsecureSkipVerify = true
+			continue
+		case "--ca-cert":
+			target = &flags.options.CAFile
+		case "--cert":
+			target = &flags.options.CertFile
+		case "--key":
+			target = &flags.options.KeyFile
+		case "--server-name":
+			target = &flags.options.ServerName
+		default:
+			rest = append(rest, args[i])
+			continue
+		}
+
+		if i+1 >= len(args) {
+			return flags, nil, fmt.Errorf("flag %s requires value", args[i])
+		}
+		*target = args[i+1]
+		flags.enabled = true
+		i++
+	}
 
-	return NewGRPCClientWithAddress(address), nil
+	return flags, rest, nil
+}
+
+// clientOptions returns client TLS options, daemon TLS config supplies defaults on same host
+func (f tlsFlags) clientOptions(grpcConfig *config.GRPCConfig) *tlsconfig.ClientOptions {
+	if grpcConfig == nil || !grpcConfig.TLS.Enabled {
+		if !f.enabled {
+			return nil
+		}
+		options := f.options
+		return &options
+	}
+
+	options := f.options
+	if options.CAFile == "" && !options.InsecureSkipVerify {
+		// Own certificate is trusted directly, works for self-signed certificates too
+		options.CAFile = grpcConfig.TLS.CertFile
+	}
+	if options.ServerName == "" {
+		if ip := net.ParseIP(grpcConfig.Host); ip != nil && ip.IsUnspecified() {
+			options.ServerName = "localhost"
+		}
+	}
+	return &options
 }
 
 // Connect establishes connection to gRPC server
@@ -64,8 +151,17 @@ func (g *GRPCClient) Connect() (*grpc.ClientConn, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
 	defer cancel()
 
+	transportCreds := insecure.NewCredentials()
+	if g.tls != nil {
+		tlsConfig, err := tlsconfig.ClientConfig(*g.tls)
+		if err != nil {
+			return nil, fmt.Errorf("failed to configure TLS: %w", err)
+		}
+		transportCreds = credentials.NewTLS(tlsConfig)
+	}
+
 	conn, err := grpc.DialContext(ctx, g.address,
-		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithTransportCredentials(transportCreds),
 		grpc.WithBlock())
 	if err != nil {
 		logger.Error("Failed to connect to gRPC server",
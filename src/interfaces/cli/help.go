@@ -29,22 +29,27 @@ func showHelp() {
 	fmt.Println("  run                   Start daemon in foreground")
 	fmt.Println("  stop                  Stop running daemon")
 	fmt.Println("  status                Show daemon status")
-	fmt.Println("  events                Show system events from database")
+	fmt.Println("  events [watch]        Show system events from database, watch streams engine events")
 	fmt.Println("  help                  Show this help")
 	fmt.Println("")
 
 	fmt.Println("MANAGEMENT COMMANDS:")
-	fmt.Println("  storage <cmd>         Storage management (status, info, help)")
+	fmt.Println("  storage <cmd>         Storage management (status, info, backup, restore, help)")
 	fmt.Println("  timer <cmd>           Timer management (add, remove, status, list, stats, help)")
+	fmt.Println("  clock <cmd>           Engine clock (show, advance, help)")
+	fmt.Println("  calendar <cmd>        Business calendars (list, show, put, delete, help)")
 	fmt.Println("  bpmn <cmd>            BPMN management (parse, list, show, delete, stats, json, help)")
 	fmt.Println("  process <cmd>         Process management (start, status, cancel, list, help)")
 	fmt.Println("  token <cmd>           Token management (list, show, trace, help)")
 	fmt.Println("  job <cmd>             Job management (list, show, activate, complete,")
-	fmt.Println("                         fail, cancel, create, throw-error, stats, help)")
+	fmt.Println("                         fail, cancel, create, throw-error, stats, workers, help)")
 	fmt.Println("  message <cmd>         Message management (publish, list, subscriptions,")
 	fmt.Println("                         buffered, cleanup, stats, test, help)")
 	fmt.Println("  expression <cmd>      Expression evaluation (eval, validate, parse, functions, test, help)")
-	fmt.Println("  incident <cmd>        Incident management (list, show, resolve, stats, help)")
+	fmt.Println("  incident <cmd>        Incident management (list, show, resolve, stats, rule, help)")
+	fmt.Println("  batch <cmd>           Batch operations (create, list, status, pause, resume,")
+	fmt.Println("                         cancel, delete, help)")
+	fmt.Println("  auth <cmd>            API key management (key create, list, show, revoke, rotate, help)")
 	fmt.Println("")
 
 	fmt.Println("QUICK REFERENCE:")
@@ -53,6 +58,8 @@ func showHelp() {
 	fmt.Println("Storage:")
 	fmt.Println("  atomd storage status          Show storage status")
 	fmt.Println("  atomd storage info            Show storage information and statistics")
+	fmt.Println("  atomd storage backup <file>   Write online backup of running daemon")
+	fmt.Println("  atomd storage restore <file>  Restore backup into empty data directory")
 	fmt.Println("")
 
 	fmt.Println("Timer:")
@@ -62,6 +69,16 @@ func showHelp() {
 	fmt.Println("  atomd timer stats                     Show timewheel statistics")
 	fmt.Println("")
 
+	fmt.Println("Clock:")
+	fmt.Println("  atomd clock show                      Show engine clock mode and time")
+	fmt.Println("  atomd clock advance <duration>        Advance manual clock (PT2H, P7D)")
+	fmt.Println("")
+
+	fmt.Println("Calendar:")
+	fmt.Println("  atomd calendar list                   List business calendars")
+	fmt.Println("  atomd calendar put <file.json>        Create or replace business calendar")
+	fmt.Println("")
+
 	fmt.Println("BPMN:")
 	fmt.Println("  atomd bpmn parse <file.bpmn> [id] [-f]    Parse BPMN file")
 	fmt.Println("  atomd bpmn list [limit]                   List BPMN processes")
@@ -95,6 +112,7 @@ func showHelp() {
 	fmt.Println("  atomd job throw-error <job_key> <code> [message]     Throw BPMN error")
 	fmt.Println("  atomd job cancel <job_key>                           Cancel job")
 	fmt.Println("  atomd job stats                                      Show statistics")
+	fmt.Println("  atomd job workers [type]                             Show workers and job leases")
 	fmt.Println("")
 
 	fmt.Println("Message:")
@@ -119,8 +137,28 @@ func showHelp() {
 	fmt.Println("  atomd incident list [status] [type] [limit]           List incidents")
 	fmt.Println("  atomd incident show <incident_id>                     Show details")
 	fmt.Println("  atomd incident resolve <id> retry [retries] [comment] Resolve with retry")
+	fmt.Println("    [--variables <json>]                                Patch variables before retry")
 	fmt.Println("  atomd incident resolve <id> dismiss [comment]         Dismiss incident")
 	fmt.Println("  atomd incident stats                                  Show statistics")
+	fmt.Println("  atomd incident rule list                              List automatic retry rules")
+	fmt.Println("")
+
+	fmt.Println("Batch:")
+	fmt.Println("  atomd batch create <type> [options]                   Create batch operation")
+	fmt.Println("  atomd batch list [state]                              List batch operations")
+	fmt.Println("  atomd batch status <batch_id>                         Show progress and failures")
+	fmt.Println("  atomd batch pause|resume|cancel <batch_id>            Control batch operation")
+	fmt.Println("")
+
+	fmt.Println("Events:")
+	fmt.Println("  atomd events watch [--process-key <key>] [--type <t>] Stream engine events until Ctrl+C")
+	fmt.Println("")
+
+	fmt.Println("Auth:")
+	fmt.Println("  atomd auth key create <name> --permissions <p1,p2>    Create API key, secret shown once")
+	fmt.Println("  atomd auth key list [--all]                           List API keys")
+	fmt.Println("  atomd auth key revoke <key_id>                        Revoke API key")
+	fmt.Println("  atomd auth key rotate <key_id> [--overlap <duration>] Rotate API key")
 	fmt.Println("")
 
 	fmt.Println("For detailed help on any command, use: atomd <command> help")
@@ -138,7 +176,67 @@ func showStorageHelp() {
 	fmt.Println("Usage:")
 	fmt.Println("  atomd storage status  - Show storage status")
 	fmt.Println("  atomd storage info    - Show storage information and statistics")
+	fmt.Println("  atomd storage backup <file> [--since <version>]")
+	fmt.Println("                        - Write online consistent backup of running daemon,")
+	fmt.Println("                          --since <version> writes incremental backup")
+	fmt.Println("  atomd storage restore <file> [--dir <path>] [--remote]")
+	fmt.Println("                        - Restore backup with daemon stopped into --dir or")
+	fmt.Println("                          configured database path. Full backup needs empty")
+	fmt.Println("                          directory, incremental one the directory restored")
+	fmt.Println("                          from previous backup. --remote uploads backup to")
+	fmt.Println("                          daemon, which restores into --dir on its host")
+	fmt.Println("  atomd storage migrate [--dir <path>] [--dry-run]")
+	fmt.Println("                        - Migrate records to current schema with daemon")
+	fmt.Println("                          stopped. --dry-run lists pending migrations and")
+	fmt.Println("                          records they change without writing. Daemon")
+	fmt.Println("                          also migrates on start and refuses newer schema")
 	fmt.Println("  atomd storage help    - Show this help")
+	fmt.Println("")
+	fmt.Println("Backup and restore require admin permission when authentication is enabled.")
+}
+
+// showClockHelp displays clock help information
+// Показывает справочную информацию по clock
+func showClockHelp() {
+	fmt.Println("Engine clock commands:")
+	fmt.Println("")
+	fmt.Println("Usage:")
+	fmt.Println("  atomd clock show                 - Show engine clock mode and time")
+	fmt.Println("  atomd clock advance <duration>   - Advance manual clock, firing due timers in order")
+	fmt.Println("  atomd clock help                 - Show this help")
+	fmt.Println("")
+	fmt.Println("Advance works only when daemon runs with clock.mode: manual")
+	fmt.Println("(or ATOM_CLOCK_MODE=manual) and requires API key with admin permission, so auth")
+	fmt.Println("must be enabled.")
+	fmt.Println("")
+	fmt.Println("Examples:")
+	fmt.Println("  atomd clock advance PT2H         - Move engine time 2 hours forward")
+	fmt.Println("  atomd clock advance P14D         - Exercise two-week SLA timers")
+}
+
+// showCalendarHelp displays calendar help information
+// Показывает справочную информацию по calendar
+func showCalendarHelp() {
+	fmt.Println("Business calendar commands:")
+	fmt.Println("")
+	fmt.Println("Usage:")
+	fmt.Println("  atomd calendar list              - List business calendars")
+	fmt.Println("  atomd calendar show <name>       - Show calendar working hours and holidays")
+	fmt.Println("  atomd calendar put <file.json>   - Create or replace calendar from JSON file")
+	fmt.Println("  atomd calendar delete <name>     - Delete calendar created through API")
+	fmt.Println("  atomd calendar help              - Show this help")
+	fmt.Println("")
+	fmt.Println("Calendars from config.yaml are read-only. Put and delete require admin")
+	fmt.Println("permission when auth is enabled.")
+	fmt.Println("")
+	fmt.Println("Calendar file example:")
+	fmt.Println(`  {"name": "support", "time_zone": "Europe/Moscow",`)
+	fmt.Println(`   "working_hours": ["09:00-13:00", "14:00-18:00"],`)
+	fmt.Println(`   "weekly_schedule": {"sat": ["10:00-14:00"]},`)
+	fmt.Println(`   "holidays": ["01-01", "2025-05-09"]}`)
+	fmt.Println("")
+	fmt.Println("Timers use calendar through BPMN timer attribute calendar=\"support\",")
+	fmt.Println("user tasks through zeebe:taskSchedule dueDate and calendar attributes.")
 }
 
 // showTimerHelp displays timer help information
@@ -157,6 +255,7 @@ func showTimerHelp() {
 	fmt.Println("List options:")
 	fmt.Println("  --page, -p <N>         Page number (default: 1)")
 	fmt.Println("  --page-size, -s <N>    Number of timers per page (default: 20)")
+	fmt.Println("  --tenant <tenant_id>   Tenant filter, <default> for default tenant (default: every tenant)")
 	fmt.Println("")
 	fmt.Println("Duration formats (ISO 8601):")
 	fmt.Println("  PT30S                                                                          - 30 seconds")
@@ -164,16 +263,25 @@ func showTimerHelp() {
 	fmt.Println("  PT1H                                                                           - 1 hour")
 	fmt.Println("  P1D                                                                            - 1 day")
 	fmt.Println("  P1DT2H30M                            - 1 day 2 hours 30 minutes")
+	fmt.Println("  P1M                                  - 1 calendar month (Jan 31 -> Feb 28)")
 	fmt.Println("")
 	fmt.Println("Repeating cycles (ISO 8601):")
 	fmt.Println("  R5/PT30S                             - Repeat 5 times every 30 seconds")
 	fmt.Println("  R/PT1M                                                                         - Repeat infinitely every minute")
 	fmt.Println("  R3/PT10S                                                                       - Repeat 3 times every 10 seconds")
+	fmt.Println("  R/2025-01-31T09:00:00[Europe/Berlin]/P1M - Monthly from start date, end of month clamped")
+	fmt.Println("  R/PT1H/2025-12-31T18:00:00Z          - Every hour until end date")
+	fmt.Println("")
+	fmt.Println("Cron cycles (seconds field optional, quote the expression):")
+	fmt.Println("  \"0 0 9 * * MON-FRI\"                 - Weekdays at 09:00 UTC")
+	fmt.Println("  \"CRON_TZ=Europe/Berlin 0 0 12 L * ?\" - Last day of month at 12:00 Berlin time")
+	fmt.Println("  @daily                               - Every day at midnight")
 	fmt.Println("")
 	fmt.Println("Examples:")
 	fmt.Println("  atomd timer add timer1 PT5S                                                   - Add timer for 5 seconds")
 	fmt.Println("  atomd timer add timer2 R3/PT10S                                               - Repeat 3 times every 10 seconds")
 	fmt.Println("  atomd timer add timer3 R/PT30M                                                - Repeat infinitely every 30 minutes")
+	fmt.Println("  atomd timer add timer4 \"0 */15 * * * *\"                                      - Every 15 minutes by cron")
 	fmt.Println("  atomd timer remove timer1                                                     - Remove timer1")
 	fmt.Println("  atomd timer status timer1                                                     - Check timer1 status")
 	fmt.Println("  atomd timer list                                                              - List first 20 timers")
@@ -188,7 +296,7 @@ func showProcessHelp() {
 	fmt.Println("Process management commands:")
 	fmt.Println("")
 	fmt.Println("Usage:")
-	fmt.Println("  atomd process start <process_key> [-v version] [-d variables]              - Start process instance")
+	fmt.Println("  atomd process start <process_key> [-v version] [-d variables] [--tenant id] - Start process instance")
 	fmt.Println("  atomd process status <instance_id>                                         - Get process instance status")
 	fmt.Println("  atomd process info <instance_id>                                           - Get complete process instance information")
 	fmt.Println("  atomd process cancel <instance_id> [reason]                                - Cancel process instance")
@@ -198,10 +306,12 @@ func showProcessHelp() {
 	fmt.Println("Start options:")
 	fmt.Println("  -v, --version <version>                                                    - Specific version to start")
 	fmt.Println("  -d, --data <json>                                                          - Process variables as JSON")
+	fmt.Println("  --tenant <tenant_id>                                                       - Tenant of deployment to start")
 	fmt.Println("")
 	fmt.Println("List options:")
 	fmt.Println("  --page, -p <N>         Page number (default: 1)")
 	fmt.Println("  --page-size, -s <N>    Number of instances per page (default: 20)")
+	fmt.Println("  --tenant <tenant_id>   Tenant filter, <default> for default tenant (default: every tenant)")
 	fmt.Println("")
 	fmt.Println("Examples:")
 	fmt.Println("  atomd process start Process_Big_Process_ID                                 - Start latest version")
@@ -249,16 +359,18 @@ func showJobHelp() {
 	fmt.Println("Usage:")
 	fmt.Println("  atomd job list [type] [worker] [process_instance_id] [process_key] [state] [--page N] [--page-size N]  - List jobs")
 	fmt.Println("  atomd job show <job_key>                                                                               - Show job details")
-	fmt.Println("  atomd job activate <type> <worker> [-j max_jobs] [-t timeout]                                          - Activate jobs for worker")
+	fmt.Println("  atomd job activate <type> <worker> [-j max_jobs] [-t timeout] [-v var1,var2] [--tenants t1,t2]         - Activate jobs for worker")
 	fmt.Println("  atomd job complete <job_key> [variables]                                                               - Complete job")
 	fmt.Println("  atomd job fail <job_key> <retries> [error] [backoff]                                                   - Fail job")
 	fmt.Println("  atomd job throw-error <job_key> <error_code> [error_message]                                            - Throw BPMN error")
 	fmt.Println("  atomd job cancel <job_key>                                                                             - Cancel job")
+	fmt.Println("  atomd job workers [type]                                                                               - Show workers and job leases")
 	fmt.Println("  atomd job help                                                                                         - Show this help")
 	fmt.Println("")
 	fmt.Println("List options:")
 	fmt.Println("  --page, -p <N>         Page number (default: 1)")
 	fmt.Println("  --page-size, -s <N>    Number of jobs per page (default: 20)")
+	fmt.Println("  --tenant <tenant_id>   Tenant filter, <default> for default tenant (default: every tenant)")
 	fmt.Println("")
 	fmt.Println("Examples:")
 	fmt.Println("  atomd job list                                                                                         - List first 20 jobs")
@@ -270,8 +382,11 @@ func showJobHelp() {
 	fmt.Println("  atomd job activate service-task worker1 -j 5                                                           - Activate up to 5 jobs")
 	fmt.Println("  atomd job activate service-task worker1 -t 5000                                                        - Activate job with 5s timeout")
 	fmt.Println("  atomd job activate service-task worker1 -j 3 -t 10000                                                  - Activate 3 jobs with 10s timeout")
+	fmt.Println("  atomd job activate invoice-check worker1 -v invoiceId,amount                                           - Fetch only invoiceId and amount")
+	fmt.Println("  atomd job activate service-task worker1 --tenants acme,globex                                          - Activate jobs of acme and globex")
 	fmt.Println("  atomd job complete atom-jobkey12345 '{\"result\": \"success\"}'                                           - Complete with variables")
 	fmt.Println("  atomd job fail atom-jobkey12345 2 \"Connection failed\"                                                  - Fail with 2 retries left")
+	fmt.Println("  atomd job fail atom-jobkey12345 2 \"Connection failed\" 30s                                              - Retry after 30s instead of task policy")
 	fmt.Println("  atomd job throw-error atom-jobkey12345 404 \"Not Found\"                                                 - Throw BPMN error 404")
 	fmt.Println("  atomd job cancel atom-jobkey12345                                                                      - Cancel job")
 }
@@ -338,7 +453,7 @@ func showBPMNHelp() {
 	fmt.Println("BPMN management commands:")
 	fmt.Println("")
 	fmt.Println("Usage:")
-	fmt.Println("  atomd bpmn parse <file.bpmn> [process_id] [--force|-f]                     - Parse BPMN file")
+	fmt.Println("  atomd bpmn parse <file.bpmn> [process_id] [--force|-f] [--tenant <id>]     - Parse BPMN file into tenant")
 	fmt.Println("  atomd bpmn list [--page N] [--page-size N]                                 - List all BPMN processes")
 	fmt.Println("  atomd bpmn show <process_key>                                               - Show BPMN process details (use PROCESS KEY from list)")
 	fmt.Println("  atomd bpmn delete <process_id>                                              - Delete BPMN process")
@@ -350,12 +465,14 @@ func showBPMNHelp() {
 	fmt.Println("List options:")
 	fmt.Println("  --page, -p <N>         Page number (default: 1)")
 	fmt.Println("  --page-size, -s <N>    Number of processes per page (default: 20)")
+	fmt.Println("  --tenant <tenant_id>   Tenant filter, <default> for default tenant (default: every tenant)")
 	fmt.Println("")
 	fmt.Println("Examples:")
 	fmt.Println("  atomd bpmn parse process.bpmn                                               - Parse process.bpmn")
 	fmt.Println("  atomd bpmn parse process.bpmn my-process-1                                  - Parse with specified ID")
 	fmt.Println("  atomd bpmn parse process.bpmn --force                                       - Force import")
 	fmt.Println("  atomd bpmn parse process.bpmn my-process-1 -f                               - Force with ID")
+	fmt.Println("  atomd bpmn parse process.bpmn --tenant acme                                 - Deploy for tenant acme")
 	fmt.Println("  atomd bpmn list                                                             - List first 20 processes")
 	fmt.Println("  atomd bpmn list --page 2                                                    - List page 2 (processes 21-40)")
 	fmt.Println("  atomd bpmn list --page-size 50                                              - List 50 processes per page")
@@ -375,13 +492,19 @@ func showIncidentHelp() {
 	fmt.Println("  atomd incident list [status] [type] [--page N] [--page-size N]               - List incidents with optional filtering")
 	fmt.Println("  atomd incident show <incident_id>                                             - Show incident details")
 	fmt.Println("  atomd incident resolve <incident_id> retry [retries] [comment]                - Resolve incident with retry")
+	fmt.Println("  atomd incident resolve <incident_id> retry --variables <json>                 - Patch variables and re-execute failed element")
 	fmt.Println("  atomd incident resolve <incident_id> dismiss [comment]                        - Dismiss incident")
 	fmt.Println("  atomd incident stats                                                          - Show incident statistics")
+	fmt.Println("  atomd incident rule list                                                      - List automatic retry rules")
+	fmt.Println("  atomd incident rule put <file.json>                                           - Create or replace retry rule")
+	fmt.Println("  atomd incident rule delete <name>                                             - Delete retry rule created through API")
+	fmt.Println("  atomd incident rule preview [file.json]                                       - Dry run of rules against open incidents")
 	fmt.Println("  atomd incident help                                                           - Show this help")
 	fmt.Println("")
 	fmt.Println("List options:")
 	fmt.Println("  --page, -p <N>         Page number (default: 1)")
 	fmt.Println("  --page-size, -s <N>    Number of incidents per page (default: 20)")
+	fmt.Println("  --tenant <tenant_id>   Tenant filter, <default> for default tenant (default: every tenant)")
 	fmt.Println("")
 	fmt.Println("Status filters:")
 	fmt.Println("  open                  - Open incidents")
@@ -407,7 +530,136 @@ func showIncidentHelp() {
 	fmt.Println("  atomd incident show srv1-abc123def456                                         - Show incident details")
 	fmt.Println("  atomd incident resolve srv1-abc123def456 retry 3                              - Retry incident with 3 retries")
 	fmt.Println("  atomd incident resolve srv1-abc123def456 retry 5 \"Fixed worker config\"        - Retry with comment")
+	fmt.Println("  atomd incident resolve srv1-abc123def456 retry --variables '{\"amount\":100}'    - Fix variable and retry element")
 	fmt.Println("  atomd incident resolve srv1-abc123def456 dismiss                              - Dismiss incident")
 	fmt.Println("  atomd incident resolve srv1-abc123def456 dismiss \"Known issue\"                - Dismiss with comment")
 	fmt.Println("  atomd incident stats                                                          - Show statistics")
+	fmt.Println("  atomd incident rule preview timeout-rule.json                                 - Show incidents the rule would retry")
+	fmt.Println("")
+	fmt.Println("Retry rule file:")
+	fmt.Println("  {\"name\": \"payment-timeouts\", \"types\": [\"JOB_FAILURE\"], \"job_types\": [\"payment\"],")
+	fmt.Println("   \"error_pattern\": \"timeout\", \"max_attempts\": 3, \"interval\": \"PT5M\"}")
+}
+
+// showBatchHelp displays batch operations help information
+// Показывает справочную информацию по пакетным операциям
+func showBatchHelp() {
+	fmt.Println("Batch operation commands:")
+	fmt.Println("")
+	fmt.Println("Usage:")
+	fmt.Println("  atomd batch create <type> [options]                     - Create batch operation from filter flags")
+	fmt.Println("  atomd batch create <file.json>                          - Create batch operation from JSON file")
+	fmt.Println("  atomd batch list [state]                                - List batch operations, newest first")
+	fmt.Println("  atomd batch status <batch_id>                           - Show progress and failed items")
+	fmt.Println("  atomd batch pause <batch_id>                            - Pause after current item")
+	fmt.Println("  atomd batch resume <batch_id>                           - Resume from saved progress")
+	fmt.Println("  atomd batch cancel <batch_id>                           - Cancel, remaining items are skipped")
+	fmt.Println("  atomd batch delete <batch_id>                           - Delete finished batch operation")
+	fmt.Println("  atomd batch help                                        - Show this help")
+	fmt.Println("")
+	fmt.Println("Types:")
+	fmt.Println("  CANCEL                - Cancel matching process instances")
+	fmt.Println("  RESOLVE               - Dismiss open incidents of matching instances")
+	fmt.Println("  RETRY                 - Retry open incidents of matching instances")
+	fmt.Println("  MIGRATE               - Move matching instances to another process version")
+	fmt.Println("  MODIFY                - Set variables of matching instances")
+	fmt.Println("")
+	fmt.Println("Filter options:")
+	fmt.Println("  --instance <id>             Process instance ID, repeatable")
+	fmt.Println("  --process-key <key>         Process ID or versioned key")
+	fmt.Println("  --version <N>               Process version")
+	fmt.Println("  --state <state>             Instance state, repeatable (default: unfinished)")
+	fmt.Println("  --element <element_id>      Active token or incident at element")
+	fmt.Println("  --variables <json>          Equal process variable values")
+	fmt.Println("  --started-after <RFC3339>   Started at or after time")
+	fmt.Println("  --started-before <RFC3339>  Started before time")
+	fmt.Println("  --incident-type <type>      Incident type, repeatable (RESOLVE, RETRY)")
+	fmt.Println("  --tenant <tenant_id>        Instance tenant, <default> for default tenant (default: every tenant)")
+	fmt.Println("")
+	fmt.Println("Operation options:")
+	fmt.Println("  --reason <text>             Cancel reason (CANCEL)")
+	fmt.Println("  --comment <text>            Resolution comment (RESOLVE, RETRY)")
+	fmt.Println("  --retries <N>               New job retries (RETRY)")
+	fmt.Println("  --set <json>                Variables to set (MODIFY, RETRY)")
+	fmt.Println("  --target-version <N>        Target process version (MIGRATE)")
+	fmt.Println("  --chunk-size <N>            Items per chunk (default: batch.chunk_size)")
+	fmt.Println("")
+	fmt.Println("Examples:")
+	fmt.Println("  atomd batch create CANCEL --process-key order --element wait_payment --reason \"Obsolete\"")
+	fmt.Println("  atomd batch create RETRY --process-key order --incident-type JOB_FAILURE --retries 3")
+	fmt.Println("  atomd batch create MIGRATE --process-key order --version 1 --target-version 2")
+	fmt.Println("  atomd batch create MODIFY --process-key order --set '{\"priority\":\"high\"}'")
+	fmt.Println("  atomd batch list RUNNING")
+	fmt.Println("")
+	fmt.Println("Batch file:")
+	fmt.Println("  {\"type\": \"CANCEL\", \"filter\": {\"process_key\": \"order\", \"version\": 1},")
+	fmt.Println("   \"params\": {\"reason\": \"Obsolete\"}, \"chunk_size\": 50}")
+}
+
+// showEventsHelp displays event stream help information
+// Показывает справочную информацию по потоку событий
+func showEventsHelp() {
+	fmt.Println("Event stream commands:")
+	fmt.Println("")
+	fmt.Println("Usage:")
+	fmt.Println("  atomd events                                            - Show system events from database")
+	fmt.Println("  atomd events watch [options]                            - Stream engine events until Ctrl+C")
+	fmt.Println("  atomd events help                                       - Show this help")
+	fmt.Println("")
+	fmt.Println("Options:")
+	fmt.Println("  --process-key <key>         BPMN process ID or process definition key")
+	fmt.Println("  --instance <id>             Process instance ID")
+	fmt.Println("  --type <t1,t2>              Event types or categories (instance, element, job, incident,")
+	fmt.Println("                              message, timer, variable, deployment)")
+	fmt.Println("  --tenant <tenant_id>        Event tenant, <default> for default tenant (default: every tenant)")
+	fmt.Println("  --from <sequence>           Replay retained events starting with sequence")
+	fmt.Println("  --json                      Print events as JSON lines")
+	fmt.Println("")
+	fmt.Println("Event types:")
+	fmt.Println("  instance.started, instance.completed, instance.canceled")
+	fmt.Println("  element.activated, element.completed")
+	fmt.Println("  job.created, job.activated, job.completed, job.failed, job.retried")
+	fmt.Println("  job.retries_updated, job.timed_out, job.error_thrown, job.canceled")
+	fmt.Println("  incident.opened, incident.resolved")
+	fmt.Println("  message.correlated")
+	fmt.Println("  timer.scheduled, timer.fired, timer.canceled")
+	fmt.Println("  variable.updated")
+	fmt.Println("  deployment.created, deployment.deleted")
+	fmt.Println("")
+	fmt.Println("Examples:")
+	fmt.Println("  atomd events watch --process-key order --type instance,incident")
+	fmt.Println("  atomd events watch --instance srv1-aBcD1234 --json")
+	fmt.Println("  atomd events watch --from 1200")
+}
+
+// showAuthHelp displays API key management help information
+// Показывает справочную информацию по управлению API ключами
+func showAuthHelp() {
+	fmt.Println("API key management commands:")
+	fmt.Println("")
+	fmt.Println("Usage:")
+	fmt.Println("  atomd auth key create <name> [options]                  - Create API key, secret is shown only once")
+	fmt.Println("  atomd auth key list [--all]                             - List active keys, --all includes revoked and expired")
+	fmt.Println("  atomd auth key show <key_id>                            - Show API key details")
+	fmt.Println("  atomd auth key revoke <key_id>                          - Revoke API key immediately")
+	fmt.Println("  atomd auth key rotate <key_id> [--overlap <duration>]   - Issue successor, old key valid for overlap")
+	fmt.Println("  atomd auth help                                         - Show this help")
+	fmt.Println("")
+	fmt.Println("Create options:")
+	fmt.Println("  --permissions <p1,p2>       Permissions, e.g. read,write or * (required)")
+	fmt.Println("  --hosts <h1,h2>             Allowed client IPs or CIDRs (default: auth.allowed_hosts)")
+	fmt.Println("  --tenants <t1,t2>           Accessible tenants, <default> for default tenant (default: all)")
+	fmt.Println("  --expires-in <duration>     Lifetime, e.g. 720h")
+	fmt.Println("  --expires-at <RFC3339>      Absolute expiry time")
+	fmt.Println("")
+	fmt.Println("Notes:")
+	fmt.Println("  Keys are stored as salted hashes, lost secrets cannot be recovered, rotate instead.")
+	fmt.Println("  Keys from config.yaml are listed with source config and cannot be revoked or rotated.")
+	fmt.Println("  Rotation overlap defaults to auth.rotation_overlap (24h), use 0s to expire old key at once.")
+	fmt.Println("")
+	fmt.Println("Examples:")
+	fmt.Println("  atomd auth key create ci-worker --permissions read,write --expires-in 2160h")
+	fmt.Println("  atomd auth key create monitoring --permissions read --hosts 10.0.0.0/8")
+	fmt.Println("  atomd auth key create acme-worker --permissions read,write --tenants acme")
+	fmt.Println("  atomd auth key rotate 3f9a1c0e5b7d2468 --overlap 1h")
 }
@@ -10,6 +10,7 @@ package cli
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"os"
 	"strconv"
@@ -31,6 +32,7 @@ func (d *DaemonCommand) IncidentList() error {
 	// Default filter values and pagination
 	var statusFilter []incidentspb.IncidentStatus
 	var typeFilter []incidentspb.IncidentType
+	var tenantFilter string
 	var pageSize, page int32 = 20, 1 // Default values
 
 	// Parse arguments: handle flags and positional arguments
@@ -51,6 +53,11 @@ func (d *DaemonCommand) IncidentList() error {
 					continue
 				}
 			}
+		} else if arg == "--tenant" {
+			if i+1 < len(args) {
+				tenantFilter = args[i+1]
+				i++
+			}
 		} else if !strings.HasPrefix(arg, "--") && !strings.HasPrefix(arg, "-") {
 			// Handle positional arguments for status and type filters
 			if len(statusFilter) == 0 && arg != "" && arg != "all" {
@@ -70,6 +77,7 @@ func (d *DaemonCommand) IncidentList() error {
 	logger.Debug("Incident list request",
 		logger.Int("status_filters", len(statusFilter)),
 		logger.Int("type_filters", len(typeFilter)),
+		logger.String("tenant_filter", tenantFilter),
 		logger.Int("page_size", int(pageSize)),
 		logger.Int("page", int(page)))
 
@@ -93,6 +101,7 @@ func (d *DaemonCommand) IncidentList() error {
 			Page:      page,
 			SortBy:    "created_at",
 			SortOrder: "DESC",
+			TenantId:  tenantFilter,
 		},
 	}
 
@@ -244,6 +253,10 @@ func (d *DaemonCommand) IncidentShow() error {
 		fmt.Printf("Element:      %s\n", incident.ElementId)
 	}
 
+	if incident.TokenId != "" {
+		fmt.Printf("Token:        %s\n", incident.TokenId)
+	}
+
 	if incident.JobKey != "" {
 		fmt.Printf("Job Key:      %s\n", incident.JobKey)
 	}
@@ -310,7 +323,7 @@ func (d *DaemonCommand) IncidentResolve() error {
 	logger.Debug("Resolving incident")
 
 	if len(os.Args) < 5 {
-		return fmt.Errorf("usage: atomd incident resolve <incident_id> <retry|dismiss> [retries] [comment]")
+		return fmt.Errorf("usage: atomd incident resolve <incident_id> <retry|dismiss> [retries] [comment] [--variables <json>]")
 	}
 
 	incidentID := os.Args[3]
@@ -319,30 +332,53 @@ func (d *DaemonCommand) IncidentResolve() error {
 	var action incidentspb.ResolveAction
 	var newRetries int32 = 0
 	var comment string = ""
+	var variables string
+
+	// Extract variable patch flag, remaining arguments are positional
+	// Извлекаем флаг патча переменных, остальные аргументы позиционные
+	var args []string
+	for i := 5; i < len(os.Args); i++ {
+		if os.Args[i] == "--variables" && i+1 < len(os.Args) {
+			variables = os.Args[i+1]
+			i++
+			continue
+		}
+		args = append(args, os.Args[i])
+	}
+
+	if variables != "" {
+		var patch map[string]interface{}
+		if err := json.Unmarshal([]byte(variables), &patch); err != nil {
+			return fmt.Errorf("invalid variables JSON: %w", err)
+		}
+	}
 
 	// Parse action
 	switch actionStr {
 	case "retry":
 		action = incidentspb.ResolveAction_RESOLVE_ACTION_RETRY
 		// Parse retries if provided
-		if len(os.Args) > 5 {
-			if retries, err := strconv.Atoi(os.Args[5]); err == nil && retries >= 0 {
+		if len(args) > 0 {
+			if retries, err := strconv.Atoi(args[0]); err == nil && retries >= 0 {
 				newRetries = int32(retries)
 			} else {
-				return fmt.Errorf("invalid retries value: %s", os.Args[5])
+				return fmt.Errorf("invalid retries value: %s", args[0])
 			}
 		} else {
 			newRetries = 3 // Default retries
 		}
 		// Parse comment if provided
-		if len(os.Args) > 6 {
-			comment = strings.Join(os.Args[6:], " ")
+		if len(args) > 1 {
+			comment = strings.Join(args[1:], " ")
 		}
 	case "dismiss":
 		action = incidentspb.ResolveAction_RESOLVE_ACTION_DISMISS
+		if variables != "" {
+			return fmt.Errorf("--variables is only supported for retry")
+		}
 		// Parse comment if provided
-		if len(os.Args) > 5 {
-			comment = strings.Join(os.Args[5:], " ")
+		if len(args) > 0 {
+			comment = strings.Join(args, " ")
 		}
 	default:
 		return fmt.Errorf("invalid action: %s. Use 'retry' or 'dismiss'", actionStr)
@@ -374,6 +410,7 @@ func (d *DaemonCommand) IncidentResolve() error {
 		Comment:    comment,
 		ResolvedBy: "cli-user",
 		NewRetries: newRetries,
+		Variables:  variables,
 	})
 	if err != nil {
 		logger.Error("Failed to resolve incident", logger.String("error", err.Error()))
@@ -394,6 +431,9 @@ func (d *DaemonCommand) IncidentResolve() error {
 	if comment != "" {
 		fmt.Printf("Comment: %s\n", comment)
 	}
+	if variables != "" {
+		fmt.Printf("Variables: %s\n", variables)
+	}
 
 	return nil
 }
@@ -0,0 +1,284 @@
+/*
+This file is part of the AtomBPMN (R) project.
+Copyright (c) 2025 Matreska Market LLC (ООО «Matreska Market»).
+Authors: Matreska Team.
+
+This project is dual-licensed under AGPL-3.0 and AtomBPMN Commercial License.
+*/
+
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"atom-engine/proto/incidents/incidentspb"
+	"atom-engine/src/core/logger"
+	"atom-engine/src/incidents"
+)
+
+// IncidentRule dispatches incident retry rule sub-commands
+// Обрабатывает под-команды правил повтора инцидентов
+func (d *DaemonCommand) IncidentRule() error {
+	if len(os.Args) < 4 {
+		showIncidentHelp()
+		return nil
+	}
+
+	switch os.Args[3] {
+	case "list":
+		return d.IncidentRuleList()
+	case "put":
+		return d.IncidentRulePut()
+	case "delete":
+		return d.IncidentRuleDelete()
+	case "preview":
+		return d.IncidentRulePreview()
+	default:
+		logger.Error("Unknown incident rule command", logger.String("subcommand", os.Args[3]))
+		return fmt.Errorf("unknown incident rule command: %s", os.Args[3])
+	}
+}
+
+// IncidentRuleList lists automatic retry rules via gRPC
+// Выводит список правил автоматического повтора через gRPC
+func (d *DaemonCommand) IncidentRuleList() error {
+	conn, err := d.grpcClient.Connect()
+	if err != nil {
+		logger.Error("Failed to connect for incident rule list", logger.String("error", err.Error()))
+		return fmt.Errorf("daemon is not running: %w", err)
+	}
+	defer conn.Close()
+
+	client := incidentspb.NewIncidentsServiceClient(conn)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	resp, err := client.ListRetryRules(ctx, &incidentspb.ListRetryRulesRequest{})
+	if err != nil {
+		logger.Error("Failed to list retry rules via gRPC", logger.String("error", err.Error()))
+		return fmt.Errorf("failed to list retry rules: %w", err)
+	}
+
+	if len(resp.Rules) == 0 {
+		fmt.Println("No incident retry rules defined")
+		return nil
+	}
+
+	fmt.Printf("%-20s %-8s %-10s %-10s %s\n", "NAME", "SOURCE", "ATTEMPTS", "INTERVAL", "MATCH")
+	fmt.Println(strings.Repeat("-", 80))
+	for _, rule := range resp.Rules {
+		fmt.Printf("%-20s %-8s %-10d %-10s %s\n",
+			rule.Name, rule.Source, rule.MaxAttempts, rule.Interval, formatRetryRuleMatch(rule))
+	}
+	fmt.Printf("\nTotal: %d\n", len(resp.Rules))
+
+	return nil
+}
+
+// IncidentRulePut creates or replaces retry rule from JSON file via gRPC
+// Создает или заменяет правило повтора из JSON файла через gRPC
+func (d *DaemonCommand) IncidentRulePut() error {
+	if len(os.Args) < 5 {
+		logger.Error("Invalid incident rule put arguments", logger.Int("args_count", len(os.Args)))
+		return fmt.Errorf("usage: atomd incident rule put <file.json>")
+	}
+
+	rule, err := readRetryRuleFile(os.Args[4])
+	if err != nil {
+		return err
+	}
+
+	conn, err := d.grpcClient.Connect()
+	if err != nil {
+		logger.Error("Failed to connect for incident rule put", logger.String("error", err.Error()))
+		return fmt.Errorf("daemon is not running: %w", err)
+	}
+	defer conn.Close()
+
+	client := incidentspb.NewIncidentsServiceClient(conn)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	resp, err := client.PutRetryRule(ctx, &incidentspb.PutRetryRuleRequest{Rule: rule})
+	if err != nil {
+		logger.Error("Failed to put retry rule via gRPC",
+			logger.String("rule", rule.Name),
+			logger.String("error", err.Error()))
+		return fmt.Errorf("failed to put retry rule: %w", err)
+	}
+
+	if !resp.Success {
+		fmt.Printf("Failed to save retry rule: %s\n", resp.Message)
+		return nil
+	}
+
+	fmt.Println(resp.Message)
+	if resp.ScheduledIncidents > 0 {
+		fmt.Printf("Open incidents scheduled for retry: %d\n", resp.ScheduledIncidents)
+	}
+	return nil
+}
+
+// IncidentRuleDelete deletes retry rule via gRPC
+// Удаляет правило повтора через gRPC
+func (d *DaemonCommand) IncidentRuleDelete() error {
+	if len(os.Args) < 5 {
+		logger.Error("Invalid incident rule delete arguments", logger.Int("args_count", len(os.Args)))
+		return fmt.Errorf("usage: atomd incident rule delete <name>")
+	}
+
+	name := os.Args[4]
+
+	conn, err := d.grpcClient.Connect()
+	if err != nil {
+		logger.Error("Failed to connect for incident rule delete", logger.String("error", err.Error()))
+		return fmt.Errorf("daemon is not running: %w", err)
+	}
+	defer conn.Close()
+
+	client := incidentspb.NewIncidentsServiceClient(conn)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	resp, err := client.DeleteRetryRule(ctx, &incidentspb.DeleteRetryRuleRequest{Name: name})
+	if err != nil {
+		logger.Error("Failed to delete retry rule via gRPC",
+			logger.String("rule", name),
+			logger.String("error", err.Error()))
+		return fmt.Errorf("failed to delete retry rule: %w", err)
+	}
+
+	if !resp.Success {
+		fmt.Printf("Failed to delete retry rule: %s\n", resp.Message)
+		return nil
+	}
+
+	fmt.Println(resp.Message)
+	return nil
+}
+
+// IncidentRulePreview shows what retry rules would do with open incidents via gRPC,
+// with rule file only incidents the candidate rule would pick up
+// Показывает действие правил повтора для открытых инцидентов через gRPC
+func (d *DaemonCommand) IncidentRulePreview() error {
+	request := &incidentspb.PreviewRetryRulesRequest{}
+	if len(os.Args) >= 5 {
+		rule, err := readRetryRuleFile(os.Args[4])
+		if err != nil {
+			return err
+		}
+		request.Rule = rule
+	}
+
+	conn, err := d.grpcClient.Connect()
+	if err != nil {
+		logger.Error("Failed to connect for incident rule preview", logger.String("error", err.Error()))
+		return fmt.Errorf("daemon is not running: %w", err)
+	}
+	defer conn.Close()
+
+	client := incidentspb.NewIncidentsServiceClient(conn)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	resp, err := client.PreviewRetryRules(ctx, request)
+	if err != nil {
+		logger.Error("Failed to preview retry rules via gRPC", logger.String("error", err.Error()))
+		return fmt.Errorf("failed to preview retry rules: %w", err)
+	}
+
+	if len(resp.Previews) == 0 {
+		fmt.Println("No open incidents matched by retry rules")
+		return nil
+	}
+
+	fmt.Printf("%-24s %-17s %-16s %-10s %-9s %s\n", "INCIDENT", "TYPE", "RULE", "ACTION", "ATTEMPTS", "NEXT ATTEMPT")
+	fmt.Println(strings.Repeat("-", 105))
+	for _, preview := range resp.Previews {
+		nextAttempt := "-"
+		if preview.NextAttemptAt != nil {
+			nextAttempt = preview.NextAttemptAt.AsTime().Format("2006-01-02 15:04:05")
+		}
+		fmt.Printf("%-24s %-17s %-16s %-10s %-9s %s\n",
+			preview.IncidentId,
+			formatIncidentType(preview.Type),
+			truncateString(preview.Rule, 16),
+			preview.Action,
+			fmt.Sprintf("%d/%d", preview.Attempts, preview.MaxAttempts),
+			nextAttempt)
+	}
+	fmt.Printf("\nTotal: %d\n", len(resp.Previews))
+
+	return nil
+}
+
+// readRetryRuleFile reads retry rule JSON file into protobuf rule
+// Читает JSON файл правила повтора в protobuf правило
+func readRetryRuleFile(filePath string) (*incidentspb.RetryRule, error) {
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read retry rule file: %w", err)
+	}
+
+	var rule incidents.RetryRule
+	if err := json.Unmarshal(data, &rule); err != nil {
+		return nil, fmt.Errorf("invalid retry rule file: %w", err)
+	}
+
+	pb := &incidentspb.RetryRule{
+		Name:         rule.Name,
+		JobTypes:     rule.JobTypes,
+		ProcessKeys:  rule.ProcessKeys,
+		ElementIds:   rule.ElementIDs,
+		ErrorCodes:   rule.ErrorCodes,
+		ErrorPattern: rule.ErrorPattern,
+		MaxAttempts:  int32(rule.MaxAttempts),
+		Interval:     rule.Interval,
+		JobRetries:   int32(rule.JobRetries),
+	}
+	for _, incidentType := range rule.Types {
+		pb.Types = append(pb.Types, parseIncidentType(string(incidentType)))
+	}
+	return pb, nil
+}
+
+// formatRetryRuleMatch formats rule conditions for list output
+// Форматирует условия правила для вывода списка
+func formatRetryRuleMatch(rule *incidentspb.RetryRule) string {
+	var conditions []string
+	if len(rule.Types) > 0 {
+		types := make([]string, 0, len(rule.Types))
+		for _, incidentType := range rule.Types {
+			types = append(types, formatIncidentType(incidentType))
+		}
+		conditions = append(conditions, "type="+strings.Join(types, ","))
+	}
+	if len(rule.JobTypes) > 0 {
+		conditions = append(conditions, "job_type="+strings.Join(rule.JobTypes, ","))
+	}
+	if len(rule.ProcessKeys) > 0 {
+		conditions = append(conditions, "process="+strings.Join(rule.ProcessKeys, ","))
+	}
+	if len(rule.ElementIds) > 0 {
+		conditions = append(conditions, "element="+strings.Join(rule.ElementIds, ","))
+	}
+	if len(rule.ErrorCodes) > 0 {
+		conditions = append(conditions, "error_code="+strings.Join(rule.ErrorCodes, ","))
+	}
+	if rule.ErrorPattern != "" {
+		conditions = append(conditions, "error=/"+rule.ErrorPattern+"/")
+	}
+	if len(conditions) == 0 {
+		return "any"
+	}
+	return strings.Join(conditions, " ")
+}
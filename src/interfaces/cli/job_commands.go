@@ -25,7 +25,7 @@ func (d *DaemonCommand) JobList() error {
 	logger.Debug("Listing jobs")
 
 	// Parse arguments for filtering and pagination
-	var jobType, worker, processInstanceID, processKey, state string
+	var jobType, worker, processInstanceID, processKey, state, tenantID string
 	var pageSize, page int32 = 20, 1 // Default values
 
 	args := os.Args[3:] // Skip "atomd job list"
@@ -48,6 +48,11 @@ func (d *DaemonCommand) JobList() error {
 					continue
 				}
 			}
+		} else if arg == "--tenant" {
+			if i+1 < len(args) {
+				tenantID = args[i+1]
+				i++
+			}
 		} else if !strings.HasPrefix(arg, "--") && !strings.HasPrefix(arg, "-") {
 			// Positional arguments
 			if jobType == "" {
@@ -70,6 +75,7 @@ func (d *DaemonCommand) JobList() error {
 		logger.String("process_instance_id", processInstanceID),
 		logger.String("process_key", processKey),
 		logger.String("state", state),
+		logger.String("tenant_id", tenantID),
 		logger.Int("page_size", int(pageSize)),
 		logger.Int("page", int(page)))
 
@@ -97,6 +103,7 @@ func (d *DaemonCommand) JobList() error {
 		SortBy:            "created_at",
 		SortOrder:         "DESC",
 		IncludeVariables:  false,
+		TenantId:          tenantID,
 	})
 	if err != nil {
 		logger.Error("Failed to list jobs", logger.String("error", err.Error()))
@@ -258,3 +265,71 @@ func (d *DaemonCommand) JobStats() error {
 
 	return nil
 }
+
+// JobWorkers lists workers with their active job leases via gRPC
+// Список воркеров с активными арендами работ через gRPC
+func (d *DaemonCommand) JobWorkers() error {
+	var jobType string
+	if len(os.Args) > 3 {
+		jobType = os.Args[3]
+	}
+
+	logger.Debug("Listing job workers", logger.String("type", jobType))
+
+	conn, err := d.grpcClient.Connect()
+	if err != nil {
+		logger.Error("Failed to connect to daemon for job workers",
+			logger.String("error", err.Error()))
+		return fmt.Errorf("daemon is not running. Start daemon first with 'atomd start': %w", err)
+	}
+	defer conn.Close()
+
+	client := jobspb.NewJobsServiceClient(conn)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	resp, err := client.ListWorkers(ctx, &jobspb.ListWorkersRequest{Type: jobType})
+	if err != nil {
+		logger.Error("Failed to list workers", logger.String("error", err.Error()))
+		return fmt.Errorf("failed to list workers: %w", err)
+	}
+
+	if !resp.Success {
+		fmt.Printf("Error: %s\n", resp.ErrorMessage)
+		return nil
+	}
+
+	fmt.Printf("Job Workers\n")
+	fmt.Printf("===========\n")
+
+	if len(resp.Workers) == 0 {
+		fmt.Printf("No workers found\n")
+		return nil
+	}
+
+	for _, worker := range resp.Workers {
+		status := "registered"
+		if !worker.Registered {
+			status = "not connected"
+		}
+
+		fmt.Printf("\nWorker: %s (%s)\n", worker.Worker, status)
+		if worker.Type != "" {
+			fmt.Printf("  Type: %s\n", worker.Type)
+		}
+		if worker.LastSeen > 0 {
+			fmt.Printf("  Last Seen: %s\n", time.Unix(worker.LastSeen, 0).Format("2006-01-02 15:04:05"))
+		}
+		fmt.Printf("  Active Leases: %d\n", len(worker.Leases))
+
+		for _, lease := range worker.Leases {
+			expires := "-"
+			if lease.LeaseExpiry > 0 {
+				expires = time.Unix(lease.LeaseExpiry, 0).Format("2006-01-02 15:04:05")
+			}
+			fmt.Printf("    %s  %-20s expires %s\n", lease.JobKey, lease.Type, expires)
+		}
+	}
+
+	return nil
+}
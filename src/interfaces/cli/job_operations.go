@@ -26,7 +26,8 @@ func (d *DaemonCommand) JobActivate() error {
 
 	if len(os.Args) < 5 {
 		logger.Error("Invalid job activate arguments", logger.Int("args_count", len(os.Args)))
-		return fmt.Errorf("usage: atomd job activate <type> <worker> [-j max_jobs] [-t timeout_ms]")
+		return fmt.Errorf("usage: atomd job activate <type> <worker> [-j max_jobs] [-t timeout_ms] [-v var1,var2] " +
+			"[--tenants t1,t2]")
 	}
 
 	jobType := os.Args[3]
@@ -35,6 +36,8 @@ func (d *DaemonCommand) JobActivate() error {
 	// Default values
 	var maxJobs int32 = 1
 	var timeout int64 = 30000
+	var fetchVariables []string
+	var tenantIDs string
 
 	// Parse flags and remaining positional arguments (for backward compatibility)
 	args := os.Args[5:] // Skip "atomd job activate type worker"
@@ -59,12 +62,25 @@ func (d *DaemonCommand) JobActivate() error {
 				return fmt.Errorf("invalid value for -t flag: %s", args[i+1])
 			}
 			i++ // Skip the value
+		} else if arg == "-v" && i+1 < len(args) {
+			// Parse comma separated variables to fetch
+			for _, name := range strings.Split(args[i+1], ",") {
+				if name = strings.TrimSpace(name); name != "" {
+					fetchVariables = append(fetchVariables, name)
+				}
+			}
+			i++ // Skip the value
+		} else if arg == "--tenants" && i+1 < len(args) {
+			// Comma separated tenants to activate jobs from
+			tenantIDs = args[i+1]
+			i++ // Skip the value
 		} else if !strings.HasPrefix(arg, "-") {
 			// Unknown positional argument
-			return fmt.Errorf("unknown argument: %s. Use -j for max_jobs or -t for timeout", arg)
+			return fmt.Errorf("unknown argument: %s. Use -j for max_jobs, -t for timeout or -v for variables", arg)
 		} else {
 			// Unknown flag
-			return fmt.Errorf("unknown flag: %s. Supported flags: -j (max_jobs), -t (timeout)", arg)
+			return fmt.Errorf("unknown flag: %s. Supported flags: -j (max_jobs), -t (timeout), "+
+				"-v (fetch variables), --tenants (tenant list)", arg)
 		}
 	}
 
@@ -86,6 +102,8 @@ func (d *DaemonCommand) JobActivate() error {
 		Worker:            worker,
 		MaxJobsToActivate: maxJobs,
 		Timeout:           int32(timeout),
+		FetchVariable:     fetchVariables,
+		TenantIds:         tenantIDs,
 	})
 	if err != nil {
 		logger.Error("Failed to activate jobs", logger.String("error", err.Error()))
@@ -194,6 +212,19 @@ func (d *DaemonCommand) JobFail() error {
 		errorMessage = os.Args[5]
 	}
 
+	// Optional retry backoff as duration ("30s") or milliseconds
+	var retryBackoff time.Duration
+	if len(os.Args) > 6 {
+		backoffStr := os.Args[6]
+		if parsed, err := time.ParseDuration(backoffStr); err == nil {
+			retryBackoff = parsed
+		} else if ms, err := strconv.ParseInt(backoffStr, 10, 64); err == nil {
+			retryBackoff = time.Duration(ms) * time.Millisecond
+		} else {
+			return fmt.Errorf("invalid backoff value: %s", backoffStr)
+		}
+	}
+
 	conn, err := d.grpcClient.Connect()
 	if err != nil {
 		logger.Error("Failed to connect to daemon for job fail",
@@ -210,6 +241,7 @@ func (d *DaemonCommand) JobFail() error {
 		JobKey:       jobKey,
 		Retries:      int32(retries),
 		ErrorMessage: errorMessage,
+		RetryBackoff: retryBackoff.Milliseconds(),
 	})
 	if err != nil {
 		logger.Error("Failed to fail job", logger.String("error", err.Error()))
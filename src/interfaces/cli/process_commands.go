@@ -27,13 +27,14 @@ func (d *DaemonCommand) ProcessStart() error {
 
 	if len(os.Args) < 4 {
 		logger.Error("Invalid process start arguments", logger.Int("args_count", len(os.Args)))
-		return fmt.Errorf("usage: atomd process start <process_key> [-v version] [-d variables]")
+		return fmt.Errorf("usage: atomd process start <process_key> [-v version] [-d variables] [--tenant tenant_id]")
 	}
 
 	// Parse arguments and flags
 	var processKey string
 	var version string
 	var variables string
+	var tenantID string
 
 	args := os.Args[3:] // Skip "atomd process start"
 	for i, arg := range args {
@@ -45,6 +46,10 @@ func (d *DaemonCommand) ProcessStart() error {
 			if i+1 < len(args) {
 				variables = args[i+1]
 			}
+		} else if arg == "--tenant" {
+			if i+1 < len(args) {
+				tenantID = args[i+1]
+			}
 		} else if processKey == "" && !strings.HasPrefix(arg, "-") {
 			processKey = arg
 		}
@@ -52,12 +57,13 @@ func (d *DaemonCommand) ProcessStart() error {
 
 	if processKey == "" {
 		logger.Error("Process key not provided")
-		return fmt.Errorf("usage: atomd process start <process_key> [-v version] [-d variables]")
+		return fmt.Errorf("usage: atomd process start <process_key> [-v version] [-d variables] [--tenant tenant_id]")
 	}
 
 	logger.Debug("Process start request",
 		logger.String("process_key", processKey),
 		logger.String("version", version),
+		logger.String("tenant_id", tenantID),
 		logger.String("variables", variables))
 
 	conn, err := d.grpcClient.Connect()
@@ -119,6 +125,7 @@ func (d *DaemonCommand) ProcessStart() error {
 	response, err := client.StartProcessInstance(ctx, &processpb.StartProcessInstanceRequest{
 		ProcessId: finalProcessKey,
 		Variables: variablesMap,
+		TenantId:  tenantID,
 	})
 	if err != nil {
 		logger.Error("Failed to start process instance via gRPC",
@@ -272,6 +279,7 @@ func (d *DaemonCommand) ProcessList() error {
 	// Parse arguments for filtering and pagination
 	var statusFilter string
 	var processKeyFilter string
+	var tenantFilter string
 	var pageSize, page int32 = 20, 1 // Default values
 
 	args := os.Args[3:] // Skip "atomd process list"
@@ -294,6 +302,11 @@ func (d *DaemonCommand) ProcessList() error {
 					continue
 				}
 			}
+		} else if arg == "--tenant" {
+			if i+1 < len(args) {
+				tenantFilter = args[i+1]
+				i++
+			}
 		} else if !strings.HasPrefix(arg, "--") && !strings.HasPrefix(arg, "-") {
 			// Positional arguments
 			if statusFilter == "" {
@@ -307,6 +320,7 @@ func (d *DaemonCommand) ProcessList() error {
 	logger.Debug("Process list request",
 		logger.String("status_filter", statusFilter),
 		logger.String("process_key_filter", processKeyFilter),
+		logger.String("tenant_filter", tenantFilter),
 		logger.Int("page_size", int(pageSize)),
 		logger.Int("page", int(page)))
 
@@ -331,6 +345,7 @@ func (d *DaemonCommand) ProcessList() error {
 		Page:             page,
 		SortBy:           "started_at",
 		SortOrder:        "DESC",
+		TenantId:         tenantFilter,
 	})
 	if err != nil {
 		logger.Error("Failed to list process instances via gRPC", logger.String("error", err.Error()))
@@ -9,14 +9,24 @@ This project is dual-licensed under AGPL-3.0 and AtomBPMN Commercial License.
 package cli
 
 import (
+	"bufio"
 	"context"
+	"errors"
 	"fmt"
+	"io"
+	"os"
+	"strconv"
 	"time"
 
 	"atom-engine/proto/storage/storagepb"
+	"atom-engine/src/core/config"
 	"atom-engine/src/core/logger"
+	"atom-engine/src/storage"
 )
 
+// restoreChunkSize is size of backup data sent in one restore message
+const restoreChunkSize = 256 * 1024
+
 // StorageStatus shows storage status via gRPC
 // Показывает статус storage через gRPC
 func (d *DaemonCommand) StorageStatus() error {
@@ -109,3 +119,299 @@ func (d *DaemonCommand) StorageInfo() error {
 
 	return nil
 }
+
+// StorageBackup streams online backup from daemon into file via gRPC
+// Сохраняет резервную копию работающего демона в файл через gRPC
+func (d *DaemonCommand) StorageBackup() error {
+	if len(os.Args) < 4 {
+		return fmt.Errorf("usage: atomd storage backup <file> [--since <version>]")
+	}
+	path := os.Args[3]
+
+	var since uint64
+	args := os.Args[4:]
+	for i := 0; i < len(args); i++ {
+		switch args[i] {
+		case "--since":
+			if i+1 >= len(args) {
+				return fmt.Errorf("flag --since requires value")
+			}
+			value, err := strconv.ParseUint(args[i+1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid --since value: %s", args[i+1])
+			}
+			since = value
+			i++
+		default:
+			return fmt.Errorf("unknown flag: %s", args[i])
+		}
+	}
+
+	if _, err := os.Stat(path); err == nil {
+		return fmt.Errorf("file %s already exists", path)
+	}
+
+	conn, err := d.grpcClient.Connect()
+	if err != nil {
+		logger.Error("Failed to connect to daemon for storage backup",
+			logger.String("error", err.Error()))
+		return fmt.Errorf("daemon is not running. Start daemon first with 'atomd start': %w", err)
+	}
+	defer conn.Close()
+
+	client := storagepb.NewStorageServiceClient(conn)
+
+	stream, err := client.BackupStorage(context.Background(), &storagepb.BackupStorageRequest{Since: since})
+	if err != nil {
+		logger.Error("Failed to start storage backup", logger.String("error", err.Error()))
+		return fmt.Errorf("failed to start storage backup: %w", err)
+	}
+
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0600)
+	if err != nil {
+		return fmt.Errorf("failed to create backup file: %w", err)
+	}
+
+	var size int64
+	err = func() error {
+		for {
+			chunk, err := stream.Recv()
+			if errors.Is(err, io.EOF) {
+				return file.Sync()
+			}
+			if err != nil {
+				return err
+			}
+			if _, err := file.Write(chunk.Data); err != nil {
+				return err
+			}
+			size += int64(len(chunk.Data))
+		}
+	}()
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		os.Remove(path)
+		logger.Error("Storage backup failed", logger.String("error", err.Error()))
+		return fmt.Errorf("storage backup failed: %w", err)
+	}
+
+	metadata, err := readBackupFileMetadata(path)
+	if err != nil {
+		return err
+	}
+
+	fmt.Println("Storage backup created:")
+	fmt.Printf("File:             %s\n", path)
+	fmt.Printf("Size:             %s\n", formatBytes(size))
+	printBackupMetadata(metadata)
+	fmt.Printf("\nNext incremental: atomd storage backup <file> --since %d\n", metadata.Version)
+	return nil
+}
+
+// StorageRestore restores backup file into data directory. Without --remote
+// the daemon must be stopped and directory defaults to configured database
+// path, with --remote daemon restores into --dir on its host.
+// Восстанавливает резервную копию в каталог данных
+func (d *DaemonCommand) StorageRestore() error {
+	if len(os.Args) < 4 {
+		return fmt.Errorf("usage: atomd storage restore <file> [--dir <path>] [--remote]")
+	}
+	path := os.Args[3]
+
+	directory := ""
+	remote := false
+	args := os.Args[4:]
+	for i := 0; i < len(args); i++ {
+		switch args[i] {
+		case "--remote":
+			remote = true
+		case "--dir":
+			if i+1 >= len(args) {
+				return fmt.Errorf("flag --dir requires value")
+			}
+			directory = args[i+1]
+			i++
+		default:
+			return fmt.Errorf("unknown flag: %s", args[i])
+		}
+	}
+
+	if remote {
+		return d.storageRestoreRemote(path, directory)
+	}
+
+	if directory == "" {
+		cfg, err := config.LoadConfigWithEnv()
+		if err != nil {
+			return fmt.Errorf("failed to load config, set directory with --dir: %w", err)
+		}
+		directory = cfg.Database.Path
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("failed to open backup file: %w", err)
+	}
+	defer file.Close()
+
+	metadata, err := storage.RestoreBackup(file, directory)
+	if err != nil {
+		logger.Error("Storage restore failed", logger.String("error", err.Error()))
+		return fmt.Errorf("storage restore failed: %w", err)
+	}
+
+	fmt.Println("Storage backup restored:")
+	fmt.Printf("Directory:        %s\n", directory)
+	printBackupMetadata(metadata)
+	return nil
+}
+
+// storageRestoreRemote uploads backup file to daemon via gRPC
+func (d *DaemonCommand) storageRestoreRemote(path, directory string) error {
+	if directory == "" {
+		return fmt.Errorf("--remote requires --dir with target directory on daemon host")
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("failed to open backup file: %w", err)
+	}
+	defer file.Close()
+
+	conn, err := d.grpcClient.Connect()
+	if err != nil {
+		logger.Error("Failed to connect to daemon for storage restore",
+			logger.String("error", err.Error()))
+		return fmt.Errorf("daemon is not running. Start daemon first with 'atomd start': %w", err)
+	}
+	defer conn.Close()
+
+	client := storagepb.NewStorageServiceClient(conn)
+
+	stream, err := client.RestoreStorage(context.Background())
+	if err != nil {
+		return fmt.Errorf("failed to start storage restore: %w", err)
+	}
+
+	request := &storagepb.RestoreStorageRequest{Directory: directory}
+	for {
+		// Sent message may be read after Send returns, so every chunk gets own buffer
+		buffer := make([]byte, restoreChunkSize)
+		n, readErr := io.ReadFull(file, buffer)
+		if n > 0 {
+			request.Data = buffer[:n]
+			if err := stream.Send(request); err != nil {
+				// Server error is reported by CloseAndRecv
+				break
+			}
+			request = &storagepb.RestoreStorageRequest{}
+		}
+		if readErr == io.EOF || readErr == io.ErrUnexpectedEOF {
+			break
+		}
+		if readErr != nil {
+			return fmt.Errorf("failed to read backup file: %w", readErr)
+		}
+	}
+
+	response, err := stream.CloseAndRecv()
+	if err != nil {
+		logger.Error("Storage restore failed", logger.String("error", err.Error()))
+		return fmt.Errorf("storage restore failed: %w", err)
+	}
+
+	fmt.Println("Storage backup restored:")
+	fmt.Printf("Directory:        %s\n", response.Directory)
+	printBackupMetadata(&storage.BackupMetadata{
+		EngineVersion: response.EngineVersion,
+		SchemaVersion: int(response.SchemaVersion),
+		CreatedAt:     response.CreatedAt.AsTime(),
+		Since:         response.Since,
+		Version:       response.Version,
+	})
+	return nil
+}
+
+// StorageMigrate migrates database directory to current schema version with
+// daemon stopped, directory defaults to configured database path
+// Мигрирует каталог базы данных на текущую версию схемы
+func (d *DaemonCommand) StorageMigrate() error {
+	directory := ""
+	dryRun := false
+	args := os.Args[3:]
+	for i := 0; i < len(args); i++ {
+		switch args[i] {
+		case "--dry-run":
+			dryRun = true
+		case "--dir":
+			if i+1 >= len(args) {
+				return fmt.Errorf("flag --dir requires value")
+			}
+			directory = args[i+1]
+			i++
+		default:
+			return fmt.Errorf("unknown flag: %s", args[i])
+		}
+	}
+
+	if directory == "" {
+		cfg, err := config.LoadConfigWithEnv()
+		if err != nil {
+			return fmt.Errorf("failed to load config, set directory with --dir: %w", err)
+		}
+		directory = cfg.Database.Path
+	}
+
+	report, err := storage.MigrateDirectory(directory, dryRun)
+	if err != nil {
+		logger.Error("Storage migration failed", logger.String("error", err.Error()))
+		return fmt.Errorf("storage migration failed: %w", err)
+	}
+
+	title := "Storage migration:"
+	if dryRun {
+		title = "Storage migration (dry run):"
+	}
+	fmt.Println(title)
+	fmt.Printf("Directory:        %s\n", directory)
+	fmt.Printf("Schema Version:   %d -> %d\n", report.FromVersion, report.ToVersion)
+	if len(report.Migrations) == 0 {
+		fmt.Println("\nSchema is up to date")
+		return nil
+	}
+
+	fmt.Println("\nMigrations:")
+	for _, migration := range report.Migrations {
+		fmt.Printf("  %d. %s (%d records)\n", migration.Version, migration.Description, migration.Records)
+	}
+	if dryRun {
+		fmt.Println("\nNothing was written, run without --dry-run to apply")
+	}
+	return nil
+}
+
+// readBackupFileMetadata reads metadata header of backup file
+func readBackupFileMetadata(path string) (*storage.BackupMetadata, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open backup file: %w", err)
+	}
+	defer file.Close()
+
+	return storage.ReadBackupMetadata(bufio.NewReader(file))
+}
+
+// printBackupMetadata prints backup metadata
+func printBackupMetadata(metadata *storage.BackupMetadata) {
+	kind := "full"
+	if metadata.IsIncremental() {
+		kind = fmt.Sprintf("incremental since %d", metadata.Since)
+	}
+	fmt.Printf("Kind:             %s\n", kind)
+	fmt.Printf("Version:          %d\n", metadata.Version)
+	fmt.Printf("Engine Version:   %s\n", metadata.EngineVersion)
+	fmt.Printf("Schema Version:   %d\n", metadata.SchemaVersion)
+	fmt.Printf("Created At:       %s\n", metadata.CreatedAt.Format(time.RFC3339))
+}
@@ -17,6 +17,7 @@ import (
 
 	"atom-engine/proto/timewheel/timewheelpb"
 	"atom-engine/src/core/logger"
+	"atom-engine/src/timewheel"
 )
 
 // TimerAdd adds new timer via gRPC
@@ -39,9 +40,9 @@ func (d *DaemonCommand) TimerAdd() error {
 	// Determine if argument is duration or cycle
 	// Определяем является ли аргумент duration или cycle
 	var duration, cycle string
-	if strings.HasPrefix(strings.ToUpper(durationOrCycle), "R") {
-		// It's a repeating cycle like R3/PT10S or r3/pt10s
-		// Это повторяющийся цикл типа R3/PT10S или r3/pt10s
+	if strings.HasPrefix(strings.ToUpper(durationOrCycle), "R") || timewheel.IsCronExpression(durationOrCycle) {
+		// It's a repeating cycle like R3/PT10S, r3/pt10s or cron "0 0 9 * * MON-FRI"
+		// Это повторяющийся цикл типа R3/PT10S, r3/pt10s или cron "0 0 9 * * MON-FRI"
 		cycle = durationOrCycle
 		logger.Debug("Detected repeating cycle", logger.String("cycle", cycle))
 	} else {
@@ -243,6 +244,22 @@ func (d *DaemonCommand) TimerStats() error {
 		}
 	}
 
+	if len(resp.LevelStats) > 0 {
+		fmt.Println("Wheel levels:")
+		fmt.Printf("  %-5s %-10s %-6s %-8s %-8s %-14s %-14s\n",
+			"LEVEL", "TICK", "SLOTS", "TIMERS", "FIRED", "AVG LATE (ms)", "MAX LATE (ms)")
+		for _, level := range resp.LevelStats {
+			fmt.Printf("  %-5d %-10s %-6d %-8d %-8d %-14.2f %-14.2f\n",
+				level.Level,
+				(time.Duration(level.TickMs) * time.Millisecond).String(),
+				level.Size,
+				level.Timers,
+				level.FiredTimers,
+				level.AvgLatenessMs,
+				level.MaxLatenessMs)
+		}
+	}
+
 	return nil
 }
 
@@ -253,6 +270,7 @@ func (d *DaemonCommand) TimerList() error {
 
 	// Parse arguments for filtering and pagination
 	var statusFilter string
+	var tenantFilter string
 	var pageSize, page int32 = 20, 1 // Default values
 
 	args := os.Args[3:] // Skip "atomd timer list"
@@ -275,6 +293,11 @@ func (d *DaemonCommand) TimerList() error {
 					continue
 				}
 			}
+		} else if arg == "--tenant" {
+			if i+1 < len(args) {
+				tenantFilter = args[i+1]
+				i++
+			}
 		} else if !strings.HasPrefix(arg, "--") && !strings.HasPrefix(arg, "-") {
 			// Positional arguments
 			if statusFilter == "" {
@@ -285,6 +308,7 @@ func (d *DaemonCommand) TimerList() error {
 
 	logger.Debug("Timer list request",
 		logger.String("status_filter", statusFilter),
+		logger.String("tenant_filter", tenantFilter),
 		logger.Int("page_size", int(pageSize)),
 		logger.Int("page", int(page)))
 
@@ -304,6 +328,7 @@ func (d *DaemonCommand) TimerList() error {
 		Page:         page,
 		SortBy:       "created_at",
 		SortOrder:    "DESC",
+		TenantId:     tenantFilter,
 	}
 
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
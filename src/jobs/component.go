@@ -14,13 +14,18 @@ import (
 	"fmt"
 	"time"
 
+	"atom-engine/src/core/clock"
 	"atom-engine/src/core/config"
 	"atom-engine/src/core/logger"
 	"atom-engine/src/core/models"
+	"atom-engine/src/core/types"
 	"atom-engine/src/incidents"
 	"atom-engine/src/storage"
 )
 
+// defaultJobRetries is used when neither BPMN model nor caller sets retries
+const defaultJobRetries = 3
+
 // CoreInterface defines core methods needed by jobs component
 // Определяет методы core необходимые jobs компоненту
 type CoreInterface interface {
@@ -47,7 +52,20 @@ func NewComponent(cfg *config.Config, storage storage.Storage) *Component {
 		storage:         storage,
 		responseChannel: make(chan string, 100), // Buffered channel for job callbacks
 	}
-	comp.manager = NewJobManager(storage, logger.NewComponentLogger("job-manager"), comp)
+	retryPolicy, err := NewRetryBackoffPolicy(cfg.Jobs.RetryBackoff)
+	if err != nil {
+		comp.logger.Warn("Invalid retry backoff configuration - using fixed default",
+			logger.String("error", err.Error()))
+		retryPolicy = RetryBackoffPolicy{Strategy: RetryBackoffFixed, InitialDelay: types.DefaultRetryBackoff}
+	}
+
+	comp.manager = NewJobManager(
+		storage,
+		logger.NewComponentLogger("job-manager"),
+		comp,
+		NewJobScheduler(cfg.Jobs),
+		retryPolicy,
+	)
 	return comp
 }
 
@@ -76,6 +94,14 @@ func (c *Component) Start() error {
 		return fmt.Errorf("failed to start job manager: %w", err)
 	}
 
+	// Arm lease timers for running jobs activated without one
+	// Устанавливаем таймеры аренды для выполняемых job'ов без них
+	if c.core != nil {
+		if err := c.manager.RestoreLeases(context.Background()); err != nil {
+			c.logger.Warn("Failed to restore job leases", logger.String("error", err.Error()))
+		}
+	}
+
 	c.isRunning = true
 	c.logger.Info("Jobs component started successfully")
 
@@ -126,7 +152,7 @@ func (c *Component) CreateIncident(
 	switch incidentType {
 	case "JOB_FAILURE":
 		payload := incidents.CreateIncidentPayload{
-			Type:              "job_failure",
+			Type:              string(incidents.IncidentTypeJobFailure),
 			Message:           errorMessage,
 			ProcessInstanceID: processInstanceID,
 			ElementID:         elementID,
@@ -158,7 +184,7 @@ func (c *Component) CreateIncident(
 
 	case "BPMN_ERROR":
 		payload := incidents.CreateIncidentPayload{
-			Type:              "bpmn_error",
+			Type:              string(incidents.IncidentTypeBPMNError),
 			Message:           errorMessage,
 			ErrorCode:         jobType, // jobType passed as errorCode for BPMN_ERROR
 			ProcessInstanceID: processInstanceID,
@@ -211,11 +237,26 @@ func (c *Component) CreateJobWithDetails(
 	jobType, processInstanceID, elementID string,
 	customHeaders map[string]string,
 	variables map[string]interface{},
+) (string, error) {
+	return c.CreateJobWithPriority(
+		jobType, processInstanceID, "", elementID,
+		customHeaders, variables, defaultJobRetries, 0)
+}
+
+// CreateJobWithPriority creates job with retries and activation priority.
+// processKey identifies the process definition for fair scheduling.
+// Создает job с количеством попыток и приоритетом активации
+func (c *Component) CreateJobWithPriority(
+	jobType, processInstanceID, processKey, elementID string,
+	customHeaders map[string]string,
+	variables map[string]interface{},
+	retries, priority int,
 ) (string, error) {
 	c.logger.Info("Creating job",
 		logger.String("type", jobType),
 		logger.String("processInstanceId", processInstanceID),
-		logger.String("elementId", elementID))
+		logger.String("elementId", elementID),
+		logger.Int("priority", priority))
 
 	// Extract token ID from variables if available
 	var tokenID string
@@ -230,15 +271,17 @@ func (c *Component) CreateJobWithDetails(
 		ID:                models.GenerateID(),
 		Type:              jobType,
 		ProcessInstanceID: processInstanceID,
+		ProcessKey:        processKey,
 		ElementID:         elementID,
 		TokenID:           tokenID,
 		CustomHeaders:     customHeaders,
 		Variables:         variables,
 		Status:            models.JobStatusPending,
-		Retries:           3,
-		MaxRetries:        3,
-		CreatedAt:         time.Now(),
-		UpdatedAt:         time.Now(),
+		Retries:           retries,
+		MaxRetries:        retries,
+		Priority:          priority,
+		CreatedAt:         clock.Now(),
+		UpdatedAt:         clock.Now(),
 	}
 
 	if customHeaders == nil {
@@ -260,34 +303,7 @@ func (c *Component) CreateJobWithDetails(
 
 // ActivateJobs activates jobs for worker
 func (c *Component) ActivateJobs(workerName, jobType string, maxJobs int) ([]JobInfo, error) {
-	c.logger.Info("Activating jobs",
-		logger.String("worker", workerName),
-		logger.String("type", jobType),
-		logger.Int("maxJobs", maxJobs),
-	)
-
-	// Delegate to job manager
-	timeout := 30 * time.Second
-	jobs, err := c.manager.ActivateJobs(context.Background(), jobType, workerName, maxJobs, timeout)
-	if err != nil {
-		return nil, err
-	}
-
-	// Convert to JobInfo
-	jobInfos := make([]JobInfo, len(jobs))
-	for i, job := range jobs {
-		jobInfos[i] = JobInfo{
-			Key:               job.ID,
-			Type:              job.Type,
-			ProcessInstanceID: job.ProcessInstanceID,
-			Variables:         job.Variables,
-			Worker:            job.WorkerID,
-			Retries:           job.Retries,
-			CreatedAt:         job.CreatedAt.Unix(),
-		}
-	}
-
-	return jobInfos, nil
+	return c.ActivateJobsWithVariables(workerName, jobType, maxJobs, 30*time.Second, nil, nil)
 }
 
 // ActivateJobsWithTimeout activates jobs for worker with custom timeout
@@ -296,50 +312,109 @@ func (c *Component) ActivateJobsWithTimeout(
 	maxJobs int,
 	timeoutMs int32,
 ) ([]JobInfo, error) {
-	c.logger.Info("Activating jobs with timeout",
+	return c.ActivateJobsWithVariables(
+		workerName, jobType, maxJobs, time.Duration(timeoutMs)*time.Millisecond, nil, nil)
+}
+
+// ActivateJobsWithVariables activates jobs of tenantIDs (every tenant when nil) for worker
+// returning only fetchVariables (all variables when empty). Jobs whose variables still exceed
+// max payload size are failed without retries, which raises an incident, and are not returned.
+// Активирует job'ы арендаторов для воркера, возвращая только запрошенные переменные
+func (c *Component) ActivateJobsWithVariables(
+	workerName, jobType string,
+	maxJobs int,
+	timeout time.Duration,
+	fetchVariables []string,
+	tenantIDs []string,
+) ([]JobInfo, error) {
+	c.logger.Info("Activating jobs",
 		logger.String("worker", workerName),
 		logger.String("type", jobType),
 		logger.Int("maxJobs", maxJobs),
-		logger.Int("timeoutMs", int(timeoutMs)))
+		logger.String("timeout", timeout.String()),
+		logger.Int("fetchVariables", len(fetchVariables)),
+		logger.Any("tenantIds", tenantIDs))
 
-	// Convert milliseconds to time.Duration
-	timeout := time.Duration(timeoutMs) * time.Millisecond
-	jobs, err := c.manager.ActivateJobs(context.Background(), jobType, workerName, maxJobs, timeout)
+	// Delegate to job manager
+	ctx := context.Background()
+	jobs, err := c.manager.ActivateJobs(ctx, jobType, workerName, maxJobs, timeout, tenantIDs)
 	if err != nil {
 		return nil, err
 	}
 
 	// Convert to JobInfo
-	jobInfos := make([]JobInfo, len(jobs))
-	for i, job := range jobs {
-		jobInfos[i] = JobInfo{
+	jobInfos := make([]JobInfo, 0, len(jobs))
+	for _, job := range jobs {
+		variables := ProjectVariables(job.Variables, fetchVariables)
+		if err := checkPayloadSize(variables, c.config.Jobs.MaxPayloadSize); err != nil {
+			c.rejectPayload(ctx, job.ID, fmt.Errorf("cannot activate job %s: %w", job.ID, err))
+			continue
+		}
+
+		jobInfos = append(jobInfos, JobInfo{
 			Key:               job.ID,
 			Type:              job.Type,
 			ProcessInstanceID: job.ProcessInstanceID,
-			Variables:         job.Variables,
+			TenantID:          job.TenantID,
+			Variables:         variables,
 			Worker:            job.WorkerID,
 			Retries:           job.Retries,
 			CreatedAt:         job.CreatedAt.Unix(),
-		}
+			Priority:          job.Priority,
+			CustomHeaders:     job.CustomHeaders,
+			LeaseExpiry:       leaseExpiry(job),
+		})
 	}
 
 	return jobInfos, nil
 }
 
-// CompleteJob completes a job
+// CompleteJob completes a job. Variables are a partial update merged into
+// the process scope: only the top-level names given are set, others are kept.
+// Завершает job. Переменные - частичное обновление, объединяемое с областью процесса
 func (c *Component) CompleteJob(jobKey string, variables map[string]interface{}) error {
 	c.logger.Info("Completing job", logger.String("jobKey", jobKey))
 
+	ctx := context.Background()
+	if err := checkPayloadSize(variables, c.config.Jobs.MaxPayloadSize); err != nil {
+		err = fmt.Errorf("cannot complete job %s: %w", jobKey, err)
+		c.rejectPayload(ctx, jobKey, err)
+		return err
+	}
+
 	// Delegate to job manager
-	return c.manager.CompleteJob(context.Background(), jobKey, variables)
+	return c.manager.CompleteJob(ctx, jobKey, variables)
+}
+
+// rejectPayload fails job without retries so the oversized payload surfaces as incident
+// Проваливает job без повторов, чтобы превышение размера стало инцидентом
+func (c *Component) rejectPayload(ctx context.Context, jobKey string, cause error) {
+	c.logger.Warn("Job payload exceeds size limit",
+		logger.String("jobKey", jobKey),
+		logger.String("error", cause.Error()))
+
+	if err := c.manager.FailJob(ctx, jobKey, 0, cause.Error(), 0); err != nil {
+		c.logger.Error("Failed to fail job with oversized payload",
+			logger.String("jobKey", jobKey),
+			logger.String("error", err.Error()))
+	}
 }
 
 // FailJob fails a job
 func (c *Component) FailJob(jobKey string, retries int, errorMessage string) error {
-	c.logger.Info("Failing job", logger.String("jobKey", jobKey), logger.Int("retries", retries))
+	return c.FailJobWithBackoff(jobKey, retries, errorMessage, 0)
+}
+
+// FailJobWithBackoff fails a job with worker supplied retry backoff.
+// Zero backoff applies the task retry backoff policy.
+// Проваливает job с задержкой повтора от воркера
+func (c *Component) FailJobWithBackoff(jobKey string, retries int, errorMessage string, retryBackoff time.Duration) error {
+	c.logger.Info("Failing job",
+		logger.String("jobKey", jobKey),
+		logger.Int("retries", retries),
+		logger.String("retryBackoff", retryBackoff.String()))
 
 	// Delegate to job manager
-	retryBackoff := 5 * time.Second
 	return c.manager.FailJob(context.Background(), jobKey, retries, errorMessage, retryBackoff)
 }
 
@@ -382,7 +457,7 @@ func (c *Component) GetJobStats() (interface{}, error) {
 	}
 
 	// Get today's date for comparison
-	today := time.Now().Format("2006-01-02")
+	today := clock.Now().Format("2006-01-02")
 
 	var activeJobs, completedJobs, failedJobs, activatedToday, completedToday int32
 	for _, job := range allJobs {
@@ -416,9 +491,9 @@ func (c *Component) GetJobStats() (interface{}, error) {
 	}, nil
 }
 
-// ListJobs lists jobs with filtering
+// ListJobs lists jobs with filtering, empty tenantID lists jobs of every tenant
 func (c *Component) ListJobs(
-	jobType, worker, processInstanceID, state string,
+	jobType, worker, processInstanceID, state, tenantID string,
 	limit, offset int,
 ) ([]JobInfo, int, error) {
 
@@ -428,6 +503,7 @@ func (c *Component) ListJobs(
 		Worker:            worker,
 		ProcessInstanceID: processInstanceID,
 		State:             state,
+		TenantID:          tenantID,
 		Limit:             limit,
 		Offset:            offset,
 		IncludeVariables:  true,
@@ -446,12 +522,16 @@ func (c *Component) ListJobs(
 			Key:               job.ID,
 			Type:              job.Type,
 			ProcessInstanceID: job.ProcessInstanceID,
+			TenantID:          job.TenantID,
 			Variables:         job.Variables,
 			Worker:            job.WorkerID,
 			Retries:           job.Retries,
 			CreatedAt:         job.CreatedAt.Unix(),
 			Status:            string(job.Status),
 			ErrorMessage:      job.ErrorMessage,
+			Priority:          job.Priority,
+			CustomHeaders:     job.CustomHeaders,
+			LeaseExpiry:       leaseExpiry(job),
 		}
 	}
 
@@ -476,12 +556,16 @@ func (c *Component) GetJob(jobID string) (*JobInfo, error) {
 		Key:               job.ID,
 		Type:              job.Type,
 		ProcessInstanceID: job.ProcessInstanceID,
+		TenantID:          job.TenantID,
 		Variables:         job.Variables,
 		Worker:            job.WorkerID,
 		Retries:           job.Retries,
 		CreatedAt:         job.CreatedAt.Unix(),
 		Status:            string(job.Status),
 		ErrorMessage:      job.ErrorMessage,
+		Priority:          job.Priority,
+		CustomHeaders:     job.CustomHeaders,
+		LeaseExpiry:       leaseExpiry(job),
 	}
 
 	return jobInfo, nil
@@ -513,17 +597,59 @@ func (c *Component) CancelJob(jobID, reason string) error {
 	return c.manager.CancelJob(context.Background(), jobID)
 }
 
+// ListWorkers lists known workers with jobs they currently hold
+// Возвращает список воркеров с удерживаемыми ими job'ами
+func (c *Component) ListWorkers(jobType string) ([]WorkerSummary, error) {
+	return c.manager.ListWorkers(context.Background(), jobType)
+}
+
 // JobInfo represents job information
 type JobInfo struct {
 	Key               string                 `json:"key"`
 	Type              string                 `json:"type"`
 	ProcessInstanceID string                 `json:"process_instance_id"`
+	TenantID          string                 `json:"tenant_id,omitempty"`
 	Variables         map[string]interface{} `json:"variables"`
 	Worker            string                 `json:"worker"`
 	Retries           int                    `json:"retries"`
 	CreatedAt         int64                  `json:"created_at"`
 	Status            string                 `json:"status"`
 	ErrorMessage      string                 `json:"error_message"`
+	Priority          int                    `json:"priority"`
+	CustomHeaders     map[string]string      `json:"custom_headers,omitempty"`
+	LeaseExpiry       int64                  `json:"lease_expiry,omitempty"`
+}
+
+// leaseExpiry returns lease expiry of running job as unix seconds
+// Возвращает время истечения аренды выполняемого job'а в unix секундах
+func leaseExpiry(job *models.Job) int64 {
+	if job.Status != models.JobStatusRunning || job.ScheduledAt == nil {
+		return 0
+	}
+	return job.ScheduledAt.Unix()
+}
+
+// WorkerSummary represents worker with its active job leases
+// Представляет воркера с активными арендами job'ов
+type WorkerSummary struct {
+	Worker string `json:"worker"`
+	// Registered is false for workers known only from leases, e.g. after restart or crash
+	Registered bool          `json:"registered"`
+	JobType    string        `json:"job_type,omitempty"`
+	LastSeen   int64         `json:"last_seen,omitempty"`
+	MaxJobs    int           `json:"max_jobs,omitempty"`
+	TimeoutMs  int64         `json:"timeout_ms,omitempty"`
+	Leases     []WorkerLease `json:"leases"`
+}
+
+// WorkerLease represents job held by worker until lease expiry
+// Представляет job удерживаемый воркером до истечения аренды
+type WorkerLease struct {
+	JobKey            string `json:"job_key"`
+	Type              string `json:"type"`
+	ProcessInstanceID string `json:"process_instance_id"`
+	ActivatedAt       int64  `json:"activated_at"`
+	LeaseExpiry       int64  `json:"lease_expiry"`
 }
 
 // JobStats represents job statistics
@@ -573,6 +699,8 @@ func (c *Component) ProcessMessage(ctx context.Context, messageJSON string) erro
 		return c.handleGetJob(ctx, request)
 	case "get_stats":
 		return c.handleGetStats(ctx, request)
+	case "list_workers":
+		return c.handleListWorkers(ctx, request)
 	default:
 		return fmt.Errorf("unknown job message type: %s", request.Type)
 	}
@@ -587,12 +715,21 @@ func (c *Component) handleCreateJob(ctx context.Context, request JobRequest) err
 		return c.sendResponse(response)
 	}
 
-	jobID, err := c.CreateJobWithDetails(
+	retries := payload.Retries
+	if retries <= 0 {
+		retries = defaultJobRetries
+	}
+
+	// Process key groups job with jobs of its process definition for fair scheduling
+	jobID, err := c.CreateJobWithPriority(
 		payload.JobType,
 		payload.ProcessInstanceID,
+		storage.InstanceProcessKey(c.storage, payload.ProcessInstanceID),
 		payload.ElementID,
 		payload.CustomHeaders,
-		payload.Variables)
+		payload.Variables,
+		retries,
+		payload.Priority)
 
 	var response JobResponse
 	if err != nil {
@@ -601,7 +738,7 @@ func (c *Component) handleCreateJob(ctx context.Context, request JobRequest) err
 		result := JobResult{
 			JobID:     jobID,
 			Success:   true,
-			Timestamp: time.Now().Unix(),
+			Timestamp: clock.Now().Unix(),
 		}
 		response = CreateJobResponse("create_job_response", request.RequestID, result)
 	}
@@ -622,14 +759,18 @@ func (c *Component) handleActivateJobs(ctx context.Context, request JobRequest)
 		return c.sendResponse(response)
 	}
 
-	var jobs []JobInfo
-	var err error
-
+	timeout := 30 * time.Second
 	if payload.TimeoutMs > 0 {
-		jobs, err = c.ActivateJobsWithTimeout(payload.WorkerName, payload.JobType, payload.MaxJobs, payload.TimeoutMs)
-	} else {
-		jobs, err = c.ActivateJobs(payload.WorkerName, payload.JobType, payload.MaxJobs)
+		timeout = time.Duration(payload.TimeoutMs) * time.Millisecond
 	}
+	jobs, err := c.ActivateJobsWithVariables(
+		payload.WorkerName,
+		payload.JobType,
+		payload.MaxJobs,
+		timeout,
+		payload.FetchVariables,
+		payload.TenantIDs,
+	)
 
 	var response JobResponse
 	if err != nil {
@@ -664,7 +805,7 @@ func (c *Component) handleCompleteJob(ctx context.Context, request JobRequest) e
 			JobKey:    payload.JobKey,
 			Success:   true,
 			Message:   "Job completed successfully",
-			Timestamp: time.Now().Unix(),
+			Timestamp: clock.Now().Unix(),
 		}
 		response = CreateJobResponse("complete_job_response", request.RequestID, result)
 	}
@@ -681,7 +822,8 @@ func (c *Component) handleFailJob(ctx context.Context, request JobRequest) error
 		return c.sendResponse(response)
 	}
 
-	err := c.FailJob(payload.JobKey, payload.Retries, payload.ErrorMessage)
+	retryBackoff := time.Duration(payload.RetryBackoff) * time.Millisecond
+	err := c.FailJobWithBackoff(payload.JobKey, payload.Retries, payload.ErrorMessage, retryBackoff)
 
 	var response JobResponse
 	if err != nil {
@@ -691,7 +833,7 @@ func (c *Component) handleFailJob(ctx context.Context, request JobRequest) error
 			JobKey:    payload.JobKey,
 			Success:   true,
 			Message:   "Job failed with retry",
-			Timestamp: time.Now().Unix(),
+			Timestamp: clock.Now().Unix(),
 		}
 		response = CreateJobResponse("fail_job_response", request.RequestID, result)
 	}
@@ -722,7 +864,7 @@ func (c *Component) handleThrowError(ctx context.Context, request JobRequest) er
 			JobKey:    payload.JobKey,
 			Success:   true,
 			Message:   "BPMN error thrown successfully",
-			Timestamp: time.Now().Unix(),
+			Timestamp: clock.Now().Unix(),
 		}
 		response = CreateJobResponse("throw_error_response", request.RequestID, result)
 	}
@@ -749,7 +891,7 @@ func (c *Component) handleCancelJob(ctx context.Context, request JobRequest) err
 			JobKey:    payload.JobKey,
 			Success:   true,
 			Message:   "Job canceled successfully",
-			Timestamp: time.Now().Unix(),
+			Timestamp: clock.Now().Unix(),
 		}
 		response = CreateJobResponse("cancel_job_response", request.RequestID, result)
 	}
@@ -784,7 +926,7 @@ func (c *Component) handleUpdateJobRetries(ctx context.Context, request JobReque
 			JobKey:    payload.JobKey,
 			Success:   true,
 			Message:   fmt.Sprintf("Job retries updated to %d", payload.NewRetries),
-			Timestamp: time.Now().Unix(),
+			Timestamp: clock.Now().Unix(),
 		}
 		response = CreateJobResponse("update_job_retries_response", request.RequestID, result)
 	}
@@ -820,7 +962,7 @@ func (c *Component) handleUpdateJobTimeout(ctx context.Context, request JobReque
 			JobKey:    payload.JobKey,
 			Success:   true,
 			Message:   fmt.Sprintf("Job timeout updated to %d ms", payload.TimeoutMs),
-			Timestamp: time.Now().Unix(),
+			Timestamp: clock.Now().Unix(),
 		}
 		response = CreateJobResponse("update_job_timeout_response", request.RequestID, result)
 	}
@@ -842,6 +984,7 @@ func (c *Component) handleListJobs(ctx context.Context, request JobRequest) erro
 		payload.Worker,
 		payload.ProcessInstanceID,
 		payload.State,
+		payload.TenantID,
 		payload.Limit,
 		payload.Offset)
 
@@ -861,6 +1004,31 @@ func (c *Component) handleListJobs(ctx context.Context, request JobRequest) erro
 	return c.sendResponse(response)
 }
 
+// handleListWorkers handles worker listing request
+// Обрабатывает запрос списка воркеров
+func (c *Component) handleListWorkers(ctx context.Context, request JobRequest) error {
+	var payload ListWorkersPayload
+	if err := mapToStruct(request.Payload, &payload); err != nil {
+		response := CreateJobErrorResponse("list_workers_response", request.RequestID, fmt.Sprintf("invalid payload: %v", err))
+		return c.sendResponse(response)
+	}
+
+	workers, err := c.ListWorkers(payload.JobType)
+
+	var response JobResponse
+	if err != nil {
+		response = CreateJobErrorResponse("list_workers_response", request.RequestID, err.Error())
+	} else {
+		result := WorkerListResult{
+			Workers: workers,
+			Total:   len(workers),
+		}
+		response = CreateJobResponse("list_workers_response", request.RequestID, result)
+	}
+
+	return c.sendResponse(response)
+}
+
 // handleGetJob handles get job request
 // Обрабатывает запрос получения job'а
 func (c *Component) handleGetJob(ctx context.Context, request JobRequest) error {
@@ -887,7 +1055,7 @@ func (c *Component) handleGetJob(ctx context.Context, request JobRequest) error
 func (c *Component) handleGetStats(ctx context.Context, request JobRequest) error {
 	// Calculate real job statistics
 	// Вычисляем реальную статистику job'ов
-	allJobs, totalJobs, err := c.ListJobs("", "", "", "", 10000, 0) // Get all jobs
+	allJobs, totalJobs, err := c.ListJobs("", "", "", "", "", 10000, 0) // Get all jobs
 	if err != nil {
 		c.logger.Error("Failed to get jobs for stats", logger.String("error", err.Error()))
 		stats := JobStatsResult{TotalJobs: 0, PendingJobs: 0, ActiveJobs: 0, CompletedJobs: 0, FailedJobs: 0, CanceledJobs: 0}
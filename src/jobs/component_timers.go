@@ -0,0 +1,118 @@
+/*
+This file is part of the AtomBPMN (R) project.
+Copyright (c) 2025 Matreska Market LLC (ООО «Matreska Market»).
+Authors: Matreska Team.
+
+This project is dual-licensed under AGPL-3.0 and AtomBPMN Commercial License.
+*/
+
+package jobs
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"atom-engine/src/core/logger"
+	"atom-engine/src/core/models"
+	"atom-engine/src/timewheel"
+)
+
+// jobTimerSource marks timers owned by jobs component
+const jobTimerSource = "jobs"
+
+// ScheduleJobRetry schedules timewheel timer returning deferred job to PENDING.
+// Timers are persisted by timewheel, so pending retries survive restarts.
+// Планирует таймер timewheel возвращающий отложенный job в PENDING
+func (c *Component) ScheduleJobRetry(job *models.Job, retryAt time.Time) error {
+	return c.scheduleJobTimer(job, models.TimerTypeJobRetry, retryAt, models.GenerateID())
+}
+
+// ScheduleJobLease schedules timewheel timer expiring activated job lease
+// Планирует таймер timewheel для истечения аренды активированного job'а
+func (c *Component) ScheduleJobLease(job *models.Job, expiresAt time.Time) error {
+	return c.scheduleJobTimer(job, models.TimerTypeJobLease, expiresAt, job.LeaseTimerID)
+}
+
+// CancelJobTimer cancels job timer in timewheel
+// Отменяет таймер job'а в timewheel
+func (c *Component) CancelJobTimer(timerID string) error {
+	if c.core == nil {
+		return fmt.Errorf("core not available for timer cancellation")
+	}
+
+	message, err := timewheel.CreateCancelTimerMessage(timerID)
+	if err != nil {
+		return err
+	}
+
+	return c.core.SendMessage("timewheel", message)
+}
+
+// HandleJobTimer handles fired job timer. For job timers token_id carries job key.
+// Обрабатывает сработавший таймер job'а. Для таймеров job'ов token_id содержит ключ job'а
+func (c *Component) HandleJobTimer(timerID string, timerType models.TimerType, jobKey string) error {
+	c.logger.Debug("Job timer fired",
+		logger.String("timerID", timerID),
+		logger.String("timerType", string(timerType)),
+		logger.String("jobKey", jobKey))
+
+	switch timerType {
+	case models.TimerTypeJobRetry:
+		return c.manager.PromoteDeferredJob(context.Background(), jobKey)
+	case models.TimerTypeJobLease:
+		return c.manager.ExpireJobLease(context.Background(), jobKey, timerID)
+	default:
+		return fmt.Errorf("unknown job timer type: %s", timerType)
+	}
+}
+
+// scheduleJobTimer sends timer request for job to timewheel component
+// Отправляет запрос таймера для job'а в timewheel компонент
+func (c *Component) scheduleJobTimer(
+	job *models.Job,
+	timerType models.TimerType,
+	dueAt time.Time,
+	timerID string,
+) error {
+	if c.core == nil {
+		return fmt.Errorf("core not available for timer scheduling")
+	}
+
+	// Timer requests require element and process instance, jobs created
+	// directly through API may not have them
+	elementID := job.ElementID
+	if elementID == "" {
+		elementID = job.Type
+	}
+	processInstanceID := job.ProcessInstanceID
+	if processInstanceID == "" {
+		processInstanceID = job.ID
+	}
+
+	timeDate := dueAt.UTC().Format(time.RFC3339Nano)
+	request := timewheel.TimerRequest{
+		ElementID:         elementID,
+		TokenID:           job.ID,
+		ProcessInstanceID: processInstanceID,
+		TimerType:         timerType,
+		ProcessContext: &models.TimerProcessContext{
+			ProcessKey:      job.ProcessKey,
+			TenantID:        job.TenantID,
+			ComponentSource: jobTimerSource,
+		},
+		TimeDate: &timeDate,
+		TimerID:  &timerID,
+	}
+
+	message, err := timewheel.CreateScheduleTimerMessage(request)
+	if err != nil {
+		return err
+	}
+
+	if err := c.core.SendMessage("timewheel", message); err != nil {
+		return fmt.Errorf("failed to schedule %s timer: %w", timerType, err)
+	}
+
+	return nil
+}
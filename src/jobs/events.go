@@ -0,0 +1,72 @@
+/*
+This file is part of the AtomBPMN (R) project.
+Copyright (c) 2025 Matreska Market LLC (ООО «Matreska Market»).
+Authors: Matreska Team.
+
+This project is dual-licensed under AGPL-3.0 and AtomBPMN Commercial License.
+*/
+
+package jobs
+
+import (
+	"strconv"
+	"time"
+
+	"atom-engine/src/core/events"
+	"atom-engine/src/core/models"
+	"atom-engine/src/storage"
+)
+
+// publishJobEvent publishes job lifecycle event, BPMN process ID is taken
+// from process instance of job
+// Публикует событие жизненного цикла задания
+func publishJobEvent(store storage.Storage, eventType string, job *models.Job) {
+	event := &events.Event{
+		Type:              eventType,
+		TenantID:          models.NormalizeTenantID(job.TenantID),
+		ProcessKey:        job.ProcessKey,
+		ProcessInstanceID: job.ProcessInstanceID,
+		ElementID:         job.ElementID,
+		JobKey:            job.ID,
+		JobType:           job.Type,
+	}
+	if instance, err := store.LoadProcessInstance(job.ProcessInstanceID); err == nil && instance != nil {
+		event.ProcessID = instance.ProcessID
+	}
+	event.Attributes = jobEventAttributes(eventType, job)
+	events.Publish(event)
+}
+
+// jobEventAttributes returns worker, retries and failure details of job event
+// Возвращает исполнителя, число повторов и детали ошибки события задания
+func jobEventAttributes(eventType string, job *models.Job) map[string]string {
+	attributes := make(map[string]string)
+	if job.WorkerID != "" {
+		attributes["worker"] = job.WorkerID
+	}
+
+	switch eventType {
+	case events.TypeJobFailed, events.TypeJobRetriesUpdated, events.TypeJobTimedOut, events.TypeJobRetried:
+		attributes["retries"] = strconv.Itoa(job.Retries)
+	}
+	switch eventType {
+	case events.TypeJobFailed, events.TypeJobErrorThrown:
+		if job.ErrorMessage != "" {
+			attributes["error_message"] = job.ErrorMessage
+		}
+	}
+	if eventType == events.TypeJobErrorThrown && job.Metadata["errorCode"] != "" {
+		attributes["error_code"] = job.Metadata["errorCode"]
+	}
+	if eventType == events.TypeJobTimedOut && job.Metadata["leaseExpiredWorker"] != "" {
+		attributes["worker"] = job.Metadata["leaseExpiredWorker"]
+	}
+	if job.Status == models.JobStatusDeferred && job.ScheduledAt != nil {
+		attributes["retry_at"] = job.ScheduledAt.UTC().Format(time.RFC3339)
+	}
+
+	if len(attributes) == 0 {
+		return nil
+	}
+	return attributes
+}
@@ -34,6 +34,8 @@ type CreateJobPayload struct {
 	ElementID         string                 `json:"element_id,omitempty"`
 	CustomHeaders     map[string]string      `json:"custom_headers,omitempty"`
 	Variables         map[string]interface{} `json:"variables,omitempty"`
+	Retries           int                    `json:"retries,omitempty"`
+	Priority          int                    `json:"priority,omitempty"`
 }
 
 // ActivateJobsPayload payload for activating jobs
@@ -43,6 +45,10 @@ type ActivateJobsPayload struct {
 	JobType    string `json:"job_type"`
 	MaxJobs    int    `json:"max_jobs"`
 	TimeoutMs  int32  `json:"timeout_ms,omitempty"`
+	// FetchVariables limits returned variables to these top-level names
+	FetchVariables []string `json:"fetch_variables,omitempty"`
+	// TenantIDs limits activation to jobs of these tenants, all tenants when nil
+	TenantIDs []string `json:"tenant_ids,omitempty"`
 }
 
 // CompleteJobPayload payload for completing a job
@@ -83,6 +89,7 @@ type ListJobsPayload struct {
 	Worker            string `json:"worker,omitempty"`
 	ProcessInstanceID string `json:"process_instance_id,omitempty"`
 	State             string `json:"state,omitempty"`
+	TenantID          string `json:"tenant_id,omitempty"`
 	Limit             int    `json:"limit,omitempty"`
 	Offset            int    `json:"offset,omitempty"`
 }
@@ -93,6 +100,12 @@ type GetJobPayload struct {
 	JobID string `json:"job_id"`
 }
 
+// ListWorkersPayload payload for listing workers
+// Payload для списка воркеров
+type ListWorkersPayload struct {
+	JobType string `json:"job_type,omitempty"`
+}
+
 // UpdateJobRetriesPayload payload for updating job retries
 // Payload для обновления retries job'а
 type UpdateJobRetriesPayload struct {
@@ -126,6 +139,13 @@ type JobListResult struct {
 	Offset int       `json:"offset"`
 }
 
+// WorkerListResult result structure for worker list operations
+// Структура результата для операций списка воркеров
+type WorkerListResult struct {
+	Workers []WorkerSummary `json:"workers"`
+	Total   int             `json:"total"`
+}
+
 // JobStatsResult result structure for job statistics
 // Структура результата для статистики job'ов
 type JobStatsResult struct {
@@ -11,16 +11,35 @@ package jobs
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"sort"
 	"strings"
 	"sync"
 	"time"
 
+	"atom-engine/src/core/clock"
+	"atom-engine/src/core/events"
 	"atom-engine/src/core/logger"
+	"atom-engine/src/core/metrics"
 	"atom-engine/src/core/models"
 	"atom-engine/src/storage"
 )
 
+// activationCandidateFactor sets pending jobs read per requested activation.
+// Pending index returns highest priority and oldest jobs first, fairness
+// interleaves process definitions within this window
+const activationCandidateFactor = 10
+
+// leaseCheckInterval is period of safety check for overdue leases without timer
+const leaseCheckInterval = 10 * time.Minute
+
+// leaseTimerScheduledState is state of persisted timer waiting to fire
+const leaseTimerScheduledState = "SCHEDULED"
+
+// errStaleLeaseTimer aborts a lease expiry fired by a superseded lease timer
+var errStaleLeaseTimer = errors.New("lease timer is no longer current")
+
 // JobCallback represents job completion callback
 // Представляет callback завершения job'а
 type JobCallback struct {
@@ -44,6 +63,9 @@ type JobManager struct {
 	isRunning bool
 	stopChan  chan struct{}
 	component JobsComponentInterface
+	scheduler *JobScheduler
+	// retryPolicy is the engine default backoff, overridden by task headers
+	retryPolicy RetryBackoffPolicy
 }
 
 // JobsComponentInterface defines interface for job callback handling
@@ -54,6 +76,9 @@ type JobsComponentInterface interface {
 		incidentType, elementID, processInstanceID, jobKey, jobType, workerID, errorMessage string,
 		retries int,
 	) error
+	ScheduleJobRetry(job *models.Job, retryAt time.Time) error
+	ScheduleJobLease(job *models.Job, expiresAt time.Time) error
+	CancelJobTimer(timerID string) error
 }
 
 // WorkerInfo contains information about job worker
@@ -85,13 +110,17 @@ func NewJobManager(
 	storage storage.Storage,
 	logger logger.ComponentLogger,
 	component JobsComponentInterface,
+	scheduler *JobScheduler,
+	retryPolicy RetryBackoffPolicy,
 ) *JobManager {
 	return &JobManager{
-		storage:   storage,
-		logger:    logger,
-		workers:   make(map[string]*WorkerInfo),
-		stopChan:  make(chan struct{}),
-		component: component,
+		storage:     storage,
+		logger:      logger,
+		workers:     make(map[string]*WorkerInfo),
+		stopChan:    make(chan struct{}),
+		component:   component,
+		scheduler:   scheduler,
+		retryPolicy: retryPolicy,
 	}
 }
 
@@ -101,9 +130,6 @@ func (jm *JobManager) Start() error {
 
 	jm.isRunning = true
 
-	// Start cleanup goroutine for expired jobs
-	go jm.cleanupExpiredJobs()
-
 	// Start worker health check
 	go jm.monitorWorkers()
 
@@ -130,29 +156,43 @@ func (jm *JobManager) IsRunning() bool {
 func (jm *JobManager) CreateJob(ctx context.Context, job *models.Job) error {
 	jm.logger.Info("Creating job", logger.String("type", job.Type), logger.String("id", job.ID))
 
+	// Job belongs to tenant of its process instance
+	if job.TenantID == models.DefaultTenantID {
+		tenantID, err := storage.InstanceTenantID(jm.storage, job.ProcessInstanceID)
+		if err != nil {
+			return fmt.Errorf("failed to resolve job tenant: %w", err)
+		}
+		job.TenantID = tenantID
+	}
+
+	beginJobSpan(job)
+
 	// Save job to storage
 	if err := jm.storage.SaveJob(ctx, job); err != nil {
 		return fmt.Errorf("failed to save job: %w", err)
 	}
+	publishJobEvent(jm.storage, events.TypeJobCreated, job)
 
 	jm.logger.Info("Job created successfully")
 	return nil
 }
 
-// ActivateJobs activates jobs for worker
+// ActivateJobs activates jobs for worker, nil tenantIDs activates jobs of every tenant
 func (jm *JobManager) ActivateJobs(
 	ctx context.Context,
 	jobType, workerID string,
 	maxJobs int,
 	timeout time.Duration,
+	tenantIDs []string,
 ) ([]*models.Job, error) {
 	jm.logger.Info("Activating jobs", logger.String("worker", workerID), logger.Int("maxJobs", maxJobs))
 
 	// Register or update worker info
 	jm.registerWorker(workerID, jobType, maxJobs, timeout)
 
-	// Get available jobs
-	jobs, err := jm.storage.ListJobsByType(ctx, jobType, models.JobStatusPending, maxJobs)
+	// Read highest priority, oldest pending jobs from pending index instead of
+	// the whole queue, fairness ordering applies within the window
+	jobs, err := jm.storage.ListPendingJobs(ctx, jobType, tenantIDs, maxJobs*activationCandidateFactor)
 	if err != nil {
 		return nil, fmt.Errorf("failed to list jobs: %w", err)
 	}
@@ -162,107 +202,121 @@ func (jm *JobManager) ActivateJobs(
 		logger.String("status", string(models.JobStatusPending)),
 		logger.Int("count", len(jobs)))
 
+	if len(jobs) == 0 {
+		return nil, nil
+	}
+
+	// Apply per-type concurrency and rate limits
+	granted, err := jm.scheduler.Reserve(jobType, maxJobs, func() (int, error) {
+		running, err := jm.storage.ListJobsByType(ctx, jobType, models.JobStatusRunning, 0)
+		return len(running), err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to reserve activations: %w", err)
+	}
+
 	var activatedJobs []*models.Job
-	for _, job := range jobs {
-		jm.logger.Debug("Processing job for activation",
-			logger.String("jobID", job.ID),
-			logger.String("currentStatus", string(job.Status)),
-			logger.String("currentWorker", job.WorkerID))
+	defer func() {
+		jm.scheduler.Release(jobType, granted, len(activatedJobs))
+	}()
+
+	if granted == 0 {
+		jm.logger.Debug("Job type activation limit reached",
+			logger.String("jobType", jobType),
+			logger.String("worker", workerID))
+		return nil, nil
+	}
 
-		// Double-check job is actually pending (race condition protection)
-		if job.Status != models.JobStatusPending {
-			jm.logger.Warn("Skipping job - not pending",
-				logger.String("jobID", job.ID),
-				logger.String("status", string(job.Status)))
-			continue
-		}
+	jobs = jm.scheduler.Order(jobType, jobs)
 
-		// Re-read job from storage to check if still pending (avoid race condition)
-		freshJob, err := jm.storage.GetJob(ctx, job.ID)
-		if err != nil {
-			jm.logger.Error("Failed to re-read job", logger.String("error", err.Error()))
-			continue
-		}
+	leaseExpiry := clock.Now().Add(timeout)
 
-		if freshJob == nil || freshJob.Status != models.JobStatusPending {
-			jm.logger.Warn("Job no longer pending - skipping",
+	for _, job := range jobs {
+		// Claim the job with a PENDING -> RUNNING compare-and-set. Only one
+		// worker can win the transition, the others see a status mismatch.
+		activated, err := jm.updateJobIf(ctx, job.ID, models.JobStatusPending, func(j *models.Job) error {
+			j.MarkAsStarted(workerID)
+			j.ScheduledAt = &leaseExpiry
+			j.LeaseTimerID = models.GenerateID()
+			return nil
+		})
+		if err != nil {
+			if errors.Is(err, storage.ErrJobStatusMismatch) || errors.Is(err, storage.ErrJobNotFound) {
+				jm.logger.Debug("Job already claimed - skipping",
+					logger.String("jobID", job.ID),
+					logger.String("error", err.Error()))
+				continue
+			}
+			jm.logger.Error("Failed to activate job",
 				logger.String("jobID", job.ID),
-				logger.String("freshStatus", string(freshJob.Status)))
+				logger.String("error", err.Error()))
 			continue
 		}
 
-		// Mark job as running
-		freshJob.MarkAsStarted(workerID)
-
-		// Set lease expiry
-		leaseExpiry := time.Now().Add(timeout)
-		freshJob.ScheduledAt = &leaseExpiry
-
-		jm.logger.Debug("Marking job as started",
-			logger.String("jobID", freshJob.ID),
+		jm.logger.Debug("Job marked as started",
+			logger.String("jobID", activated.ID),
 			logger.String("newWorker", workerID),
-			logger.String("newStatus", string(freshJob.Status)),
 			logger.String("timeout", timeout.String()),
 			logger.String("scheduledAt", leaseExpiry.Format("15:04:05.000")))
 
-		if err := jm.storage.SaveJob(ctx, freshJob); err != nil {
-			jm.logger.Error("Failed to save activated job", logger.String("error", err.Error()))
-			continue
-		}
+		jm.scheduleLease(activated)
+		activatedJobs = append(activatedJobs, activated)
+		publishJobEvent(jm.storage, events.TypeJobActivated, activated)
 
-		// Verify job was saved correctly
-		savedJob, err := jm.storage.GetJob(ctx, freshJob.ID)
-		if err == nil && savedJob != nil {
-			jm.logger.Debug("Job saved verification",
-				logger.String("jobID", savedJob.ID),
-				logger.String("savedStatus", string(savedJob.Status)),
-				logger.String("savedWorker", savedJob.WorkerID))
-		}
-
-		activatedJobs = append(activatedJobs, freshJob)
-
-		if len(activatedJobs) >= maxJobs {
+		if len(activatedJobs) >= granted {
 			break
 		}
 	}
 
+	jm.updateWorkerActiveJobs(workerID, len(activatedJobs))
+	metrics.JobsActivated.WithLabelValues(jobType).Add(float64(len(activatedJobs)))
+
 	jm.logger.Info("Jobs activated", logger.String("worker", workerID), logger.Int("count", len(activatedJobs)))
 	return activatedJobs, nil
 }
 
+// updateJobIf applies compare-and-set job update and reports jobs leaving
+// RUNNING status to scheduler, which counts running jobs in memory
+func (jm *JobManager) updateJobIf(
+	ctx context.Context,
+	jobID string,
+	expectedStatus models.JobStatus,
+	update func(job *models.Job) error,
+) (*models.Job, error) {
+	job, err := jm.storage.UpdateJobIf(ctx, jobID, expectedStatus, update)
+	if err == nil && expectedStatus == models.JobStatusRunning && job.Status != models.JobStatusRunning {
+		jm.scheduler.JobStopped(job.Type)
+	}
+	return job, err
+}
+
 // CompleteJob completes a job
 func (jm *JobManager) CompleteJob(ctx context.Context, jobID string, variables map[string]interface{}) error {
 	jm.logger.Info("Completing job", logger.String("jobID", jobID))
 
-	job, err := jm.storage.GetJob(ctx, jobID)
-	if err != nil {
-		return fmt.Errorf("failed to get job: %w", err)
-	}
-
-	if job == nil {
-		return fmt.Errorf("job not found: %s", jobID)
-	}
-
-	if job.Status != models.JobStatusRunning {
-		return fmt.Errorf("job is not running: %s", jobID)
-	}
-
-	// Update job variables if provided
-	if variables != nil {
-		if job.Variables == nil {
-			job.Variables = make(map[string]interface{})
-		}
-		for k, v := range variables {
-			job.Variables[k] = v
+	job, err := jm.updateJobIf(ctx, jobID, models.JobStatusRunning, func(job *models.Job) error {
+		// Update job variables if provided
+		if variables != nil {
+			if job.Variables == nil {
+				job.Variables = make(map[string]interface{})
+			}
+			for k, v := range variables {
+				job.Variables[k] = v
+			}
 		}
-	}
-
-	job.MarkAsCompleted()
 
-	if err := jm.storage.SaveJob(ctx, job); err != nil {
-		return fmt.Errorf("failed to save completed job: %w", err)
+		job.MarkAsCompleted()
+		return nil
+	})
+	if err != nil {
+		return jobTransitionError("complete", jobID, err)
 	}
 
+	jm.releaseLease(job)
+	metrics.JobsCompleted.WithLabelValues(job.Type).Inc()
+	endJobSpan(job, nil)
+	publishJobEvent(jm.storage, events.TypeJobCompleted, job)
+
 	// Update worker info
 	jm.updateWorkerActiveJobs(job.WorkerID, -1)
 
@@ -274,7 +328,7 @@ func (jm *JobManager) CompleteJob(ctx context.Context, jobID string, variables m
 		ProcessInstanceID: job.ProcessInstanceID,
 		Status:            "COMPLETED",
 		Variables:         variables,
-		CompletedAt:       time.Now(),
+		CompletedAt:       clock.Now(),
 	}
 
 	if jm.component != nil {
@@ -297,29 +351,35 @@ func (jm *JobManager) CompleteJobWithBPMNError(ctx context.Context, jobID, error
 		logger.String("errorCode", errorCode),
 		logger.String("errorMessage", errorMessage))
 
-	job, err := jm.storage.GetJob(ctx, jobID)
+	current, err := jm.storage.GetJob(ctx, jobID)
 	if err != nil {
 		return fmt.Errorf("failed to get job: %w", err)
 	}
 
-	if job == nil {
+	if current == nil {
 		return fmt.Errorf("job not found: %s", jobID)
 	}
 
 	// Job should be RUNNING when BPMN error is thrown
-	if job.Status != models.JobStatusRunning {
+	if current.Status != models.JobStatusRunning {
 		jm.logger.Warn("Job is not running when completing with BPMN error",
 			logger.String("jobID", jobID),
-			logger.String("status", string(job.Status)))
+			logger.String("status", string(current.Status)))
 	}
 
-	// Mark as ERROR_THROWN with error details
-	job.MarkAsErrorThrown(errorCode, errorMessage)
-
-	if err := jm.storage.SaveJob(ctx, job); err != nil {
-		return fmt.Errorf("failed to save job with BPMN error completion: %w", err)
+	// Mark as ERROR_THROWN with error details, guarded by the status we observed
+	job, err := jm.updateJobIf(ctx, jobID, current.Status, func(job *models.Job) error {
+		job.MarkAsErrorThrown(errorCode, errorMessage)
+		return nil
+	})
+	if err != nil {
+		return jobTransitionError("complete with BPMN error", jobID, err)
 	}
 
+	jm.releaseLease(job)
+	endJobSpan(job, nil)
+	publishJobEvent(jm.storage, events.TypeJobErrorThrown, job)
+
 	// Update worker info - job is now closed
 	jm.updateWorkerActiveJobs(job.WorkerID, -1)
 
@@ -329,7 +389,8 @@ func (jm *JobManager) CompleteJobWithBPMNError(ctx context.Context, jobID, error
 	return nil
 }
 
-// FailJob fails a job
+// FailJob fails a job. A positive retryBackoff is the worker override,
+// otherwise the delay comes from the task retry backoff policy.
 func (jm *JobManager) FailJob(
 	ctx context.Context,
 	jobID string,
@@ -343,35 +404,50 @@ func (jm *JobManager) FailJob(
 		logger.String("error", errorMessage),
 	)
 
-	job, err := jm.storage.GetJob(ctx, jobID)
-	if err != nil {
-		return fmt.Errorf("failed to get job: %w", err)
-	}
-
-	if job == nil {
-		return fmt.Errorf("job not found: %s", jobID)
-	}
+	var canRetry bool
+	var retryAt time.Time
+	job, err := jm.updateJobIf(ctx, jobID, models.JobStatusRunning, func(job *models.Job) error {
+		// Update retries and mark as failed
+		now := clock.Now()
+		job.Status = models.JobStatusFailed
+		job.ErrorMessage = errorMessage
+		job.Retries = retries // Set explicit retries value from CLI
+		job.CompletedAt = &now
+		job.UpdatedAt = now
+
+		// Check if can retry BEFORE changing status to DEFERRED
+		canRetry = job.CanRetry()
+		if !canRetry {
+			return nil
+		}
 
-	// Update retries and mark as failed
-	now := time.Now()
-	job.Status = models.JobStatusFailed
-	job.ErrorMessage = errorMessage
-	job.Retries = retries // Set explicit retries value from CLI
-	job.CompletedAt = &now
-	job.UpdatedAt = now
+		backoff := retryBackoff
+		if backoff <= 0 {
+			backoff = jm.retryBackoffFor(job)
+		}
 
-	// Check if can retry BEFORE changing status to DEFERRED
-	canRetry := job.CanRetry()
+		// Without delay the job is activatable right away
+		if backoff <= 0 {
+			job.Status = models.JobStatusPending
+			job.WorkerID = ""
+			job.ScheduledAt = nil
+			return nil
+		}
 
-	// Schedule retry if retries available
-	if canRetry && retryBackoff > 0 {
-		retryTime := time.Now().Add(retryBackoff)
-		job.Status = models.JobStatusDeferred
-		job.ScheduledAt = &retryTime
+		retryAt = now.Add(backoff)
+		job.MarkAsDeferred(retryAt)
+		return nil
+	})
+	if err != nil {
+		return jobTransitionError("fail", jobID, err)
 	}
 
-	if err := jm.storage.SaveJob(ctx, job); err != nil {
-		return fmt.Errorf("failed to save failed job: %w", err)
+	jm.releaseLease(job)
+	metrics.JobsFailed.WithLabelValues(job.Type).Inc()
+	publishJobEvent(jm.storage, events.TypeJobFailed, job)
+
+	if job.Status == models.JobStatusDeferred {
+		jm.scheduleRetry(ctx, job, retryAt)
 	}
 
 	// Update worker info
@@ -379,6 +455,8 @@ func (jm *JobManager) FailJob(
 
 	// Send job failure callback only if cannot retry anymore
 	if !canRetry {
+		endJobSpan(job, errors.New(errorMessage))
+
 		callback := JobCallback{
 			JobID:             job.ID,
 			ElementID:         job.ElementID,
@@ -386,7 +464,7 @@ func (jm *JobManager) FailJob(
 			ProcessInstanceID: job.ProcessInstanceID,
 			Status:            "FAILED",
 			ErrorMessage:      errorMessage,
-			CompletedAt:       time.Now(),
+			CompletedAt:       clock.Now(),
 		}
 
 		if jm.component != nil {
@@ -405,7 +483,7 @@ func (jm *JobManager) FailJob(
 		}
 	}
 
-	jm.logger.Info("Job failed", logger.String("jobID", jobID), logger.Bool("canRetry", job.CanRetry()))
+	jm.logger.Info("Job failed", logger.String("jobID", jobID), logger.Bool("canRetry", canRetry))
 	return nil
 }
 
@@ -417,41 +495,33 @@ func (jm *JobManager) ThrowError(
 ) error {
 	jm.logger.Info("Throwing error for job", logger.String("jobID", jobID), logger.String("errorCode", errorCode))
 
-	job, err := jm.storage.GetJob(ctx, jobID)
-	if err != nil {
-		return fmt.Errorf("failed to get job: %w", err)
-	}
-
-	if job == nil {
-		return fmt.Errorf("job not found: %s", jobID)
-	}
-
-	// Initialize job variables if needed
-	if job.Variables == nil {
-		job.Variables = make(map[string]interface{})
-	}
-
-	// Update job variables if provided
-	for k, v := range variables {
-		job.Variables[k] = v
-	}
-
-	// Add error information to variables for callback processing
-	job.Variables["errorCode"] = errorCode
-	job.Variables["errorMessage"] = errorMessage
-
-	// Add error information to metadata for storage
-	if job.Metadata == nil {
-		job.Metadata = make(map[string]string)
-	}
-	job.Metadata["errorCode"] = errorCode
-	job.Metadata["errorType"] = "BPMN_ERROR"
-
 	// Do not mark job as failed yet - let process engine decide after checking boundary events
 	// Не помечаем job как failed сразу - пусть process engine решает после проверки boundary events
+	job, err := jm.updateJobIf(ctx, jobID, models.JobStatusRunning, func(job *models.Job) error {
+		// Initialize job variables if needed
+		if job.Variables == nil {
+			job.Variables = make(map[string]interface{})
+		}
 
-	if err := jm.storage.SaveJob(ctx, job); err != nil {
-		return fmt.Errorf("failed to save job with error metadata: %w", err)
+		// Update job variables if provided
+		for k, v := range variables {
+			job.Variables[k] = v
+		}
+
+		// Add error information to variables for callback processing
+		job.Variables["errorCode"] = errorCode
+		job.Variables["errorMessage"] = errorMessage
+
+		// Add error information to metadata for storage
+		if job.Metadata == nil {
+			job.Metadata = make(map[string]string)
+		}
+		job.Metadata["errorCode"] = errorCode
+		job.Metadata["errorType"] = "BPMN_ERROR"
+		return nil
+	})
+	if err != nil {
+		return jobTransitionError("throw error for", jobID, err)
 	}
 
 	// Do not update worker info yet - job is still running until boundary event processing completes
@@ -467,7 +537,7 @@ func (jm *JobManager) ThrowError(
 		ErrorMessage: errorMessage,
 		ErrorCode:    errorCode,
 		Variables:    job.Variables,
-		CompletedAt:  time.Now(),
+		CompletedAt:  clock.Now(),
 	}
 
 	if jm.component != nil {
@@ -491,28 +561,31 @@ func (jm *JobManager) ThrowError(
 func (jm *JobManager) UpdateJobRetries(ctx context.Context, jobID string, retries int) error {
 	jm.logger.Info("Updating job retries", logger.String("jobID", jobID), logger.Int("retries", retries))
 
-	job, err := jm.storage.GetJob(ctx, jobID)
+	current, err := jm.storage.GetJob(ctx, jobID)
 	if err != nil {
 		return fmt.Errorf("failed to get job: %w", err)
 	}
 
-	if job == nil {
+	if current == nil {
 		return fmt.Errorf("job not found: %s", jobID)
 	}
 
-	job.Retries = retries
-	job.UpdatedAt = time.Now()
+	job, err := jm.updateJobIf(ctx, jobID, current.Status, func(job *models.Job) error {
+		job.Retries = retries
+		job.UpdatedAt = clock.Now()
 
-	// If job was failed but now has retries, make it pending again
-	if job.Status == models.JobStatusFailed && retries > 0 {
-		job.Status = models.JobStatusPending
-		job.ErrorMessage = ""
-		job.CompletedAt = nil
-	}
-
-	if err := jm.storage.SaveJob(ctx, job); err != nil {
-		return fmt.Errorf("failed to save job: %w", err)
+		// If job was failed but now has retries, make it pending again
+		if job.Status == models.JobStatusFailed && retries > 0 {
+			job.Status = models.JobStatusPending
+			job.ErrorMessage = ""
+			job.CompletedAt = nil
+		}
+		return nil
+	})
+	if err != nil {
+		return jobTransitionError("update retries of", jobID, err)
 	}
+	publishJobEvent(jm.storage, events.TypeJobRetriesUpdated, job)
 
 	jm.logger.Info("Job retries updated", logger.Int("retries", retries))
 	return nil
@@ -522,28 +595,34 @@ func (jm *JobManager) UpdateJobRetries(ctx context.Context, jobID string, retrie
 func (jm *JobManager) CancelJob(ctx context.Context, jobID string) error {
 	jm.logger.Info("Canceling job", logger.String("jobID", jobID))
 
-	job, err := jm.storage.GetJob(ctx, jobID)
+	current, err := jm.storage.GetJob(ctx, jobID)
 	if err != nil {
 		return fmt.Errorf("failed to get job: %w", err)
 	}
 
-	if job == nil {
+	if current == nil {
 		return fmt.Errorf("job not found: %s", jobID)
 	}
 
-	if job.IsCompleted() {
+	if current.IsCompleted() {
 		return fmt.Errorf("job is already completed: %s", jobID)
 	}
 
-	job.Status = models.JobStatusCanceled
-	job.UpdatedAt = time.Now()
-	now := time.Now()
-	job.CompletedAt = &now
-
-	if err := jm.storage.SaveJob(ctx, job); err != nil {
-		return fmt.Errorf("failed to save canceled job: %w", err)
+	job, err := jm.updateJobIf(ctx, jobID, current.Status, func(job *models.Job) error {
+		now := clock.Now()
+		job.Status = models.JobStatusCanceled
+		job.UpdatedAt = now
+		job.CompletedAt = &now
+		return nil
+	})
+	if err != nil {
+		return jobTransitionError("cancel", jobID, err)
 	}
 
+	jm.releaseLease(job)
+	endJobSpan(job, nil)
+	publishJobEvent(jm.storage, events.TypeJobCanceled, job)
+
 	// Update worker info
 	if job.WorkerID != "" {
 		jm.updateWorkerActiveJobs(job.WorkerID, -1)
@@ -591,6 +670,11 @@ func (jm *JobManager) ListJobs(ctx context.Context, filter *ListJobsFilter) ([]*
 			continue
 		}
 
+		// Filter by tenant
+		if filter.TenantID != "" && job.TenantID != models.NormalizeTenantID(filter.TenantID) {
+			continue
+		}
+
 		// Filter by process key
 		if filter.ProcessKey != "" {
 			// Load process instance to get process key
@@ -647,28 +731,34 @@ func (jm *JobManager) GetJob(ctx context.Context, jobID string) (*models.Job, er
 	return jm.storage.GetJob(ctx, jobID)
 }
 
-// UpdateJobTimeout updates job timeout
+// UpdateJobTimeout updates job timeout and reschedules its lease timer
 func (jm *JobManager) UpdateJobTimeout(ctx context.Context, jobID string, timeout time.Duration) error {
 	jm.logger.Info("Updating job timeout", logger.String("jobID", jobID), logger.String("timeout", timeout.String()))
 
-	job, err := jm.storage.GetJob(ctx, jobID)
-	if err != nil {
-		return fmt.Errorf("failed to get job: %w", err)
-	}
-
-	if job == nil {
-		return fmt.Errorf("job not found: %s", jobID)
-	}
+	var previousTimerID string
+	var rescheduled bool
+	job, err := jm.updateJobIf(ctx, jobID, models.JobStatusRunning, func(job *models.Job) error {
+		if job.ScheduledAt == nil {
+			return nil
+		}
 
-	if job.Status == models.JobStatusRunning && job.ScheduledAt != nil {
-		// Extend lease expiry
-		newExpiry := time.Now().Add(timeout)
+		// Extend lease expiry under a new timer, the old one becomes stale
+		now := clock.Now()
+		newExpiry := now.Add(timeout)
+		previousTimerID = job.LeaseTimerID
 		job.ScheduledAt = &newExpiry
-		job.UpdatedAt = time.Now()
+		job.LeaseTimerID = models.GenerateID()
+		job.UpdatedAt = now
+		rescheduled = true
+		return nil
+	})
+	if err != nil && !errors.Is(err, storage.ErrJobStatusMismatch) {
+		return jobTransitionError("update timeout of", jobID, err)
+	}
 
-		if err := jm.storage.SaveJob(ctx, job); err != nil {
-			return fmt.Errorf("failed to save job: %w", err)
-		}
+	if err == nil && rescheduled {
+		jm.cancelJobTimer(previousTimerID)
+		jm.scheduleLease(job)
 	}
 
 	jm.logger.Info("Job timeout updated", logger.String("jobID", jobID))
@@ -685,39 +775,30 @@ func (jm *JobManager) ThrowJobError(
 		logger.String("jobID", jobID),
 		logger.String("errorCode", errorCode))
 
-	job, err := jm.storage.GetJob(ctx, jobID)
-	if err != nil {
-		return fmt.Errorf("failed to get job: %w", err)
-	}
-
-	if job == nil {
-		return fmt.Errorf("job not found: %s", jobID)
-	}
+	job, err := jm.updateJobIf(ctx, jobID, models.JobStatusRunning, func(job *models.Job) error {
+		// Update job status to ERROR_THROWN
+		job.Status = models.JobStatusErrorThrown
+		job.ErrorMessage = fmt.Sprintf("BPMN Error %s: %s", errorCode, errorMessage)
 
-	// Update job status to ERROR_THROWN
-	job.Status = models.JobStatusErrorThrown
-	job.ErrorMessage = fmt.Sprintf("BPMN Error %s: %s", errorCode, errorMessage)
-
-	// Merge variables if provided
-	if variables != nil {
+		// Merge variables if provided
 		if job.Variables == nil {
 			job.Variables = make(map[string]interface{})
 		}
 		for k, v := range variables {
 			job.Variables[k] = v
 		}
-	}
 
-	// Add error metadata
-	job.Variables["__error_code"] = errorCode
-	job.Variables["__error_message"] = errorMessage
-
-	// Save updated job
-	err = jm.storage.SaveJob(ctx, job)
+		// Add error metadata
+		job.Variables["__error_code"] = errorCode
+		job.Variables["__error_message"] = errorMessage
+		return nil
+	})
 	if err != nil {
-		return fmt.Errorf("failed to save job after error: %w", err)
+		return jobTransitionError("throw BPMN error for", jobID, err)
 	}
 
+	jm.releaseLease(job)
+
 	// Send error callback to process component via response channel
 	if jm.component != nil {
 		errorCallback := fmt.Sprintf(
@@ -769,7 +850,7 @@ func (jm *JobManager) registerWorker(workerID, jobType string, maxJobs int, time
 		jm.workers[workerID] = worker
 	}
 
-	worker.LastPing = time.Now()
+	worker.LastPing = clock.Now()
 	worker.JobType = jobType
 	worker.MaxJobs = maxJobs
 	worker.Timeout = timeout
@@ -788,63 +869,72 @@ func (jm *JobManager) updateWorkerActiveJobs(workerID string, delta int) {
 	}
 }
 
-// cleanupExpiredJobs runs cleanup for expired jobs
-func (jm *JobManager) cleanupExpiredJobs() {
-	ticker := time.NewTicker(2 * time.Second)
-	defer ticker.Stop()
-
-	for {
-		select {
-		case <-ticker.C:
-			jm.performCleanup()
-		case <-jm.stopChan:
-			return
-		}
-	}
-}
-
-// performCleanup performs cleanup of expired jobs
-func (jm *JobManager) performCleanup() {
-	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
-	defer cancel()
-
-	// Get all running jobs
-	jobs, err := jm.storage.ListJobsByType(ctx, "", models.JobStatusRunning, 1000)
+// ListWorkers lists registered workers and workers holding job leases.
+// Leases come from storage so workers lost on crash or restart are visible too.
+func (jm *JobManager) ListWorkers(ctx context.Context, jobType string) ([]WorkerSummary, error) {
+	running, err := jm.storage.ListJobsByType(ctx, jobType, models.JobStatusRunning, 0)
 	if err != nil {
-		jm.logger.Error("Failed to list jobs for cleanup", logger.String("error", err.Error()))
-		return
+		return nil, fmt.Errorf("failed to list running jobs: %w", err)
 	}
 
-	now := time.Now()
-	expiredCount := 0
+	summaries := make(map[string]*WorkerSummary)
 
-	for _, job := range jobs {
-		// Check if job lease has expired
-		if job.ScheduledAt != nil && now.After(*job.ScheduledAt) {
-			jm.logger.Debug("Job expired",
-				logger.String("jobID", job.ID),
-				logger.String("now", now.Format("15:04:05.000")),
-				logger.String("scheduledAt", job.ScheduledAt.Format("15:04:05.000")))
+	jm.mutex.RLock()
+	for workerID, worker := range jm.workers {
+		if jobType != "" && worker.JobType != jobType {
+			continue
+		}
+		summaries[workerID] = &WorkerSummary{
+			Worker:     workerID,
+			Registered: true,
+			JobType:    worker.JobType,
+			LastSeen:   worker.LastPing.Unix(),
+			MaxJobs:    worker.MaxJobs,
+			TimeoutMs:  worker.Timeout.Milliseconds(),
+			Leases:     []WorkerLease{},
+		}
+	}
+	jm.mutex.RUnlock()
 
-			// Reset job to pending for retry
-			job.Status = models.JobStatusPending
-			job.WorkerID = ""
-			job.ScheduledAt = nil
-			job.UpdatedAt = now
+	for _, job := range running {
+		if job.WorkerID == "" {
+			continue
+		}
 
-			if err := jm.storage.SaveJob(ctx, job); err != nil {
-				jm.logger.Error("Failed to reset expired job", logger.String("error", err.Error()))
-				continue
+		summary, exists := summaries[job.WorkerID]
+		if !exists {
+			summary = &WorkerSummary{
+				Worker:  job.WorkerID,
+				JobType: job.Type,
+				Leases:  []WorkerLease{},
 			}
+			summaries[job.WorkerID] = summary
+		}
 
-			expiredCount++
-			jm.logger.Info("Reset expired job", logger.String("type", job.Type))
+		lease := WorkerLease{
+			JobKey:            job.ID,
+			Type:              job.Type,
+			ProcessInstanceID: job.ProcessInstanceID,
+			LeaseExpiry:       leaseExpiry(job),
 		}
+		if job.StartedAt != nil {
+			lease.ActivatedAt = job.StartedAt.Unix()
+		}
+		summary.Leases = append(summary.Leases, lease)
 	}
 
-	if expiredCount > 0 {
-		jm.logger.Info("Cleaned up expired jobs", logger.Int("cleanedCount", expiredCount))
+	workers := make([]WorkerSummary, 0, len(summaries))
+	for _, summary := range summaries {
+		sort.Slice(summary.Leases, func(i, j int) bool {
+			return summary.Leases[i].LeaseExpiry < summary.Leases[j].LeaseExpiry
+		})
+		workers = append(workers, *summary)
 	}
+	sort.Slice(workers, func(i, j int) bool {
+		return workers[i].Worker < workers[j].Worker
+	})
+
+	return workers, nil
 }
 
 // monitorWorkers monitors worker health
@@ -852,10 +942,18 @@ func (jm *JobManager) monitorWorkers() {
 	ticker := time.NewTicker(60 * time.Second)
 	defer ticker.Stop()
 
+	leaseTicker := time.NewTicker(leaseCheckInterval)
+	defer leaseTicker.Stop()
+
 	for {
 		select {
 		case <-ticker.C:
 			jm.checkWorkerHealth()
+		case <-leaseTicker.C:
+			// Safety net for overdue leases whose timer was lost
+			if err := jm.restoreLeases(context.Background(), true); err != nil {
+				jm.logger.Warn("Failed to check job leases", logger.String("error", err.Error()))
+			}
 		case <-jm.stopChan:
 			return
 		}
@@ -867,7 +965,7 @@ func (jm *JobManager) checkWorkerHealth() {
 	jm.mutex.Lock()
 	defer jm.mutex.Unlock()
 
-	now := time.Now()
+	now := clock.Now()
 	inactiveThreshold := 5 * time.Minute
 
 	for workerID, worker := range jm.workers {
@@ -880,3 +978,246 @@ func (jm *JobManager) checkWorkerHealth() {
 		}
 	}
 }
+
+// ExpireJobLease returns a RUNNING job to PENDING when its lease timer fires.
+// Retries are preserved since the worker never reported a failure.
+func (jm *JobManager) ExpireJobLease(ctx context.Context, jobID, timerID string) error {
+	now := clock.Now()
+	var rearm bool
+	var previousWorker string
+
+	job, err := jm.updateJobIf(ctx, jobID, models.JobStatusRunning, func(j *models.Job) error {
+		if j.LeaseTimerID != timerID {
+			return errStaleLeaseTimer
+		}
+
+		// The wheel may fire up to one tick early - keep the lease and arm a new
+		// timer for the remainder instead of cutting the worker short
+		if j.ScheduledAt != nil && now.Before(*j.ScheduledAt) {
+			j.LeaseTimerID = models.GenerateID()
+			rearm = true
+			return nil
+		}
+
+		previousWorker = j.WorkerID
+		j.Status = models.JobStatusPending
+		j.WorkerID = ""
+		j.ScheduledAt = nil
+		j.LeaseTimerID = ""
+		j.UpdatedAt = now
+		if j.Metadata == nil {
+			j.Metadata = make(map[string]string)
+		}
+		j.Metadata["leaseExpiredWorker"] = previousWorker
+		j.Metadata["leaseExpiredAt"] = now.Format(time.RFC3339)
+		return nil
+	})
+	if err != nil {
+		if errors.Is(err, errStaleLeaseTimer) ||
+			errors.Is(err, storage.ErrJobStatusMismatch) ||
+			errors.Is(err, storage.ErrJobNotFound) {
+			jm.logger.Debug("Lease timer no longer applies",
+				logger.String("jobID", jobID),
+				logger.String("timerID", timerID),
+				logger.String("reason", err.Error()))
+			return nil
+		}
+		return jobTransitionError("expire lease of", jobID, err)
+	}
+
+	if rearm {
+		jm.scheduleLease(job)
+		return nil
+	}
+
+	jm.updateWorkerActiveJobs(previousWorker, -1)
+	publishJobEvent(jm.storage, events.TypeJobTimedOut, job)
+
+	message := fmt.Sprintf("Job %s (%s) lease of worker %s expired, returned to PENDING with %d retries",
+		job.ID, job.Type, previousWorker, job.Retries)
+	if err := jm.storage.LogSystemEvent(models.EventTypeJobExpired, models.StatusSuccess, message); err != nil {
+		jm.logger.Warn("Failed to record lease expiry event", logger.String("error", err.Error()))
+	}
+
+	jm.logger.Info("Job lease expired",
+		logger.String("jobID", job.ID),
+		logger.String("type", job.Type),
+		logger.String("worker", previousWorker))
+	return nil
+}
+
+// RestoreLeases schedules lease timers for RUNNING jobs whose timer is
+// missing: jobs activated before lease timers were introduced, jobs whose
+// timer failed to schedule and jobs whose timer was not persisted before crash
+func (jm *JobManager) RestoreLeases(ctx context.Context) error {
+	return jm.restoreLeases(ctx, false)
+}
+
+// restoreLeases re-arms missing lease timers, overdueOnly limits the check to
+// leases past expiry so that timers still being scheduled are not replaced
+func (jm *JobManager) restoreLeases(ctx context.Context, overdueOnly bool) error {
+	if jm.component == nil {
+		return nil
+	}
+
+	jobs, err := jm.storage.ListJobsByType(ctx, "", models.JobStatusRunning, 0)
+	if err != nil {
+		return fmt.Errorf("failed to list running jobs: %w", err)
+	}
+
+	now := clock.Now()
+	for _, job := range jobs {
+		if job.ScheduledAt == nil || (overdueOnly && now.Before(*job.ScheduledAt)) {
+			continue
+		}
+		if job.LeaseTimerID != "" {
+			// Timer that fired without expiring the lease will not fire again
+			timer, err := jm.storage.LoadTimer(job.LeaseTimerID)
+			if err == nil && timer.State == leaseTimerScheduledState {
+				continue
+			}
+			if err != nil && !errors.Is(err, storage.ErrTimerNotFound) {
+				continue
+			}
+		}
+
+		currentTimerID := job.LeaseTimerID
+		restored, err := jm.updateJobIf(ctx, job.ID, models.JobStatusRunning, func(j *models.Job) error {
+			if j.LeaseTimerID != currentTimerID {
+				return errStaleLeaseTimer
+			}
+			j.LeaseTimerID = models.GenerateID()
+			return nil
+		})
+		if err != nil {
+			continue
+		}
+
+		jm.logger.Info("Restoring missing job lease timer",
+			logger.String("jobID", restored.ID),
+			logger.String("leaseExpiry", restored.ScheduledAt.Format(time.RFC3339)))
+		jm.scheduleLease(restored)
+	}
+
+	return nil
+}
+
+// scheduleLease registers lease expiry timer for activated job. When the
+// timer cannot be scheduled the lease timer ID is cleared, so the job is
+// found by lease restore instead of waiting for a timer that never fires.
+func (jm *JobManager) scheduleLease(job *models.Job) {
+	if jm.component == nil || job.ScheduledAt == nil {
+		return
+	}
+
+	err := jm.component.ScheduleJobLease(job, *job.ScheduledAt)
+	if err == nil {
+		return
+	}
+	jm.logger.Error("Failed to schedule job lease timer",
+		logger.String("jobID", job.ID),
+		logger.String("error", err.Error()))
+
+	timerID := job.LeaseTimerID
+	_, err = jm.updateJobIf(context.Background(), job.ID, models.JobStatusRunning, func(j *models.Job) error {
+		if j.LeaseTimerID != timerID {
+			return errStaleLeaseTimer
+		}
+		j.LeaseTimerID = ""
+		return nil
+	})
+	if err != nil && !errors.Is(err, errStaleLeaseTimer) && !errors.Is(err, storage.ErrJobStatusMismatch) {
+		jm.logger.Warn("Failed to clear lease timer of job",
+			logger.String("jobID", job.ID),
+			logger.String("error", err.Error()))
+	}
+}
+
+// releaseLease cancels lease timer of job that left RUNNING state
+func (jm *JobManager) releaseLease(job *models.Job) {
+	jm.cancelJobTimer(job.LeaseTimerID)
+}
+
+// cancelJobTimer cancels job timer, a timer that already fired is not an error
+func (jm *JobManager) cancelJobTimer(timerID string) {
+	if jm.component == nil || timerID == "" {
+		return
+	}
+
+	if err := jm.component.CancelJobTimer(timerID); err != nil {
+		jm.logger.Debug("Job timer not canceled",
+			logger.String("timerID", timerID),
+			logger.String("error", err.Error()))
+	}
+}
+
+// PromoteDeferredJob returns a deferred job to PENDING once its retry backoff elapsed
+func (jm *JobManager) PromoteDeferredJob(ctx context.Context, jobID string) error {
+	job, err := jm.updateJobIf(ctx, jobID, models.JobStatusDeferred, func(job *models.Job) error {
+		job.Status = models.JobStatusPending
+		job.WorkerID = ""
+		job.ScheduledAt = nil
+		job.UpdatedAt = clock.Now()
+		return nil
+	})
+	if err != nil {
+		// The job was canceled or retried manually while waiting - nothing to do
+		if errors.Is(err, storage.ErrJobStatusMismatch) || errors.Is(err, storage.ErrJobNotFound) {
+			jm.logger.Debug("Deferred job no longer waiting for retry",
+				logger.String("jobID", jobID),
+				logger.String("reason", err.Error()))
+			return nil
+		}
+		return jobTransitionError("promote", jobID, err)
+	}
+
+	publishJobEvent(jm.storage, events.TypeJobRetried, job)
+
+	jm.logger.Info("Deferred job is pending again", logger.String("jobID", jobID))
+	return nil
+}
+
+// retryBackoffFor computes retry delay from engine policy and task headers
+func (jm *JobManager) retryBackoffFor(job *models.Job) time.Duration {
+	policy, err := jm.retryPolicy.WithHeaders(job.CustomHeaders)
+	if err != nil {
+		jm.logger.Warn("Invalid retry backoff headers - using engine default",
+			logger.String("jobID", job.ID),
+			logger.String("error", err.Error()))
+		policy = jm.retryPolicy
+	}
+
+	// Attempts are counted from retries already consumed
+	return policy.Delay(job.MaxRetries - job.Retries)
+}
+
+// scheduleRetry registers retry timer for deferred job. If the timer cannot be
+// scheduled the job is released immediately rather than left deferred forever.
+func (jm *JobManager) scheduleRetry(ctx context.Context, job *models.Job, retryAt time.Time) {
+	if jm.component != nil {
+		err := jm.component.ScheduleJobRetry(job, retryAt)
+		if err == nil {
+			jm.logger.Info("Job retry scheduled",
+				logger.String("jobID", job.ID),
+				logger.String("retryAt", retryAt.Format(time.RFC3339)))
+			return
+		}
+		jm.logger.Error("Failed to schedule job retry - releasing job now",
+			logger.String("jobID", job.ID),
+			logger.String("error", err.Error()))
+	}
+
+	if err := jm.PromoteDeferredJob(ctx, job.ID); err != nil {
+		jm.logger.Error("Failed to release deferred job",
+			logger.String("jobID", job.ID),
+			logger.String("error", err.Error()))
+	}
+}
+
+// jobTransitionError converts a failed storage transition into a job manager error
+func jobTransitionError(action, jobID string, err error) error {
+	if errors.Is(err, storage.ErrJobNotFound) {
+		return fmt.Errorf("job not found: %s", jobID)
+	}
+	return fmt.Errorf("failed to %s job: %w", action, err)
+}
@@ -0,0 +1,167 @@
+/*
+This file is part of the AtomBPMN (R) project.
+Copyright (c) 2025 Matreska Market LLC (ООО «Matreska Market»).
+Authors: Matreska Team.
+
+This project is dual-licensed under AGPL-3.0 and AtomBPMN Commercial License.
+*/
+
+package jobs
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"strconv"
+	"strings"
+	"time"
+
+	"atom-engine/src/core/config"
+	"atom-engine/src/core/duration"
+)
+
+// Retry backoff strategies
+// Стратегии задержки повторов
+const (
+	RetryBackoffFixed       = "fixed"
+	RetryBackoffLinear      = "linear"
+	RetryBackoffExponential = "exponential"
+)
+
+// Task headers overriding engine retry backoff defaults per service task
+// Заголовки задачи переопределяющие задержку повторов по умолчанию
+const (
+	HeaderRetryBackoffPolicy     = "retryBackoffPolicy"
+	HeaderRetryBackoff           = "retryBackoff"
+	HeaderRetryBackoffMax        = "retryBackoffMax"
+	HeaderRetryBackoffMultiplier = "retryBackoffMultiplier"
+	HeaderRetryBackoffJitter     = "retryBackoffJitter"
+)
+
+// RetryBackoffPolicy computes delay before next job retry
+// Вычисляет задержку перед следующим повтором задания
+type RetryBackoffPolicy struct {
+	Strategy     string
+	InitialDelay time.Duration
+	MaxDelay     time.Duration
+	Multiplier   float64
+	Jitter       float64
+}
+
+// NewRetryBackoffPolicy creates retry backoff policy from engine configuration
+// Создает политику задержки повторов из конфигурации движка
+func NewRetryBackoffPolicy(cfg config.RetryBackoffConfig) (RetryBackoffPolicy, error) {
+	policy := RetryBackoffPolicy{
+		Strategy:   strings.ToLower(cfg.Policy),
+		Multiplier: cfg.Multiplier,
+		Jitter:     cfg.Jitter,
+	}
+
+	var err error
+	if policy.InitialDelay, err = duration.Parse(cfg.InitialDelay); err != nil {
+		return policy, fmt.Errorf("invalid initial_delay: %w", err)
+	}
+	if policy.MaxDelay, err = duration.Parse(cfg.MaxDelay); err != nil {
+		return policy, fmt.Errorf("invalid max_delay: %w", err)
+	}
+
+	return policy, policy.validate()
+}
+
+// WithHeaders returns policy overridden by retryBackoff* task headers
+// Возвращает политику переопределенную заголовками retryBackoff* задачи
+func (p RetryBackoffPolicy) WithHeaders(headers map[string]string) (RetryBackoffPolicy, error) {
+	if value := strings.TrimSpace(headers[HeaderRetryBackoffPolicy]); value != "" {
+		p.Strategy = strings.ToLower(value)
+	}
+
+	if value := strings.TrimSpace(headers[HeaderRetryBackoff]); value != "" {
+		delay, err := duration.Parse(value)
+		if err != nil {
+			return p, fmt.Errorf("invalid %s header: %w", HeaderRetryBackoff, err)
+		}
+		p.InitialDelay = delay
+		// A task asking for a longer base delay than the engine cap should get it
+		if p.MaxDelay < delay {
+			p.MaxDelay = delay
+		}
+	}
+
+	if value := strings.TrimSpace(headers[HeaderRetryBackoffMax]); value != "" {
+		delay, err := duration.Parse(value)
+		if err != nil {
+			return p, fmt.Errorf("invalid %s header: %w", HeaderRetryBackoffMax, err)
+		}
+		p.MaxDelay = delay
+	}
+
+	if value := strings.TrimSpace(headers[HeaderRetryBackoffMultiplier]); value != "" {
+		multiplier, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return p, fmt.Errorf("invalid %s header: %w", HeaderRetryBackoffMultiplier, err)
+		}
+		p.Multiplier = multiplier
+	}
+
+	if value := strings.TrimSpace(headers[HeaderRetryBackoffJitter]); value != "" {
+		jitter, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return p, fmt.Errorf("invalid %s header: %w", HeaderRetryBackoffJitter, err)
+		}
+		p.Jitter = jitter
+	}
+
+	return p, p.validate()
+}
+
+// Delay returns delay before retry after given failed attempt (1-based)
+// Возвращает задержку перед повтором после указанной неудачной попытки
+func (p RetryBackoffPolicy) Delay(attempt int) time.Duration {
+	if attempt < 1 {
+		attempt = 1
+	}
+
+	base := float64(p.InitialDelay)
+	switch p.Strategy {
+	case RetryBackoffLinear:
+		base *= float64(attempt)
+	case RetryBackoffExponential:
+		base *= math.Pow(p.Multiplier, float64(attempt-1))
+	}
+
+	if p.Jitter > 0 {
+		// Spread delay uniformly over [base*(1-jitter), base*(1+jitter)]
+		base *= 1 - p.Jitter + 2*p.Jitter*rand.Float64()
+	}
+
+	if p.MaxDelay > 0 && base > float64(p.MaxDelay) {
+		return p.MaxDelay
+	}
+	if base > math.MaxInt64 {
+		return time.Duration(math.MaxInt64)
+	}
+
+	return time.Duration(base)
+}
+
+// validate checks policy parameters
+// Проверяет параметры политики
+func (p RetryBackoffPolicy) validate() error {
+	switch p.Strategy {
+	case RetryBackoffFixed, RetryBackoffLinear, RetryBackoffExponential:
+	default:
+		return fmt.Errorf("unknown retry backoff policy: %s", p.Strategy)
+	}
+
+	if p.InitialDelay < 0 || p.MaxDelay < 0 {
+		return fmt.Errorf("retry backoff delays cannot be negative")
+	}
+	if p.Strategy == RetryBackoffExponential && p.Multiplier < 1 {
+		return fmt.Errorf("retry backoff multiplier must be at least 1, got %v", p.Multiplier)
+	}
+	if p.Jitter < 0 || p.Jitter > 1 {
+		return fmt.Errorf("retry backoff jitter must be between 0 and 1, got %v", p.Jitter)
+	}
+
+	return nil
+}
@@ -0,0 +1,256 @@
+/*
+This file is part of the AtomBPMN (R) project.
+Copyright (c) 2025 Matreska Market LLC (ООО «Matreska Market»).
+Authors: Matreska Team.
+
+This project is dual-licensed under AGPL-3.0 and AtomBPMN Commercial License.
+*/
+
+package jobs
+
+import (
+	"math"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"atom-engine/src/core/config"
+	"atom-engine/src/core/models"
+)
+
+// Scheduling policies for jobs with equal priority
+// Политики планирования для заданий с равным приоритетом
+const (
+	SchedulingPolicyFair = "fair"
+	SchedulingPolicyFIFO = "fifo"
+)
+
+// JobScheduler orders activatable jobs and enforces per-type activation limits
+// Упорядочивает доступные задания и применяет лимиты активации по типам
+type JobScheduler struct {
+	policy  string
+	limits  map[string]config.JobTypeLimitConfig
+	buckets map[string]*activationBucket
+	// inFlight counts activations granted but not yet released, per job type
+	inFlight map[string]int
+	// running counts RUNNING jobs of capped job types, read from storage once
+	running map[string]int
+	// cursors rotate the first process definition served per job type
+	cursors map[string]int
+	mutex   sync.Mutex
+}
+
+// activationBucket is a token bucket limiting activation rate of one job type
+// Token bucket ограничивающий скорость активации одного типа заданий
+type activationBucket struct {
+	tokens     float64
+	capacity   float64
+	rate       float64
+	lastRefill time.Time
+}
+
+// NewJobScheduler creates job scheduler from jobs configuration
+// Создает планировщик заданий из конфигурации jobs
+func NewJobScheduler(cfg config.JobsConfig) *JobScheduler {
+	policy := strings.ToLower(cfg.SchedulingPolicy)
+	if policy == "" {
+		policy = SchedulingPolicyFair
+	}
+
+	s := &JobScheduler{
+		policy:   policy,
+		limits:   make(map[string]config.JobTypeLimitConfig),
+		buckets:  make(map[string]*activationBucket),
+		inFlight: make(map[string]int),
+		running:  make(map[string]int),
+		cursors:  make(map[string]int),
+	}
+
+	now := time.Now()
+	for jobType, limit := range cfg.TypeLimits {
+		s.limits[jobType] = limit
+		if limit.ActivationsPerSecond > 0 {
+			capacity := float64(limit.Burst)
+			if capacity <= 0 {
+				capacity = math.Max(1, math.Ceil(limit.ActivationsPerSecond))
+			}
+			s.buckets[jobType] = &activationBucket{
+				tokens:     capacity,
+				capacity:   capacity,
+				rate:       limit.ActivationsPerSecond,
+				lastRefill: now,
+			}
+		}
+	}
+
+	return s
+}
+
+// Order sorts jobs by priority (highest first) and then by age (oldest first).
+// With the fair policy jobs of equal priority are interleaved round-robin across
+// process definitions, so a bulk backfill of one process cannot starve others.
+// Сортирует задания по приоритету, затем по возрасту. При политике fair задания
+// с равным приоритетом чередуются между определениями процессов.
+func (s *JobScheduler) Order(jobType string, jobs []*models.Job) []*models.Job {
+	sort.SliceStable(jobs, func(i, j int) bool {
+		if jobs[i].Priority != jobs[j].Priority {
+			return jobs[i].Priority > jobs[j].Priority
+		}
+		return jobs[i].CreatedAt.Before(jobs[j].CreatedAt)
+	})
+
+	if s.policy != SchedulingPolicyFair || len(jobs) < 2 {
+		return jobs
+	}
+
+	s.mutex.Lock()
+	cursor := s.cursors[jobType]
+	s.cursors[jobType] = cursor + 1
+	s.mutex.Unlock()
+
+	ordered := make([]*models.Job, 0, len(jobs))
+	for start := 0; start < len(jobs); {
+		end := start
+		for end < len(jobs) && jobs[end].Priority == jobs[start].Priority {
+			end++
+		}
+		ordered = append(ordered, interleaveByProcess(jobs[start:end], cursor)...)
+		start = end
+	}
+
+	return ordered
+}
+
+// interleaveByProcess merges age-ordered jobs of one priority band round-robin
+// across process definitions, starting from the definition selected by cursor
+// Объединяет задания одного приоритета поочередно по определениям процессов
+func interleaveByProcess(band []*models.Job, cursor int) []*models.Job {
+	var keys []string
+	queues := make(map[string][]*models.Job)
+	for _, job := range band {
+		if _, exists := queues[job.ProcessKey]; !exists {
+			keys = append(keys, job.ProcessKey)
+		}
+		queues[job.ProcessKey] = append(queues[job.ProcessKey], job)
+	}
+
+	if len(keys) < 2 {
+		return band
+	}
+
+	// Rotate so that repeated small activations do not always favour the
+	// process definition owning the oldest job
+	offset := cursor % len(keys)
+	keys = append(keys[offset:], keys[:offset]...)
+
+	result := make([]*models.Job, 0, len(band))
+	for len(result) < len(band) {
+		for _, key := range keys {
+			if queue := queues[key]; len(queue) > 0 {
+				result = append(result, queue[0])
+				queues[key] = queue[1:]
+			}
+		}
+	}
+
+	return result
+}
+
+// Reserve grants up to requested activations for job type within its
+// concurrency cap and rate limit. countRunning is only called on first
+// reservation of a type with concurrency cap, afterwards running jobs are
+// counted in memory by Release and JobStopped.
+// Every Reserve must be followed by Release with the number actually activated.
+// Резервирует активации в пределах лимитов типа. После каждого Reserve
+// необходимо вызвать Release с фактическим числом активаций.
+func (s *JobScheduler) Reserve(jobType string, requested int, countRunning func() (int, error)) (int, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	granted := requested
+	limit, hasLimit := s.limits[jobType]
+	if !hasLimit {
+		return granted, nil
+	}
+
+	if limit.MaxConcurrent > 0 {
+		running, counted := s.running[jobType]
+		if !counted {
+			var err error
+			if running, err = countRunning(); err != nil {
+				return 0, err
+			}
+			s.running[jobType] = running
+		}
+		available := limit.MaxConcurrent - running - s.inFlight[jobType]
+		if available < granted {
+			granted = available
+		}
+	}
+
+	if bucket, exists := s.buckets[jobType]; exists {
+		bucket.refill(time.Now())
+		if tokens := int(bucket.tokens); tokens < granted {
+			granted = tokens
+		}
+	}
+
+	if granted < 0 {
+		granted = 0
+	}
+
+	if bucket, exists := s.buckets[jobType]; exists {
+		bucket.tokens -= float64(granted)
+	}
+	s.inFlight[jobType] += granted
+
+	return granted, nil
+}
+
+// Release returns unused activations reserved for job type
+// Возвращает неиспользованные зарезервированные активации
+func (s *JobScheduler) Release(jobType string, granted, activated int) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if _, hasLimit := s.limits[jobType]; !hasLimit {
+		return
+	}
+
+	s.inFlight[jobType] -= granted
+	if s.inFlight[jobType] < 0 {
+		s.inFlight[jobType] = 0
+	}
+	if _, counted := s.running[jobType]; counted {
+		s.running[jobType] += activated
+	}
+
+	if bucket, exists := s.buckets[jobType]; exists && granted > activated {
+		bucket.tokens = math.Min(bucket.capacity, bucket.tokens+float64(granted-activated))
+	}
+}
+
+// JobStopped records RUNNING job of type leaving RUNNING status
+// Учитывает выход job'а типа из статуса RUNNING
+func (s *JobScheduler) JobStopped(jobType string) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	// Count may briefly go below zero when job stops before its activation
+	// is released
+	if _, counted := s.running[jobType]; counted {
+		s.running[jobType]--
+	}
+}
+
+// refill adds tokens accumulated since last refill
+// Добавляет токены накопленные с момента последнего пополнения
+func (b *activationBucket) refill(now time.Time) {
+	elapsed := now.Sub(b.lastRefill).Seconds()
+	if elapsed <= 0 {
+		return
+	}
+	b.tokens = math.Min(b.capacity, b.tokens+elapsed*b.rate)
+	b.lastRefill = now
+}
@@ -0,0 +1,68 @@
+/*
+This file is part of the AtomBPMN (R) project.
+Copyright (c) 2025 Matreska Market LLC (ООО «Matreska Market»).
+Authors: Matreska Team.
+
+This project is dual-licensed under AGPL-3.0 and AtomBPMN Commercial License.
+*/
+
+package jobs
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+
+	"atom-engine/src/core/clock"
+	"atom-engine/src/core/models"
+	"atom-engine/src/core/tracing"
+)
+
+// beginJobSpan opens lifetime span of job under traceparent custom header and
+// replaces header with job span, so workers continue trace from their job.
+// Parent is kept in job metadata for span emitted when job ends.
+// Открывает спан жизни задания под заголовком traceparent и подменяет
+// заголовок спаном задания, чтобы воркеры продолжали трассу от задания
+func beginJobSpan(job *models.Job) {
+	parent := job.CustomHeaders[tracing.TraceparentKey]
+	if parent == "" {
+		return
+	}
+	ctx := tracing.ContextWithTraceparent(context.Background(), parent)
+	traceparent := tracing.BeginSpan(ctx, jobSpanName(job))
+	if traceparent == "" {
+		return
+	}
+
+	job.CustomHeaders[tracing.TraceparentKey] = traceparent
+	if job.Metadata == nil {
+		job.Metadata = make(map[string]string)
+	}
+	job.Metadata[tracing.ParentKey] = parent
+}
+
+// endJobSpan emits lifetime span of finished job
+// Выпускает спан жизни завершенного задания
+func endJobSpan(job *models.Job, err error) {
+	end := clock.Now()
+	if job.CompletedAt != nil {
+		end = *job.CompletedAt
+	}
+	tracing.EndSpan(jobSpanName(job),
+		job.CustomHeaders[tracing.TraceparentKey],
+		job.Metadata[tracing.ParentKey],
+		job.CreatedAt, end, err,
+		attribute.String("job.key", job.ID),
+		attribute.String("job.type", job.Type),
+		attribute.String("job.status", string(job.Status)),
+		attribute.String("job.worker", job.WorkerID),
+		attribute.Int("job.retries", job.Retries),
+		attribute.String("bpmn.element_id", job.ElementID),
+		attribute.String("bpmn.instance_id", job.ProcessInstanceID))
+}
+
+// jobSpanName returns name of job lifetime span
+// Возвращает имя спана жизни задания
+func jobSpanName(job *models.Job) string {
+	return "job " + job.Type
+}
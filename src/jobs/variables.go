@@ -0,0 +1,59 @@
+/*
+This file is part of the AtomBPMN (R) project.
+Copyright (c) 2025 Matreska Market LLC (ООО «Matreska Market»).
+Authors: Matreska Team.
+
+This project is dual-licensed under AGPL-3.0 and AtomBPMN Commercial License.
+*/
+
+package jobs
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrPayloadTooLarge is returned when job variables exceed jobs.max_payload_size
+// Возвращается когда переменные задания превышают jobs.max_payload_size
+var ErrPayloadTooLarge = errors.New("job payload too large")
+
+// ProjectVariables returns only requested top-level variables.
+// Empty fetch list returns all variables, unknown names are skipped.
+// Возвращает только запрошенные переменные верхнего уровня
+func ProjectVariables(variables map[string]interface{}, fetchVariables []string) map[string]interface{} {
+	if len(fetchVariables) == 0 {
+		return variables
+	}
+
+	projected := make(map[string]interface{}, len(fetchVariables))
+	for _, name := range fetchVariables {
+		name = strings.TrimSpace(name)
+		if value, exists := variables[name]; exists {
+			projected[name] = value
+		}
+	}
+
+	return projected
+}
+
+// checkPayloadSize verifies JSON size of variables against limit in bytes.
+// Negative limit disables the check.
+// Проверяет размер JSON переменных относительно лимита в байтах
+func checkPayloadSize(variables map[string]interface{}, limit int) error {
+	if limit < 0 || len(variables) == 0 {
+		return nil
+	}
+
+	data, err := json.Marshal(variables)
+	if err != nil {
+		return fmt.Errorf("failed to measure job variables: %w", err)
+	}
+
+	if len(data) > limit {
+		return fmt.Errorf("%w: variables are %d bytes, limit is %d bytes", ErrPayloadTooLarge, len(data), limit)
+	}
+
+	return nil
+}
@@ -178,8 +178,8 @@ func (bm *BufferManager) ProcessBufferedMessages(
 
 	processedCount := 0
 	for _, message := range messages {
-		// Check if message matches subscription
-		if message.Name != subscription.MessageName {
+		// Check if message matches subscription, tenants never correlate across each other
+		if message.Name != subscription.MessageName || !sameTenant(message.TenantID, subscription.TenantID) {
 			continue
 		}
 
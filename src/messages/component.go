@@ -16,6 +16,7 @@ import (
 	"strings"
 	"time"
 
+	"atom-engine/src/core/clock"
 	"atom-engine/src/core/config"
 	"atom-engine/src/core/logger"
 	"atom-engine/src/core/models"
@@ -385,7 +386,7 @@ func (c *Component) handlePublishMessage(ctx context.Context, request MessageReq
 			Success:           true,
 			ProcessInstanceID: result.ProcessInstanceID,
 			Variables:         result.Variables,
-			Timestamp:         time.Now().Unix(),
+			Timestamp:         clock.Now().Unix(),
 		}
 		response = CreateMessageResponse("publish_message_response", request.RequestID, messageResult)
 	}
@@ -425,7 +426,7 @@ func (c *Component) handleCorrelateMessage(ctx context.Context, request MessageR
 			Success:           true,
 			ProcessInstanceID: result.ProcessInstanceID,
 			Variables:         result.Variables,
-			Timestamp:         time.Now().Unix(),
+			Timestamp:         clock.Now().Unix(),
 		}
 		response = CreateMessageResponse("correlate_message_response", request.RequestID, messageResult)
 	}
@@ -460,8 +461,8 @@ func (c *Component) handleCreateSubscription(ctx context.Context, request Messag
 		MessageRef:           payload.MessageName,
 		CorrelationKey:       payload.CorrelationKey,
 		IsActive:             true,
-		CreatedAt:            time.Now(),
-		UpdatedAt:            time.Now(),
+		CreatedAt:            clock.Now(),
+		UpdatedAt:            clock.Now(),
 	}
 
 	err := c.CreateMessageSubscription(ctx, subscription)
@@ -474,7 +475,7 @@ func (c *Component) handleCreateSubscription(ctx context.Context, request Messag
 			SubscriptionID: subscription.ID, // Use ID from subscription object
 			Success:        true,
 			Message:        "Subscription created successfully",
-			Timestamp:      time.Now().Unix(),
+			Timestamp:      clock.Now().Unix(),
 		}
 		response = CreateMessageResponse("create_subscription_response", request.RequestID, subscResult)
 	}
@@ -505,7 +506,7 @@ func (c *Component) handleDeleteSubscription(ctx context.Context, request Messag
 			SubscriptionID: payload.SubscriptionID,
 			Success:        true,
 			Message:        "Subscription deleted successfully",
-			Timestamp:      time.Now().Unix(),
+			Timestamp:      clock.Now().Unix(),
 		}
 		response = CreateMessageResponse("delete_subscription_response", request.RequestID, subscResult)
 	}
@@ -586,7 +587,7 @@ func (c *Component) handleCleanupExpired(ctx context.Context, request MessageReq
 			ExpiredCount: expiredCount,
 			Success:      true,
 			Message:      fmt.Sprintf("Cleaned up %d expired messages", expiredCount),
-			Timestamp:    time.Now().Unix(),
+			Timestamp:    clock.Now().Unix(),
 		}
 		response = CreateMessageResponse("cleanup_expired_response", request.RequestID, cleanupResult)
 	}
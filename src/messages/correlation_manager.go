@@ -15,7 +15,9 @@ import (
 	"strings"
 	"time"
 
+	"atom-engine/src/core/clock"
 	"atom-engine/src/core/logger"
+	"atom-engine/src/core/metrics"
 	"atom-engine/src/core/models"
 	"atom-engine/src/storage"
 )
@@ -79,7 +81,7 @@ func (cm *CorrelationManager) PublishMessage(
 	// Create message ID
 	messageID := models.GenerateID()
 
-	// Try to find active subscription
+	// Try to find active subscription, empty tenant filter lists every tenant so match strictly below
 	subscriptions, err := cm.storage.ListProcessMessageSubscriptions(ctx, tenantID, 100, 0)
 	if err != nil {
 		return nil, fmt.Errorf("failed to list subscriptions: %w", err)
@@ -87,7 +89,7 @@ func (cm *CorrelationManager) PublishMessage(
 
 	var targetSubscription *models.ProcessMessageSubscription
 	for _, sub := range subscriptions {
-		if sub.MessageName == messageName && sub.IsActive {
+		if sub.MessageName == messageName && sub.IsActive && sameTenant(sub.TenantID, tenantID) {
 			// Check correlation key match if specified
 			if correlationKey != "" && sub.CorrelationKey != "" {
 				// Handle FEEL expressions in subscription correlation key
@@ -133,7 +135,7 @@ func (cm *CorrelationManager) PublishMessage(
 		MessageName:     messageName,
 		CorrelationKey:  correlationKey,
 		Variables:       variables,
-		CreatedAt:       time.Now(),
+		CreatedAt:       clock.Now(),
 		InstanceCreated: false,
 	}
 
@@ -145,7 +147,7 @@ func (cm *CorrelationManager) PublishMessage(
 		if isIntermediateCatchEvent {
 			// For intermediate catch events, find waiting token and activate it
 			// Для intermediate catch events находим ожидающий токен и активируем его
-			waitingToken, err := cm.findWaitingToken(targetSubscription.StartEventID, messageName)
+			waitingToken, err := cm.findWaitingToken(targetSubscription.StartEventID, messageName, tenantID)
 			if err != nil {
 				return nil, fmt.Errorf("failed to find waiting token: %w", err)
 			}
@@ -162,6 +164,7 @@ func (cm *CorrelationManager) PublishMessage(
 				cm.logger.Warn("No waiting token found for intermediate catch event",
 					logger.String("element_id", targetSubscription.StartEventID),
 					logger.String("message_name", messageName))
+				metrics.MessagesPublished.WithLabelValues("uncorrelated").Inc()
 				return result, nil
 			}
 		} else {
@@ -180,6 +183,9 @@ func (cm *CorrelationManager) PublishMessage(
 			)
 		}
 
+		metrics.MessagesPublished.WithLabelValues("correlated").Inc()
+		cm.publishCorrelated(result, targetSubscription.ProcessDefinitionKey)
+
 		// Send correlation callback if response channel is available
 		// Отправляем correlation callback если канал ответов доступен
 		if cm.responseChannel != nil {
@@ -190,8 +196,9 @@ func (cm *CorrelationManager) PublishMessage(
 				"correlation_key":     correlationKey,
 				"process_instance_id": result.ProcessInstanceID,
 				"subscription_id":     targetSubscription.ID,
+				"tenant_id":           targetSubscription.TenantID,
 				"variables":           variables,
-				"correlated_at":       time.Now().Format(time.RFC3339),
+				"correlated_at":       clock.Now().Format(time.RFC3339),
 			}
 
 			// For intermediate catch events, include token_id
@@ -200,6 +207,7 @@ func (cm *CorrelationManager) PublishMessage(
 				if waitingToken, err := cm.findWaitingToken(
 					targetSubscription.StartEventID,
 					messageName,
+					tenantID,
 				); err == nil && waitingToken != nil {
 					callback["token_id"] = waitingToken.TokenID
 				}
@@ -244,14 +252,14 @@ func (cm *CorrelationManager) PublishMessage(
 			Name:           messageName,
 			CorrelationKey: correlationKey,
 			Variables:      variables,
-			PublishedAt:    time.Now(),
-			BufferedAt:     time.Now(),
+			PublishedAt:    clock.Now(),
+			BufferedAt:     clock.Now(),
 			Reason:         "No active subscription found",
 			ElementID:      elementID,
 		}
 
 		if ttl != nil {
-			expiresAt := time.Now().Add(*ttl)
+			expiresAt := clock.Now().Add(*ttl)
 			bufferedMessage.ExpiresAt = &expiresAt
 		}
 
@@ -259,6 +267,7 @@ func (cm *CorrelationManager) PublishMessage(
 			cm.logger.Error("Failed to buffer message", logger.String("error", err.Error()))
 			result.ErrorMessage = fmt.Sprintf("failed to buffer message: %v", err)
 		} else {
+			metrics.MessagesPublished.WithLabelValues("buffered").Inc()
 			cm.logger.Info("Message buffered", logger.String("reason", bufferedMessage.Reason))
 		}
 	}
@@ -292,7 +301,7 @@ func (cm *CorrelationManager) CorrelateMessage(
 		CorrelationKey:    correlationKey,
 		ProcessInstanceID: processInstanceID,
 		Variables:         variables,
-		CreatedAt:         time.Now(),
+		CreatedAt:         clock.Now(),
 		InstanceCreated:   false, // Not creating new instance, correlating with existing
 	}
 
@@ -305,10 +314,17 @@ func (cm *CorrelationManager) CorrelateMessage(
 		return nil, fmt.Errorf("failed to save correlation result: %w", err)
 	}
 
+	metrics.MessagesPublished.WithLabelValues("correlated").Inc()
+	cm.publishCorrelated(result, "")
 	cm.logger.Info("Message correlated successfully", logger.String("messageId", messageID))
 	return result, nil
 }
 
+// sameTenant reports whether both tenant IDs denote same tenant, legacy default marker included
+func sameTenant(left, right string) bool {
+	return models.NormalizeTenantID(left) == models.NormalizeTenantID(right)
+}
+
 // GetStats returns message statistics
 func (cm *CorrelationManager) GetStats(ctx context.Context, tenantID string) (*MessageStats, error) {
 	cm.logger.Debug("Getting message stats")
@@ -340,7 +356,7 @@ func (cm *CorrelationManager) GetStats(ctx context.Context, tenantID string) (*M
 	}
 
 	// Get today's correlation results
-	today := time.Now().Format("2006-01-02")
+	today := clock.Now().Format("2006-01-02")
 	correlationResults, err := cm.storage.ListMessageCorrelationResults(ctx, tenantID, "", "", 1000, 0)
 	if err != nil {
 		cm.logger.Warn("Failed to get correlation results for stats", logger.String("error", err.Error()))
@@ -379,7 +395,7 @@ func (cm *CorrelationManager) CleanupExpiredData(ctx context.Context) (int, erro
 	cm.logger.Info("Cleaning up expired correlation data")
 
 	// Clean up old correlation results (older than 30 days)
-	cutoffDate := time.Now().AddDate(0, 0, -30)
+	cutoffDate := clock.Now().AddDate(0, 0, -30)
 
 	// Get all correlation results
 	results, err := cm.storage.ListMessageCorrelationResults(ctx, "", "", "", 1000, 0)
@@ -459,7 +475,7 @@ func (cm *CorrelationManager) isIntermediateCatchEvent(elementID string) bool {
 
 // findWaitingToken finds token waiting for message on specific element
 // Находит токен ожидающий сообщение на определенном элементе
-func (cm *CorrelationManager) findWaitingToken(elementID, messageName string) (*models.Token, error) {
+func (cm *CorrelationManager) findWaitingToken(elementID, messageName, tenantID string) (*models.Token, error) {
 	// Load all waiting tokens
 	// Загружаем все ожидающие токены
 	waitingTokens, err := cm.storage.LoadTokensByState(models.TokenStateWaiting)
@@ -470,7 +486,7 @@ func (cm *CorrelationManager) findWaitingToken(elementID, messageName string) (*
 	// Find token waiting for this message on this element
 	// Находим токен ожидающий это сообщение на этом элементе
 	for _, token := range waitingTokens {
-		if token.CurrentElementID == elementID {
+		if token.CurrentElementID == elementID && sameTenant(token.TenantID, tenantID) {
 			// Check if token is waiting for this message
 			// Проверяем ждет ли токен это сообщение
 			expectedWaiting := fmt.Sprintf("message:%s", messageName)
@@ -0,0 +1,51 @@
+/*
+This file is part of the AtomBPMN (R) project.
+Copyright (c) 2025 Matreska Market LLC (ООО «Matreska Market»).
+Authors: Matreska Team.
+
+This project is dual-licensed under AGPL-3.0 and AtomBPMN Commercial License.
+*/
+
+package messages
+
+import (
+	"strings"
+
+	"atom-engine/src/core/events"
+	"atom-engine/src/core/models"
+)
+
+// publishCorrelated publishes correlation of message with process instance or
+// message start event of process definition processKey
+// Публикует корреляцию сообщения с экземпляром процесса или стартовым событием
+func (cm *CorrelationManager) publishCorrelated(result *models.MessageCorrelationResult, processKey string) {
+	event := &events.Event{
+		Type:        events.TypeMessageCorrelated,
+		TenantID:    models.NormalizeTenantID(result.TenantID),
+		ProcessID:   processIDFromKey(processKey),
+		ProcessKey:  processKey,
+		MessageName: result.MessageName,
+		Attributes:  map[string]string{"message_id": result.MessageID},
+	}
+	if result.CorrelationKey != "" {
+		event.Attributes["correlation_key"] = result.CorrelationKey
+	}
+	if result.InstanceCreated {
+		// Instance of message start event is reported by its own started event
+		event.Attributes["instance_created"] = "true"
+	} else if instance, err := cm.storage.LoadProcessInstance(result.ProcessInstanceID); err == nil && instance != nil {
+		event.ProcessInstanceID = instance.InstanceID
+		event.ProcessID = instance.ProcessID
+		event.ProcessKey = instance.ProcessKey
+	}
+	events.Publish(event)
+}
+
+// processIDFromKey strips tenant prefix and version suffix from "<process_id>:v<version>" process key
+func processIDFromKey(processKey string) string {
+	_, processKey = models.SplitTenantScopedKey(processKey)
+	if index := strings.Index(processKey, ":v"); index >= 0 {
+		return processKey[:index]
+	}
+	return processKey
+}
@@ -11,8 +11,8 @@ package messages
 import (
 	"context"
 	"fmt"
-	"time"
 
+	"atom-engine/src/core/clock"
 	"atom-engine/src/core/logger"
 	"atom-engine/src/core/models"
 	"atom-engine/src/storage"
@@ -230,7 +230,7 @@ func (sm *SubscriptionManager) ActivateSubscription(ctx context.Context, subscri
 	}
 
 	subscription.IsActive = true
-	subscription.UpdatedAt = time.Now()
+	subscription.UpdatedAt = clock.Now()
 
 	if err := sm.storage.SaveProcessMessageSubscription(ctx, subscription); err != nil {
 		return fmt.Errorf("failed to activate subscription: %w", err)
@@ -259,7 +259,7 @@ func (sm *SubscriptionManager) DeactivateSubscription(ctx context.Context, subsc
 	}
 
 	subscription.IsActive = false
-	subscription.UpdatedAt = time.Now()
+	subscription.UpdatedAt = clock.Now()
 
 	if err := sm.storage.SaveProcessMessageSubscription(ctx, subscription); err != nil {
 		return fmt.Errorf("failed to deactivate subscription: %w", err)
@@ -18,6 +18,7 @@ import (
 	"time"
 
 	"atom-engine/src/core/config"
+	"atom-engine/src/core/events"
 	"atom-engine/src/core/logger"
 	"atom-engine/src/core/models"
 	"atom-engine/src/storage"
@@ -31,6 +32,14 @@ type Component struct {
 	parser          *BPMNParser
 	ready           bool
 	responseChannel chan string
+	listener        DeploymentListener
+}
+
+// DeploymentListener is notified after process definition is saved or deleted
+// Уведомляется после сохранения или удаления определения процесса
+type DeploymentListener interface {
+	ProcessDeployed(processKey string)
+	ProcessDeleted(processKey string)
 }
 
 // NewComponent creates new parser component
@@ -45,6 +54,12 @@ func NewComponent(cfg *config.Config, storage storage.Storage) *Component {
 	}
 }
 
+// SetDeploymentListener sets listener notified about deployments
+// Устанавливает слушателя уведомляемого о развертываниях
+func (c *Component) SetDeploymentListener(listener DeploymentListener) {
+	c.listener = listener
+}
+
 // Init initializes parser component
 // Инициализирует компонент парсера
 func (c *Component) Init() error {
@@ -84,16 +99,20 @@ func (c *Component) IsReady() bool {
 	return c.ready
 }
 
-// ParseBPMNContent parses BPMN content and saves to storage
-// Парсит содержимое BPMN и сохраняет в storage
-func (c *Component) ParseBPMNContent(bpmnContent, processID string, force bool) (*ParseResult, error) {
+// ParseBPMNContent parses BPMN content and saves to storage as tenant deployment
+// Парсит содержимое BPMN и сохраняет в storage как развертывание арендатора
+func (c *Component) ParseBPMNContent(bpmnContent, processID, tenantID string, force bool) (*ParseResult, error) {
 	if !c.ready {
 		return nil, fmt.Errorf("parser component not ready")
 	}
+	if err := models.ValidateTenantID(tenantID); err != nil {
+		return nil, err
+	}
 
 	logger.Info("Parsing BPMN content",
 		logger.String("content_length", fmt.Sprintf("%d", len(bpmnContent))),
 		logger.String("process_id", processID),
+		logger.String("tenant_id", tenantID),
 		logger.Bool("force", force))
 
 	// Parse BPMN content directly
@@ -105,6 +124,7 @@ func (c *Component) ParseBPMNContent(bpmnContent, processID string, force bool)
 	// Set additional metadata like in ParseBPMNFile
 	bpmnProcess.ParsedAt = time.Now()
 	bpmnProcess.Status = "active"
+	bpmnProcess.TenantID = tenantID
 
 	// Determine correct version number - prefer XML version if available
 	// Определяем правильный номер версии - предпочитаем версию из XML если доступна
@@ -118,7 +138,7 @@ func (c *Component) ParseBPMNContent(bpmnContent, processID string, force bool)
 	} else {
 		// Fall back to auto-increment version if no version in XML
 		// Откат к автоинкременту версии если нет версии в XML
-		maxVersion, err := c.storage.GetMaxProcessVersionByProcessID(bpmnProcess.ProcessID)
+		maxVersion, err := c.storage.GetMaxProcessVersionByProcessID(tenantID, bpmnProcess.ProcessID)
 		if err != nil {
 			logger.Warn("Failed to get max version for process",
 				logger.String("process_id", bpmnProcess.ProcessID),
@@ -140,12 +160,17 @@ func (c *Component) ParseBPMNContent(bpmnContent, processID string, force bool)
 		return nil, fmt.Errorf("failed to convert to JSON: %w", err)
 	}
 
-	// Save to storage using processID:v{version} format
-	storageKey := fmt.Sprintf("%s:v%d", bpmnProcess.ProcessID, bpmnProcess.ProcessVersion)
+	// Save to storage using tenant/processID:v{version} format
+	storageKey := models.TenantScopedKey(tenantID,
+		fmt.Sprintf("%s:v%d", bpmnProcess.ProcessID, bpmnProcess.ProcessVersion))
 	err = c.storage.SaveBPMNProcess(storageKey, jsonData)
 	if err != nil {
 		return nil, fmt.Errorf("failed to save BPMN process to storage: %w", err)
 	}
+	publishDeploymentEvent(events.TypeDeploymentCreated, storageKey)
+	if c.listener != nil {
+		c.listener.ProcessDeployed(storageKey)
+	}
 
 	// Save original content to filesystem (configured directory)
 	err = c.saveOriginalFile(bpmnProcess, []byte(bpmnContent))
@@ -167,6 +192,7 @@ func (c *Component) ParseBPMNContent(bpmnContent, processID string, force bool)
 		ProcessID:      bpmnProcess.ProcessID,
 		ProcessName:    bpmnProcess.ProcessName,
 		ProcessVersion: bpmnProcess.ProcessVersion,
+		TenantID:       bpmnProcess.TenantID,
 		TotalElements:  totalElements,
 		ElementCounts:  bpmnProcess.ElementCounts,
 		Success:        true,
@@ -181,12 +207,15 @@ func (c *Component) ParseBPMNContent(bpmnContent, processID string, force bool)
 	return result, nil
 }
 
-// ParseBPMNFile parses BPMN file and saves to storage
-// Парсит BPMN файл и сохраняет в storage
-func (c *Component) ParseBPMNFile(filePath, processID string, force bool) (*ParseResult, error) {
+// ParseBPMNFile parses BPMN file and saves to storage as tenant deployment
+// Парсит BPMN файл и сохраняет в storage как развертывание арендатора
+func (c *Component) ParseBPMNFile(filePath, processID, tenantID string, force bool) (*ParseResult, error) {
 	if !c.ready {
 		return nil, fmt.Errorf("parser component not ready")
 	}
+	if err := models.ValidateTenantID(tenantID); err != nil {
+		return nil, err
+	}
 
 	// Check if file exists
 	// Проверка существования файла
@@ -197,6 +226,7 @@ func (c *Component) ParseBPMNFile(filePath, processID string, force bool) (*Pars
 	logger.Info("Parsing BPMN file",
 		logger.String("file", filePath),
 		logger.String("process_id", processID),
+		logger.String("tenant_id", tenantID),
 		logger.Bool("force", force))
 
 	// Parse BPMN file
@@ -217,6 +247,7 @@ func (c *Component) ParseBPMNFile(filePath, processID string, force bool) (*Pars
 	// Установка дополнительных метаданных
 	bpmnProcess.ParsedAt = time.Now()
 	bpmnProcess.Status = "active"
+	bpmnProcess.TenantID = tenantID
 
 	// Determine correct version number - prefer XML version if available
 	// Определяем правильный номер версии - предпочитаем версию из XML если доступна
@@ -230,7 +261,7 @@ func (c *Component) ParseBPMNFile(filePath, processID string, force bool) (*Pars
 	} else {
 		// Fall back to auto-increment version if no version in XML
 		// Откат к автоинкременту версии если нет версии в XML
-		maxVersion, err := c.storage.GetMaxProcessVersionByProcessID(bpmnProcess.ProcessID)
+		maxVersion, err := c.storage.GetMaxProcessVersionByProcessID(tenantID, bpmnProcess.ProcessID)
 		if err != nil {
 			logger.Warn("Failed to get max version for process",
 				logger.String("process_id", bpmnProcess.ProcessID),
@@ -253,13 +284,18 @@ func (c *Component) ParseBPMNFile(filePath, processID string, force bool) (*Pars
 		return nil, fmt.Errorf("failed to convert to JSON: %w", err)
 	}
 
-	// Save to storage using processID:v{version} format
-	// Сохранение в storage с форматом processID:v{version}
-	storageKey := fmt.Sprintf("%s:v%d", bpmnProcess.ProcessID, bpmnProcess.ProcessVersion)
+	// Save to storage using tenant/processID:v{version} format
+	// Сохранение в storage с форматом tenant/processID:v{version}
+	storageKey := models.TenantScopedKey(tenantID,
+		fmt.Sprintf("%s:v%d", bpmnProcess.ProcessID, bpmnProcess.ProcessVersion))
 	err = c.storage.SaveBPMNProcess(storageKey, jsonData)
 	if err != nil {
 		return nil, fmt.Errorf("failed to save BPMN process to storage: %w", err)
 	}
+	publishDeploymentEvent(events.TypeDeploymentCreated, storageKey)
+	if c.listener != nil {
+		c.listener.ProcessDeployed(storageKey)
+	}
 
 	// Save original file to filesystem (configured directory)
 	// Сохранение оригинального файла в файловую систему (настроенная директория)
@@ -297,6 +333,7 @@ func (c *Component) ParseBPMNFile(filePath, processID string, force bool) (*Pars
 		ProcessID:      bpmnProcess.ProcessID,
 		ProcessName:    bpmnProcess.ProcessName,
 		ProcessVersion: bpmnProcess.ProcessVersion,
+		TenantID:       bpmnProcess.TenantID,
 		TotalElements:  bpmnProcess.GetTotalElements(),
 		ElementCounts:  bpmnProcess.ElementCounts,
 		ParsedAt:       bpmnProcess.ParsedAt,
@@ -345,6 +382,7 @@ func (c *Component) ListBPMNProcesses(limit int) ([]*ProcessInfo, error) {
 			ProcessName:    bpmnProcess.ProcessName,
 			Version:        bpmnProcess.Version,
 			ProcessVersion: bpmnProcess.ProcessVersion,
+			TenantID:       bpmnProcess.TenantID,
 			Status:         bpmnProcess.Status,
 			TotalElements:  bpmnProcess.GetTotalElements(),
 			ParsedAt:       bpmnProcess.ParsedAt,
@@ -419,6 +457,10 @@ func (c *Component) DeleteBPMNProcess(processID string) error {
 	if err != nil {
 		return fmt.Errorf("failed to delete BPMN process: %w", err)
 	}
+	publishDeploymentEvent(events.TypeDeploymentDeleted, processID)
+	if c.listener != nil {
+		c.listener.ProcessDeleted(processID)
+	}
 
 	// Log deletion
 	// Логирование удаления
@@ -503,10 +545,16 @@ func (c *Component) getBPMNPath() string {
 	return "bpmn_test"
 }
 
+// tenantBPMNPath returns BPMN directory of tenant, default tenant uses configured directory itself
+// Возвращает директорию BPMN арендатора
+func (c *Component) tenantBPMNPath(tenantID string) string {
+	return filepath.Join(c.getBPMNPath(), tenantID)
+}
+
 // saveOriginalFile saves original BPMN file to configured directory
 // Сохраняет оригинальный BPMN файл в настроенную директорию
 func (c *Component) saveOriginalFile(bpmnProcess *models.BPMNProcess, content []byte) error {
-	bpmnPath := c.getBPMNPath()
+	bpmnPath := c.tenantBPMNPath(bpmnProcess.TenantID)
 
 	// Ensure BPMN directory exists
 	// Убеждаемся что BPMN директория существует
@@ -534,7 +582,7 @@ func (c *Component) saveOriginalFile(bpmnProcess *models.BPMNProcess, content []
 // saveJSONFile saves parsed JSON to configured directory
 // Сохраняет спарсенный JSON в настроенную директорию
 func (c *Component) saveJSONFile(bpmnProcess *models.BPMNProcess, jsonData []byte) error {
-	bpmnPath := c.getBPMNPath()
+	bpmnPath := c.tenantBPMNPath(bpmnProcess.TenantID)
 
 	// Ensure BPMN directory exists
 	// Убеждаемся что BPMN директория существует
@@ -566,6 +614,7 @@ type ParseResult struct {
 	ProcessID      string         `json:"process_id"`
 	ProcessName    string         `json:"process_name"`
 	ProcessVersion int            `json:"process_version"`
+	TenantID       string         `json:"tenant_id,omitempty"`
 	TotalElements  int            `json:"total_elements"`
 	ElementCounts  map[string]int `json:"element_counts"`
 	ParsedAt       time.Time      `json:"parsed_at"`
@@ -580,6 +629,7 @@ type ProcessInfo struct {
 	ProcessName    string    `json:"process_name"`
 	Version        string    `json:"version"`
 	ProcessVersion int       `json:"process_version"`
+	TenantID       string    `json:"tenant_id,omitempty"`
 	Status         string    `json:"status"`
 	TotalElements  int       `json:"total_elements"`
 	ParsedAt       time.Time `json:"parsed_at"`
@@ -648,7 +698,7 @@ func (c *Component) handleParseBPMNFile(ctx context.Context, request ParserReque
 		return c.sendResponse(response)
 	}
 
-	result, err := c.ParseBPMNFile(payload.FilePath, payload.ProcessID, payload.Force)
+	result, err := c.ParseBPMNFile(payload.FilePath, payload.ProcessID, payload.TenantID, payload.Force)
 
 	var response ParserResponse
 	if err != nil {
@@ -659,6 +709,7 @@ func (c *Component) handleParseBPMNFile(ctx context.Context, request ParserReque
 			ProcessID:      result.ProcessID,
 			ProcessName:    result.ProcessName,
 			ProcessVersion: result.ProcessVersion, // Extracted from BPMN XML
+			TenantID:       result.TenantID,
 			ElementsCount:  result.TotalElements,
 			Success:        result.Success,
 			Message:        "BPMN file parsed successfully",
@@ -684,7 +735,7 @@ func (c *Component) handleParseBPMNContent(ctx context.Context, request ParserRe
 		return c.sendResponse(response)
 	}
 
-	result, err := c.ParseBPMNContent(payload.BPMNContent, payload.ProcessID, payload.Force)
+	result, err := c.ParseBPMNContent(payload.BPMNContent, payload.ProcessID, payload.TenantID, payload.Force)
 
 	var response ParserResponse
 	if err != nil {
@@ -694,6 +745,7 @@ func (c *Component) handleParseBPMNContent(ctx context.Context, request ParserRe
 			ProcessKey:     result.BPMNID,
 			ProcessID:      result.ProcessID,
 			ProcessVersion: result.ProcessVersion, // Extracted from BPMN XML
+			TenantID:       result.TenantID,
 			ElementsCount:  result.TotalElements,
 			Success:        result.Success,
 			Message:        "BPMN content parsed successfully",
@@ -722,10 +774,10 @@ func (c *Component) handleValidateBPMN(ctx context.Context, request ParserReques
 	// Validation by parsing - validates XML structure and BPMN elements
 	var err error
 	if payload.FilePath != "" {
-		_, err = c.ParseBPMNFile(payload.FilePath, "", false)
+		_, err = c.ParseBPMNFile(payload.FilePath, "", models.DefaultTenantID, false)
 	} else if payload.BPMNContent != "" {
 		// Use existing ParseBPMNContent for content validation
-		_, err = c.ParseBPMNContent(payload.BPMNContent, "", false)
+		_, err = c.ParseBPMNContent(payload.BPMNContent, "", models.DefaultTenantID, false)
 	} else {
 		err = fmt.Errorf("neither file path nor content provided for validation")
 	}
@@ -766,6 +818,7 @@ func (c *Component) handleGetProcessInfo(ctx context.Context, request ParserRequ
 			ProcessKey:     bpmnProcess.BPMNID,
 			ProcessID:      bpmnProcess.ProcessID,
 			ProcessVersion: bpmnProcess.ProcessVersion,
+			TenantID:       bpmnProcess.TenantID,
 			Name:           bpmnProcess.ProcessName,
 			ElementsCount:  bpmnProcess.GetTotalElements(),
 			Status:         bpmnProcess.Status,
@@ -805,6 +858,7 @@ func (c *Component) handleListProcesses(ctx context.Context, request ParserReque
 				ProcessKey:     p.BPMNID,
 				ProcessID:      p.ProcessID,
 				ProcessVersion: p.ProcessVersion,
+				TenantID:       p.TenantID,
 				Name:           p.ProcessName,
 				ElementsCount:  p.TotalElements,
 				Status:         p.Status,
@@ -929,7 +983,7 @@ func (c *Component) GetBPMNProcessXML(processKey string) ([]byte, error) {
 
 	// Build file path using ProcessID and Version
 	// Строим путь к файлу используя ProcessID и Version
-	bpmnPath := c.getBPMNPath()
+	bpmnPath := c.tenantBPMNPath(processDetails.TenantID)
 	filename := fmt.Sprintf("%s_v%d.bpmn", processDetails.ProcessID, processDetails.ProcessVersion)
 	filePath := filepath.Join(bpmnPath, filename)
 
@@ -0,0 +1,37 @@
+/*
+This file is part of the AtomBPMN (R) project.
+Copyright (c) 2025 Matreska Market LLC (ООО «Matreska Market»).
+Authors: Matreska Team.
+
+This project is dual-licensed under AGPL-3.0 and AtomBPMN Commercial License.
+*/
+
+package parser
+
+import (
+	"strconv"
+	"strings"
+
+	"atom-engine/src/core/events"
+	"atom-engine/src/core/models"
+)
+
+// publishDeploymentEvent publishes deployment or deletion of process
+// definition stored under tenant/processID:vN key
+// Публикует развертывание или удаление определения процесса
+func publishDeploymentEvent(eventType, processKey string) {
+	tenantID, key := models.SplitTenantScopedKey(processKey)
+	event := &events.Event{
+		Type:       eventType,
+		TenantID:   models.NormalizeTenantID(tenantID),
+		ProcessID:  key,
+		ProcessKey: processKey,
+	}
+	if i := strings.LastIndex(key, ":v"); i > 0 {
+		event.ProcessID = key[:i]
+		if _, err := strconv.Atoi(key[i+2:]); err == nil {
+			event.Attributes = map[string]string{"version": key[i+2:]}
+		}
+	}
+	events.Publish(event)
+}
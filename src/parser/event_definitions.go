@@ -156,6 +156,14 @@ func (p *EventDefinitionParser) Parse(element *XMLElement, context *ParseContext
 func (p *EventDefinitionParser) parseTimerEventDefinition(element *XMLElement) map[string]interface{} {
 	timer := make(map[string]interface{})
 
+	// Business calendar, e.g. atom:calendar="support"
+	// Бизнес-календарь, например atom:calendar="support"
+	for _, attr := range element.Attributes {
+		if attr.Name.Local == "calendar" {
+			timer["calendar"] = attr.Value
+		}
+	}
+
 	for _, child := range element.Children {
 		switch child.XMLName.Local {
 		case "timeDuration":
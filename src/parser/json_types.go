@@ -31,6 +31,7 @@ type ParserResponse struct {
 type ParseBPMNFilePayload struct {
 	FilePath  string `json:"file_path"`
 	ProcessID string `json:"process_id,omitempty"`
+	TenantID  string `json:"tenant_id,omitempty"`
 	Force     bool   `json:"force,omitempty"`
 }
 
@@ -39,6 +40,7 @@ type ParseBPMNFilePayload struct {
 type ParseBPMNContentPayload struct {
 	BPMNContent string `json:"bpmn_content"`
 	ProcessID   string `json:"process_id,omitempty"`
+	TenantID    string `json:"tenant_id,omitempty"`
 	Force       bool   `json:"force,omitempty"`
 }
 
@@ -76,6 +78,7 @@ type JSONParseResult struct {
 	ProcessID        string                 `json:"process_id"`
 	ProcessName      string                 `json:"process_name"`
 	ProcessVersion   int                    `json:"process_version"`
+	TenantID         string                 `json:"tenant_id,omitempty"`
 	ElementsCount    int                    `json:"elements_count"`
 	Success          bool                   `json:"success"`
 	Message          string                 `json:"message,omitempty"`
@@ -100,6 +103,7 @@ type ProcessInfoResult struct {
 	ProcessKey     string                 `json:"process_key"`
 	ProcessID      string                 `json:"process_id"`
 	ProcessVersion int                    `json:"process_version"`
+	TenantID       string                 `json:"tenant_id,omitempty"`
 	Name           string                 `json:"name,omitempty"`
 	ElementsCount  int                    `json:"elements_count"`
 	Status         string                 `json:"status"`
@@ -145,13 +145,14 @@ func (p *BPMNParser) registerElementParsers() {
 		p.elementParsers[eventDefType] = eventDefParser
 	}
 
-	// Metadata parser for all zeebe extension and metadata elements
+	// Metadata parser for all zeebe extension and metadata elements.
+	// zeebe:userTask is not registered, it would shadow bpmn:userTask parser
 	// Парсер метаданных для всех элементов расширения zeebe и метаданных
 	metadataParser := NewMetadataParser()
 	metadataTypes := []string{
 		"properties", "property", "taskDefinition", "subscription", "formDefinition",
 		"calledElement", "ioMapping", "input", "output", "header", "script",
-		"assignmentDefinition", "userTask",
+		"assignmentDefinition",
 	}
 	for _, metadataType := range metadataTypes {
 		p.elementParsers[metadataType] = metadataParser
@@ -148,6 +148,17 @@ func (p *BPMNParser) extractProcessIDFromXML(root *XMLElement) string {
 	return p.findElementAttribute(root, "process", "id")
 }
 
+// ExtractProcessID returns BPMN process ID declared in content without parsing elements
+// Возвращает BPMN ID процесса, объявленный в содержимом, без разбора элементов
+func ExtractProcessID(content []byte) (string, error) {
+	p := &BPMNParser{}
+	root, err := p.parseXMLStructure(content)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse XML structure: %w", err)
+	}
+	return p.extractProcessIDFromXML(root), nil
+}
+
 // extractProcessNameFromXML extracts process name from XML root
 // Извлекает имя процесса из корня XML
 func (p *BPMNParser) extractProcessNameFromXML(root *XMLElement) string {
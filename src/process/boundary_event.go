@@ -10,8 +10,8 @@ package process
 
 import (
 	"fmt"
-	"time"
 
+	"atom-engine/src/core/clock"
 	"atom-engine/src/core/logger"
 	"atom-engine/src/core/models"
 )
@@ -172,14 +172,14 @@ func (bee *BoundaryEventExecutor) handleMessageBoundaryEvent(
 	if bee.processComponent != nil && messageName != "" {
 		subscription := &models.ProcessMessageSubscription{
 			ID:                   models.GenerateID(),
-			TenantID:             "", // Default tenant
+			TenantID:             token.TenantID,
 			ProcessDefinitionKey: token.ProcessKey,
 			StartEventID:         token.CurrentElementID, // Use current element as reference
 			MessageName:          messageName,
 			CorrelationKey:       correlationKey,
 			IsActive:             true,
-			CreatedAt:            time.Now(),
-			UpdatedAt:            time.Now(),
+			CreatedAt:            clock.Now(),
+			UpdatedAt:            clock.Now(),
 		}
 
 		if err := bee.processComponent.CreateMessageSubscription(subscription); err != nil {
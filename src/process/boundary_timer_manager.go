@@ -65,9 +65,11 @@ func (btm *BoundaryTimerManager) CreateBoundaryTimer(timerRequest *TimerRequest)
 
 	// Get process version from ProcessInstanceID
 	processVersion := 1 // Default fallback
+	tenantID := models.DefaultTenantID
 	if btm.storage != nil {
 		if instance, err := btm.storage.LoadProcessInstance(timerRequest.ProcessInstanceID); err == nil && instance != nil {
 			processVersion = instance.ProcessVersion
+			tenantID = instance.TenantID
 		}
 	}
 
@@ -81,6 +83,7 @@ func (btm *BoundaryTimerManager) CreateBoundaryTimer(timerRequest *TimerRequest)
 			ProcessKey:      timerRequest.ProcessKey,
 			ProcessVersion:  processVersion, // Use actual version from process instance
 			ProcessName:     "Boundary Timer",
+			TenantID:        tenantID,
 			ComponentSource: "process",
 		},
 	}
@@ -95,6 +98,7 @@ func (btm *BoundaryTimerManager) CreateBoundaryTimer(timerRequest *TimerRequest)
 	} else {
 		return fmt.Errorf("no timer definition provided")
 	}
+	twRequest.Calendar = timerRequest.Calendar
 
 	// Set boundary timer metadata for proper scope tracking
 	// Устанавливаем метаданные boundary timer для правильного отслеживания scope
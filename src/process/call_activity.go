@@ -107,8 +107,9 @@ func (cae *CallActivityExecutor) Execute(
 		}, nil
 	}
 
-	// Start child process instance with evaluated variables
-	childInstance, err := cae.component.StartProcessInstance(calledProcessID, evaluatedVariables)
+	// Start child process instance of same tenant with evaluated variables
+	childInstance, err := cae.component.StartProcessInstance(
+		elementContext(token.TokenID), calledProcessID, token.TenantID, evaluatedVariables)
 	if err != nil {
 		logger.Error("Failed to start child process",
 			logger.String("token_id", token.TokenID),
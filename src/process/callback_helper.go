@@ -74,6 +74,7 @@ func (ch *CallbackHelper) ProcessCallbackAndContinue(
 	token.ClearWaitingFor()
 	if variables != nil {
 		token.MergeVariables(variables)
+		publishTokenVariableEvent(ch.storage, token, variables)
 	}
 
 	// Cancel boundary timers when token leaves activity (Service Task, etc.)
@@ -119,6 +120,7 @@ func (ch *CallbackHelper) ProcessCallbackAndContinue(
 		logger.String("element_id_param", elementID),
 		logger.String("using_element_id", currentElementID))
 
+	publishElementCompleted(ch.tokenMovement.bpmnHelper, token, currentElementID)
 	if err := ch.tokenMovement.MoveTokenToNextElements(token, currentElementID); err != nil {
 		logger.Error("DEBUG: Failed to move token to next elements",
 			logger.String("token_id", token.TokenID),
@@ -149,6 +151,7 @@ func (ch *CallbackHelper) ProcessCallbackAndContinueWithFlows(
 	token.ClearWaitingFor()
 	if variables != nil {
 		token.MergeVariables(variables)
+		publishTokenVariableEvent(ch.storage, token, variables)
 	}
 
 	// Cancel boundary timers
@@ -190,6 +193,7 @@ func (ch *CallbackHelper) ProcessCallbackAndContinueWithFlows(
 
 	// Move token to first target element
 	if len(targetElements) > 0 {
+		publishElementCompleted(ch.tokenMovement.bpmnHelper, token, token.CurrentElementID)
 		token.MoveTo(targetElements[0])
 		if err := ch.storage.UpdateToken(token); err != nil {
 			return fmt.Errorf("failed to update token: %w", err)
@@ -13,12 +13,12 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
-	"reflect"
 	"strings"
-	"time"
 
+	"atom-engine/src/core/clock"
 	"atom-engine/src/core/logger"
 	"atom-engine/src/core/models"
+	"atom-engine/src/jobs"
 	"atom-engine/src/storage"
 )
 
@@ -44,7 +44,11 @@ type ComponentInterface interface {
 	IsReady() bool
 
 	// Process management
-	StartProcessInstance(processKey string, variables map[string]interface{}) (*models.ProcessInstance, error)
+	StartProcessInstance(
+		ctx context.Context,
+		processKey, tenantID string,
+		variables map[string]interface{},
+	) (*models.ProcessInstance, error)
 	GetProcessInstanceStatus(instanceID string) (*models.ProcessInstance, error)
 	CancelProcessInstance(instanceID string, reason string) error
 	ListProcessInstances(statusFilter string, processKeyFilter string, limit int) ([]*models.ProcessInstance, error)
@@ -75,11 +79,11 @@ type ComponentInterface interface {
 
 	// Message management
 	HandleMessageCallback(
-		messageID, messageName, correlationKey, tokenID string,
+		messageID, messageName, correlationKey, tokenID, tenantID string,
 		variables map[string]interface{},
 	) error
 	HandleEngineMessageCallback(
-		messageID, messageName, correlationKey, tokenID string,
+		messageID, messageName, correlationKey, tokenID, tenantID string,
 		variables map[string]interface{},
 	) error
 	CheckBufferedMessages(
@@ -143,6 +147,10 @@ type TimerRequest struct {
 	TimeDate     *string `json:"time_date,omitempty"`     // "2025-12-31T23:59:59Z"
 	TimeCycle    *string `json:"time_cycle,omitempty"`    // "R3/PT20S"
 
+	// Business calendar name from timer definition
+	// Имя бизнес-календаря из определения таймера
+	Calendar *string `json:"calendar,omitempty"`
+
 	// Boundary timer specific metadata
 	AttachedToRef  *string `json:"attached_to_ref,omitempty"` // Element ID this boundary timer is attached to
 	CancelActivity *bool   `json:"cancel_activity,omitempty"` // Whether this is interrupting boundary timer
@@ -381,10 +389,11 @@ func (c *Component) IsReady() bool {
 // Делегирование ProcessManagerInterface
 
 func (c *Component) StartProcessInstance(
-	processKey string,
+	ctx context.Context,
+	processKey, tenantID string,
 	variables map[string]interface{},
 ) (*models.ProcessInstance, error) {
-	return c.processManager.StartProcessInstance(processKey, variables)
+	return c.processManager.StartProcessInstance(ctx, processKey, tenantID, variables)
 }
 
 func (c *Component) GetProcessInstanceStatus(instanceID string) (*models.ProcessInstance, error) {
@@ -488,6 +497,34 @@ func (c *Component) GetBPMNProcessForToken(token *models.Token) (map[string]inte
 	return c.timerManager.GetBPMNProcessForToken(token)
 }
 
+func (c *Component) ScheduleStartTimers(processKey string) error {
+	return c.timerManager.ScheduleStartTimers(processKey)
+}
+
+func (c *Component) CancelStartTimers(processKey string) error {
+	return c.timerManager.CancelStartTimers(processKey)
+}
+
+// ProcessDeployed schedules timer start events of deployed definition
+// Планирует таймерные стартовые события развернутого определения
+func (c *Component) ProcessDeployed(processKey string) {
+	if err := c.ScheduleStartTimers(processKey); err != nil {
+		logger.Error("Failed to schedule timer start events",
+			logger.String("process_key", processKey),
+			logger.String("error", err.Error()))
+	}
+}
+
+// ProcessDeleted cancels timer start events of deleted definition
+// Отменяет таймерные стартовые события удаленного определения
+func (c *Component) ProcessDeleted(processKey string) {
+	if err := c.CancelStartTimers(processKey); err != nil {
+		logger.Error("Failed to cancel timer start events",
+			logger.String("process_key", processKey),
+			logger.String("error", err.Error()))
+	}
+}
+
 // Gateway synchronization methods implementation
 // Реализация методов синхронизации шлюзов
 func (c *Component) SaveGatewaySyncState(state *models.GatewaySyncState) error {
@@ -546,6 +583,16 @@ func (c *Component) CancelJobByID(jobID string) error {
 	return fmt.Errorf("job manager does not support job cancellation")
 }
 
+// instanceJobsComponent is jobs component API used to cancel jobs of
+// process instance, asserted at compile time so signature changes break build
+// API jobs компонента для отмены job'ов экземпляра процесса
+type instanceJobsComponent interface {
+	ListJobs(jobType, worker, processInstanceID, state, tenantID string, limit, offset int) ([]jobs.JobInfo, int, error)
+	CancelJob(jobID, reason string) error
+}
+
+var _ instanceJobsComponent = (*jobs.Component)(nil)
+
 // CancelAllJobsForProcessInstance cancels all active jobs for process instance
 // Отменяет все активные job для экземпляра процесса
 func (c *Component) CancelAllJobsForProcessInstance(instanceID string, reason string) error {
@@ -556,165 +603,44 @@ func (c *Component) CancelAllJobsForProcessInstance(instanceID string, reason st
 		return fmt.Errorf("core interface not available")
 	}
 
-	jobsComp := core.GetJobsComponent()
-	if jobsComp == nil {
+	jobsComp, ok := core.GetJobsComponent().(instanceJobsComponent)
+	if !ok {
 		return fmt.Errorf("jobs component not available")
 	}
 
-	// Use reflection to call ListJobs and CancelJob methods
-	// Используем рефлексию для вызова методов ListJobs и CancelJob
-	jobsCompValue := reflect.ValueOf(jobsComp)
-	if !jobsCompValue.IsValid() {
-		return fmt.Errorf("invalid jobs component")
-	}
-
-	// Call ListJobs method using reflection
-	// Вызываем метод ListJobs используя рефлексию
-	listJobsMethod := jobsCompValue.MethodByName("ListJobs")
-	if !listJobsMethod.IsValid() {
-		return fmt.Errorf("ListJobs method not found in jobs component")
-	}
-
-	// Prepare arguments for ListJobs
-	// Подготавливаем аргументы для ListJobs
-	args := []reflect.Value{
-		reflect.ValueOf(""),              // jobType
-		reflect.ValueOf(""),              // worker
-		reflect.ValueOf(instanceID),      // processInstanceID
-		reflect.ValueOf(""),              // state
-		reflect.ValueOf(10000),           // limit
-		reflect.ValueOf(0),               // offset
-	}
-
-	// Call ListJobs
-	// Вызываем ListJobs
-	results := listJobsMethod.Call(args)
-	if len(results) != 3 {
-		return fmt.Errorf("ListJobs returned unexpected number of results")
-	}
-
-	// Check for error
-	// Проверяем на ошибку
-	if !results[2].IsNil() {
-		err, ok := results[2].Interface().(error)
-		if ok && err != nil {
-			return fmt.Errorf("failed to list jobs: %w", err)
-		}
+	// Instance jobs of any tenant
+	// Job'ы экземпляра любого тенанта
+	instanceJobs, total, err := jobsComp.ListJobs("", "", instanceID, "", "", 10000, 0)
+	if err != nil {
+		return fmt.Errorf("failed to list jobs: %w", err)
 	}
-
-	// Get total count
-	// Получаем общее количество
-	total := int(results[1].Int())
 	if total == 0 {
 		logger.Debug("No jobs found for process instance",
 			logger.String("instance_id", instanceID))
 		return nil
 	}
 
-	// Get jobs slice
-	// Получаем slice job
-	jobsResult := results[0]
-	if jobsResult.Kind() != reflect.Slice {
-		return fmt.Errorf("jobs result is not a slice")
-	}
-
-	rv := jobsResult
-
-	// Filter active jobs (PENDING, RUNNING) and cancel them
-	// Фильтруем активные job (PENDING, RUNNING) и отменяем их
-	activeStatuses := map[string]bool{
-		"PENDING": true,
-		"RUNNING": true,
-	}
-
+	// Cancel active jobs (PENDING, RUNNING)
+	// Отменяем активные job (PENDING, RUNNING)
 	canceledCount := 0
-	for i := 0; i < rv.Len(); i++ {
-		jobValue := rv.Index(i)
-		if !jobValue.IsValid() {
-			continue
-		}
-
-		// Extract job key and status using reflection
-		// Извлекаем key и status job используя рефлексию
-		var jobKey string
-		var jobStatus string
-
-		if jobValue.Kind() == reflect.Ptr {
-			jobValue = jobValue.Elem()
-		}
-
-		if jobValue.Kind() == reflect.Struct {
-			// Try to get Key field
-			// Пробуем получить поле Key
-			if keyField := jobValue.FieldByName("Key"); keyField.IsValid() && keyField.Kind() == reflect.String {
-				jobKey = keyField.String()
-			}
-			// Try to get Status field
-			// Пробуем получить поле Status
-			if statusField := jobValue.FieldByName("Status"); statusField.IsValid() && statusField.Kind() == reflect.String {
-				jobStatus = statusField.String()
-			}
-		} else if jobValue.Kind() == reflect.Map {
-			// Handle map case
-			// Обрабатываем случай map
-			if keyVal := jobValue.MapIndex(reflect.ValueOf("key")); keyVal.IsValid() {
-				if keyVal.Kind() == reflect.String {
-					jobKey = keyVal.String()
-				}
-			} else if keyVal := jobValue.MapIndex(reflect.ValueOf("Key")); keyVal.IsValid() {
-				if keyVal.Kind() == reflect.String {
-					jobKey = keyVal.String()
-				}
-			}
-			if statusVal := jobValue.MapIndex(reflect.ValueOf("status")); statusVal.IsValid() {
-				if statusVal.Kind() == reflect.String {
-					jobStatus = statusVal.String()
-				}
-			} else if statusVal := jobValue.MapIndex(reflect.ValueOf("Status")); statusVal.IsValid() {
-				if statusVal.Kind() == reflect.String {
-					jobStatus = statusVal.String()
-				}
-			}
-		}
-
-		if jobKey == "" {
+	for _, job := range instanceJobs {
+		if job.Key == "" {
 			continue
 		}
-
-		// Check if job is active
-		// Проверяем, активен ли job
-		if !activeStatuses[jobStatus] {
+		if job.Status != string(models.JobStatusPending) && job.Status != string(models.JobStatusRunning) {
 			continue
 		}
 
-		// Cancel the job using reflection
-		// Отменяем job используя рефлексию
-		cancelJobMethod := jobsCompValue.MethodByName("CancelJob")
-		if !cancelJobMethod.IsValid() {
-			logger.Error("CancelJob method not found in jobs component",
-				logger.String("job_id", jobKey))
+		if err := jobsComp.CancelJob(job.Key, reason); err != nil {
+			logger.Error("Failed to cancel job",
+				logger.String("job_id", job.Key),
+				logger.String("instance_id", instanceID),
+				logger.String("error", err.Error()))
+			// Continue canceling other jobs
+			// Продолжаем отмену остальных job
 			continue
 		}
 
-		cancelArgs := []reflect.Value{
-			reflect.ValueOf(jobKey),
-			reflect.ValueOf(reason),
-		}
-
-		cancelResults := cancelJobMethod.Call(cancelArgs)
-		if len(cancelResults) > 0 && !cancelResults[0].IsNil() {
-			err, ok := cancelResults[0].Interface().(error)
-			if ok && err != nil {
-				logger.Error("Failed to cancel job",
-					logger.String("job_id", jobKey),
-					logger.String("instance_id", instanceID),
-					logger.String("error", err.Error()))
-				// Continue canceling other jobs
-				// Продолжаем отмену остальных job
-				continue
-			}
-		}
-
 		canceledCount++
 	}
 
@@ -731,17 +657,17 @@ func (c *Component) CancelAllJobsForProcessInstance(instanceID string, reason st
 // Делегирование MessageCallbackManagerInterface
 
 func (c *Component) HandleMessageCallback(
-	messageID, messageName, correlationKey, tokenID string,
+	messageID, messageName, correlationKey, tokenID, tenantID string,
 	variables map[string]interface{},
 ) error {
-	return c.messageManager.HandleMessageCallback(messageID, messageName, correlationKey, tokenID, variables)
+	return c.messageManager.HandleMessageCallback(messageID, messageName, correlationKey, tokenID, tenantID, variables)
 }
 
 func (c *Component) HandleEngineMessageCallback(
-	messageID, messageName, correlationKey, tokenID string,
+	messageID, messageName, correlationKey, tokenID, tenantID string,
 	variables map[string]interface{},
 ) error {
-	return c.engine.HandleMessageCallback(messageID, messageName, correlationKey, tokenID, variables)
+	return c.engine.HandleMessageCallback(messageID, messageName, correlationKey, tokenID, tenantID, variables)
 }
 
 func (c *Component) CheckBufferedMessages(messageName, correlationKey string) (*models.BufferedMessage, error) {
@@ -820,7 +746,7 @@ func main() {
 	response := map[string]interface{}{
 		"success":   true,
 		"message":   "process component ready",
-		"timestamp": time.Now().Unix(),
+		"timestamp": clock.Now().Unix(),
 	}
 
 	responseJSON, _ := json.Marshal(response)
@@ -378,6 +378,12 @@ func (ece *EmailConnectorExecutor) setConfigValue(config *EmailConnectorConfig,
 	}
 }
 
+// SendEmail sends email via SMTP using connector sender
+// Отправляет письмо через SMTP используя отправителя коннектора
+func SendEmail(config *EmailConnectorConfig) (*EmailResponse, error) {
+	return (&EmailConnectorExecutor{}).sendEmail(config)
+}
+
 // sendEmail sends email via SMTP
 func (ece *EmailConnectorExecutor) sendEmail(config *EmailConnectorConfig) (*EmailResponse, error) {
 	client, err := ece.connectSMTP(config)
@@ -11,11 +11,16 @@ package process
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"strconv"
 	"strings"
+	"sync"
+	"time"
 
+	"atom-engine/src/core/events"
 	"atom-engine/src/core/logger"
+	"atom-engine/src/core/metrics"
 	"atom-engine/src/core/models"
 	"atom-engine/src/storage"
 )
@@ -27,6 +32,7 @@ type Engine struct {
 	component          ComponentInterface
 	executorRegistry   *ExecutorRegistry
 	executionProcessor *ExecutionProcessor
+	retries            sync.Map // token ID -> *elementRetry
 }
 
 // NewEngine creates new process engine
@@ -251,7 +257,20 @@ func (e *Engine) ExecuteToken(token *models.Token) error {
 		logger.String("element_id", token.CurrentElementID),
 		logger.String("element_type", elementType))
 
+	elementID := token.CurrentElementID
+	executionStart := time.Now()
+	span := startElementSpan(e.storage, token, elementType)
+	publishElementEvent(events.TypeElementActivated, token, bpmnProcess.ProcessID, elementID, elementType)
 	result, err := executor.Execute(token, elementMap)
+	metrics.ElementExecutionDuration.WithLabelValues(elementType).Observe(time.Since(executionStart).Seconds())
+	retry := e.takeElementRetry(token.TokenID)
+	if retry != nil && err == nil && result != nil && !result.Success && result.Error != "" {
+		// Some executors report failure in result only, retried element
+		// must report it so the incident stays open
+		// Некоторые исполнители сообщают об ошибке только в результате
+		err = errors.New(result.Error)
+	}
+	endElementSpan(token, span, err)
 	if err != nil {
 		logger.Error("🔴 [DEBUG] Element execution failed - CRITICAL ERROR",
 			logger.String("token_id", token.TokenID),
@@ -259,21 +278,8 @@ func (e *Engine) ExecuteToken(token *models.Token) error {
 			logger.String("element_type", elementType),
 			logger.String("error", err.Error()))
 
-		// Cancel boundary timers before marking token as failed
-		// Отменяем boundary таймеры перед отметкой токена как провалившегося
-		if err := e.component.CancelBoundaryTimersForToken(token.TokenID); err != nil {
-			logger.Error("Failed to cancel boundary timers for failed token",
-				logger.String("token_id", token.TokenID),
-				logger.String("error", err.Error()))
-		}
-
-		// Mark token as failed
-		token.SetState(models.TokenStateFailed)
-		if updateErr := e.storage.UpdateToken(token); updateErr != nil {
-			logger.Error("Failed to update failed token", logger.String("error", updateErr.Error()))
-		}
-
-		return fmt.Errorf("element execution failed: %w", err)
+		e.failElement(token, elementID, elementType, err, retry)
+		return fmt.Errorf("%w: %w", errElementFailed, err)
 	}
 
 	logger.Info("✅ [DEBUG] Element execution successful",
@@ -284,6 +290,12 @@ func (e *Engine) ExecuteToken(token *models.Token) error {
 		logger.Bool("completed", result.Completed),
 		logger.String("waiting_for", result.WaitingFor))
 
+	// Element waiting for job, message or timer completes on callback
+	// Элемент, ожидающий задание, сообщение или таймер, завершается по callback
+	if result.WaitingFor == "" {
+		publishElementEvent(events.TypeElementCompleted, token, bpmnProcess.ProcessID, elementID, elementType)
+	}
+
 	// Process execution result
 	logger.Info("🔍 [DEBUG] Processing execution result",
 		logger.String("token_id", token.TokenID),
@@ -294,7 +306,14 @@ func (e *Engine) ExecuteToken(token *models.Token) error {
 			logger.String("token_id", token.TokenID),
 			logger.String("element_id", token.CurrentElementID),
 			logger.String("error", err.Error()))
-		return fmt.Errorf("failed to process execution result: %w", err)
+
+		// Failures of following elements are already handled by their execution
+		// Ошибки следующих элементов уже обработаны при их выполнении
+		if errors.Is(err, errElementFailed) {
+			return fmt.Errorf("failed to process execution result: %w", err)
+		}
+		e.failElement(token, elementID, elementType, err, retry)
+		return fmt.Errorf("%w: failed to process execution result: %w", errElementFailed, err)
 	}
 
 	logger.Info("🎉 [DEBUG] === TOKEN EXECUTION COMPLETED SUCCESSFULLY ===",
@@ -324,7 +343,7 @@ func (e *Engine) GetExecutor(elementType string) (ElementExecutor, bool) {
 // HandleMessageCallback handles message correlation callback
 // Обрабатывает callback корреляции сообщения
 func (e *Engine) HandleMessageCallback(
-	messageID, messageName, correlationKey, tokenID string,
+	messageID, messageName, correlationKey, tokenID, tenantID string,
 	variables map[string]interface{},
 ) error {
 	logger.Info("🔍 [DEBUG] Engine HandleMessageCallback START",
@@ -347,7 +366,7 @@ func (e *Engine) HandleMessageCallback(
 		logger.Info("Message Start Event callback detected - creating new process instance",
 			logger.String("message_id", messageID),
 			logger.String("message_name", messageName))
-		return e.handleMessageStartEventCallback(messageID, messageName, correlationKey, variables)
+		return e.handleMessageStartEventCallback(messageID, messageName, correlationKey, tenantID, variables)
 	}
 
 	// Load the specific token that is waiting for this message (for intermediate catch events)
@@ -415,6 +434,7 @@ func (e *Engine) HandleMessageCallback(
 			logger.Any("incoming_variables", variables))
 
 		token.MergeVariables(variables)
+		publishTokenVariableEvent(e.storage, token, variables)
 		logger.Info("✅ [DEBUG] Message variables merged successfully",
 			logger.String("token_id", tokenID),
 			logger.Any("merged_variables", token.Variables))
@@ -458,16 +478,17 @@ func (e *Engine) HandleMessageCallback(
 	return nil
 }
 
-// handleMessageStartEventCallback handles Message Start Event callback
-// Обрабатывает callback для Message Start Event
+// handleMessageStartEventCallback handles Message Start Event callback of tenant
+// Обрабатывает callback для Message Start Event арендатора
 func (e *Engine) handleMessageStartEventCallback(
-	messageID, messageName, correlationKey string,
+	messageID, messageName, correlationKey, tenantID string,
 	variables map[string]interface{},
 ) error {
 	logger.Info("Handling Message Start Event callback",
 		logger.String("message_id", messageID),
 		logger.String("message_name", messageName),
-		logger.String("correlation_key", correlationKey))
+		logger.String("correlation_key", correlationKey),
+		logger.String("tenant_id", tenantID))
 
 	// Find subscription to get process key and start event ID
 	// We need to access subscriptions through storage since we don't have direct messages component access
@@ -479,7 +500,8 @@ func (e *Engine) handleMessageStartEventCallback(
 
 	var targetSubscription *models.ProcessMessageSubscription
 	for _, sub := range subscriptions {
-		if sub.MessageName == messageName && sub.IsActive {
+		if sub.MessageName == messageName && sub.IsActive &&
+			models.NormalizeTenantID(sub.TenantID) == models.NormalizeTenantID(tenantID) {
 			// For Message Start Events, we don't need exact correlation key match
 			// since we're creating new instances, not finding existing tokens
 			targetSubscription = sub
@@ -508,6 +530,7 @@ func (e *Engine) handleMessageStartEventCallback(
 	// Mark instance as active since it received trigger message
 	// Отмечаем экземпляр как активный поскольку получил сообщение-триггер
 	processInstance.State = models.ProcessInstanceStateActive
+	beginInstanceSpan(context.Background(), processInstance)
 
 	// Set variables from message
 	// Устанавливаем переменные из сообщения
@@ -520,6 +543,8 @@ func (e *Engine) handleMessageStartEventCallback(
 	if err := e.storage.SaveProcessInstance(processInstance); err != nil {
 		return fmt.Errorf("failed to save process instance: %w", err)
 	}
+	metrics.ProcessInstancesStarted.WithLabelValues(processInstance.ProcessID).Inc()
+	publishInstanceEvent(events.TypeInstanceStarted, processInstance)
 
 	// Create initial token at start event
 	// Создаем начальный токен на start event
@@ -553,8 +578,9 @@ func (e *Engine) handleMessageStartEventCallback(
 // Helper functions
 // Функции-помощники
 
-// extractProcessIDFromKey extracts process ID from process key
+// extractProcessIDFromKey extracts process ID from tenant-scoped process key
 func extractProcessIDFromKey(processKey string) string {
+	_, processKey = models.SplitTenantScopedKey(processKey)
 	if strings.Contains(processKey, ":v") {
 		return strings.Split(processKey, ":v")[0]
 	}
@@ -0,0 +1,123 @@
+/*
+This file is part of the AtomBPMN (R) project.
+Copyright (c) 2025 Matreska Market LLC (ООО «Matreska Market»).
+Authors: Matreska Team.
+
+This project is dual-licensed under AGPL-3.0 and AtomBPMN Commercial License.
+*/
+
+package process
+
+import (
+	"encoding/json"
+	"sort"
+	"strings"
+
+	"atom-engine/src/core/events"
+	"atom-engine/src/core/models"
+	"atom-engine/src/storage"
+)
+
+// publishInstanceEvent publishes lifecycle event of process instance
+// Публикует событие жизненного цикла экземпляра процесса
+func publishInstanceEvent(eventType string, instance *models.ProcessInstance) {
+	event := &events.Event{
+		Type:              eventType,
+		TenantID:          models.NormalizeTenantID(instance.TenantID),
+		ProcessID:         instance.ProcessID,
+		ProcessKey:        instance.ProcessKey,
+		ProcessInstanceID: instance.InstanceID,
+	}
+	if reason, ok := instance.GetMetadata("cancel_reason"); ok && eventType == events.TypeInstanceCanceled {
+		if text, _ := reason.(string); text != "" {
+			event.Attributes = map[string]string{"reason": text}
+		}
+	}
+	events.Publish(event)
+}
+
+// publishElementEvent publishes activation or completion of element reached by token
+// Публикует активацию или завершение элемента, достигнутого токеном
+func publishElementEvent(eventType string, token *models.Token, processID, elementID, elementType string) {
+	events.Publish(&events.Event{
+		Type:              eventType,
+		TenantID:          models.NormalizeTenantID(token.TenantID),
+		ProcessID:         processID,
+		ProcessKey:        token.ProcessKey,
+		ProcessInstanceID: token.ProcessInstanceID,
+		ElementID:         elementID,
+		ElementType:       elementType,
+		Attributes:        map[string]string{"token_id": token.TokenID},
+	})
+}
+
+// publishElementCompleted publishes completion of waiting element resumed by
+// callback, resolving process ID and element type from process definition
+// Публикует завершение ожидающего элемента, продолженного по callback
+func publishElementCompleted(helper *BPMNHelper, token *models.Token, elementID string) {
+	var processID, elementType string
+	if bpmnProcess, err := helper.LoadBPMNProcess(token.ProcessKey); err == nil {
+		processID = bpmnProcess.ProcessID
+		if element, ok := bpmnProcess.Elements[elementID].(map[string]interface{}); ok {
+			elementType, _ = element["type"].(string)
+		}
+	}
+	publishElementEvent(events.TypeElementCompleted, token, processID, elementID, elementType)
+}
+
+// publishInstanceVariableEvent publishes variables set on process instance
+// Публикует переменные, установленные в экземпляре процесса
+func publishInstanceVariableEvent(instance *models.ProcessInstance, variables map[string]interface{}) {
+	if len(variables) == 0 {
+		return
+	}
+	events.Publish(&events.Event{
+		Type:              events.TypeVariableUpdated,
+		TenantID:          models.NormalizeTenantID(instance.TenantID),
+		ProcessID:         instance.ProcessID,
+		ProcessKey:        instance.ProcessKey,
+		ProcessInstanceID: instance.InstanceID,
+		Attributes:        variableAttributes("instance", variables),
+	})
+}
+
+// publishTokenVariableEvent publishes variables merged into token at its
+// current element, BPMN process ID is taken from process instance of token
+// Публикует переменные, объединенные с токеном на его текущем элементе
+func publishTokenVariableEvent(store storage.Storage, token *models.Token, variables map[string]interface{}) {
+	if len(variables) == 0 {
+		return
+	}
+	event := &events.Event{
+		Type:              events.TypeVariableUpdated,
+		TenantID:          models.NormalizeTenantID(token.TenantID),
+		ProcessKey:        token.ProcessKey,
+		ProcessInstanceID: token.ProcessInstanceID,
+		ElementID:         token.CurrentElementID,
+		Attributes:        variableAttributes("token", variables),
+	}
+	event.Attributes["token_id"] = token.TokenID
+	if instance, err := store.LoadProcessInstance(token.ProcessInstanceID); err == nil && instance != nil {
+		event.ProcessID = instance.ProcessID
+	}
+	events.Publish(event)
+}
+
+// variableAttributes returns scope, sorted names and JSON values of variables
+// Возвращает область, отсортированные имена и JSON значения переменных
+func variableAttributes(scope string, variables map[string]interface{}) map[string]string {
+	names := make([]string, 0, len(variables))
+	for name := range variables {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	attributes := map[string]string{
+		"scope":     scope,
+		"variables": strings.Join(names, ","),
+	}
+	if values, err := json.Marshal(variables); err == nil {
+		attributes["values"] = string(values)
+	}
+	return attributes
+}
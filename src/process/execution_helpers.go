@@ -123,3 +123,13 @@ func logElementExecution(token *models.Token, element map[string]interface{}, el
 		logger.String("element_type", elementType),
 		logger.String("element_name", elementName))
 }
+
+// timerCalendarName returns business calendar name of parsed timer definition
+// Возвращает имя бизнес-календаря разобранного определения таймера
+func timerCalendarName(timerMap map[string]interface{}) *string {
+	name, ok := timerMap["calendar"].(string)
+	if !ok || name == "" {
+		return nil
+	}
+	return &name
+}
@@ -11,7 +11,9 @@ package process
 import (
 	"fmt"
 
+	"atom-engine/src/core/events"
 	"atom-engine/src/core/logger"
+	"atom-engine/src/core/metrics"
 	"atom-engine/src/core/models"
 	"atom-engine/src/storage"
 )
@@ -42,6 +44,7 @@ func (ep *ExecutionProcessor) processExecutionResult(
 	// Update token variables if provided
 	if result.Variables != nil {
 		token.MergeVariables(result.Variables)
+		publishTokenVariableEvent(ep.storage, token, result.Variables)
 	}
 
 	// Handle timer request from intermediate catch events
@@ -324,6 +327,9 @@ func (ep *ExecutionProcessor) checkProcessCompletion(instanceID string) error {
 			return fmt.Errorf("failed to update process instance: %w", err)
 		}
 
+		metrics.ProcessInstancesCompleted.WithLabelValues(instance.ProcessID).Inc()
+		endInstanceSpan(instance)
+		publishInstanceEvent(events.TypeInstanceCompleted, instance)
 		logger.Info("Process instance completed", logger.String("instance_id", instanceID))
 
 		// Check for call activity parent tokens waiting for this process
@@ -41,7 +41,7 @@ func (er *ExecutorRegistry) registerExecutors() {
 	)
 	er.RegisterExecutor(NewEndEventExecutor(er.component))
 	er.RegisterExecutor(&TaskExecutor{})
-	er.RegisterExecutor(&UserTaskExecutor{})
+	er.RegisterExecutor(NewUserTaskExecutor(er.component))
 
 	// Register service task executor with process component access
 	logger.Info("Registering ServiceTaskExecutor with process component",
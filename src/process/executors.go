@@ -9,7 +9,6 @@ This project is dual-licensed under AGPL-3.0 and AtomBPMN Commercial License.
 package process
 
 import (
-	"atom-engine/src/core/logger"
 	"atom-engine/src/core/models"
 )
 
@@ -32,41 +31,3 @@ func (te *TaskExecutor) GetElementType() string {
 
 // NOTE: ServiceTaskExecutor moved to src/process/flow/elements/task/service_task.go
 // Old ServiceTaskExecutor removed - now using new version with Jobs integration
-
-// UserTaskExecutor executes user tasks
-// Исполнитель пользовательских задач
-type UserTaskExecutor struct{}
-
-// Execute executes user task
-// Выполняет пользовательскую задачу
-func (ute *UserTaskExecutor) Execute(token *models.Token, element map[string]interface{}) (*ExecutionResult, error) {
-	logger.Info("Executing user task",
-		logger.String("token_id", token.TokenID),
-		logger.String("element_id", token.CurrentElementID))
-
-	// Get task name for logging
-	taskName, _ := element["name"].(string)
-	if taskName == "" {
-		taskName = token.CurrentElementID
-	}
-
-	// User tasks typically wait for external completion
-	// For now, we'll put the token in waiting state
-	logger.Info("User task waiting for completion",
-		logger.String("token_id", token.TokenID),
-		logger.String("task_name", taskName))
-
-	return &ExecutionResult{
-		Success:      true,
-		TokenUpdated: true,
-		NextElements: []string{},
-		WaitingFor:   "user_task_completion",
-		Completed:    false,
-	}, nil
-}
-
-// GetElementType returns element type
-// Возвращает тип элемента
-func (ute *UserTaskExecutor) GetElementType() string {
-	return "userTask"
-}
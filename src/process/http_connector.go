@@ -10,6 +10,7 @@ package process
 
 import (
 	"bytes"
+	"context"
 	"encoding/base64"
 	"encoding/json"
 	"encoding/xml"
@@ -21,8 +22,12 @@ import (
 	"strings"
 	"time"
 
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
 	"atom-engine/src/core/logger"
 	"atom-engine/src/core/models"
+	"atom-engine/src/core/tracing"
 )
 
 // HttpConnectorExecutor executes HTTP connector tasks
@@ -134,7 +139,7 @@ func (hce *HttpConnectorExecutor) Execute(
 		logger.String("auth_type", config.AuthenticationType))
 
 	// Execute HTTP request
-	response, err := hce.executeHttpRequest(config)
+	response, err := hce.executeHttpRequest(elementContext(token.TokenID), config)
 	if err != nil {
 		logger.Error("HTTP request failed",
 			logger.String("token_id", token.TokenID),
@@ -615,8 +620,25 @@ func (hce *HttpConnectorExecutor) evaluateInputValue(source string, variables ma
 	return source
 }
 
-// executeHttpRequest executes the HTTP request with the given configuration
-func (hce *HttpConnectorExecutor) executeHttpRequest(config *HttpConnectorConfig) (*HttpConnectorResponse, error) {
+// executeHttpRequest executes the HTTP request with the given configuration.
+// Request is traced as client span under ctx and carries traceparent header.
+func (hce *HttpConnectorExecutor) executeHttpRequest(
+	ctx context.Context,
+	config *HttpConnectorConfig,
+) (response *HttpConnectorResponse, err error) {
+	ctx, span := tracing.Tracer().Start(ctx, "HTTP "+config.Method,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			attribute.String("http.request.method", config.Method),
+			attribute.String("url.full", config.URL),
+		))
+	defer func() {
+		if response != nil {
+			span.SetAttributes(attribute.Int("http.response.status_code", response.Status))
+		}
+		tracing.End(span, err)
+	}()
+
 	// Create HTTP client with timeouts
 	client := &http.Client{
 		Timeout: time.Duration(config.ConnectionTimeoutInSeconds) * time.Second,
@@ -656,7 +678,7 @@ func (hce *HttpConnectorExecutor) executeHttpRequest(config *HttpConnectorConfig
 	}
 
 	// Create HTTP request
-	req, err := http.NewRequest(config.Method, parsedURL.String(), bodyReader)
+	req, err := http.NewRequestWithContext(ctx, config.Method, parsedURL.String(), bodyReader)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create HTTP request: %v", err)
 	}
@@ -670,6 +692,7 @@ func (hce *HttpConnectorExecutor) executeHttpRequest(config *HttpConnectorConfig
 	for key, value := range config.Headers {
 		req.Header.Set(key, fmt.Sprintf("%v", value))
 	}
+	tracing.InjectHTTP(ctx, req.Header)
 
 	// Apply authentication
 	err = hce.applyAuthentication(req, config, parsedURL)
@@ -1614,6 +1637,7 @@ func (hce *HttpConnectorExecutor) createBoundaryTimerForEvent(
 			ProcessInstanceID: token.ProcessInstanceID,
 			ProcessKey:        token.ProcessKey,
 		}
+		timerRequest.Calendar = timerCalendarName(timerMap)
 
 		if attachedToRef, exists := boundaryEvent["attached_to_ref"]; exists {
 			if attachedStr, ok := attachedToRef.(string); ok {
@@ -1909,7 +1933,7 @@ func (hce *HttpConnectorExecutor) evaluateTimerExpression(expression string, tok
 
 	result, err := expressionComp.EvaluateExpressionEngine(expression, token.Variables)
 	if err != nil {
-		return nil, fmt.Errorf("failed to evaluate FEEL expression '%s': %w", expression, err)
+		return nil, &ExpressionError{Expression: expression, Err: err}
 	}
 
 	logger.Debug("Boundary timer expression evaluated successfully",
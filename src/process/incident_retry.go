@@ -0,0 +1,262 @@
+/*
+This file is part of the AtomBPMN (R) project.
+Copyright (c) 2025 Matreska Market LLC (ООО «Matreska Market»).
+Authors: Matreska Team.
+
+This project is dual-licensed under AGPL-3.0 and AtomBPMN Commercial License.
+*/
+
+package process
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"atom-engine/src/core/logger"
+	"atom-engine/src/core/models"
+	"atom-engine/src/incidents"
+)
+
+// errElementFailed marks error already handled by failed element execution
+// Отмечает ошибку уже обработанную при проваленном выполнении элемента
+var errElementFailed = errors.New("element execution failed")
+
+// ExpressionError reports failed FEEL expression of element, element failing
+// with it raises EXPRESSION_ERROR incident
+// Ошибка вычисления FEEL выражения элемента
+type ExpressionError struct {
+	Expression string
+	Err        error
+}
+
+// Error returns error message
+// Возвращает сообщение ошибки
+func (e *ExpressionError) Error() string {
+	return fmt.Sprintf("failed to evaluate FEEL expression '%s': %v", e.Expression, e.Err)
+}
+
+// Unwrap returns cause of evaluation failure
+// Возвращает причину ошибки вычисления
+func (e *ExpressionError) Unwrap() error {
+	return e.Err
+}
+
+// elementRetry records outcome of retried element execution
+// Фиксирует результат повторного выполнения элемента
+type elementRetry struct {
+	executed bool
+	err      error
+}
+
+// RetryElement applies variable patch and re-executes current element of
+// incident token. Without token ID the failed token at element is used.
+// Применяет патч переменных и повторно выполняет текущий элемент токена инцидента
+func (c *Component) RetryElement(
+	processInstanceID, tokenID, elementID string,
+	variables map[string]interface{},
+) error {
+	if !c.IsReady() {
+		return fmt.Errorf("process component not ready")
+	}
+
+	token, err := c.findRetryToken(processInstanceID, tokenID, elementID)
+	if err != nil {
+		return err
+	}
+
+	instance, err := c.storage.LoadProcessInstance(token.ProcessInstanceID)
+	if err != nil {
+		return fmt.Errorf("failed to load process instance: %w", err)
+	}
+	if instance.State != models.ProcessInstanceStateActive {
+		return fmt.Errorf("process instance %s is %s", instance.InstanceID, instance.State)
+	}
+
+	if len(variables) > 0 {
+		token.MergeVariables(variables)
+		instance.SetVariables(variables)
+		if err := c.storage.UpdateProcessInstance(instance); err != nil {
+			return fmt.Errorf("failed to update process instance variables: %w", err)
+		}
+		publishInstanceVariableEvent(instance, variables)
+	}
+
+	token.ClearWaitingFor()
+	token.SetState(models.TokenStateActive)
+	token.CompletedAt = nil
+	if err := c.storage.UpdateToken(token); err != nil {
+		return fmt.Errorf("failed to update token: %w", err)
+	}
+
+	logger.Info("Retrying element of incident token",
+		logger.String("token_id", token.TokenID),
+		logger.String("element_id", token.CurrentElementID),
+		logger.String("process_instance_id", token.ProcessInstanceID),
+		logger.Int("variables", len(variables)))
+
+	return c.engine.RetryToken(token)
+}
+
+// findRetryToken finds token of incident that can be retried
+// Находит токен инцидента который можно повторить
+func (c *Component) findRetryToken(processInstanceID, tokenID, elementID string) (*models.Token, error) {
+	if tokenID != "" {
+		token, err := c.storage.LoadToken(tokenID)
+		if err != nil {
+			return nil, fmt.Errorf("token not found: %s", tokenID)
+		}
+		if token.ProcessInstanceID != processInstanceID {
+			return nil, fmt.Errorf("token %s does not belong to process instance %s", tokenID, processInstanceID)
+		}
+		if elementID != "" && token.CurrentElementID != elementID {
+			return nil, fmt.Errorf("token %s has left element %s", tokenID, elementID)
+		}
+		if isFinishedToken(token) {
+			return nil, fmt.Errorf("token %s is %s", tokenID, token.State)
+		}
+		return token, nil
+	}
+
+	tokens, err := c.GetTokensByProcessInstance(processInstanceID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tokens: %w", err)
+	}
+
+	var candidate *models.Token
+	for _, token := range tokens {
+		if token.CurrentElementID != elementID || isFinishedToken(token) {
+			continue
+		}
+		if token.State == models.TokenStateFailed {
+			return token, nil
+		}
+		if candidate == nil {
+			candidate = token
+		}
+	}
+	if candidate == nil {
+		return nil, fmt.Errorf("no token found at element %s of process instance %s", elementID, processInstanceID)
+	}
+	return candidate, nil
+}
+
+// isFinishedToken reports whether token has completed or was canceled
+// Проверяет завершен или отменен ли токен
+func isFinishedToken(token *models.Token) bool {
+	return token.State == models.TokenStateCompleted || token.State == models.TokenStateCanceled
+}
+
+// RetryToken executes token as retry of its current element. Failure of
+// that element is returned without raising new incident, failures of
+// following elements raise their own incidents.
+// Выполняет токен как повтор его текущего элемента
+func (e *Engine) RetryToken(token *models.Token) error {
+	retry := &elementRetry{}
+	e.retries.Store(token.TokenID, retry)
+	defer e.retries.Delete(token.TokenID)
+
+	err := e.ExecuteToken(token)
+	if !retry.executed {
+		return err
+	}
+	if err != nil && retry.err == nil {
+		logger.Warn("Retried element completed, following element failed",
+			logger.String("token_id", token.TokenID),
+			logger.String("error", err.Error()))
+	}
+	return retry.err
+}
+
+// takeElementRetry returns retry record when token is retried, first
+// element execution of retried token consumes it
+// Возвращает запись повтора если токен повторяется
+func (e *Engine) takeElementRetry(tokenID string) *elementRetry {
+	value, ok := e.retries.LoadAndDelete(tokenID)
+	if !ok {
+		return nil
+	}
+	retry := value.(*elementRetry)
+	retry.executed = true
+	return retry
+}
+
+// failElement marks token failed at element and raises incident. Retried
+// element keeps its open incident and reports error to retry instead.
+// Отмечает токен проваленным на элементе и создает инцидент
+func (e *Engine) failElement(token *models.Token, elementID, elementType string, cause error, retry *elementRetry) {
+	if token.CurrentElementID == elementID {
+		// Cancel boundary timers before marking token as failed
+		// Отменяем boundary таймеры перед отметкой токена как провалившегося
+		if err := e.component.CancelBoundaryTimersForToken(token.TokenID); err != nil {
+			logger.Error("Failed to cancel boundary timers for failed token",
+				logger.String("token_id", token.TokenID),
+				logger.String("error", err.Error()))
+		}
+
+		token.SetState(models.TokenStateFailed)
+		if err := e.storage.UpdateToken(token); err != nil {
+			logger.Error("Failed to update failed token", logger.String("error", err.Error()))
+		}
+	}
+
+	if retry != nil {
+		retry.err = cause
+		return
+	}
+	e.raiseElementIncident(token, elementID, elementType, cause)
+}
+
+// raiseElementIncident creates incident for failed element execution so
+// the token can be retried after the cause is fixed
+// Создает инцидент для проваленного выполнения элемента
+func (e *Engine) raiseElementIncident(token *models.Token, elementID, elementType string, cause error) {
+	core := e.component.GetCore()
+	if core == nil || core.GetIncidentsComponent() == nil {
+		return
+	}
+
+	incidentType := incidents.IncidentTypeProcessError
+	var exprErr *ExpressionError
+	if errors.As(cause, &exprErr) {
+		incidentType = incidents.IncidentTypeExpressionError
+	}
+
+	payload := incidents.CreateIncidentPayload{
+		Type:              string(incidentType),
+		Message:           cause.Error(),
+		ProcessInstanceID: token.ProcessInstanceID,
+		ProcessKey:        token.ProcessKey,
+		TenantID:          token.TenantID,
+		ElementID:         elementID,
+		ElementType:       elementType,
+		TokenID:           token.TokenID,
+	}
+
+	// Persist incident before returning so it survives restart. Core holds
+	// its lock while components start and restore tokens, so incident is
+	// created directly by incidents component, not through core messages
+	// Сохраняем инцидент до возврата, создание напрямую компонентом инцидентов
+	entryID, err := incidents.EnqueueIncident(e.storage, payload)
+	if err != nil {
+		logger.Error("Failed to persist element incident",
+			logger.String("token_id", token.TokenID),
+			logger.String("element_id", elementID),
+			logger.String("error", err.Error()))
+		return
+	}
+
+	// Incidents component not started yet drains outbox on start
+	// Не запущенный компонент инцидентов обработает очередь при старте
+	if drainer, ok := core.GetIncidentsComponent().(interface {
+		DrainOutbox(ctx context.Context) int
+	}); ok {
+		drainer.DrainOutbox(context.Background())
+	}
+
+	logger.Info("Element incident raised",
+		logger.String("token_id", token.TokenID),
+		logger.String("element_id", elementID),
+		logger.String("outbox_entry_id", entryID),
+		logger.String("type", string(incidentType)))
+}
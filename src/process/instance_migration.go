@@ -0,0 +1,271 @@
+/*
+This file is part of the AtomBPMN (R) project.
+Copyright (c) 2025 Matreska Market LLC (ООО «Matreska Market»).
+Authors: Matreska Team.
+
+This project is dual-licensed under AGPL-3.0 and AtomBPMN Commercial License.
+*/
+
+package process
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"atom-engine/src/core/clock"
+	"atom-engine/src/core/models"
+)
+
+// eventDefinitionRefs are event definition attributes naming caught event
+var eventDefinitionRefs = []string{"message_ref", "signal_ref", "error_ref", "escalation_ref"}
+
+// checkMigratedElement rejects migration of token element whose type or
+// boundary events differ between versions
+// Проверяет, что тип элемента и его граничные события совпадают в версиях
+func checkMigratedElement(source, target *models.BPMNProcess, elementID string, targetVersion int) error {
+	targetElement, exists := target.Elements[elementID]
+	if !exists {
+		return fmt.Errorf("element %s does not exist in version %d", elementID, targetVersion)
+	}
+
+	sourceType := migrationElementType(source.Elements[elementID])
+	targetType := migrationElementType(targetElement)
+	if sourceType != targetType {
+		return fmt.Errorf("element %s is %s in version %d, instance runs it as %s",
+			elementID, targetType, targetVersion, sourceType)
+	}
+
+	sourceBoundaries := boundarySignatures(source.Elements, elementID)
+	targetBoundaries := boundarySignatures(target.Elements, elementID)
+	if len(sourceBoundaries) != len(targetBoundaries) {
+		return fmt.Errorf("element %s has %d boundary events in version %d, instance has %d",
+			elementID, len(targetBoundaries), targetVersion, len(sourceBoundaries))
+	}
+	for boundaryID, signature := range sourceBoundaries {
+		if targetBoundaries[boundaryID] != signature {
+			return fmt.Errorf("boundary event %s of element %s differs in version %d",
+				boundaryID, elementID, targetVersion)
+		}
+	}
+	return nil
+}
+
+// migrationElementType returns BPMN type of parsed element
+func migrationElementType(element interface{}) string {
+	elementMap, _ := element.(map[string]interface{})
+	elementType, _ := elementMap["type"].(string)
+	return elementType
+}
+
+// boundarySignatures returns caught events and interruption of boundary
+// events attached to element, keyed by boundary event ID
+func boundarySignatures(elements map[string]interface{}, elementID string) map[string]string {
+	signatures := make(map[string]string)
+	for _, boundaryID := range attachedBoundaryIDs(elements, elementID) {
+		element := elements[boundaryID].(map[string]interface{})
+
+		var definitions []string
+		if list, ok := element["event_definitions"].([]interface{}); ok {
+			for _, item := range list {
+				definition, ok := item.(map[string]interface{})
+				if !ok {
+					continue
+				}
+				parts := []string{fmt.Sprintf("%v", definition["type"])}
+				for _, ref := range eventDefinitionRefs {
+					if value, exists := definition[ref]; exists {
+						parts = append(parts, fmt.Sprintf("%s=%v", ref, value))
+					}
+				}
+				definitions = append(definitions, strings.Join(parts, " "))
+			}
+		}
+		sort.Strings(definitions)
+
+		signatures[boundaryID] = fmt.Sprintf("%s cancel_activity=%t",
+			strings.Join(definitions, ","), boundaryInterrupting(element))
+	}
+	return signatures
+}
+
+// attachedBoundaryIDs returns IDs of boundary events attached to element
+func attachedBoundaryIDs(elements map[string]interface{}, elementID string) []string {
+	var ids []string
+	for id, raw := range elements {
+		element, ok := raw.(map[string]interface{})
+		if !ok || element["type"] != "boundaryEvent" {
+			continue
+		}
+		if attachedTo, _ := element["attached_to_ref"].(string); attachedTo == elementID {
+			ids = append(ids, id)
+		}
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+// boundaryInterrupting reports whether boundary event cancels its activity,
+// interrupting is default
+func boundaryInterrupting(element map[string]interface{}) bool {
+	switch value := element["cancel_activity"].(type) {
+	case bool:
+		return value
+	case string:
+		return value != "false"
+	}
+	return true
+}
+
+// migrateInstanceJobs moves unfinished jobs of instance to target process
+func (c *Component) migrateInstanceJobs(instanceID, targetKey string) (int, error) {
+	ctx := context.Background()
+	jobs, err := c.storage.ListJobsByProcessInstance(ctx, instanceID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to list jobs: %w", err)
+	}
+
+	migrated := 0
+	for _, job := range jobs {
+		switch job.Status {
+		case models.JobStatusPending, models.JobStatusRunning, models.JobStatusDeferred:
+		default:
+			continue
+		}
+		_, err := c.storage.UpdateJobIf(ctx, job.ID, job.Status, func(job *models.Job) error {
+			job.ProcessKey = targetKey
+			job.UpdatedAt = clock.Now()
+			return nil
+		})
+		if err != nil {
+			return migrated, fmt.Errorf("failed to update job %s: %w", job.ID, err)
+		}
+		migrated++
+	}
+	return migrated, nil
+}
+
+// migrateInstanceTimers moves scheduled timers of migrated tokens to target
+// process. Boundary events are unchanged between versions, so timers keep
+// their schedule.
+func (c *Component) migrateInstanceTimers(instanceID string, tokenIDs map[string]bool,
+	targetKey string, targetVersion int) (int, error) {
+	timers, err := c.storage.LoadAllTimers()
+	if err != nil {
+		return 0, fmt.Errorf("failed to load timers: %w", err)
+	}
+
+	migrated := 0
+	for _, timer := range timers {
+		if timer.ProcessInstanceID != instanceID || !tokenIDs[timer.TokenID] || timer.State != "SCHEDULED" {
+			continue
+		}
+		if timer.ProcessContext == nil {
+			timer.ProcessContext = make(map[string]interface{})
+		}
+		timer.ProcessContext["process_key"] = targetKey
+		timer.ProcessContext["process_version"] = targetVersion
+		timer.UpdatedAt = clock.Now()
+		if err := c.storage.UpdateTimer(timer); err != nil {
+			return migrated, fmt.Errorf("failed to update timer %s: %w", timer.ID, err)
+		}
+		migrated++
+	}
+	return migrated, nil
+}
+
+// migrateInstanceSubscriptions makes message subscriptions of elements held
+// by migrated tokens available in target process. Subscription is shared by
+// all tokens waiting at element of process version, so source subscription is
+// removed only when no token of source version waits at element any more.
+func (c *Component) migrateInstanceSubscriptions(tenantID, sourceKey, targetKey string,
+	targetVersion int, elementIDs map[string]bool) (int, error) {
+	ctx := context.Background()
+	subscriptions, err := c.storage.ListProcessMessageSubscriptions(ctx, tenantID, 0, 0)
+	if err != nil {
+		return 0, fmt.Errorf("failed to list message subscriptions: %w", err)
+	}
+
+	existing := make(map[string]bool)
+	for _, subscription := range subscriptions {
+		if subscription.ProcessDefinitionKey == targetKey {
+			existing[subscription.StartEventID] = true
+		}
+	}
+
+	var stillUsed map[string]bool
+	migrated := 0
+	for _, subscription := range subscriptions {
+		if subscription.ProcessDefinitionKey != sourceKey || !elementIDs[subscription.StartEventID] {
+			continue
+		}
+
+		if !existing[subscription.StartEventID] {
+			moved := *subscription
+			moved.ID = models.GenerateID()
+			moved.ProcessDefinitionKey = targetKey
+			moved.ProcessVersion = int32(targetVersion)
+			moved.UpdatedAt = clock.Now()
+			if err := c.storage.SaveProcessMessageSubscription(ctx, &moved); err != nil {
+				return migrated, fmt.Errorf("failed to save message subscription: %w", err)
+			}
+			existing[subscription.StartEventID] = true
+		}
+
+		if stillUsed == nil {
+			if stillUsed, err = c.elementsHeldByProcess(sourceKey); err != nil {
+				return migrated, err
+			}
+		}
+		if !stillUsed[subscription.StartEventID] {
+			if err := c.storage.DeleteProcessMessageSubscription(ctx, subscription.ID); err != nil {
+				return migrated, fmt.Errorf("failed to delete message subscription %s: %w", subscription.ID, err)
+			}
+		}
+		migrated++
+	}
+	return migrated, nil
+}
+
+// elementsHeldByProcess returns elements and their boundary events held by
+// unfinished tokens of process definition
+func (c *Component) elementsHeldByProcess(processKey string) (map[string]bool, error) {
+	tokens, err := c.storage.LoadAllTokens()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load tokens: %w", err)
+	}
+
+	var source *models.BPMNProcess
+	held := make(map[string]bool)
+	for _, token := range tokens {
+		if token.ProcessKey != processKey || isFinishedToken(token) {
+			continue
+		}
+		held[token.CurrentElementID] = true
+
+		if source == nil {
+			if source, err = c.loadProcessDefinition(processKey); err != nil {
+				return nil, err
+			}
+		}
+		for _, boundaryID := range attachedBoundaryIDs(source.Elements, token.CurrentElementID) {
+			held[boundaryID] = true
+		}
+	}
+	return held, nil
+}
+
+// loadProcessDefinition loads parsed process definition by process key
+func (c *Component) loadProcessDefinition(processKey string) (*models.BPMNProcess, error) {
+	processData, err := c.storage.LoadBPMNProcess(processKey)
+	if err != nil {
+		return nil, fmt.Errorf("process definition %s not found: %w", processKey, err)
+	}
+	var process models.BPMNProcess
+	if err := json.Unmarshal(processData, &process); err != nil {
+		return nil, fmt.Errorf("failed to parse process definition %s: %w", processKey, err)
+	}
+	return &process, nil
+}
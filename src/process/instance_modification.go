@@ -0,0 +1,172 @@
+/*
+This file is part of the AtomBPMN (R) project.
+Copyright (c) 2025 Matreska Market LLC (ООО «Matreska Market»).
+Authors: Matreska Team.
+
+This project is dual-licensed under AGPL-3.0 and AtomBPMN Commercial License.
+*/
+
+package process
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"atom-engine/src/core/clock"
+	"atom-engine/src/core/logger"
+	"atom-engine/src/core/models"
+)
+
+// SetProcessInstanceVariables merges variable patch into unfinished process
+// instance and its active tokens
+// Объединяет патч переменных с незавершенным экземпляром процесса и его токенами
+func (c *Component) SetProcessInstanceVariables(instanceID string, variables map[string]interface{}) error {
+	if !c.IsReady() {
+		return fmt.Errorf("process component not ready")
+	}
+
+	instance, err := c.storage.LoadProcessInstance(instanceID)
+	if err != nil {
+		return fmt.Errorf("process instance not found: %s", instanceID)
+	}
+	if instance.IsCompleted() {
+		return fmt.Errorf("process instance %s is %s", instanceID, instance.State)
+	}
+	if len(variables) == 0 {
+		return nil
+	}
+
+	tokens, err := c.GetTokensByProcessInstance(instanceID)
+	if err != nil {
+		return fmt.Errorf("failed to get tokens: %w", err)
+	}
+
+	instance.SetVariables(variables)
+	if err := c.storage.UpdateProcessInstance(instance); err != nil {
+		return fmt.Errorf("failed to update process instance variables: %w", err)
+	}
+	publishInstanceVariableEvent(instance, variables)
+
+	for _, token := range tokens {
+		if isFinishedToken(token) {
+			continue
+		}
+		token.MergeVariables(variables)
+		if err := c.storage.UpdateToken(token); err != nil {
+			return fmt.Errorf("failed to update token %s: %w", token.TokenID, err)
+		}
+	}
+
+	logger.Info("Process instance variables modified",
+		logger.String("instance_id", instanceID),
+		logger.Int("variables", len(variables)))
+
+	return nil
+}
+
+// MigrateProcessInstance moves unfinished process instance to another version
+// of its process definition. Every element holding unfinished token must exist
+// in target version with same type and boundary events, tokens continue from
+// same element IDs. Jobs, timers and message subscriptions of tokens follow.
+// Переносит незавершенный экземпляр процесса на другую версию его определения
+func (c *Component) MigrateProcessInstance(instanceID string, targetVersion int) error {
+	if !c.IsReady() {
+		return fmt.Errorf("process component not ready")
+	}
+
+	instance, err := c.storage.LoadProcessInstance(instanceID)
+	if err != nil {
+		return fmt.Errorf("process instance not found: %s", instanceID)
+	}
+	if instance.IsCompleted() {
+		return fmt.Errorf("process instance %s is %s", instanceID, instance.State)
+	}
+	if instance.ProcessVersion == targetVersion {
+		return fmt.Errorf("process instance %s already runs version %d", instanceID, targetVersion)
+	}
+
+	source, err := c.loadProcessDefinition(instance.ProcessKey)
+	if err != nil {
+		return err
+	}
+
+	processData, targetKey, err := c.storage.LoadBPMNProcessByProcessID(
+		instance.TenantID, instance.ProcessID, targetVersion)
+	if err != nil {
+		return fmt.Errorf("target version %d of process %s not found: %w", targetVersion, instance.ProcessID, err)
+	}
+
+	var target models.BPMNProcess
+	if err := json.Unmarshal(processData, &target); err != nil {
+		return fmt.Errorf("failed to parse target process definition: %w", err)
+	}
+
+	tokens, err := c.GetTokensByProcessInstance(instanceID)
+	if err != nil {
+		return fmt.Errorf("failed to get tokens: %w", err)
+	}
+
+	var active []*models.Token
+	tokenIDs := make(map[string]bool)
+	elementIDs := make(map[string]bool)
+	for _, token := range tokens {
+		if isFinishedToken(token) {
+			continue
+		}
+		if err := checkMigratedElement(source, &target, token.CurrentElementID, targetVersion); err != nil {
+			return fmt.Errorf("token %s: %w", token.TokenID, err)
+		}
+		active = append(active, token)
+		tokenIDs[token.TokenID] = true
+		elementIDs[token.CurrentElementID] = true
+		for _, boundaryID := range attachedBoundaryIDs(source.Elements, token.CurrentElementID) {
+			elementIDs[boundaryID] = true
+		}
+	}
+
+	sourceKey := instance.ProcessKey
+	sourceVersion := instance.ProcessVersion
+
+	for _, token := range active {
+		token.ProcessKey = targetKey
+		token.UpdatedAt = clock.Now()
+		if err := c.storage.UpdateToken(token); err != nil {
+			return fmt.Errorf("failed to update token %s: %w", token.TokenID, err)
+		}
+	}
+
+	jobs, err := c.migrateInstanceJobs(instanceID, targetKey)
+	if err != nil {
+		return err
+	}
+	timers, err := c.migrateInstanceTimers(instanceID, tokenIDs, targetKey, target.ProcessVersion)
+	if err != nil {
+		return err
+	}
+	subscriptions, err := c.migrateInstanceSubscriptions(
+		instance.TenantID, sourceKey, targetKey, target.ProcessVersion, elementIDs)
+	if err != nil {
+		return err
+	}
+
+	instance.ProcessKey = targetKey
+	instance.ProcessVersion = target.ProcessVersion
+	instance.ProcessName = target.ProcessName
+	instance.AddMetadata("migrated_from", sourceKey)
+	if err := c.storage.UpdateProcessInstance(instance); err != nil {
+		return fmt.Errorf("failed to update process instance: %w", err)
+	}
+
+	logger.Info("Process instance migrated",
+		logger.String("instance_id", instanceID),
+		logger.String("from_key", sourceKey),
+		logger.String("to_key", targetKey),
+		logger.Int("from_version", sourceVersion),
+		logger.Int("to_version", target.ProcessVersion),
+		logger.Int("tokens", len(active)),
+		logger.Int("jobs", jobs),
+		logger.Int("timers", timers),
+		logger.Int("subscriptions", subscriptions))
+
+	return nil
+}
@@ -10,8 +10,8 @@ package process
 
 import (
 	"fmt"
-	"time"
 
+	"atom-engine/src/core/clock"
 	"atom-engine/src/core/logger"
 	"atom-engine/src/core/models"
 )
@@ -198,15 +198,15 @@ func (icmh *IntermediateCatchMessageHandler) HandleMessageEvent(
 		// Создаем подписку на сообщение
 		subscription := &models.ProcessMessageSubscription{
 			ID:                   models.GenerateID(),
-			TenantID:             "DEFAULT_TENANT",
+			TenantID:             token.TenantID,
 			ProcessDefinitionKey: token.ProcessKey,
 			ProcessVersion:       int32(processVersion), // Use actual version from ProcessKey
 			StartEventID:         token.CurrentElementID,
 			MessageName:          messageName,
 			CorrelationKey:       correlationKey,
 			IsActive:             true,
-			CreatedAt:            time.Now(),
-			UpdatedAt:            time.Now(),
+			CreatedAt:            clock.Now(),
+			UpdatedAt:            clock.Now(),
 		}
 
 		if err := icmh.processComponent.CreateMessageSubscription(subscription); err != nil {
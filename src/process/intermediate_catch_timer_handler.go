@@ -94,6 +94,7 @@ func (icth *IntermediateCatchTimerHandler) createTimerRequest(
 		TokenID:           token.TokenID,
 		ProcessInstanceID: token.ProcessInstanceID,
 		ProcessKey:        token.ProcessKey,
+		Calendar:          timerCalendarName(timerMap),
 	}
 
 	// Extract timer definition based on type with FEEL expression evaluation
@@ -247,7 +248,7 @@ func (icth *IntermediateCatchTimerHandler) evaluateTimerExpression(
 	// Вычисляем FEEL expression используя expression engine
 	result, err := expressionComp.EvaluateExpressionEngine(expression, token.Variables)
 	if err != nil {
-		return nil, fmt.Errorf("failed to evaluate FEEL expression '%s': %w", expression, err)
+		return nil, &ExpressionError{Expression: expression, Err: err}
 	}
 
 	logger.Debug("Timer expression evaluated successfully",
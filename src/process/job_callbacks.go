@@ -581,11 +581,12 @@ func (jc *JobCallbacks) createJobFailureIncident(token *models.Token, jobID, ele
 	}
 
 	payload := incidents.CreateIncidentPayload{
-		Type:              "job_failure",
+		Type:              string(incidents.IncidentTypeJobFailure),
 		Message:           errorMessage,
 		ProcessInstanceID: token.ProcessInstanceID,
 		ElementID:         elementID,
 		ElementType:       "serviceTask", // Service task element type
+		TokenID:           token.TokenID,
 		JobKey:            jobID,
 		OriginalRetries:   0,
 	}
@@ -626,12 +627,13 @@ func (jc *JobCallbacks) createBPMNErrorIncident(token *models.Token, elementID,
 	}
 
 	payload := incidents.CreateIncidentPayload{
-		Type:              "bpmn_error",
+		Type:              string(incidents.IncidentTypeBPMNError),
 		Message:           fmt.Sprintf("%s: %s", errorCode, errorMessage),
 		ErrorCode:         errorCode,
 		ProcessInstanceID: token.ProcessInstanceID,
 		ElementID:         elementID,
 		ElementType:       "serviceTask", // Service task element type
+		TokenID:           token.TokenID,
 	}
 
 	message, err := incidents.CreateIncidentMessage(payload)
@@ -673,12 +675,13 @@ func (jc *JobCallbacks) createUnhandledBPMNErrorIncident(
 	}
 
 	payload := incidents.CreateIncidentPayload{
-		Type:              "bpmn_error",
+		Type:              string(incidents.IncidentTypeBPMNError),
 		Message:           fmt.Sprintf("UNHANDLED_BPMN_ERROR %s: %s", errorCode, errorMessage),
 		ErrorCode:         errorCode,
 		ProcessInstanceID: token.ProcessInstanceID,
 		ElementID:         elementID,
 		ElementType:       "serviceTask", // Service task element type
+		TokenID:           token.TokenID,
 	}
 
 	message, err := incidents.CreateIncidentMessage(payload)
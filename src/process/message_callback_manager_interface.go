@@ -16,7 +16,10 @@ import (
 // Интерфейс менеджера сообщений
 type MessageCallbackManagerInterface interface {
 	// Message callback operations
-	HandleMessageCallback(messageID, messageName, correlationKey, tokenID string, variables map[string]interface{}) error
+	HandleMessageCallback(
+		messageID, messageName, correlationKey, tokenID, tenantID string,
+		variables map[string]interface{},
+	) error
 	CheckBufferedMessages(messageName, correlationKey string) (*models.BufferedMessage, error)
 	ProcessBufferedMessage(message *models.BufferedMessage, token *models.Token) error
 
@@ -9,9 +9,12 @@ This project is dual-licensed under AGPL-3.0 and AtomBPMN Commercial License.
 package process
 
 import (
+	"context"
 	"fmt"
 
+	"atom-engine/src/core/events"
 	"atom-engine/src/core/logger"
+	"atom-engine/src/core/metrics"
 	"atom-engine/src/core/models"
 	"atom-engine/src/storage"
 )
@@ -44,10 +47,11 @@ func (pim *ProcessInstanceManager) Init() error {
 // StartProcessInstance starts new process instance
 // Запускает новый экземпляр процесса
 func (pim *ProcessInstanceManager) StartProcessInstance(
-	processKey string,
+	ctx context.Context,
+	processKey, tenantID string,
 	variables map[string]interface{},
 ) (*models.ProcessInstance, error) {
-	return pim.processStarter.StartProcessInstance(processKey, variables)
+	return pim.processStarter.StartProcessInstance(ctx, processKey, tenantID, variables)
 }
 
 // GetProcessInstanceStatus gets process instance status
@@ -95,6 +99,9 @@ func (pim *ProcessInstanceManager) CancelProcessInstance(instanceID string, reas
 	if err := pim.storage.UpdateProcessInstance(instance); err != nil {
 		return fmt.Errorf("failed to update process instance: %w", err)
 	}
+	metrics.ProcessInstancesCanceled.WithLabelValues(instance.ProcessID).Inc()
+	endInstanceSpan(instance)
+	publishInstanceEvent(events.TypeInstanceCanceled, instance)
 
 	// Cancel all active tokens
 	tokens, err := pim.storage.LoadTokensByProcessInstance(instanceID)
@@ -9,6 +9,8 @@ This project is dual-licensed under AGPL-3.0 and AtomBPMN Commercial License.
 package process
 
 import (
+	"context"
+
 	"atom-engine/src/core/models"
 )
 
@@ -16,7 +18,11 @@ import (
 // Интерфейс менеджера процессов
 type ProcessManagerInterface interface {
 	// Process instance lifecycle
-	StartProcessInstance(processKey string, variables map[string]interface{}) (*models.ProcessInstance, error)
+	StartProcessInstance(
+		ctx context.Context,
+		processKey, tenantID string,
+		variables map[string]interface{},
+	) (*models.ProcessInstance, error)
 	GetProcessInstanceStatus(instanceID string) (*models.ProcessInstance, error)
 	CancelProcessInstance(instanceID string, reason string) error
 	ListProcessInstances(statusFilter string, processKeyFilter string, limit int) ([]*models.ProcessInstance, error)
@@ -9,12 +9,14 @@ This project is dual-licensed under AGPL-3.0 and AtomBPMN Commercial License.
 package process
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"strconv"
 	"strings"
 
 	"atom-engine/src/core/logger"
+	"atom-engine/src/core/metrics"
 	"atom-engine/src/core/models"
 	"atom-engine/src/storage"
 )
@@ -39,14 +41,16 @@ func NewProcessStarter(storage storage.Storage, component ComponentInterface) *P
 	}
 }
 
-// StartProcessInstance starts new process instance
-// Запускает новый экземпляр процесса
+// StartProcessInstance starts new process instance of definition deployed for tenant
+// Запускает новый экземпляр процесса по определению, развернутому для арендатора
 func (ps *ProcessStarter) StartProcessInstance(
-	processKey string,
+	ctx context.Context,
+	processKey, tenantID string,
 	variables map[string]interface{},
 ) (*models.ProcessInstance, error) {
 	logger.Info("Starting process instance",
-		logger.String("process_key", processKey))
+		logger.String("process_key", processKey),
+		logger.String("tenant_id", tenantID))
 
 	if !ps.component.IsReady() {
 		return nil, fmt.Errorf("process component not ready")
@@ -55,8 +59,8 @@ func (ps *ProcessStarter) StartProcessInstance(
 	// Parse process key to get process ID and version
 	processID, version := ps.parseProcessKey(processKey)
 
-	// Load process definition from storage
-	processData, actualStorageKey, err := ps.loadProcessDefinition(processID, version)
+	// Load process definition deployed for tenant from storage
+	processData, actualStorageKey, err := ps.loadProcessDefinition(models.NormalizeTenantID(tenantID), processID, version)
 	if err != nil {
 		return nil, fmt.Errorf("failed to load process definition: %w", err)
 	}
@@ -69,6 +73,7 @@ func (ps *ProcessStarter) StartProcessInstance(
 
 	// Create process instance
 	instance := ps.createProcessInstance(bpmnProcess, actualStorageKey, variables)
+	beginInstanceSpan(ctx, instance)
 
 	// Save to storage first (sets InstanceID)
 	if err := ps.storage.SaveProcessInstance(instance); err != nil {
@@ -81,8 +86,12 @@ func (ps *ProcessStarter) StartProcessInstance(
 		logger.String("process_key", processKey),
 		logger.String("state", string(instance.State)))
 
-	// Start execution
-	if err := ps.startExecution(instance, bpmnProcess, actualStorageKey, variables); err != nil {
+	// Start execution, instance registered for message start is not started yet
+	err = ps.startExecution(instance, bpmnProcess, actualStorageKey, variables)
+	if instance.State != models.ProcessInstanceStateMessages {
+		metrics.ProcessInstancesStarted.WithLabelValues(instance.ProcessID).Inc()
+	}
+	if err != nil {
 		logger.Error("Failed to start process execution",
 			logger.String("instance_id", instance.InstanceID),
 			logger.String("error", err.Error()))
@@ -96,6 +105,42 @@ func (ps *ProcessStarter) StartProcessInstance(
 	return instance, nil
 }
 
+// StartProcessInstanceAtEvent starts instance of exact deployed definition at given
+// start event, used when start event trigger such as timer fires
+// Запускает экземпляр точного определения в заданном стартовом событии при срабатывании триггера
+func (ps *ProcessStarter) StartProcessInstanceAtEvent(
+	ctx context.Context,
+	processKey, startEventID string,
+) (*models.ProcessInstance, error) {
+	if !ps.component.IsReady() {
+		return nil, fmt.Errorf("process component not ready")
+	}
+
+	bpmnProcess, err := ps.bpmnHelper.LoadBPMNProcess(processKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load process definition: %w", err)
+	}
+
+	instance := ps.createProcessInstance(bpmnProcess, processKey, nil)
+	beginInstanceSpan(ctx, instance)
+
+	if err := ps.storage.SaveProcessInstance(instance); err != nil {
+		return nil, fmt.Errorf("failed to save process instance: %w", err)
+	}
+
+	logger.Info("Process instance created at start event",
+		logger.String("instance_id", instance.InstanceID),
+		logger.String("process_key", processKey),
+		logger.String("start_event_id", startEventID))
+
+	metrics.ProcessInstancesStarted.WithLabelValues(instance.ProcessID).Inc()
+	if err := ps.handleRegularStartEvent(instance, processKey, startEventID); err != nil {
+		return instance, fmt.Errorf("failed to start process execution: %w", err)
+	}
+
+	return instance, nil
+}
+
 // parseProcessKey parses process key to extract process ID and version
 // Парсит ключ процесса для извлечения ID процесса и версии
 func (ps *ProcessStarter) parseProcessKey(processKey string) (string, int) {
@@ -117,16 +162,18 @@ func (ps *ProcessStarter) parseProcessKey(processKey string) (string, int) {
 	return processKey, version
 }
 
-// loadProcessDefinition loads process definition from storage
-// Загружает определение процесса из storage
-func (ps *ProcessStarter) loadProcessDefinition(processID string, version int) ([]byte, string, error) {
+// loadProcessDefinition loads tenant process definition from storage
+// Загружает определение процесса арендатора из storage
+func (ps *ProcessStarter) loadProcessDefinition(tenantID, processID string, version int) ([]byte, string, error) {
 	logger.Info("Loading process for new instance",
+		logger.String("tenant_id", tenantID),
 		logger.String("process_id", processID),
 		logger.Int("version", version))
 
-	processData, actualStorageKey, err := ps.storage.LoadBPMNProcessByProcessID(processID, version)
+	processData, actualStorageKey, err := ps.storage.LoadBPMNProcessByProcessID(tenantID, processID, version)
 	if err != nil {
 		logger.Error("Failed to load process for new instance",
+			logger.String("tenant_id", tenantID),
 			logger.String("process_id", processID),
 			logger.Int("version", version),
 			logger.String("error", err.Error()))
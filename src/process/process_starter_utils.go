@@ -10,8 +10,9 @@ package process
 
 import (
 	"fmt"
-	"time"
 
+	"atom-engine/src/core/clock"
+	"atom-engine/src/core/events"
 	"atom-engine/src/core/logger"
 	"atom-engine/src/core/models"
 )
@@ -98,6 +99,9 @@ func (ps *ProcessStarter) handleRegularStartEvent(
 		}
 	}
 
+	// Publish before execution so element events follow instance start
+	publishInstanceEvent(events.TypeInstanceStarted, instance)
+
 	// Execute token to start the process
 	if err := ps.component.ExecuteToken(token); err != nil {
 		logger.Error("Failed to execute initial token", logger.String("error", err.Error()))
@@ -272,14 +276,14 @@ func (ps *ProcessStarter) createMessageStartEventSubscription(
 	// Create permanent subscription
 	subscription := &models.ProcessMessageSubscription{
 		ID:                   models.GenerateID(),
-		TenantID:             "", // Default tenant
+		TenantID:             bpmnProcess.TenantID,
 		ProcessDefinitionKey: processKey,
 		StartEventID:         startEventID,
 		MessageName:          messageName,
 		CorrelationKey:       correlationKey,
 		IsActive:             true,
-		CreatedAt:            time.Now(),
-		UpdatedAt:            time.Now(),
+		CreatedAt:            clock.Now(),
+		UpdatedAt:            clock.Now(),
 	}
 
 	if err := ps.component.CreateMessageSubscription(subscription); err != nil {
@@ -10,8 +10,8 @@ package process
 
 import (
 	"fmt"
-	"time"
 
+	"atom-engine/src/core/clock"
 	"atom-engine/src/core/logger"
 	"atom-engine/src/core/models"
 )
@@ -170,15 +170,15 @@ func (rte *ReceiveTaskExecutor) Execute(token *models.Token, element map[string]
 		// Создаем подписку на сообщение
 		subscription := &models.ProcessMessageSubscription{
 			ID:                   models.GenerateID(),
-			TenantID:             "DEFAULT_TENANT",
+			TenantID:             token.TenantID,
 			ProcessDefinitionKey: token.ProcessKey,
 			ProcessVersion:       int32(processVersion),  // Use actual version from ProcessKey
 			StartEventID:         token.CurrentElementID, // This is the receive task ID
 			MessageName:          messageName,
 			CorrelationKey:       correlationKey,
 			IsActive:             true,
-			CreatedAt:            time.Now(),
-			UpdatedAt:            time.Now(),
+			CreatedAt:            clock.Now(),
+			UpdatedAt:            clock.Now(),
 		}
 
 		if err := rte.processComponent.CreateMessageSubscription(subscription); err != nil {
@@ -458,6 +458,7 @@ func (rte *ReceiveTaskExecutor) createBoundaryTimerForEvent(
 			ProcessInstanceID: token.ProcessInstanceID,
 			ProcessKey:        token.ProcessKey,
 		}
+		timerRequest.Calendar = timerCalendarName(timerMap)
 
 		// Extract boundary event metadata for proper scope tracking
 		// Извлекаем метаданные boundary события для правильного отслеживания scope
@@ -141,7 +141,7 @@ func (rte *ReceiveTaskExecutor) evaluateTimerExpression(expression string, token
 	// Вычисляем FEEL expression используя expression engine
 	result, err := expressionComp.EvaluateExpressionEngine(expression, token.Variables)
 	if err != nil {
-		return nil, fmt.Errorf("failed to evaluate FEEL expression '%s': %w", expression, err)
+		return nil, &ExpressionError{Expression: expression, Err: err}
 	}
 
 	logger.Debug("Boundary timer expression evaluated successfully for receive task",
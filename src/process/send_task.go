@@ -380,6 +380,7 @@ func (ste *SendTaskExecutor) createBoundaryTimerForEvent(
 			ProcessInstanceID: token.ProcessInstanceID,
 			ProcessKey:        token.ProcessKey,
 		}
+		timerRequest.Calendar = timerCalendarName(timerMap)
 
 		// Extract boundary event metadata for proper scope tracking
 		// Извлекаем метаданные boundary события для правильного отслеживания scope
@@ -728,7 +729,7 @@ func (ste *SendTaskExecutor) evaluateTimerExpression(expression string, token *m
 	// Вычисляем FEEL expression используя expression engine
 	result, err := expressionComp.EvaluateExpressionEngine(expression, token.Variables)
 	if err != nil {
-		return nil, fmt.Errorf("failed to evaluate FEEL expression '%s': %w", expression, err)
+		return nil, &ExpressionError{Expression: expression, Err: err}
 	}
 
 	logger.Debug("Boundary timer expression evaluated successfully for send task",
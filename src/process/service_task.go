@@ -10,9 +10,12 @@ package process
 
 import (
 	"fmt"
+	"strconv"
+	"strings"
 
 	"atom-engine/src/core/logger"
 	"atom-engine/src/core/models"
+	"atom-engine/src/core/tracing"
 )
 
 // ServiceTaskExecutor executes service tasks
@@ -34,6 +37,20 @@ type JobComponentInterface interface {
 	) (string, error)
 }
 
+// PriorityJobComponentInterface creates jobs with retries and activation priority
+// Создает задания с количеством попыток и приоритетом активации
+type PriorityJobComponentInterface interface {
+	CreateJobWithPriority(
+		jobType, processInstanceID, processKey, elementID string,
+		customHeaders map[string]string,
+		variables map[string]interface{},
+		retries, priority int,
+	) (string, error)
+}
+
+// priorityHeader is the task header holding job activation priority
+const priorityHeader = "priority"
+
 // NewServiceTaskExecutor creates new service task executor
 // Создает новый исполнитель сервисных задач
 func NewServiceTaskExecutor(processComponent ComponentInterface) *ServiceTaskExecutor {
@@ -108,6 +125,28 @@ func (ste *ServiceTaskExecutor) Execute(token *models.Token, element map[string]
 	// Extract custom headers from task definition
 	customHeaders := ste.extractCustomHeaders(element)
 
+	// Job continues trace of service task, workers read it from custom headers
+	if traceparent := tracing.Traceparent(elementContext(token.TokenID)); traceparent != "" {
+		if customHeaders == nil {
+			customHeaders = make(map[string]string)
+		}
+		customHeaders[tracing.TraceparentKey] = traceparent
+	}
+
+	// Resolve job priority from task headers
+	priority, err := ste.resolveJobPriority(customHeaders, token)
+	if err != nil {
+		logger.Error("Failed to resolve job priority",
+			logger.String("token_id", token.TokenID),
+			logger.String("element_id", token.CurrentElementID),
+			logger.String("error", err.Error()))
+		return &ExecutionResult{
+			Success:   false,
+			Error:     fmt.Sprintf("failed to resolve job priority: %v", err),
+			Completed: false,
+		}, nil
+	}
+
 	// Add token ID to variables for job callback
 	jobVariables := make(map[string]interface{})
 	for k, v := range token.Variables {
@@ -117,11 +156,15 @@ func (ste *ServiceTaskExecutor) Execute(token *models.Token, element map[string]
 
 	// Get job component dynamically from process component
 	var jobComponent JobComponentInterface
+	var priorityJobComponent PriorityJobComponentInterface
 	if ste.processComponent != nil {
 		if jobComp := ste.processComponent.GetJobsComponent(); jobComp != nil {
 			if jc, ok := jobComp.(JobComponentInterface); ok {
 				jobComponent = jc
 			}
+			if pjc, ok := jobComp.(PriorityJobComponentInterface); ok {
+				priorityJobComponent = pjc
+			}
 		}
 	}
 
@@ -135,13 +178,27 @@ func (ste *ServiceTaskExecutor) Execute(token *models.Token, element map[string]
 			logger.String("token_id", token.TokenID),
 			logger.String("job_type", taskDefinition.Type))
 
-		jobID, err := jobComponent.CreateJobWithDetails(
-			taskDefinition.Type,
-			token.ProcessInstanceID,
-			token.CurrentElementID,
-			customHeaders,
-			jobVariables,
-		)
+		var jobID string
+		if priorityJobComponent != nil {
+			jobID, err = priorityJobComponent.CreateJobWithPriority(
+				taskDefinition.Type,
+				token.ProcessInstanceID,
+				token.ProcessKey,
+				token.CurrentElementID,
+				customHeaders,
+				jobVariables,
+				taskDefinition.Retries,
+				priority,
+			)
+		} else {
+			jobID, err = jobComponent.CreateJobWithDetails(
+				taskDefinition.Type,
+				token.ProcessInstanceID,
+				token.CurrentElementID,
+				customHeaders,
+				jobVariables,
+			)
+		}
 		if err != nil {
 			logger.Error("Failed to create job for service task",
 				logger.String("token_id", token.TokenID),
@@ -281,8 +338,16 @@ func (ste *ServiceTaskExecutor) extractTaskDefinition(element map[string]interfa
 
 			retries := 3 // default retries
 			if retriesVal, exists := taskDefMap["retries"]; exists {
-				if retriesInt, ok := retriesVal.(int); ok {
-					retries = retriesInt
+				switch v := retriesVal.(type) {
+				case int:
+					retries = v
+				case float64:
+					// Definitions loaded from storage carry JSON numbers
+					retries = int(v)
+				case string:
+					if parsed, err := strconv.Atoi(v); err == nil {
+						retries = parsed
+					}
 				}
 			}
 
@@ -335,20 +400,82 @@ func (ste *ServiceTaskExecutor) extractCustomHeaders(element map[string]interfac
 			}
 
 			extType, exists := extMap["type"]
-			if !exists || extType != "properties" {
+			if !exists || extType != "taskHeaders" {
 				continue
 			}
 
-			// Extract properties as custom headers
-			// This would contain task headers if they existed in BPMN
-			logger.Debug("Found properties extension",
-				logger.String("extension_data", fmt.Sprintf("%v", extMap)))
+			taskHeaders, ok := extMap["task_headers"].(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			// Headers are []map before storage round-trip and []interface{} after
+			var headers []map[string]interface{}
+			switch list := taskHeaders["headers"].(type) {
+			case []map[string]interface{}:
+				headers = list
+			case []interface{}:
+				for _, item := range list {
+					if header, ok := item.(map[string]interface{}); ok {
+						headers = append(headers, header)
+					}
+				}
+			}
+
+			for _, header := range headers {
+				key, _ := header["key"].(string)
+				if key == "" {
+					continue
+				}
+				value, _ := header["value"].(string)
+				customHeaders[key] = value
+			}
 		}
 	}
 
 	return customHeaders
 }
 
+// resolveJobPriority resolves job priority from the priority task header.
+// The header holds an integer or a FEEL expression evaluated against token variables.
+// Вычисляет приоритет задания из заголовка priority (число или FEEL выражение)
+func (ste *ServiceTaskExecutor) resolveJobPriority(customHeaders map[string]string, token *models.Token) (int, error) {
+	raw := strings.TrimSpace(customHeaders[priorityHeader])
+	if raw == "" {
+		return 0, nil
+	}
+
+	if !strings.HasPrefix(raw, "=") {
+		priority, err := strconv.Atoi(raw)
+		if err != nil {
+			return 0, fmt.Errorf("invalid priority '%s': %w", raw, err)
+		}
+		return priority, nil
+	}
+
+	result, err := ste.evaluateTimerExpression(raw, token)
+	if err != nil {
+		return 0, err
+	}
+
+	switch v := result.(type) {
+	case int:
+		return v, nil
+	case int64:
+		return int(v), nil
+	case float64:
+		return int(v), nil
+	case string:
+		priority, err := strconv.Atoi(strings.TrimSpace(v))
+		if err != nil {
+			return 0, &ExpressionError{Expression: raw, Err: fmt.Errorf("returned non-integer '%s'", v)}
+		}
+		return priority, nil
+	default:
+		return 0, &ExpressionError{Expression: raw, Err: fmt.Errorf("returned unsupported type %T", result)}
+	}
+}
+
 // createBoundaryTimers creates boundary timers for activity
 // Создает boundary таймеры для активности
 func (ste *ServiceTaskExecutor) createBoundaryTimers(token *models.Token, element map[string]interface{}) error {
@@ -483,6 +610,7 @@ func (ste *ServiceTaskExecutor) createBoundaryTimerForEvent(
 			ProcessInstanceID: token.ProcessInstanceID,
 			ProcessKey:        token.ProcessKey,
 		}
+		timerRequest.Calendar = timerCalendarName(timerMap)
 
 		// Extract boundary event metadata for proper scope tracking
 		// Извлекаем метаданные boundary события для правильного отслеживания scope
@@ -828,7 +956,7 @@ func (ste *ServiceTaskExecutor) evaluateTimerExpression(expression string, token
 	// Вычисляем FEEL expression используя expression engine
 	result, err := expressionComp.EvaluateExpressionEngine(expression, token.Variables)
 	if err != nil {
-		return nil, fmt.Errorf("failed to evaluate FEEL expression '%s': %w", expression, err)
+		return nil, &ExpressionError{Expression: expression, Err: err}
 	}
 
 	logger.Debug("Boundary timer expression evaluated successfully",
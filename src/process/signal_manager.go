@@ -12,6 +12,7 @@ import (
 	"sync"
 	"time"
 
+	"atom-engine/src/core/clock"
 	"atom-engine/src/core/logger"
 )
 
@@ -59,7 +60,7 @@ func (sm *SignalManager) Subscribe(
 		ElementID:      elementID,
 		CancelActivity: cancelActivity,
 		Variables:      variables,
-		CreatedAt:      time.Now(),
+		CreatedAt:      clock.Now(),
 	}
 
 	sm.subscriptions[signalName] = append(sm.subscriptions[signalName], subscription)
@@ -132,6 +133,7 @@ func (sm *SignalManager) processSignalSubscription(
 		subscription.SignalName, // messageName (signal name)
 		subscription.TokenID,    // correlationKey (using tokenID)
 		subscription.TokenID,    // tokenID
+		"",                      // tenantID, used only for message start events
 		mergedVariables,         // variables
 	)
 }
@@ -12,6 +12,7 @@ import (
 	"fmt"
 	"time"
 
+	"atom-engine/src/core/clock"
 	"atom-engine/src/core/logger"
 	"atom-engine/src/core/models"
 )
@@ -177,14 +178,14 @@ func (se *StartEventExecutor) handleMessageStartEvent(
 	if se.processComponent != nil && messageName != "" {
 		subscription := &models.ProcessMessageSubscription{
 			ID:                   models.GenerateID(),
-			TenantID:             "", // Default tenant
+			TenantID:             token.TenantID,
 			ProcessDefinitionKey: token.ProcessKey,
 			StartEventID:         token.CurrentElementID,
 			MessageName:          messageName,
 			CorrelationKey:       correlationKey,
 			IsActive:             true,
-			CreatedAt:            time.Now(),
-			UpdatedAt:            time.Now(),
+			CreatedAt:            clock.Now(),
+			UpdatedAt:            clock.Now(),
 		}
 
 		if err := se.processComponent.CreateMessageSubscription(subscription); err != nil {
@@ -221,10 +222,9 @@ func (se *StartEventExecutor) handleTimerStartEvent(
 		logger.String("token_id", token.TokenID),
 		logger.String("element_id", token.CurrentElementID))
 
-	// Timer start events are typically triggered by external scheduler
-	// For now, treat as regular start event
-	// Стартовые события таймера обычно запускаются внешним планировщиком
-	// Пока что обрабатываем как обычное стартовое событие
+	// Instance was created by fired START timer scheduled on deployment,
+	// so token passes on like regular start event
+	// Экземпляр создан сработавшим START таймером, запланированным при развертывании
 	return se.executeRegularStartEvent(token, element)
 }
 
@@ -314,7 +314,7 @@ func (se *StartEventExecutor) isAutoStartedToken(token *models.Token) bool {
 
 	// Check if process instance was created within last few seconds (likely auto-started)
 	// Проверяем был ли process instance создан в последние несколько секунд (вероятно автозапуск)
-	if token.CreatedAt.Add(time.Second * 5).After(time.Now()) {
+	if token.CreatedAt.Add(time.Second * 5).After(clock.Now()) {
 		logger.Info("Token created recently - likely auto-started",
 			logger.String("token_id", token.TokenID))
 		return true
@@ -0,0 +1,303 @@
+/*
+This file is part of the AtomBPMN (R) project.
+Copyright (c) 2025 Matreska Market LLC (ООО «Matreska Market»).
+Authors: Matreska Team.
+
+This project is dual-licensed under AGPL-3.0 and AtomBPMN Commercial License.
+*/
+
+package process
+
+import (
+	"context"
+	"fmt"
+
+	"atom-engine/src/core/logger"
+	"atom-engine/src/core/models"
+	"atom-engine/src/storage"
+	"atom-engine/src/timewheel"
+)
+
+// StartTimerManager schedules timer start events of deployed process definitions.
+// Only latest version of definition keeps its start timers, token_id and
+// process_instance_id of START timer carry process definition key.
+// Планирует таймерные стартовые события развернутых определений процессов
+type StartTimerManager struct {
+	storage      storage.Storage
+	component    ComponentInterface
+	bpmnHelper   *BPMNHelper
+	timerHandler *IntermediateCatchTimerHandler
+	starter      *ProcessStarter
+}
+
+// NewStartTimerManager creates new start timer manager
+// Создает новый менеджер стартовых таймеров
+func NewStartTimerManager(storage storage.Storage, component ComponentInterface) *StartTimerManager {
+	return &StartTimerManager{
+		storage:      storage,
+		component:    component,
+		bpmnHelper:   NewBPMNHelper(storage),
+		timerHandler: NewIntermediateCatchTimerHandler(component),
+		starter:      NewProcessStarter(storage, component),
+	}
+}
+
+// ScheduleStartTimers replaces start timers of process with timers of deployed definition
+// Заменяет стартовые таймеры процесса таймерами развернутого определения
+func (stm *StartTimerManager) ScheduleStartTimers(processKey string) error {
+	bpmnProcess, err := stm.bpmnHelper.LoadBPMNProcess(processKey)
+	if err != nil {
+		return err
+	}
+
+	tenantID := models.NormalizeTenantID(bpmnProcess.TenantID)
+	maxVersion, err := stm.storage.GetMaxProcessVersionByProcessID(tenantID, bpmnProcess.ProcessID)
+	if err != nil {
+		return fmt.Errorf("failed to get latest process version: %w", err)
+	}
+	if bpmnProcess.ProcessVersion < maxVersion {
+		logger.Debug("Skipping start timers of superseded process version",
+			logger.String("process_key", processKey),
+			logger.Int("latest_version", maxVersion))
+		return nil
+	}
+
+	stm.cancelStartTimers(func(timerProcessKey string) bool {
+		timerTenantID, _ := models.SplitTenantScopedKey(timerProcessKey)
+		return timerTenantID == tenantID && extractProcessIDFromKey(timerProcessKey) == bpmnProcess.ProcessID
+	})
+
+	for _, startEventID := range stm.findTimerStartEvents(bpmnProcess) {
+		if err := stm.scheduleStartTimer(bpmnProcess, processKey, startEventID); err != nil {
+			return fmt.Errorf("failed to schedule timer start event %s: %w", startEventID, err)
+		}
+	}
+
+	return nil
+}
+
+// HandleProcessDeleted cancels start timers of deleted definition and re-arms
+// previous version if deleted definition was latest one
+// Отменяет стартовые таймеры удаленного определения и восстанавливает предыдущую версию
+func (stm *StartTimerManager) HandleProcessDeleted(processKey string) error {
+	stm.cancelStartTimers(func(timerProcessKey string) bool {
+		return timerProcessKey == processKey
+	})
+
+	tenantID, key := models.SplitTenantScopedKey(processKey)
+	processID := extractProcessIDFromKey(processKey)
+	maxVersion, err := stm.storage.GetMaxProcessVersionByProcessID(tenantID, processID)
+	if err != nil {
+		return fmt.Errorf("failed to get latest process version: %w", err)
+	}
+	if maxVersion == 0 || maxVersion > extractVersionFromKey(key) {
+		return nil
+	}
+
+	latestKey := models.TenantScopedKey(tenantID, fmt.Sprintf("%s:v%d", processID, maxVersion))
+	return stm.ScheduleStartTimers(latestKey)
+}
+
+// HandleStartTimerCallback starts process instance at start event of fired timer
+// Запускает экземпляр процесса в стартовом событии сработавшего таймера
+func (stm *StartTimerManager) HandleStartTimerCallback(timerRecord *storage.TimerRecord) error {
+	// START timers of CLI come from logs component and only get logged
+	// START таймеры CLI приходят от компонента logs и только логируются
+	if source, _ := timerRecord.ProcessContext["component_source"].(string); source != "process" {
+		logger.Debug("Ignoring start timer not owned by process component",
+			logger.String("timer_id", timerRecord.ID),
+			logger.String("component_source", source))
+		return nil
+	}
+
+	processKey, _ := timerRecord.ProcessContext["process_key"].(string)
+	tenantID, key := models.SplitTenantScopedKey(processKey)
+	maxVersion, err := stm.storage.GetMaxProcessVersionByProcessID(tenantID, extractProcessIDFromKey(processKey))
+	if err != nil {
+		return fmt.Errorf("failed to get latest process version: %w", err)
+	}
+	if maxVersion != extractVersionFromKey(key) {
+		logger.Warn("Ignoring start timer of superseded or deleted process version",
+			logger.String("timer_id", timerRecord.ID),
+			logger.String("process_key", processKey),
+			logger.Int("latest_version", maxVersion))
+		return nil
+	}
+
+	instance, err := stm.starter.StartProcessInstanceAtEvent(context.Background(), processKey, timerRecord.ElementID)
+	if err != nil {
+		return fmt.Errorf("failed to start process %s by timer %s: %w", processKey, timerRecord.ID, err)
+	}
+
+	logger.Info("Process instance started by timer start event",
+		logger.String("timer_id", timerRecord.ID),
+		logger.String("process_key", processKey),
+		logger.String("start_event_id", timerRecord.ElementID),
+		logger.String("instance_id", instance.InstanceID))
+
+	return nil
+}
+
+// findTimerStartEvents returns top-level start events with timer definition
+// Возвращает стартовые события верхнего уровня с определением таймера
+func (stm *StartTimerManager) findTimerStartEvents(bpmnProcess *models.BPMNProcess) []string {
+	var startEventIDs []string
+	for elementID, element := range bpmnProcess.Elements {
+		elementMap, ok := element.(map[string]interface{})
+		if !ok || elementMap["type"] != "startEvent" {
+			continue
+		}
+		if parentScope, exists := elementMap["parent_scope"]; exists &&
+			parentScope != nil && parentScope != "" && parentScope != bpmnProcess.ProcessID {
+			continue
+		}
+		if timerEventDefinition(elementMap) != nil {
+			startEventIDs = append(startEventIDs, elementID)
+		}
+	}
+	return startEventIDs
+}
+
+// scheduleStartTimer schedules START timer for timer start event
+// Планирует START таймер для таймерного стартового события
+func (stm *StartTimerManager) scheduleStartTimer(
+	bpmnProcess *models.BPMNProcess,
+	processKey, startEventID string,
+) error {
+	element, _ := bpmnProcess.Elements[startEventID].(map[string]interface{})
+
+	// Start timer has no instance variables, same extraction as catch events
+	// Стартовый таймер не имеет переменных экземпляра, извлечение как у catch событий
+	definitionToken := &models.Token{
+		TokenID:           processKey,
+		ProcessInstanceID: processKey,
+		ProcessKey:        processKey,
+		CurrentElementID:  startEventID,
+		Variables:         map[string]interface{}{},
+	}
+	request := stm.timerHandler.createTimerRequest(definitionToken, timerEventDefinition(element))
+	if request == nil {
+		return fmt.Errorf("invalid timer definition")
+	}
+
+	twRequest := timewheel.TimerRequest{
+		ElementID:         startEventID,
+		TokenID:           processKey,
+		ProcessInstanceID: processKey,
+		TimerType:         models.TimerTypeStart,
+		ProcessContext: &models.TimerProcessContext{
+			ProcessKey:      processKey,
+			ProcessVersion:  bpmnProcess.ProcessVersion,
+			ProcessName:     bpmnProcess.ProcessName,
+			TenantID:        models.NormalizeTenantID(bpmnProcess.TenantID),
+			ComponentSource: "process",
+		},
+		TimeDate:     request.TimeDate,
+		TimeDuration: request.TimeDuration,
+		TimeCycle:    request.TimeCycle,
+		Calendar:     request.Calendar,
+	}
+
+	messageJSON, err := timewheel.CreateScheduleTimerMessage(twRequest)
+	if err != nil {
+		return fmt.Errorf("failed to create start timer message: %w", err)
+	}
+
+	twComp, err := stm.timewheel()
+	if err != nil {
+		return err
+	}
+	if err := twComp.ProcessMessage(context.Background(), messageJSON); err != nil {
+		return fmt.Errorf("failed to process start timer message: %w", err)
+	}
+
+	logger.Info("Timer start event scheduled",
+		logger.String("process_key", processKey),
+		logger.String("start_event_id", startEventID))
+
+	return nil
+}
+
+// cancelStartTimers cancels scheduled START timers of process component
+// whose process key matches
+// Отменяет запланированные START таймеры с подходящим ключом процесса
+func (stm *StartTimerManager) cancelStartTimers(match func(processKey string) bool) {
+	allTimers, err := stm.storage.LoadAllTimers()
+	if err != nil {
+		logger.Error("Failed to load timers for start timer cancellation",
+			logger.String("error", err.Error()))
+		return
+	}
+
+	twComp, err := stm.timewheel()
+	if err != nil {
+		logger.Error("Failed to cancel start timers", logger.String("error", err.Error()))
+		return
+	}
+
+	ctx := context.Background()
+	for _, timer := range allTimers {
+		if timer.TimerType != string(models.TimerTypeStart) || timer.State != "SCHEDULED" {
+			continue
+		}
+		if source, _ := timer.ProcessContext["component_source"].(string); source != "process" {
+			continue
+		}
+		if processKey, _ := timer.ProcessContext["process_key"].(string); !match(processKey) {
+			continue
+		}
+
+		cancelMessage, err := timewheel.CreateCancelTimerMessage(timer.ID)
+		if err != nil {
+			logger.Error("Failed to create cancel timer message",
+				logger.String("timer_id", timer.ID),
+				logger.String("error", err.Error()))
+			continue
+		}
+		if err := twComp.ProcessMessage(ctx, cancelMessage); err != nil {
+			logger.Error("Failed to cancel start timer in timewheel",
+				logger.String("timer_id", timer.ID),
+				logger.String("error", err.Error()))
+		}
+
+		timer.State = "CANCELLED"
+		if err := stm.storage.UpdateTimer(timer); err != nil {
+			logger.Error("Failed to update start timer state",
+				logger.String("timer_id", timer.ID),
+				logger.String("error", err.Error()))
+		}
+	}
+}
+
+// timewheel returns timewheel component accepting timer messages
+// Возвращает timewheel компонент принимающий сообщения таймеров
+func (stm *StartTimerManager) timewheel() (interface {
+	ProcessMessage(ctx context.Context, messageJSON string) error
+}, error) {
+	core := stm.component.GetCore()
+	if core == nil {
+		return nil, fmt.Errorf("core interface not available")
+	}
+
+	twComp, ok := core.GetTimewheelComponentInterface().(interface {
+		ProcessMessage(ctx context.Context, messageJSON string) error
+	})
+	if !ok {
+		return nil, fmt.Errorf("timewheel component does not implement ProcessMessage")
+	}
+
+	return twComp, nil
+}
+
+// timerEventDefinition returns timer event definition of element or nil
+// Возвращает определение timer события элемента или nil
+func timerEventDefinition(element map[string]interface{}) map[string]interface{} {
+	eventDefList, _ := element["event_definitions"].([]interface{})
+	for _, eventDef := range eventDefList {
+		if eventDefMap, ok := eventDef.(map[string]interface{}); ok &&
+			eventDefMap["type"] == "timerEventDefinition" {
+			return eventDefMap
+		}
+	}
+	return nil
+}
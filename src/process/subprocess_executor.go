@@ -395,13 +395,14 @@ func (spe *SubProcessExecutor) handleTimerStartEvent(
 	element map[string]interface{},
 	subprocessVariables map[string]interface{},
 ) error {
-	logger.Info("Timer start event in subprocess - scheduling timer",
+	// Event sub-processes have no runtime yet, their timer start events are not
+	// scheduled and sub-process is entered like with none start event
+	// Событийные подпроцессы пока не исполняются, их таймеры не планируются
+	logger.Warn("Timer start event in subprocess is not scheduled - entering subprocess directly",
 		logger.String("parent_token_id", parentToken.TokenID),
 		logger.String("subprocess_id", parentToken.CurrentElementID),
 		logger.String("start_event_id", startEventInfo.ID))
 
-	// TODO: Implement timer scheduling for subprocess start event
-	// For now, treat as none start event
 	return spe.handleNoneStartEvent(parentToken, startEventInfo, subprocessVariables, "")
 }
 
@@ -600,6 +601,7 @@ func (spe *SubProcessExecutor) createBoundaryTimerForEvent(
 			ProcessInstanceID: token.ProcessInstanceID,
 			ProcessKey:        token.ProcessKey,
 		}
+		timerRequest.Calendar = timerCalendarName(timerMap)
 
 		// Extract boundary event metadata
 		if attachedToRef, exists := boundaryEvent["attached_to_ref"]; exists {
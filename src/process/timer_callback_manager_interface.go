@@ -29,6 +29,10 @@ type TimerCallbackManagerInterface interface {
 	// Process timer operations
 	CancelAllTimersForProcessInstance(instanceID string) error
 
+	// Start timer operations
+	ScheduleStartTimers(processKey string) error
+	CancelStartTimers(processKey string) error
+
 	// Helper operations
 	GetBPMNProcessForToken(token *models.Token) (map[string]interface{}, error)
 }
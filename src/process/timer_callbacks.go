@@ -64,9 +64,11 @@ func (tc *TimerCallbacks) CreateTimer(timerRequest *TimerRequest) error {
 
 	// Get process version from ProcessInstanceID
 	processVersion := 1 // Default fallback
+	tenantID := models.DefaultTenantID
 	if tc.storage != nil {
 		if instance, err := tc.storage.LoadProcessInstance(timerRequest.ProcessInstanceID); err == nil && instance != nil {
 			processVersion = instance.ProcessVersion
+			tenantID = instance.TenantID
 		}
 	}
 
@@ -80,6 +82,7 @@ func (tc *TimerCallbacks) CreateTimer(timerRequest *TimerRequest) error {
 			ProcessKey:      timerRequest.ProcessKey,
 			ProcessVersion:  processVersion, // Use actual version from process instance
 			ProcessName:     "Process Timer",
+			TenantID:        tenantID,
 			ComponentSource: "process",
 		},
 	}
@@ -94,6 +97,7 @@ func (tc *TimerCallbacks) CreateTimer(timerRequest *TimerRequest) error {
 	} else {
 		return fmt.Errorf("no timer definition provided")
 	}
+	twRequest.Calendar = timerRequest.Calendar
 
 	// Create schedule timer message
 	messageJSON, err := timewheel.CreateScheduleTimerMessage(twRequest)
@@ -10,8 +10,8 @@ package process
 
 import (
 	"fmt"
-	"time"
 
+	"atom-engine/src/core/clock"
 	"atom-engine/src/core/logger"
 	"atom-engine/src/core/models"
 	"atom-engine/src/storage"
@@ -123,7 +123,7 @@ func (tm *TokenManager) GetToken(tokenID string) (*models.Token, error) {
 // UpdateToken updates token in storage
 // Обновляет токен в storage
 func (tm *TokenManager) UpdateToken(token *models.Token) error {
-	token.UpdatedAt = time.Now()
+	token.UpdatedAt = clock.Now()
 	return tm.storage.UpdateToken(token)
 }
 
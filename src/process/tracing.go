@@ -0,0 +1,115 @@
+/*
+This file is part of the AtomBPMN (R) project.
+Copyright (c) 2025 Matreska Market LLC (ООО «Matreska Market»).
+Authors: Matreska Team.
+
+This project is dual-licensed under AGPL-3.0 and AtomBPMN Commercial License.
+*/
+
+package process
+
+import (
+	"context"
+	"sync"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"atom-engine/src/core/clock"
+	"atom-engine/src/core/models"
+	"atom-engine/src/core/tracing"
+	"atom-engine/src/storage"
+)
+
+// elementSpans maps token ID to context of element span being executed, so
+// executors nest their spans and propagate trace to jobs and HTTP calls
+// Сопоставляет ID токена с контекстом выполняемого спана элемента
+var elementSpans sync.Map
+
+// instanceSpanName returns name of process instance lifetime span
+// Возвращает имя спана жизни экземпляра процесса
+func instanceSpanName(instance *models.ProcessInstance) string {
+	return "process_instance " + instance.ProcessID
+}
+
+// beginInstanceSpan opens lifetime span of new instance under span context of
+// ctx and stores its traceparent and parent on instance metadata
+// Открывает спан жизни нового экземпляра и сохраняет traceparent в метаданных
+func beginInstanceSpan(ctx context.Context, instance *models.ProcessInstance) {
+	traceparent := tracing.BeginSpan(ctx, instanceSpanName(instance))
+	if traceparent == "" {
+		return
+	}
+	instance.AddMetadata(tracing.TraceparentKey, traceparent)
+	if parent := tracing.Traceparent(ctx); parent != "" {
+		instance.AddMetadata(tracing.ParentKey, parent)
+	}
+}
+
+// endInstanceSpan emits lifetime span of finished instance
+// Выпускает спан жизни завершенного экземпляра
+func endInstanceSpan(instance *models.ProcessInstance) {
+	end := clock.Now()
+	if instance.CompletedAt != nil {
+		end = *instance.CompletedAt
+	}
+	tracing.EndSpan(instanceSpanName(instance),
+		instanceMetadata(instance, tracing.TraceparentKey),
+		instanceMetadata(instance, tracing.ParentKey),
+		instance.StartedAt, end, nil,
+		attribute.String("bpmn.process_id", instance.ProcessID),
+		attribute.Int("bpmn.process_version", instance.ProcessVersion),
+		attribute.String("bpmn.instance_id", instance.InstanceID),
+		attribute.String("bpmn.instance_state", string(instance.State)))
+}
+
+// instanceMetadata returns string metadata value of instance
+// Возвращает строковое значение метаданных экземпляра
+func instanceMetadata(instance *models.ProcessInstance, key string) string {
+	value, _ := instance.GetMetadata(key)
+	text, _ := value.(string)
+	return text
+}
+
+// startElementSpan starts span of element execution under instance span and
+// registers it for executors of token. Caller finishes it by endElementSpan.
+// Запускает спан выполнения элемента под спаном экземпляра
+func startElementSpan(
+	store storage.Storage,
+	token *models.Token,
+	elementType string,
+) trace.Span {
+	ctx := context.Background()
+	if tracing.Enabled() {
+		if instance, err := store.LoadProcessInstance(token.ProcessInstanceID); err == nil && instance != nil {
+			ctx = tracing.ContextWithTraceparent(ctx, instanceMetadata(instance, tracing.TraceparentKey))
+		}
+	}
+
+	ctx, span := tracing.Tracer().Start(ctx, "element "+elementType,
+		trace.WithAttributes(
+			attribute.String("bpmn.element_id", token.CurrentElementID),
+			attribute.String("bpmn.element_type", elementType),
+			attribute.String("bpmn.instance_id", token.ProcessInstanceID),
+			attribute.String("bpmn.token_id", token.TokenID),
+		))
+	elementSpans.Store(token.TokenID, ctx)
+	return span
+}
+
+// endElementSpan finishes element span and unregisters it
+// Завершает спан элемента и снимает его регистрацию
+func endElementSpan(token *models.Token, span trace.Span, err error) {
+	elementSpans.Delete(token.TokenID)
+	tracing.End(span, err)
+}
+
+// elementContext returns context of element span executing token, background
+// context when token is not being executed
+// Возвращает контекст спана элемента, выполняющего токен
+func elementContext(tokenID string) context.Context {
+	if ctx, ok := elementSpans.Load(tokenID); ok {
+		return ctx.(context.Context)
+	}
+	return context.Background()
+}
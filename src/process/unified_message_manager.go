@@ -48,7 +48,7 @@ func (umm *UnifiedMessageManager) SetCore(core CoreInterface) {
 // HandleMessageCallback handles message callback following proper architectural patterns
 // Обрабатывает message callback следуя правильным архитектурным паттернам
 func (umm *UnifiedMessageManager) HandleMessageCallback(
-	messageID, messageName, correlationKey, tokenID string,
+	messageID, messageName, correlationKey, tokenID, tenantID string,
 	variables map[string]interface{},
 ) error {
 	if !umm.component.IsReady() {
@@ -69,7 +69,8 @@ func (umm *UnifiedMessageManager) HandleMessageCallback(
 			logger.String("message_name", messageName))
 
 		// Delegate Message Start Event handling to engine
-		return umm.component.HandleEngineMessageCallback(messageID, messageName, correlationKey, tokenID, variables)
+		return umm.component.HandleEngineMessageCallback(
+			messageID, messageName, correlationKey, tokenID, tenantID, variables)
 	}
 
 	// Handle Intermediate Catch Message Events using CallbackHelper pattern
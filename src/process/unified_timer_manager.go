@@ -25,6 +25,7 @@ type UnifiedTimerManager struct {
 	component            ComponentInterface
 	timerCallbacks       *TimerCallbacks
 	boundaryTimerManager *BoundaryTimerManager
+	startTimerManager    *StartTimerManager
 	bpmnHelper           *BPMNHelper
 }
 
@@ -36,6 +37,7 @@ func NewUnifiedTimerManager(storage storage.Storage, component ComponentInterfac
 		component:            component,
 		timerCallbacks:       NewTimerCallbacks(storage, component),
 		boundaryTimerManager: NewBoundaryTimerManager(storage, component),
+		startTimerManager:    NewStartTimerManager(storage, component),
 		bpmnHelper:           NewBPMNHelper(storage),
 	}
 }
@@ -82,11 +84,25 @@ func (utm *UnifiedTimerManager) HandleTimerCallback(timerID, elementID, tokenID
 		return utm.boundaryTimerManager.HandleBoundaryTimerCallback(timerID, elementID, tokenID, timerRecord)
 	case "EVENT":
 		return utm.timerCallbacks.HandleTimerCallback(timerID, elementID, tokenID)
+	case "START":
+		return utm.startTimerManager.HandleStartTimerCallback(timerRecord)
 	default:
 		return utm.timerCallbacks.HandleTimerCallback(timerID, elementID, tokenID)
 	}
 }
 
+// ScheduleStartTimers schedules timer start events of deployed process
+// Планирует таймерные стартовые события развернутого процесса
+func (utm *UnifiedTimerManager) ScheduleStartTimers(processKey string) error {
+	return utm.startTimerManager.ScheduleStartTimers(processKey)
+}
+
+// CancelStartTimers cancels timer start events of deleted process
+// Отменяет таймерные стартовые события удаленного процесса
+func (utm *UnifiedTimerManager) CancelStartTimers(processKey string) error {
+	return utm.startTimerManager.HandleProcessDeleted(processKey)
+}
+
 // CreateBoundaryTimer creates boundary timer
 // Создает boundary таймер
 func (utm *UnifiedTimerManager) CreateBoundaryTimer(timerRequest *TimerRequest) error {
@@ -0,0 +1,224 @@
+/*
+This file is part of the AtomBPMN (R) project.
+Copyright (c) 2025 Matreska Market LLC (ООО «Matreska Market»).
+Authors: Matreska Team.
+
+This project is dual-licensed under AGPL-3.0 and AtomBPMN Commercial License.
+*/
+
+package process
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"atom-engine/src/core/calendar"
+	"atom-engine/src/core/clock"
+	"atom-engine/src/core/logger"
+	"atom-engine/src/core/models"
+	"atom-engine/src/timewheel"
+)
+
+// userTaskDueDateKey stores computed due date in token execution context
+// Хранит вычисленный срок в контексте выполнения токена
+const userTaskDueDateKey = "due_date"
+
+// UserTaskExecutor executes user tasks
+// Исполнитель пользовательских задач
+type UserTaskExecutor struct {
+	processComponent ComponentInterface
+}
+
+// NewUserTaskExecutor creates new user task executor
+// Создает новый исполнитель пользовательских задач
+func NewUserTaskExecutor(processComponent ComponentInterface) *UserTaskExecutor {
+	return &UserTaskExecutor{
+		processComponent: processComponent,
+	}
+}
+
+// Execute executes user task
+// Выполняет пользовательскую задачу
+func (ute *UserTaskExecutor) Execute(token *models.Token, element map[string]interface{}) (*ExecutionResult, error) {
+	logger.Info("Executing user task",
+		logger.String("token_id", token.TokenID),
+		logger.String("element_id", token.CurrentElementID))
+
+	// Get task name for logging
+	taskName, _ := element["name"].(string)
+	if taskName == "" {
+		taskName = token.CurrentElementID
+	}
+
+	// Due date from zeebe:taskSchedule, optionally in business calendar working time
+	// Срок из zeebe:taskSchedule, при необходимости в рабочем времени бизнес-календаря
+	if dueDate, ok, err := ute.resolveDueDate(token, element); err != nil {
+		logger.Error("Failed to compute user task due date",
+			logger.String("token_id", token.TokenID),
+			logger.String("element_id", token.CurrentElementID),
+			logger.String("error", err.Error()))
+	} else if ok {
+		if token.ExecutionContext == nil {
+			token.ExecutionContext = make(map[string]interface{})
+		}
+		token.ExecutionContext[userTaskDueDateKey] = dueDate.Format(time.RFC3339)
+		logger.Info("User task due date computed",
+			logger.String("token_id", token.TokenID),
+			logger.String("due_date", dueDate.Format(time.RFC3339)))
+	}
+
+	// User tasks typically wait for external completion
+	// For now, we'll put the token in waiting state
+	logger.Info("User task waiting for completion",
+		logger.String("token_id", token.TokenID),
+		logger.String("task_name", taskName))
+
+	return &ExecutionResult{
+		Success:      true,
+		TokenUpdated: true,
+		NextElements: []string{},
+		WaitingFor:   "user_task_completion",
+		Completed:    false,
+	}, nil
+}
+
+// GetElementType returns element type
+// Возвращает тип элемента
+func (ute *UserTaskExecutor) GetElementType() string {
+	return "userTask"
+}
+
+// resolveDueDate computes due date from taskSchedule extension. dueDate is
+// FEEL expression, ISO 8601 date or duration from task activation. With
+// calendar attribute durations count working time and dates move into it.
+// Вычисляет срок из расширения taskSchedule
+func (ute *UserTaskExecutor) resolveDueDate(
+	token *models.Token,
+	element map[string]interface{},
+) (time.Time, bool, error) {
+	attributes := findExtensionAttributes(element, "taskSchedule")
+	expression := attributes["dueDate"]
+	if expression == "" {
+		return time.Time{}, false, nil
+	}
+
+	var cal *calendar.Calendar
+	if name := attributes["calendar"]; name != "" {
+		resolved, err := calendar.Get(name)
+		if err != nil {
+			return time.Time{}, false, err
+		}
+		cal = resolved
+	}
+
+	evaluated, err := ute.evaluateDueDateExpression(expression, token)
+	if err != nil {
+		return time.Time{}, false, err
+	}
+	value := strings.TrimSpace(fmt.Sprintf("%v", evaluated))
+
+	parser := timewheel.NewISO8601DurationParser()
+	if strings.HasPrefix(strings.ToUpper(value), "P") {
+		if cal != nil {
+			dueDate, err := timewheel.AddWorkingDuration(cal, clock.Now(), value)
+			return dueDate, err == nil, err
+		}
+		duration, err := parser.ParseCalendarDuration(value)
+		if err != nil {
+			return time.Time{}, false, err
+		}
+		return duration.AddTo(clock.Now(), 1), true, nil
+	}
+
+	dueDate, err := parser.ParseDate(value)
+	if err != nil {
+		return time.Time{}, false, err
+	}
+	if cal != nil {
+		if dueDate, err = cal.NextWorkingTime(dueDate); err != nil {
+			return time.Time{}, false, err
+		}
+	}
+	return dueDate, true, nil
+}
+
+// evaluateDueDateExpression evaluates FEEL expression of due date.
+// Plain values are returned unchanged.
+// Вычисляет FEEL выражение срока
+func (ute *UserTaskExecutor) evaluateDueDateExpression(expression string, token *models.Token) (interface{}, error) {
+	// If not a FEEL expression (doesn't start with =), return as is
+	// Если не FEEL expression (не начинается с =), возвращаем как есть
+	if !strings.HasPrefix(expression, "=") {
+		return expression, nil
+	}
+
+	if ute.processComponent == nil {
+		return nil, fmt.Errorf("process component not available for expression evaluation")
+	}
+
+	core := ute.processComponent.GetCore()
+	if core == nil {
+		return nil, fmt.Errorf("core interface not available for expression evaluation")
+	}
+
+	type ExpressionEvaluator interface {
+		EvaluateExpressionEngine(expression interface{}, variables map[string]interface{}) (interface{}, error)
+	}
+
+	expressionComp, ok := core.GetExpressionComponent().(ExpressionEvaluator)
+	if !ok {
+		return nil, fmt.Errorf("expression component not available")
+	}
+
+	result, err := expressionComp.EvaluateExpressionEngine(expression, token.Variables)
+	if err != nil {
+		return nil, &ExpressionError{Expression: expression, Err: err}
+	}
+	return result, nil
+}
+
+// findExtensionAttributes returns attributes of first extension element of type
+// Возвращает атрибуты первого элемента расширения указанного типа
+func findExtensionAttributes(element map[string]interface{}, extensionType string) map[string]string {
+	result := make(map[string]string)
+
+	extElementsList, ok := element["extension_elements"].([]interface{})
+	if !ok {
+		return result
+	}
+
+	for _, extElement := range extElementsList {
+		extElementMap, ok := extElement.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		extensionsList, ok := extElementMap["extensions"].([]interface{})
+		if !ok {
+			continue
+		}
+
+		for _, ext := range extensionsList {
+			extMap, ok := ext.(map[string]interface{})
+			if !ok || extMap["type"] != extensionType {
+				continue
+			}
+
+			switch attributes := extMap["attributes"].(type) {
+			case map[string]interface{}:
+				for key, value := range attributes {
+					if str, ok := value.(string); ok {
+						result[key] = str
+					}
+				}
+			case map[string]string:
+				for key, value := range attributes {
+					result[key] = value
+				}
+			}
+			return result
+		}
+	}
+
+	return result
+}
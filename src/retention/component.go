@@ -0,0 +1,369 @@
+/*
+This file is part of the AtomBPMN (R) project.
+Copyright (c) 2025 Matreska Market LLC (ООО «Matreska Market»).
+Authors: Matreska Team.
+
+This project is dual-licensed under AGPL-3.0 and AtomBPMN Commercial License.
+*/
+
+package retention
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"atom-engine/src/core/clock"
+	"atom-engine/src/core/config"
+	"atom-engine/src/core/logger"
+	"atom-engine/src/core/metrics"
+	"atom-engine/src/core/models"
+	"atom-engine/src/exporters"
+	"atom-engine/src/storage"
+)
+
+// deleteChunk limits records archived and deleted at once
+const deleteChunk = 1000
+
+// errBatchFull stops instance scan once batch of expired instances is selected
+var errBatchFull = errors.New("retention batch full")
+
+// RunResult describes single cleanup run
+// Описывает один запуск очистки
+type RunResult struct {
+	StartedAt  time.Time      `json:"started_at"`
+	FinishedAt time.Time      `json:"finished_at"`
+	Deleted    map[string]int `json:"deleted"` // Deleted records by history kind
+}
+
+// archivedRecord is archive line of deleted record
+type archivedRecord struct {
+	ArchivedAt time.Time `json:"archived_at"`
+	*storage.HistoryRecord
+}
+
+// Component removes expired history in background: finished process
+// instances with all their records and standalone jobs, incidents,
+// correlation results and system events. Badger TTL is not used since
+// period depends on process definition and records are archived first.
+// Удаляет устаревшую историю в фоне
+type Component struct {
+	storage storage.Storage
+	logger  logger.ComponentLogger
+
+	cfg       config.RetentionConfig
+	policy    *Policy
+	interval  time.Duration
+	batchSize int
+	archive   *exporters.JSONLinesFile
+
+	runMu sync.Mutex // Serializes cleanup runs
+
+	ready  bool
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// NewComponent creates new retention component
+// Создает новый компонент политик хранения
+func NewComponent(cfg *config.Config, storage storage.Storage) *Component {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	c := &Component{
+		storage:   storage,
+		logger:    logger.NewComponentLogger("retention"),
+		interval:  time.Hour,
+		batchSize: 10000,
+		ctx:       ctx,
+		cancel:    cancel,
+	}
+
+	if cfg != nil {
+		c.cfg = cfg.Retention
+		if interval, err := time.ParseDuration(cfg.Retention.Interval); err == nil && interval > 0 {
+			c.interval = interval
+		}
+		if cfg.Retention.BatchSize > 0 {
+			c.batchSize = cfg.Retention.BatchSize
+		}
+	}
+
+	return c
+}
+
+// Init parses retention policy and opens history archive
+// Разбирает политику хранения и открывает архив истории
+func (c *Component) Init() error {
+	c.logger.Info("Initializing retention component")
+
+	if c.storage == nil {
+		return fmt.Errorf("storage is required for retention component")
+	}
+
+	policy, err := NewPolicy(c.cfg)
+	if err != nil {
+		return fmt.Errorf("invalid retention policy: %w", err)
+	}
+	c.policy = policy
+
+	if c.cfg.Enabled && c.cfg.Archive.Path != "" {
+		c.archive = exporters.NewJSONLinesFile(c.cfg.Archive)
+		if err := c.archive.Open(); err != nil {
+			return fmt.Errorf("failed to open history archive: %w", err)
+		}
+	}
+
+	c.logger.Info("Retention component initialized",
+		logger.Bool("enabled", c.cfg.Enabled),
+		logger.Bool("archive", c.archive != nil))
+	return nil
+}
+
+// Start starts periodic cleanup when retention is enabled
+// Запускает периодическую очистку, если политики включены
+func (c *Component) Start() error {
+	if c.cfg.Enabled {
+		c.wg.Add(1)
+		go c.cleanupLoop()
+	}
+
+	c.ready = true
+	c.logger.Info("Retention component started")
+	return nil
+}
+
+// Stop stops cleanup, interrupted run continues on next start
+// Останавливает очистку
+func (c *Component) Stop() error {
+	c.ready = false
+	c.cancel()
+	c.wg.Wait()
+
+	if c.archive != nil {
+		if err := c.archive.Close(); err != nil {
+			c.logger.Warn("Failed to close history archive", logger.String("error", err.Error()))
+		}
+	}
+
+	c.logger.Info("Retention component stopped")
+	return nil
+}
+
+// IsReady returns component readiness
+// Возвращает готовность компонента
+func (c *Component) IsReady() bool {
+	return c.ready
+}
+
+// Run removes expired history once. Records are archived before deletion
+// when archive is configured, failed archive write keeps records.
+// Удаляет устаревшую историю один раз
+func (c *Component) Run() (*RunResult, error) {
+	c.runMu.Lock()
+	defer c.runMu.Unlock()
+
+	now := clock.Now()
+	result := &RunResult{StartedAt: now, Deleted: make(map[string]int)}
+
+	if err := c.purgeProcessInstances(now, result); err != nil {
+		return result, fmt.Errorf("failed to remove process instances: %w", err)
+	}
+
+	for kind, period := range c.policy.Kinds {
+		before := now.Add(-period)
+		err := c.purge(result, func(handler func(record *storage.HistoryRecord) error) error {
+			return c.storage.ScanExpiredHistory(kind, before, handler)
+		})
+		if err != nil {
+			return result, fmt.Errorf("failed to remove %s records: %w", kind, err)
+		}
+	}
+
+	if len(result.Deleted) > 0 {
+		if _, err := c.storage.CollectGarbage(); err != nil {
+			c.logger.Warn("Storage garbage collection failed", logger.String("error", err.Error()))
+		}
+	}
+
+	result.FinishedAt = clock.Now()
+	return result, nil
+}
+
+// cleanupLoop runs cleanup at start and then every interval
+func (c *Component) cleanupLoop() {
+	defer c.wg.Done()
+
+	ticker := time.NewTicker(c.interval)
+	defer ticker.Stop()
+
+	for {
+		result, err := c.Run()
+		switch {
+		case err != nil && c.ctx.Err() == nil:
+			c.logger.Error("History cleanup failed", logger.String("error", err.Error()))
+		case err == nil:
+			total := 0
+			for _, deleted := range result.Deleted {
+				total += deleted
+			}
+			if total > 0 {
+				c.logger.Info("History cleanup completed",
+					logger.Int("deleted", total),
+					logger.Any("by_kind", result.Deleted),
+					logger.String("duration", result.FinishedAt.Sub(result.StartedAt).String()))
+			}
+		}
+
+		select {
+		case <-c.ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// purgeProcessInstances removes finished instances past their retention in
+// batches, each batch scans instance records once per record kind
+func (c *Component) purgeProcessInstances(now time.Time, result *RunResult) error {
+	for {
+		periods := make(map[string]time.Duration) // Definition retention by process key
+		expired := make(map[string]bool)
+
+		err := c.storage.ScanFinishedProcessInstances(func(instance *models.ProcessInstance) error {
+			period := c.instanceRetention(instance, periods)
+			if period <= 0 {
+				return nil
+			}
+			finished := instance.UpdatedAt
+			if instance.CompletedAt != nil {
+				finished = *instance.CompletedAt
+			}
+			if !finished.Before(now.Add(-period)) {
+				return nil
+			}
+
+			expired[instance.InstanceID] = true
+			if len(expired) >= c.batchSize {
+				return errBatchFull
+			}
+			return nil
+		})
+		if err != nil && !errors.Is(err, errBatchFull) {
+			return err
+		}
+		if len(expired) == 0 {
+			return nil
+		}
+
+		err = c.purge(result, func(handler func(record *storage.HistoryRecord) error) error {
+			return c.storage.ScanProcessInstanceHistory(expired, handler)
+		})
+		if err != nil {
+			return err
+		}
+		if len(expired) < c.batchSize {
+			return nil
+		}
+	}
+}
+
+// instanceRetention returns retention of finished instance: configured
+// process override, then historyTimeToLive property of its process
+// definition, then default period
+func (c *Component) instanceRetention(instance *models.ProcessInstance, periods map[string]time.Duration) time.Duration {
+	tenantID := models.NormalizeTenantID(instance.TenantID)
+	if period, ok := c.policy.ProcessOverride(tenantID, instance.ProcessID); ok {
+		return period
+	}
+
+	period, cached := periods[instance.ProcessKey]
+	if !cached {
+		period = c.definitionRetention(instance.ProcessKey)
+		periods[instance.ProcessKey] = period
+	}
+	return period
+}
+
+// definitionRetention reads historyTimeToLive property of process
+// definition, falling back to default period
+func (c *Component) definitionRetention(processKey string) time.Duration {
+	data, err := c.storage.LoadBPMNProcess(processKey)
+	if err != nil {
+		return c.policy.ProcessInstances
+	}
+	var process models.BPMNProcess
+	if err := json.Unmarshal(data, &process); err != nil {
+		return c.policy.ProcessInstances
+	}
+
+	value, ok := process.GetProcessProperty(HistoryTimeToLiveProperty)
+	if !ok {
+		return c.policy.ProcessInstances
+	}
+	period, err := ParseHistoryTimeToLive(value)
+	if err != nil {
+		c.logger.Warn("Invalid historyTimeToLive of process, default retention applies",
+			logger.String("process_key", processKey),
+			logger.String("value", value),
+			logger.String("error", err.Error()))
+		return c.policy.ProcessInstances
+	}
+	return period
+}
+
+// purge archives and deletes records passed by scan in chunks
+func (c *Component) purge(result *RunResult, scan func(handler func(record *storage.HistoryRecord) error) error) error {
+	chunk := make([]*storage.HistoryRecord, 0, deleteChunk)
+
+	err := scan(func(record *storage.HistoryRecord) error {
+		chunk = append(chunk, record)
+		if len(chunk) < deleteChunk {
+			return nil
+		}
+		err := c.deleteRecords(chunk, result)
+		chunk = chunk[:0]
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	return c.deleteRecords(chunk, result)
+}
+
+// deleteRecords archives records when archive is configured and deletes them
+func (c *Component) deleteRecords(records []*storage.HistoryRecord, result *RunResult) error {
+	if len(records) == 0 {
+		return nil
+	}
+	if err := c.ctx.Err(); err != nil {
+		return err
+	}
+
+	if c.archive != nil {
+		archivedAt := clock.Now()
+		lines := make([][]byte, 0, len(records))
+		for _, record := range records {
+			line, err := json.Marshal(&archivedRecord{ArchivedAt: archivedAt, HistoryRecord: record})
+			if err != nil {
+				return fmt.Errorf("failed to marshal archived record %s: %w", record.Key, err)
+			}
+			lines = append(lines, line)
+		}
+		if err := c.archive.WriteLines(lines); err != nil {
+			return fmt.Errorf("failed to archive history: %w", err)
+		}
+	}
+
+	if err := c.storage.DeleteHistoryRecords(records); err != nil {
+		return err
+	}
+	for _, record := range records {
+		result.Deleted[record.Kind]++
+		metrics.RetentionRecordsDeleted.WithLabelValues(record.Kind).Inc()
+	}
+	return nil
+}
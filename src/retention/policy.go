@@ -0,0 +1,116 @@
+/*
+This file is part of the AtomBPMN (R) project.
+Copyright (c) 2025 Matreska Market LLC (ООО «Matreska Market»).
+Authors: Matreska Team.
+
+This project is dual-licensed under AGPL-3.0 and AtomBPMN Commercial License.
+*/
+
+package retention
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"atom-engine/src/core/config"
+	"atom-engine/src/core/models"
+	"atom-engine/src/storage"
+	"atom-engine/src/timewheel"
+)
+
+// HistoryTimeToLiveProperty names zeebe:property of process which overrides
+// retention of its finished instances, e.g. "P30D" or "720h"
+// Имя zeebe:property процесса, переопределяющего хранение его экземпляров
+const HistoryTimeToLiveProperty = "historyTimeToLive"
+
+// Policy holds retention periods, zero period keeps records forever
+// Содержит периоды хранения, нулевой период хранит записи бессрочно
+type Policy struct {
+	ProcessInstances time.Duration
+	Kinds            map[string]time.Duration // Records removed regardless of instance
+
+	processes       map[string]time.Duration // Overrides of process in single tenant
+	tenantProcesses map[string]time.Duration // Overrides of process in every tenant
+}
+
+// NewPolicy parses retention periods of configuration
+// Разбирает периоды хранения из конфигурации
+func NewPolicy(cfg config.RetentionConfig) (*Policy, error) {
+	policy := &Policy{
+		Kinds:           make(map[string]time.Duration),
+		processes:       make(map[string]time.Duration),
+		tenantProcesses: make(map[string]time.Duration),
+	}
+
+	var err error
+	if policy.ProcessInstances, err = parsePeriod(cfg.ProcessInstances); err != nil {
+		return nil, fmt.Errorf("process_instances: %w", err)
+	}
+
+	kinds := map[string]string{
+		storage.HistoryKindJob:                cfg.Jobs,
+		storage.HistoryKindIncident:           cfg.Incidents,
+		storage.HistoryKindMessageCorrelation: cfg.MessageCorrelations,
+		storage.HistoryKindSystemEvent:        cfg.SystemEvents,
+	}
+	for kind, value := range kinds {
+		period, err := parsePeriod(value)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", kind, err)
+		}
+		if period > 0 {
+			policy.Kinds[kind] = period
+		}
+	}
+
+	for _, process := range cfg.Processes {
+		period, err := parsePeriod(process.ProcessInstances)
+		if err != nil {
+			return nil, fmt.Errorf("process %s: %w", process.ProcessID, err)
+		}
+		if process.TenantID == "" {
+			policy.tenantProcesses[process.ProcessID] = period
+			continue
+		}
+		tenantID := models.NormalizeTenantID(process.TenantID)
+		policy.processes[models.TenantScopedKey(tenantID, process.ProcessID)] = period
+	}
+
+	return policy, nil
+}
+
+// ProcessOverride returns configured retention of process instances
+// Возвращает настроенный период хранения экземпляров процесса
+func (p *Policy) ProcessOverride(tenantID, processID string) (time.Duration, bool) {
+	if period, ok := p.processes[models.TenantScopedKey(tenantID, processID)]; ok {
+		return period, true
+	}
+	period, ok := p.tenantProcesses[processID]
+	return period, ok
+}
+
+// ParseHistoryTimeToLive parses ISO-8601 ("P30D") or Go ("720h") duration
+// Разбирает длительность в формате ISO-8601 ("P30D") или Go ("720h")
+func ParseHistoryTimeToLive(value string) (time.Duration, error) {
+	value = strings.TrimSpace(value)
+	if strings.HasPrefix(strings.ToUpper(value), "P") {
+		return timewheel.NewISO8601DurationParser().ParseDuration(strings.ToUpper(value))
+	}
+	return parsePeriod(value)
+}
+
+// parsePeriod parses optional Go duration, empty keeps records forever
+func parsePeriod(value string) (time.Duration, error) {
+	if value == "" {
+		return 0, nil
+	}
+	period, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, err
+	}
+	if period < 0 {
+		return 0, fmt.Errorf("negative period %s", value)
+	}
+	return period, nil
+}
@@ -10,6 +10,8 @@ package storage
 
 import (
 	"context"
+	"io"
+	"sync"
 	"time"
 
 	"atom-engine/src/core/models"
@@ -28,6 +30,8 @@ type Storage interface {
 	LoadSystemEvents(limit int) ([]*SystemEventRecord, error)
 	GetStatus() (*StorageStatus, error)
 	GetInfo() (*StorageInfo, error)
+	GetDiskUsage() (lsmBytes, vlogBytes int64)
+	Backup(w io.Writer, since uint64) (*BackupMetadata, error)
 
 	// Timer persistence methods
 	// Методы персистентности таймеров
@@ -41,10 +45,10 @@ type Storage interface {
 	// Методы персистентности BPMN
 	SaveBPMNProcess(processID string, data []byte) error
 	LoadBPMNProcess(processID string) ([]byte, error)
-	LoadBPMNProcessByProcessID(processID string, version int) ([]byte, string, error)
+	LoadBPMNProcessByProcessID(tenantID, processID string, version int) ([]byte, string, error)
 	LoadBPMNProcessByBPMNID(bpmnID string) ([]byte, error)
 	LoadAllBPMNProcesses() (map[string][]byte, error)
-	GetMaxProcessVersionByProcessID(processID string) (int, error)
+	GetMaxProcessVersionByProcessID(tenantID, processID string) (int, error)
 	DeleteBPMNProcess(processID string) error
 	// Note: SaveBPMNFile and LoadBPMNFile removed - XML files saved to filesystem only
 	// Примечание: SaveBPMNFile и LoadBPMNFile удалены - XML файлы сохраняются только в файловую систему
@@ -55,6 +59,7 @@ type Storage interface {
 	LoadProcessInstance(instanceID string) (*models.ProcessInstance, error)
 	LoadProcessInstancesByProcessKey(processKey string) ([]*models.ProcessInstance, error)
 	LoadAllProcessInstances() ([]*models.ProcessInstance, error)
+	ScanProcessInstances(filter ProcessInstanceFilter, handler func(instance *models.ProcessInstance) error) error
 	UpdateProcessInstance(instance *models.ProcessInstance) error
 	DeleteProcessInstance(instanceID string) error
 
@@ -73,7 +78,15 @@ type Storage interface {
 	// Методы персистентности заданий
 	SaveJob(ctx context.Context, job *models.Job) error
 	GetJob(ctx context.Context, jobID string) (*models.Job, error)
+	UpdateJobIf(
+		ctx context.Context,
+		jobID string,
+		expectedStatus models.JobStatus,
+		update func(job *models.Job) error,
+	) (*models.Job, error)
 	ListJobsByType(ctx context.Context, jobType string, status models.JobStatus, limit int) ([]*models.Job, error)
+	ListPendingJobs(ctx context.Context, jobType string, tenantIDs []string, limit int) ([]*models.Job, error)
+	ListJobsByProcessInstance(ctx context.Context, processInstanceID string) ([]*models.Job, error)
 
 	// Message persistence methods
 	// Методы персистентности сообщений
@@ -109,12 +122,62 @@ type Storage interface {
 	LoadGatewaySyncState(gatewayID, processInstanceID string) (*models.GatewaySyncState, error)
 	DeleteGatewaySyncState(gatewayID, processInstanceID string) error
 
+	// Business calendar persistence methods
+	// Методы персистентности бизнес-календарей
+	SaveBusinessCalendar(calendar *models.BusinessCalendar) error
+	LoadBusinessCalendar(name string) (*models.BusinessCalendar, error)
+	LoadAllBusinessCalendars() ([]*models.BusinessCalendar, error)
+	DeleteBusinessCalendar(name string) error
+
 	// Incident persistence methods
 	// Методы персистентности инцидентов
 	SaveIncident(incident interface{}) error
 	GetIncident(incidentID string) (interface{}, error)
 	ListIncidents(filter interface{}) (interface{}, int, error)
 
+	// Incident notification outbox persistence methods
+	// Методы персистентности очереди уведомлений об инцидентах
+	SaveIncidentNotification(deliveryID string, data []byte) error
+	LoadIncidentNotifications() ([][]byte, error)
+	DeleteIncidentNotification(deliveryID string) error
+
+	// Incident creation outbox persistence methods
+	// Методы персистентности очереди создания инцидентов
+	SaveIncidentOutboxEntry(entryID string, data []byte) error
+	LoadIncidentOutbox() ([][]byte, error)
+	DeleteIncidentOutboxEntry(entryID string) error
+
+	// Incident retry rule persistence methods
+	// Методы персистентности правил повтора инцидентов
+	SaveIncidentRetryRule(name string, data []byte) error
+	LoadIncidentRetryRules() ([][]byte, error)
+	DeleteIncidentRetryRule(name string) error
+
+	// Batch operation persistence methods
+	// Методы персистентности пакетных операций
+	SaveBatchOperation(batchID string, data []byte) error
+	LoadBatchOperations() ([][]byte, error)
+	SaveBatchOperationItems(batchID string, data []byte) error
+	LoadBatchOperationItems(batchID string) ([]byte, error)
+	DeleteBatchOperation(batchID string) error
+
+	// Export log persistence methods
+	// Методы персистентности журнала экспорта
+	SaveExportRecord(position uint64, data []byte) error
+	LoadExportRecords(afterPosition uint64, limit int) ([][]byte, error)
+	LoadExportLogBounds() (compacted, last uint64, err error)
+	CompactExportRecords(position uint64) (int, error)
+	SaveExporterPosition(exporterID string, position uint64) error
+	LoadExporterPositions() (map[string]uint64, error)
+
+	// History retention methods
+	// Методы политик хранения истории
+	ScanFinishedProcessInstances(handler func(instance *models.ProcessInstance) error) error
+	ScanProcessInstanceHistory(instanceIDs map[string]bool, handler func(record *HistoryRecord) error) error
+	ScanExpiredHistory(kind string, before time.Time, handler func(record *HistoryRecord) error) error
+	DeleteHistoryRecords(records []*HistoryRecord) error
+	CollectGarbage() (int, error)
+
 	// System metrics persistence methods
 	// Методы персистентности системных метрик
 	SaveSystemMetrics(metrics *SystemMetrics) error
@@ -124,6 +187,11 @@ type Storage interface {
 	UpdateCPUUsage(usage float64) error
 	UpdateMemoryUsage(usage int64) error
 
+	// API key persistence methods
+	// Методы персистентности API ключей
+	SaveAPIKey(keyID string, data []byte) error
+	LoadAPIKeys() ([][]byte, error)
+
 	// Rate limiter persistence methods
 	// Методы персистентности rate limiter
 	SaveRateLimitInfo(identifier string, info *RateLimitInfo) error
@@ -157,6 +225,7 @@ type BadgerStorage struct {
 	config    *Config
 	ready     bool
 	startTime time.Time
+	jobMutex  sync.Mutex // serializes job writes so status checks stay valid until commit
 }
 
 // Config holds database configuration
@@ -248,6 +317,7 @@ type TimerRecord struct {
 	ElementID         string                 `json:"element_id"`
 	TokenID           string                 `json:"token_id"`
 	ProcessInstanceID string                 `json:"process_instance_id"`
+	TenantID          string                 `json:"tenant_id,omitempty"`
 	TimerType         string                 `json:"timer_type"`
 	ScheduledAt       time.Time              `json:"scheduled_at"`
 	TimeDate          *string                `json:"time_date,omitempty"`
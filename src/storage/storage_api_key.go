@@ -0,0 +1,65 @@
+/*
+This file is part of the AtomBPMN (R) project.
+Copyright (c) 2025 Matreska Market LLC (ООО «Matreska Market»).
+Authors: Matreska Team.
+
+This project is dual-licensed under AGPL-3.0 and AtomBPMN Commercial License.
+*/
+
+package storage
+
+import (
+	"fmt"
+
+	"github.com/dgraph-io/badger/v3"
+)
+
+// APIKeyPrefix is key prefix of persisted API keys
+// Префикс ключей сохраненных API ключей
+const APIKeyPrefix = "api_key:"
+
+// SaveAPIKey saves API key record holding salted secret hash
+// Сохраняет запись API ключа с солью и хешем секрета
+func (bs *BadgerStorage) SaveAPIKey(keyID string, data []byte) error {
+	if bs.db == nil {
+		return fmt.Errorf("database not initialized")
+	}
+
+	if keyID == "" {
+		return fmt.Errorf("API key ID is required")
+	}
+
+	return bs.db.Update(func(txn *badger.Txn) error {
+		return txn.Set([]byte(APIKeyPrefix+keyID), data)
+	})
+}
+
+// LoadAPIKeys loads all API key records
+// Загружает все записи API ключей
+func (bs *BadgerStorage) LoadAPIKeys() ([][]byte, error) {
+	if bs.db == nil {
+		return nil, fmt.Errorf("database not initialized")
+	}
+
+	var keys [][]byte
+	err := bs.db.View(func(txn *badger.Txn) error {
+		opts := badger.DefaultIteratorOptions
+		opts.Prefix = []byte(APIKeyPrefix)
+		it := txn.NewIterator(opts)
+		defer it.Close()
+
+		for it.Rewind(); it.Valid(); it.Next() {
+			data, err := it.Item().ValueCopy(nil)
+			if err != nil {
+				return err
+			}
+			keys = append(keys, data)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to load API keys: %w", err)
+	}
+
+	return keys, nil
+}
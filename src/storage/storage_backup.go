@@ -0,0 +1,240 @@
+/*
+This file is part of the AtomBPMN (R) project.
+Copyright (c) 2025 Matreska Market LLC (ООО «Matreska Market»).
+Authors: Matreska Team.
+
+This project is dual-licensed under AGPL-3.0 and AtomBPMN Commercial License.
+*/
+
+package storage
+
+import (
+	"bufio"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"atom-engine/src/core/logger"
+	"atom-engine/src/version"
+
+	"github.com/dgraph-io/badger/v3"
+)
+
+// Backup file layout: magic, big endian uint32 metadata length, metadata
+// JSON, then Badger backup stream
+// Формат файла резервной копии
+const (
+	backupMagic           = "ATOMBAK1"
+	backupMaxMetadataSize = 64 * 1024
+	backupLoadPendingSize = 256
+)
+
+// BackupRestoredVersionKey holds version of last backup restored into
+// database, incremental backup continues only from it
+// Ключ версии последней восстановленной резервной копии
+const BackupRestoredVersionKey = "backup:restored_version"
+
+// BackupMetadata describes backup file contents
+// Описывает содержимое файла резервной копии
+type BackupMetadata struct {
+	EngineVersion string    `json:"engine_version"`
+	SchemaVersion int       `json:"schema_version"`
+	CreatedAt     time.Time `json:"created_at"`
+	Since         uint64    `json:"since"`   // 0 = full backup, otherwise version of backup it continues
+	Version       uint64    `json:"version"` // Latest included version, since of next incremental backup
+}
+
+// IsIncremental reports whether backup holds only changes since earlier backup
+func (m *BackupMetadata) IsIncremental() bool {
+	return m.Since > 0
+}
+
+// Backup writes online consistent snapshot of entries with version above
+// since. Writes continue while backup runs.
+// Записывает согласованный снимок записей с версией выше since
+func (bs *BadgerStorage) Backup(w io.Writer, since uint64) (*BackupMetadata, error) {
+	if bs.db == nil {
+		return nil, fmt.Errorf("database not initialized")
+	}
+
+	// Snapshot read timestamp is taken inside Backup and is not below this
+	// version, so entries between both are repeated by next incremental
+	metadata := &BackupMetadata{
+		EngineVersion: version.Version,
+		SchemaVersion: SchemaVersion,
+		CreatedAt:     time.Now().UTC(),
+		Since:         since,
+		Version:       bs.db.MaxVersion(),
+	}
+	if err := writeBackupHeader(w, metadata); err != nil {
+		return nil, err
+	}
+
+	if _, err := bs.db.Backup(w, since); err != nil {
+		return nil, fmt.Errorf("failed to write backup stream: %w", err)
+	}
+
+	logger.Info("Storage backup created",
+		logger.Any("since", since),
+		logger.Any("version", metadata.Version))
+	return metadata, nil
+}
+
+// ReadBackupMetadata reads backup header leaving reader at backup stream
+// Читает заголовок резервной копии
+func ReadBackupMetadata(r io.Reader) (*BackupMetadata, error) {
+	magic := make([]byte, len(backupMagic))
+	if _, err := io.ReadFull(r, magic); err != nil {
+		return nil, fmt.Errorf("failed to read backup header: %w", err)
+	}
+	if string(magic) != backupMagic {
+		return nil, fmt.Errorf("not an atom engine backup")
+	}
+
+	var size uint32
+	if err := binary.Read(r, binary.BigEndian, &size); err != nil {
+		return nil, fmt.Errorf("failed to read backup header: %w", err)
+	}
+	if size == 0 || size > backupMaxMetadataSize {
+		return nil, fmt.Errorf("invalid backup metadata size %d", size)
+	}
+
+	data := make([]byte, size)
+	if _, err := io.ReadFull(r, data); err != nil {
+		return nil, fmt.Errorf("failed to read backup metadata: %w", err)
+	}
+
+	var metadata BackupMetadata
+	if err := json.Unmarshal(data, &metadata); err != nil {
+		return nil, fmt.Errorf("invalid backup metadata: %w", err)
+	}
+	return &metadata, nil
+}
+
+// RestoreBackup loads backup into database directory which no engine
+// uses. Full backup needs empty directory, incremental backup needs
+// directory restored from backup it continues.
+// Восстанавливает резервную копию в каталог базы данных
+func RestoreBackup(r io.Reader, directory string) (*BackupMetadata, error) {
+	reader := bufio.NewReader(r)
+	metadata, err := ReadBackupMetadata(reader)
+	if err != nil {
+		return nil, err
+	}
+
+	if metadata.SchemaVersion > SchemaVersion {
+		return nil, fmt.Errorf("backup schema version %d is newer than supported version %d, "+
+			"restore with newer engine", metadata.SchemaVersion, SchemaVersion)
+	}
+	if metadata.EngineVersion != version.Version {
+		logger.Warn("Restoring backup created by other engine version",
+			logger.String("backup_version", metadata.EngineVersion),
+			logger.String("engine_version", version.Version))
+	}
+
+	if !metadata.IsIncremental() {
+		empty, err := isEmptyDirectory(directory)
+		if err != nil {
+			return nil, err
+		}
+		if !empty {
+			return nil, fmt.Errorf("directory %s is not empty, full backup restores into empty directory", directory)
+		}
+	} else if _, err := os.Stat(directory); err != nil {
+		return nil, fmt.Errorf("incremental backup needs directory restored from previous backup: %w", err)
+	}
+
+	opts := badger.DefaultOptions(directory)
+	opts.Logger = nil
+	db, err := badger.Open(opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open directory %s: %w", directory, err)
+	}
+	defer db.Close()
+
+	if metadata.IsIncremental() {
+		restored, err := loadRestoredVersion(db)
+		if err != nil {
+			return nil, err
+		}
+		if restored != metadata.Since {
+			return nil, fmt.Errorf("incremental backup continues version %d, directory holds backup up to version %d",
+				metadata.Since, restored)
+		}
+	}
+
+	if err := db.Load(reader, backupLoadPendingSize); err != nil {
+		return nil, fmt.Errorf("failed to load backup stream: %w", err)
+	}
+
+	value := make([]byte, 8)
+	binary.BigEndian.PutUint64(value, metadata.Version)
+	err = db.Update(func(txn *badger.Txn) error {
+		return txn.Set([]byte(BackupRestoredVersionKey), value)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to save restored backup version: %w", err)
+	}
+
+	logger.Info("Storage backup restored",
+		logger.String("directory", directory),
+		logger.Any("since", metadata.Since),
+		logger.Any("version", metadata.Version))
+	return metadata, nil
+}
+
+// writeBackupHeader writes magic and metadata preceding backup stream
+func writeBackupHeader(w io.Writer, metadata *BackupMetadata) error {
+	data, err := json.Marshal(metadata)
+	if err != nil {
+		return fmt.Errorf("failed to marshal backup metadata: %w", err)
+	}
+
+	header := make([]byte, 0, len(backupMagic)+4+len(data))
+	header = append(header, backupMagic...)
+	header = binary.BigEndian.AppendUint32(header, uint32(len(data)))
+	header = append(header, data...)
+	if _, err := w.Write(header); err != nil {
+		return fmt.Errorf("failed to write backup header: %w", err)
+	}
+	return nil
+}
+
+// loadRestoredVersion returns version of last backup restored into database
+func loadRestoredVersion(db *badger.DB) (uint64, error) {
+	var restored uint64
+	err := db.View(func(txn *badger.Txn) error {
+		item, err := txn.Get([]byte(BackupRestoredVersionKey))
+		if err == badger.ErrKeyNotFound {
+			return fmt.Errorf("directory holds no restored backup")
+		}
+		if err != nil {
+			return err
+		}
+		value, err := item.ValueCopy(nil)
+		if err != nil {
+			return err
+		}
+		if len(value) != 8 {
+			return fmt.Errorf("invalid restored backup version")
+		}
+		restored = binary.BigEndian.Uint64(value)
+		return nil
+	})
+	return restored, err
+}
+
+// isEmptyDirectory reports whether directory is missing or has no entries
+func isEmptyDirectory(directory string) (bool, error) {
+	entries, err := os.ReadDir(directory)
+	if os.IsNotExist(err) {
+		return true, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed to read directory %s: %w", directory, err)
+	}
+	return len(entries) == 0, nil
+}
@@ -180,6 +180,10 @@ func (s *BadgerStorage) Start() error {
 	}
 
 	logger.Info("Starting BadgerDB storage...")
+	if err := s.migrateSchema(); err != nil {
+		return err
+	}
+
 	s.ready = true
 	s.startTime = time.Now()
 	logger.Info("BadgerDB storage is ready")
@@ -0,0 +1,122 @@
+/*
+This file is part of the AtomBPMN (R) project.
+Copyright (c) 2025 Matreska Market LLC (ООО «Matreska Market»).
+Authors: Matreska Team.
+
+This project is dual-licensed under AGPL-3.0 and AtomBPMN Commercial License.
+*/
+
+package storage
+
+import (
+	"fmt"
+
+	"github.com/dgraph-io/badger/v3"
+)
+
+// Batch operation key prefixes
+// Префиксы ключей пакетных операций
+const (
+	BatchOperationPrefix      = "batch_operation:"
+	BatchOperationItemsPrefix = "batch_operation_items:"
+)
+
+// SaveBatchOperation saves batch operation state
+// Сохраняет состояние пакетной операции
+func (bs *BadgerStorage) SaveBatchOperation(batchID string, data []byte) error {
+	if bs.db == nil {
+		return fmt.Errorf("database not initialized")
+	}
+
+	if batchID == "" {
+		return fmt.Errorf("batch operation ID is required")
+	}
+
+	return bs.db.Update(func(txn *badger.Txn) error {
+		return txn.Set([]byte(BatchOperationPrefix+batchID), data)
+	})
+}
+
+// LoadBatchOperations loads all batch operations
+// Загружает все пакетные операции
+func (bs *BadgerStorage) LoadBatchOperations() ([][]byte, error) {
+	if bs.db == nil {
+		return nil, fmt.Errorf("database not initialized")
+	}
+
+	var operations [][]byte
+	err := bs.db.View(func(txn *badger.Txn) error {
+		opts := badger.DefaultIteratorOptions
+		opts.Prefix = []byte(BatchOperationPrefix)
+		it := txn.NewIterator(opts)
+		defer it.Close()
+
+		for it.Rewind(); it.Valid(); it.Next() {
+			data, err := it.Item().ValueCopy(nil)
+			if err != nil {
+				return err
+			}
+			operations = append(operations, data)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to load batch operations: %w", err)
+	}
+
+	return operations, nil
+}
+
+// SaveBatchOperationItems saves item snapshot of batch operation
+// Сохраняет снимок элементов пакетной операции
+func (bs *BadgerStorage) SaveBatchOperationItems(batchID string, data []byte) error {
+	if bs.db == nil {
+		return fmt.Errorf("database not initialized")
+	}
+
+	if batchID == "" {
+		return fmt.Errorf("batch operation ID is required")
+	}
+
+	return bs.db.Update(func(txn *badger.Txn) error {
+		return txn.Set([]byte(BatchOperationItemsPrefix+batchID), data)
+	})
+}
+
+// LoadBatchOperationItems loads item snapshot of batch operation
+// Загружает снимок элементов пакетной операции
+func (bs *BadgerStorage) LoadBatchOperationItems(batchID string) ([]byte, error) {
+	if bs.db == nil {
+		return nil, fmt.Errorf("database not initialized")
+	}
+
+	var data []byte
+	err := bs.db.View(func(txn *badger.Txn) error {
+		item, err := txn.Get([]byte(BatchOperationItemsPrefix + batchID))
+		if err != nil {
+			return err
+		}
+		data, err = item.ValueCopy(nil)
+		return err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to load batch operation items: %w", err)
+	}
+
+	return data, nil
+}
+
+// DeleteBatchOperation deletes batch operation with its item snapshot
+// Удаляет пакетную операцию вместе со снимком элементов
+func (bs *BadgerStorage) DeleteBatchOperation(batchID string) error {
+	if bs.db == nil {
+		return fmt.Errorf("database not initialized")
+	}
+
+	return bs.db.Update(func(txn *badger.Txn) error {
+		if err := txn.Delete([]byte(BatchOperationPrefix + batchID)); err != nil {
+			return err
+		}
+		return txn.Delete([]byte(BatchOperationItemsPrefix + batchID))
+	})
+}
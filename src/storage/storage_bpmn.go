@@ -15,6 +15,8 @@ import (
 	"time"
 
 	"github.com/dgraph-io/badger/v3"
+
+	"atom-engine/src/core/models"
 )
 
 // BPMN storage key prefixes
@@ -70,9 +72,12 @@ func (bs *BadgerStorage) LoadBPMNProcess(processID string) ([]byte, error) {
 	return data, nil
 }
 
-// LoadBPMNProcessByProcessID loads BPMN process by process_id and version
-// Загружает BPMN процесс по process_id и версии
-func (bs *BadgerStorage) LoadBPMNProcessByProcessID(processID string, version int) ([]byte, string, error) {
+// LoadBPMNProcessByProcessID loads BPMN process by tenant, process_id and version
+// Загружает BPMN процесс по арендатору, process_id и версии
+func (bs *BadgerStorage) LoadBPMNProcessByProcessID(
+	tenantID, processID string,
+	version int,
+) ([]byte, string, error) {
 	if bs.db == nil {
 		return nil, "", fmt.Errorf("database not initialized")
 	}
@@ -102,8 +107,8 @@ func (bs *BadgerStorage) LoadBPMNProcessByProcessID(processID string, version in
 					return nil // Skip invalid JSON, continue iteration
 				}
 
-				// Check process_id
-				if procID, exists := processData["process_id"]; exists {
+				// Check process_id within tenant
+				if procID, exists := processData["process_id"]; exists && processTenantID(processData) == tenantID {
 					if procIDStr, ok := procID.(string); ok && procIDStr == processID {
 						// Check version
 						if procVer, exists := processData["process_version"]; exists {
@@ -151,7 +156,8 @@ func (bs *BadgerStorage) LoadBPMNProcessByProcessID(processID string, version in
 		return nil, "", fmt.Errorf("failed to search BPMN processes: %w", err)
 	}
 
-	return nil, "", fmt.Errorf("BPMN process not found: process_id=%s, version=%d", processID, version)
+	return nil, "", fmt.Errorf("BPMN process not found: tenant_id=%s, process_id=%s, version=%d",
+		tenantID, processID, version)
 }
 
 // LoadBPMNProcessByBPMNID loads BPMN process by BPMN ID
@@ -407,9 +413,9 @@ func (bs *BadgerStorage) GetBPMNProcessStats() (map[string]interface{}, error) {
 	return stats, nil
 }
 
-// GetMaxProcessVersionByProcessID finds highest version number for given ProcessID
-// Находит максимальный номер версии для указанного ProcessID
-func (bs *BadgerStorage) GetMaxProcessVersionByProcessID(processID string) (int, error) {
+// GetMaxProcessVersionByProcessID finds highest version number for given ProcessID within tenant
+// Находит максимальный номер версии для указанного ProcessID в пределах арендатора
+func (bs *BadgerStorage) GetMaxProcessVersionByProcessID(tenantID, processID string) (int, error) {
 	if bs.db == nil {
 		return 0, fmt.Errorf("database not initialized")
 	}
@@ -436,7 +442,7 @@ func (bs *BadgerStorage) GetMaxProcessVersionByProcessID(processID string) (int,
 
 				// Check if this process matches the ProcessID we're looking for
 				// Проверяем совпадает ли ProcessID с тем что мы ищем
-				if procID, exists := process["process_id"]; exists {
+				if procID, exists := process["process_id"]; exists && processTenantID(process) == tenantID {
 					if procIDStr, ok := procID.(string); ok && procIDStr == processID {
 						// Extract ProcessVersion
 						// Извлекаем ProcessVersion
@@ -465,3 +471,9 @@ func (bs *BadgerStorage) GetMaxProcessVersionByProcessID(processID string) (int,
 
 	return maxVersion, nil
 }
+
+// processTenantID returns tenant of stored BPMN process JSON, default tenant when absent
+func processTenantID(process map[string]interface{}) string {
+	tenantID, _ := process["tenant_id"].(string)
+	return models.NormalizeTenantID(tenantID)
+}
@@ -0,0 +1,122 @@
+/*
+This file is part of the AtomBPMN (R) project.
+Copyright (c) 2025 Matreska Market LLC (ООО «Matreska Market»).
+Authors: Matreska Team.
+
+This project is dual-licensed under AGPL-3.0 and AtomBPMN Commercial License.
+*/
+
+package storage
+
+import (
+	"fmt"
+
+	"atom-engine/src/core/models"
+
+	"github.com/dgraph-io/badger/v3"
+)
+
+// Business calendar storage key prefixes
+// Префиксы ключей для хранения бизнес-календарей
+const (
+	BusinessCalendarPrefix = "calendar:"
+)
+
+// SaveBusinessCalendar saves business calendar definition to storage
+// Сохраняет определение бизнес-календаря в storage
+func (bs *BadgerStorage) SaveBusinessCalendar(calendar *models.BusinessCalendar) error {
+	if bs.db == nil {
+		return fmt.Errorf("database not initialized")
+	}
+
+	data, err := calendar.ToJSON()
+	if err != nil {
+		return fmt.Errorf("failed to serialize business calendar: %w", err)
+	}
+
+	return bs.db.Update(func(txn *badger.Txn) error {
+		return txn.Set([]byte(BusinessCalendarPrefix+calendar.Name), data)
+	})
+}
+
+// LoadBusinessCalendar loads business calendar definition by name
+// Загружает определение бизнес-календаря по имени
+func (bs *BadgerStorage) LoadBusinessCalendar(name string) (*models.BusinessCalendar, error) {
+	if bs.db == nil {
+		return nil, fmt.Errorf("database not initialized")
+	}
+
+	var data []byte
+	err := bs.db.View(func(txn *badger.Txn) error {
+		item, err := txn.Get([]byte(BusinessCalendarPrefix + name))
+		if err != nil {
+			return err
+		}
+
+		return item.Value(func(val []byte) error {
+			data = append([]byte(nil), val...)
+			return nil
+		})
+	})
+	if err != nil {
+		if err == badger.ErrKeyNotFound {
+			return nil, fmt.Errorf("business calendar not found: %s", name)
+		}
+		return nil, fmt.Errorf("failed to load business calendar: %w", err)
+	}
+
+	var calendar models.BusinessCalendar
+	if err := calendar.FromJSON(data); err != nil {
+		return nil, fmt.Errorf("failed to deserialize business calendar: %w", err)
+	}
+
+	return &calendar, nil
+}
+
+// LoadAllBusinessCalendars loads all business calendar definitions
+// Загружает все определения бизнес-календарей
+func (bs *BadgerStorage) LoadAllBusinessCalendars() ([]*models.BusinessCalendar, error) {
+	if bs.db == nil {
+		return nil, fmt.Errorf("database not initialized")
+	}
+
+	var calendars []*models.BusinessCalendar
+	err := bs.db.View(func(txn *badger.Txn) error {
+		opts := badger.DefaultIteratorOptions
+		opts.Prefix = []byte(BusinessCalendarPrefix)
+		it := txn.NewIterator(opts)
+		defer it.Close()
+
+		for it.Rewind(); it.Valid(); it.Next() {
+			err := it.Item().Value(func(val []byte) error {
+				var calendar models.BusinessCalendar
+				if err := calendar.FromJSON(val); err != nil {
+					return err
+				}
+				calendars = append(calendars, &calendar)
+				return nil
+			})
+			if err != nil {
+				return fmt.Errorf("failed to deserialize business calendar: %w", err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to load business calendars: %w", err)
+	}
+
+	return calendars, nil
+}
+
+// DeleteBusinessCalendar deletes business calendar definition by name
+// Удаляет определение бизнес-календаря по имени
+func (bs *BadgerStorage) DeleteBusinessCalendar(name string) error {
+	if bs.db == nil {
+		return fmt.Errorf("database not initialized")
+	}
+
+	return bs.db.Update(func(txn *badger.Txn) error {
+		return txn.Delete([]byte(BusinessCalendarPrefix + name))
+	})
+}
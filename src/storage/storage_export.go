@@ -0,0 +1,257 @@
+/*
+This file is part of the AtomBPMN (R) project.
+Copyright (c) 2025 Matreska Market LLC (ООО «Matreska Market»).
+Authors: Matreska Team.
+
+This project is dual-licensed under AGPL-3.0 and AtomBPMN Commercial License.
+*/
+
+package storage
+
+import (
+	"encoding/binary"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/dgraph-io/badger/v3"
+)
+
+// Export log key prefixes. Record keys carry zero padded position so
+// iteration order is position order.
+// Префиксы ключей журнала экспорта
+const (
+	ExportRecordPrefix     = "export_record:"
+	ExporterPositionPrefix = "exporter_position:"
+	ExportCompactedKey     = "export_log:compacted"
+)
+
+// exportDeleteChunk limits record deletions per transaction
+const exportDeleteChunk = 1000
+
+// exportRecordKey returns key of export record at position
+func exportRecordKey(position uint64) []byte {
+	return []byte(fmt.Sprintf("%s%020d", ExportRecordPrefix, position))
+}
+
+// exportRecordPosition parses position from export record key
+func exportRecordPosition(key []byte) (uint64, error) {
+	return strconv.ParseUint(strings.TrimPrefix(string(key), ExportRecordPrefix), 10, 64)
+}
+
+// SaveExportRecord appends record at position to export log
+// Добавляет запись в журнал экспорта на указанную позицию
+func (bs *BadgerStorage) SaveExportRecord(position uint64, data []byte) error {
+	if bs.db == nil {
+		return fmt.Errorf("database not initialized")
+	}
+
+	return bs.db.Update(func(txn *badger.Txn) error {
+		return txn.Set(exportRecordKey(position), data)
+	})
+}
+
+// LoadExportRecords loads up to limit records following afterPosition in position order
+// Загружает до limit записей после afterPosition в порядке позиций
+func (bs *BadgerStorage) LoadExportRecords(afterPosition uint64, limit int) ([][]byte, error) {
+	if bs.db == nil {
+		return nil, fmt.Errorf("database not initialized")
+	}
+
+	var records [][]byte
+	err := bs.db.View(func(txn *badger.Txn) error {
+		opts := badger.DefaultIteratorOptions
+		opts.Prefix = []byte(ExportRecordPrefix)
+		opts.PrefetchSize = limit
+		it := txn.NewIterator(opts)
+		defer it.Close()
+
+		for it.Seek(exportRecordKey(afterPosition + 1)); it.Valid() && len(records) < limit; it.Next() {
+			data, err := it.Item().ValueCopy(nil)
+			if err != nil {
+				return err
+			}
+			records = append(records, data)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to load export records: %w", err)
+	}
+
+	return records, nil
+}
+
+// LoadExportLogBounds returns position compacted log was cleared through and
+// position of latest record, which is not below compacted position
+// Возвращает позицию, до которой журнал сжат, и позицию последней записи
+func (bs *BadgerStorage) LoadExportLogBounds() (compacted, last uint64, err error) {
+	if bs.db == nil {
+		return 0, 0, fmt.Errorf("database not initialized")
+	}
+
+	err = bs.db.View(func(txn *badger.Txn) error {
+		item, err := txn.Get([]byte(ExportCompactedKey))
+		switch {
+		case err == nil:
+			value, err := item.ValueCopy(nil)
+			if err != nil {
+				return err
+			}
+			if len(value) != 8 {
+				return fmt.Errorf("invalid compacted export position")
+			}
+			compacted = binary.BigEndian.Uint64(value)
+		case err != badger.ErrKeyNotFound:
+			return err
+		}
+
+		opts := badger.DefaultIteratorOptions
+		opts.Prefix = []byte(ExportRecordPrefix)
+		opts.PrefetchValues = false
+		opts.Reverse = true
+		it := txn.NewIterator(opts)
+		defer it.Close()
+
+		// Reverse seek lands on greatest key not above seek key
+		it.Seek([]byte(ExportRecordPrefix + "\xff"))
+		if it.Valid() {
+			position, err := exportRecordPosition(it.Item().Key())
+			if err != nil {
+				return fmt.Errorf("invalid export record key: %w", err)
+			}
+			last = position
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to load export log bounds: %w", err)
+	}
+
+	if last < compacted {
+		last = compacted
+	}
+	return compacted, last, nil
+}
+
+// CompactExportRecords deletes records up to and including position and
+// remembers position so numbering continues after log becomes empty
+// Удаляет записи до позиции включительно и запоминает позицию сжатия
+func (bs *BadgerStorage) CompactExportRecords(position uint64) (int, error) {
+	if bs.db == nil {
+		return 0, fmt.Errorf("database not initialized")
+	}
+
+	deleted := 0
+	for {
+		var keys [][]byte
+		err := bs.db.View(func(txn *badger.Txn) error {
+			opts := badger.DefaultIteratorOptions
+			opts.Prefix = []byte(ExportRecordPrefix)
+			opts.PrefetchValues = false
+			it := txn.NewIterator(opts)
+			defer it.Close()
+
+			end := exportRecordKey(position)
+			for it.Rewind(); it.Valid() && len(keys) < exportDeleteChunk; it.Next() {
+				key := it.Item().KeyCopy(nil)
+				if string(key) > string(end) {
+					break
+				}
+				keys = append(keys, key)
+			}
+			return nil
+		})
+		if err != nil {
+			return deleted, fmt.Errorf("failed to scan export records: %w", err)
+		}
+
+		last := len(keys) < exportDeleteChunk
+		err = bs.db.Update(func(txn *badger.Txn) error {
+			for _, key := range keys {
+				if err := txn.Delete(key); err != nil {
+					return err
+				}
+			}
+			if !last {
+				return nil
+			}
+			// Compacted position never moves back
+			item, err := txn.Get([]byte(ExportCompactedKey))
+			if err == nil {
+				current, err := item.ValueCopy(nil)
+				if err != nil {
+					return err
+				}
+				if len(current) == 8 && binary.BigEndian.Uint64(current) >= position {
+					return nil
+				}
+			} else if err != badger.ErrKeyNotFound {
+				return err
+			}
+			value := make([]byte, 8)
+			binary.BigEndian.PutUint64(value, position)
+			return txn.Set([]byte(ExportCompactedKey), value)
+		})
+		if err != nil {
+			return deleted, fmt.Errorf("failed to delete export records: %w", err)
+		}
+		deleted += len(keys)
+
+		if last {
+			return deleted, nil
+		}
+	}
+}
+
+// SaveExporterPosition saves position of last record acknowledged by exporter
+// Сохраняет позицию последней подтвержденной экспортером записи
+func (bs *BadgerStorage) SaveExporterPosition(exporterID string, position uint64) error {
+	if bs.db == nil {
+		return fmt.Errorf("database not initialized")
+	}
+
+	if exporterID == "" {
+		return fmt.Errorf("exporter ID is required")
+	}
+
+	value := make([]byte, 8)
+	binary.BigEndian.PutUint64(value, position)
+	return bs.db.Update(func(txn *badger.Txn) error {
+		return txn.Set([]byte(ExporterPositionPrefix+exporterID), value)
+	})
+}
+
+// LoadExporterPositions loads acknowledged positions by exporter ID
+// Загружает подтвержденные позиции по ID экспортера
+func (bs *BadgerStorage) LoadExporterPositions() (map[string]uint64, error) {
+	if bs.db == nil {
+		return nil, fmt.Errorf("database not initialized")
+	}
+
+	positions := make(map[string]uint64)
+	err := bs.db.View(func(txn *badger.Txn) error {
+		opts := badger.DefaultIteratorOptions
+		opts.Prefix = []byte(ExporterPositionPrefix)
+		it := txn.NewIterator(opts)
+		defer it.Close()
+
+		for it.Rewind(); it.Valid(); it.Next() {
+			value, err := it.Item().ValueCopy(nil)
+			if err != nil {
+				return err
+			}
+			if len(value) != 8 {
+				continue
+			}
+			exporterID := strings.TrimPrefix(string(it.Item().Key()), ExporterPositionPrefix)
+			positions[exporterID] = binary.BigEndian.Uint64(value)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to load exporter positions: %w", err)
+	}
+
+	return positions, nil
+}
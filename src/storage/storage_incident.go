@@ -16,6 +16,8 @@ import (
 	"time"
 
 	"github.com/dgraph-io/badger/v3"
+
+	"atom-engine/src/core/models"
 )
 
 // IncidentStorageInterface represents incident-specific storage operations
@@ -248,6 +250,16 @@ func (bs *BadgerStorage) matchesIncidentFilter(incident map[string]interface{},
 		}
 	}
 
+	// Check tenant filter
+	if tenantID, exists := filter["tenant_id"]; exists {
+		if tenantIDStr, ok := tenantID.(string); ok && tenantIDStr != "" {
+			incidentTenantID, _ := incident["tenant_id"].(string)
+			if incidentTenantID != models.NormalizeTenantID(tenantIDStr) {
+				return false
+			}
+		}
+	}
+
 	// Check element ID filter
 	if elementID, exists := filter["element_id"]; exists {
 		if elementIDStr, ok := elementID.(string); ok && elementIDStr != "" {
@@ -0,0 +1,79 @@
+/*
+This file is part of the AtomBPMN (R) project.
+Copyright (c) 2025 Matreska Market LLC (ООО «Matreska Market»).
+Authors: Matreska Team.
+
+This project is dual-licensed under AGPL-3.0 and AtomBPMN Commercial License.
+*/
+
+package storage
+
+import (
+	"fmt"
+
+	"github.com/dgraph-io/badger/v3"
+)
+
+// Incident notification outbox key prefixes
+// Префиксы ключей очереди уведомлений об инцидентах
+const (
+	IncidentNotificationPrefix = "incident_notification:"
+)
+
+// SaveIncidentNotification saves pending notification delivery to outbox
+// Сохраняет ожидающую доставку уведомления в очередь
+func (bs *BadgerStorage) SaveIncidentNotification(deliveryID string, data []byte) error {
+	if bs.db == nil {
+		return fmt.Errorf("database not initialized")
+	}
+
+	if deliveryID == "" {
+		return fmt.Errorf("delivery ID is required")
+	}
+
+	return bs.db.Update(func(txn *badger.Txn) error {
+		return txn.Set([]byte(IncidentNotificationPrefix+deliveryID), data)
+	})
+}
+
+// LoadIncidentNotifications loads all pending notification deliveries
+// Загружает все ожидающие доставки уведомлений
+func (bs *BadgerStorage) LoadIncidentNotifications() ([][]byte, error) {
+	if bs.db == nil {
+		return nil, fmt.Errorf("database not initialized")
+	}
+
+	var deliveries [][]byte
+	err := bs.db.View(func(txn *badger.Txn) error {
+		opts := badger.DefaultIteratorOptions
+		opts.Prefix = []byte(IncidentNotificationPrefix)
+		it := txn.NewIterator(opts)
+		defer it.Close()
+
+		for it.Rewind(); it.Valid(); it.Next() {
+			data, err := it.Item().ValueCopy(nil)
+			if err != nil {
+				return err
+			}
+			deliveries = append(deliveries, data)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to load incident notifications: %w", err)
+	}
+
+	return deliveries, nil
+}
+
+// DeleteIncidentNotification removes notification delivery from outbox
+// Удаляет доставку уведомления из очереди
+func (bs *BadgerStorage) DeleteIncidentNotification(deliveryID string) error {
+	if bs.db == nil {
+		return fmt.Errorf("database not initialized")
+	}
+
+	return bs.db.Update(func(txn *badger.Txn) error {
+		return txn.Delete([]byte(IncidentNotificationPrefix + deliveryID))
+	})
+}
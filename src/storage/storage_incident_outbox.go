@@ -0,0 +1,79 @@
+/*
+This file is part of the AtomBPMN (R) project.
+Copyright (c) 2025 Matreska Market LLC (ООО «Matreska Market»).
+Authors: Matreska Team.
+
+This project is dual-licensed under AGPL-3.0 and AtomBPMN Commercial License.
+*/
+
+package storage
+
+import (
+	"fmt"
+
+	"github.com/dgraph-io/badger/v3"
+)
+
+// Incident creation outbox key prefixes
+// Префиксы ключей очереди создания инцидентов
+const (
+	IncidentOutboxPrefix = "incident_outbox:"
+)
+
+// SaveIncidentOutboxEntry saves incident waiting for creation to outbox
+// Сохраняет ожидающий создания инцидент в очередь
+func (bs *BadgerStorage) SaveIncidentOutboxEntry(entryID string, data []byte) error {
+	if bs.db == nil {
+		return fmt.Errorf("database not initialized")
+	}
+
+	if entryID == "" {
+		return fmt.Errorf("outbox entry ID is required")
+	}
+
+	return bs.db.Update(func(txn *badger.Txn) error {
+		return txn.Set([]byte(IncidentOutboxPrefix+entryID), data)
+	})
+}
+
+// LoadIncidentOutbox loads all incidents waiting for creation
+// Загружает все ожидающие создания инциденты
+func (bs *BadgerStorage) LoadIncidentOutbox() ([][]byte, error) {
+	if bs.db == nil {
+		return nil, fmt.Errorf("database not initialized")
+	}
+
+	var entries [][]byte
+	err := bs.db.View(func(txn *badger.Txn) error {
+		opts := badger.DefaultIteratorOptions
+		opts.Prefix = []byte(IncidentOutboxPrefix)
+		it := txn.NewIterator(opts)
+		defer it.Close()
+
+		for it.Rewind(); it.Valid(); it.Next() {
+			data, err := it.Item().ValueCopy(nil)
+			if err != nil {
+				return err
+			}
+			entries = append(entries, data)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to load incident outbox: %w", err)
+	}
+
+	return entries, nil
+}
+
+// DeleteIncidentOutboxEntry removes created incident from outbox
+// Удаляет созданный инцидент из очереди
+func (bs *BadgerStorage) DeleteIncidentOutboxEntry(entryID string) error {
+	if bs.db == nil {
+		return fmt.Errorf("database not initialized")
+	}
+
+	return bs.db.Update(func(txn *badger.Txn) error {
+		return txn.Delete([]byte(IncidentOutboxPrefix + entryID))
+	})
+}
@@ -0,0 +1,79 @@
+/*
+This file is part of the AtomBPMN (R) project.
+Copyright (c) 2025 Matreska Market LLC (ООО «Matreska Market»).
+Authors: Matreska Team.
+
+This project is dual-licensed under AGPL-3.0 and AtomBPMN Commercial License.
+*/
+
+package storage
+
+import (
+	"fmt"
+
+	"github.com/dgraph-io/badger/v3"
+)
+
+// Incident retry rule key prefixes
+// Префиксы ключей правил повтора инцидентов
+const (
+	IncidentRetryRulePrefix = "incident_retry_rule:"
+)
+
+// SaveIncidentRetryRule saves incident retry rule created through API
+// Сохраняет правило повтора инцидентов созданное через API
+func (bs *BadgerStorage) SaveIncidentRetryRule(name string, data []byte) error {
+	if bs.db == nil {
+		return fmt.Errorf("database not initialized")
+	}
+
+	if name == "" {
+		return fmt.Errorf("retry rule name is required")
+	}
+
+	return bs.db.Update(func(txn *badger.Txn) error {
+		return txn.Set([]byte(IncidentRetryRulePrefix+name), data)
+	})
+}
+
+// LoadIncidentRetryRules loads all incident retry rules created through API
+// Загружает все правила повтора инцидентов созданные через API
+func (bs *BadgerStorage) LoadIncidentRetryRules() ([][]byte, error) {
+	if bs.db == nil {
+		return nil, fmt.Errorf("database not initialized")
+	}
+
+	var rules [][]byte
+	err := bs.db.View(func(txn *badger.Txn) error {
+		opts := badger.DefaultIteratorOptions
+		opts.Prefix = []byte(IncidentRetryRulePrefix)
+		it := txn.NewIterator(opts)
+		defer it.Close()
+
+		for it.Rewind(); it.Valid(); it.Next() {
+			data, err := it.Item().ValueCopy(nil)
+			if err != nil {
+				return err
+			}
+			rules = append(rules, data)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to load incident retry rules: %w", err)
+	}
+
+	return rules, nil
+}
+
+// DeleteIncidentRetryRule deletes incident retry rule by name
+// Удаляет правило повтора инцидентов по имени
+func (bs *BadgerStorage) DeleteIncidentRetryRule(name string) error {
+	if bs.db == nil {
+		return fmt.Errorf("database not initialized")
+	}
+
+	return bs.db.Update(func(txn *badger.Txn) error {
+		return txn.Delete([]byte(IncidentRetryRulePrefix + name))
+	})
+}
@@ -11,6 +11,7 @@ package storage
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 
 	"atom-engine/src/core/models"
@@ -20,10 +21,129 @@ import (
 
 // Job storage methods
 
-// SaveJob saves job to storage
+// maxJobUpdateAttempts bounds retries of a job transaction aborted by a write conflict
+const maxJobUpdateAttempts = 3
+
+var (
+	// ErrJobNotFound is returned when a job transition targets a missing job
+	ErrJobNotFound = errors.New("job not found")
+	// ErrJobStatusMismatch is returned when a job is not in the expected status
+	ErrJobStatusMismatch = errors.New("job status mismatch")
+)
+
+// SaveJob saves job to storage and its pending index entry in one transaction
 func (bs *BadgerStorage) SaveJob(ctx context.Context, job *models.Job) error {
-	key := fmt.Sprintf("job:%s", job.ID)
-	return bs.saveJSON(key, job)
+	if err := bs.validateStorage(); err != nil {
+		return err
+	}
+
+	bs.jobMutex.Lock()
+	defer bs.jobMutex.Unlock()
+
+	key := []byte(fmt.Sprintf("job:%s", job.ID))
+	data, err := json.Marshal(job)
+	if err != nil {
+		return fmt.Errorf("failed to marshal job: %w", err)
+	}
+
+	return bs.db.Update(func(txn *badger.Txn) error {
+		var previous *models.Job
+		item, err := txn.Get(key)
+		switch {
+		case err == nil:
+			previous = &models.Job{}
+			if err := item.Value(func(val []byte) error {
+				return json.Unmarshal(val, previous)
+			}); err != nil {
+				return fmt.Errorf("failed to unmarshal job: %w", err)
+			}
+		case err != badger.ErrKeyNotFound:
+			return fmt.Errorf("failed to get job: %w", err)
+		}
+
+		if err := txn.Set(key, data); err != nil {
+			return fmt.Errorf("failed to save job: %w", err)
+		}
+		return updatePendingJobIndex(txn, previous, job)
+	})
+}
+
+// UpdateJobIf applies update to a job only if its stored status equals expectedStatus.
+// The read, status check and write happen in one transaction, so concurrent
+// callers racing on the same transition see ErrJobStatusMismatch instead of
+// silently overwriting each other. Errors returned by update abort the
+// transaction and are passed through unchanged.
+func (bs *BadgerStorage) UpdateJobIf(
+	ctx context.Context,
+	jobID string,
+	expectedStatus models.JobStatus,
+	update func(job *models.Job) error,
+) (*models.Job, error) {
+	if err := bs.validateStorage(); err != nil {
+		return nil, err
+	}
+
+	bs.jobMutex.Lock()
+	defer bs.jobMutex.Unlock()
+
+	key := []byte(fmt.Sprintf("job:%s", jobID))
+
+	for attempt := 1; ; attempt++ {
+		var updated *models.Job
+
+		err := bs.db.Update(func(txn *badger.Txn) error {
+			item, err := txn.Get(key)
+			if err != nil {
+				if err == badger.ErrKeyNotFound {
+					return fmt.Errorf("%w: %s", ErrJobNotFound, jobID)
+				}
+				return fmt.Errorf("failed to get job: %w", err)
+			}
+
+			var job models.Job
+			if err := item.Value(func(val []byte) error {
+				return json.Unmarshal(val, &job)
+			}); err != nil {
+				return fmt.Errorf("failed to unmarshal job: %w", err)
+			}
+
+			if job.Status != expectedStatus {
+				return fmt.Errorf("%w: job %s is %s, expected %s",
+					ErrJobStatusMismatch, jobID, job.Status, expectedStatus)
+			}
+
+			previous := job
+			if err := update(&job); err != nil {
+				return err
+			}
+
+			data, err := json.Marshal(&job)
+			if err != nil {
+				return fmt.Errorf("failed to marshal job: %w", err)
+			}
+
+			if err := txn.Set(key, data); err != nil {
+				return fmt.Errorf("failed to save job: %w", err)
+			}
+			if err := updatePendingJobIndex(txn, &previous, &job); err != nil {
+				return err
+			}
+
+			updated = &job
+			return nil
+		})
+
+		// A conflict means another transaction committed this key after we read it;
+		// re-run so the status check sees the committed value
+		if err == badger.ErrConflict && attempt < maxJobUpdateAttempts {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		return updated, nil
+	}
 }
 
 // GetJob gets job from storage
@@ -49,6 +169,84 @@ func (bs *BadgerStorage) ListJobsByType(
 	status models.JobStatus,
 	limit int,
 ) ([]*models.Job, error) {
+	return bs.listJobs(limit, func(job *models.Job) bool {
+		return (jobType == "" || job.Type == jobType) && (status == "" || job.Status == status)
+	})
+}
+
+// ListJobsByProcessInstance lists jobs created by process instance
+func (bs *BadgerStorage) ListJobsByProcessInstance(
+	ctx context.Context,
+	processInstanceID string,
+) ([]*models.Job, error) {
+	return bs.listJobs(0, func(job *models.Job) bool {
+		return job.ProcessInstanceID == processInstanceID
+	})
+}
+
+// ListPendingJobs lists up to limit pending jobs of type owned by tenants
+// from pending index, highest priority first and oldest first within
+// priority. Nil tenantIDs matches jobs of every tenant, zero limit lists all.
+func (bs *BadgerStorage) ListPendingJobs(
+	ctx context.Context,
+	jobType string,
+	tenantIDs []string,
+	limit int,
+) ([]*models.Job, error) {
+	if bs.db == nil {
+		return nil, fmt.Errorf("database not initialized")
+	}
+
+	var jobs []*models.Job
+	err := bs.db.View(func(txn *badger.Txn) error {
+		opts := badger.DefaultIteratorOptions
+		opts.Prefix = pendingJobTypePrefix(jobType)
+		it := txn.NewIterator(opts)
+		defer it.Close()
+
+		for it.Rewind(); it.Valid() && (limit <= 0 || len(jobs) < limit); it.Next() {
+			item := it.Item()
+			if tenantIDs != nil {
+				tenantID, err := item.ValueCopy(nil)
+				if err != nil {
+					return err
+				}
+				if !models.TenantAllowed(tenantIDs, string(tenantID)) {
+					continue
+				}
+			}
+
+			jobItem, err := txn.Get([]byte("job:" + jobIDFromIndexKey(item.Key())))
+			if err == badger.ErrKeyNotFound {
+				continue
+			}
+			if err != nil {
+				return err
+			}
+
+			var job models.Job
+			if err := jobItem.Value(func(val []byte) error {
+				return json.Unmarshal(val, &job)
+			}); err != nil {
+				return err
+			}
+			// Entry left by record removed outside job transactions
+			if job.Status != models.JobStatusPending || job.Type != jobType {
+				continue
+			}
+			jobs = append(jobs, &job)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pending jobs: %w", err)
+	}
+
+	return jobs, nil
+}
+
+// listJobs lists up to limit jobs accepted by match, zero limit lists all
+func (bs *BadgerStorage) listJobs(limit int, match func(job *models.Job) bool) ([]*models.Job, error) {
 	if bs.db == nil {
 		return nil, fmt.Errorf("database not initialized")
 	}
@@ -71,13 +269,7 @@ func (bs *BadgerStorage) ListJobsByType(
 					return err
 				}
 
-				// Filter by type if specified
-				if jobType != "" && job.Type != jobType {
-					return nil
-				}
-
-				// Filter by status if specified
-				if status != "" && job.Status != status {
+				if !match(&job) {
 					return nil
 				}
 
@@ -0,0 +1,114 @@
+/*
+This file is part of the AtomBPMN (R) project.
+Copyright (c) 2025 Matreska Market LLC (ООО «Matreska Market»).
+Authors: Matreska Team.
+
+This project is dual-licensed under AGPL-3.0 and AtomBPMN Commercial License.
+*/
+
+package storage
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"atom-engine/src/core/models"
+
+	"github.com/dgraph-io/badger/v3"
+)
+
+// JobPendingIndexPrefix keys pending jobs by type, descending priority and
+// creation time, value holds tenant of job
+// Префикс индекса ожидающих заданий по типу, приоритету и возрасту
+const JobPendingIndexPrefix = "job_pending:"
+
+// signFlip maps int64 order onto uint64 order of fixed width hex keys
+const signFlip = uint64(1) << 63
+
+// pendingJobTypePrefix returns index prefix of job type. Type is NUL
+// terminated, so prefix of one type does not match longer types.
+func pendingJobTypePrefix(jobType string) []byte {
+	return []byte(JobPendingIndexPrefix + jobType + "\x00")
+}
+
+// pendingJobIndexKey returns index key of job, higher priority sorts first,
+// then older job
+func pendingJobIndexKey(job *models.Job) []byte {
+	invertedPriority := ^(uint64(int64(job.Priority)) ^ signFlip)
+	createdAt := uint64(job.CreatedAt.UnixNano()) ^ signFlip
+	return append(pendingJobTypePrefix(job.Type),
+		[]byte(fmt.Sprintf("%016x:%016x:%s", invertedPriority, createdAt, job.ID))...)
+}
+
+// jobIDFromIndexKey returns job ID of index key, it follows type terminator
+// and 34 characters of priority and creation time
+func jobIDFromIndexKey(key []byte) string {
+	terminator := bytes.IndexByte(key, 0)
+	return string(key[terminator+1+34:])
+}
+
+// updatePendingJobIndex moves index entry of job from previous state to
+// current one inside job transaction, nil previous means new job
+func updatePendingJobIndex(txn *badger.Txn, previous, current *models.Job) error {
+	if previous != nil && previous.Status == models.JobStatusPending {
+		if err := txn.Delete(pendingJobIndexKey(previous)); err != nil {
+			return fmt.Errorf("failed to remove pending job index: %w", err)
+		}
+	}
+	if current.Status == models.JobStatusPending {
+		if err := txn.Set(pendingJobIndexKey(current), []byte(current.TenantID)); err != nil {
+			return fmt.Errorf("failed to write pending job index: %w", err)
+		}
+	}
+	return nil
+}
+
+// migratePendingJobIndex indexes pending jobs written before pending index
+func migratePendingJobIndex(db *badger.DB, dryRun bool) (int, error) {
+	var batch *badger.WriteBatch
+	if !dryRun {
+		batch = db.NewWriteBatch()
+		defer batch.Cancel()
+	}
+
+	indexed := 0
+	prefix := []byte("job:")
+	err := db.View(func(txn *badger.Txn) error {
+		opts := badger.DefaultIteratorOptions
+		opts.Prefix = prefix
+		it := txn.NewIterator(opts)
+		defer it.Close()
+
+		for it.Rewind(); it.Valid(); it.Next() {
+			var job models.Job
+			if err := it.Item().Value(func(val []byte) error {
+				return json.Unmarshal(val, &job)
+			}); err != nil {
+				// Unreadable job is left for manual inspection
+				continue
+			}
+			if job.Status != models.JobStatusPending {
+				continue
+			}
+			indexed++
+			if dryRun {
+				continue
+			}
+			if err := batch.Set(pendingJobIndexKey(&job), []byte(job.TenantID)); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to index pending jobs: %w", err)
+	}
+
+	if !dryRun {
+		if err := batch.Flush(); err != nil {
+			return 0, fmt.Errorf("failed to write pending job index: %w", err)
+		}
+	}
+	return indexed, nil
+}
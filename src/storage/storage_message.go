@@ -118,7 +118,7 @@ func (bs *BadgerStorage) ListProcessMessageSubscriptions(
 				}
 
 				// Filter by tenant if specified
-				if tenantID != "" && sub.TenantID != tenantID {
+				if tenantID != "" && models.NormalizeTenantID(sub.TenantID) != models.NormalizeTenantID(tenantID) {
 					return nil
 				}
 
@@ -261,7 +261,7 @@ func (bs *BadgerStorage) ListBufferedMessages(
 			}
 
 			// Filter by tenant if specified (early filtering for performance)
-			if tenantID != "" && msg.TenantID != tenantID {
+			if tenantID != "" && models.NormalizeTenantID(msg.TenantID) != models.NormalizeTenantID(tenantID) {
 				continue
 			}
 
@@ -346,7 +346,7 @@ func (bs *BadgerStorage) ListMessageCorrelationResults(
 				}
 
 				// Apply filters
-				if tenantID != "" && result.TenantID != tenantID {
+				if tenantID != "" && models.NormalizeTenantID(result.TenantID) != models.NormalizeTenantID(tenantID) {
 					return nil
 				}
 				if messageName != "" && result.MessageName != messageName {
@@ -0,0 +1,329 @@
+/*
+This file is part of the AtomBPMN (R) project.
+Copyright (c) 2025 Matreska Market LLC (ООО «Matreska Market»).
+Authors: Matreska Team.
+
+This project is dual-licensed under AGPL-3.0 and AtomBPMN Commercial License.
+*/
+
+package storage
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+
+	"atom-engine/src/core/logger"
+
+	"github.com/dgraph-io/badger/v3"
+)
+
+// SchemaVersion is version of record layout written by this engine, equals
+// version of last registered migration
+// Версия структуры записей, которую пишет этот движок
+const SchemaVersion = 3
+
+// SchemaVersionKey holds schema version of records on disk
+// Ключ версии схемы записей в базе данных
+const SchemaVersionKey = "meta:schema_version"
+
+// unversionedSchemaVersion is schema of databases created before schema
+// version was recorded
+const unversionedSchemaVersion = 1
+
+// Migration rewrites records from schema Version-1 to schema Version.
+// Migrate returns number of changed records and changes nothing when dryRun
+// is set. Migration must be safe to repeat after interrupted run.
+// Миграция записей с версии схемы Version-1 на Version
+type Migration struct {
+	Version     int
+	Description string
+	Migrate     func(db *badger.DB, dryRun bool) (int, error)
+}
+
+// migrations is ordered registry of schema migrations, append new migration
+// with next version and bump SchemaVersion
+var migrations = []Migration{
+	{
+		Version:     2,
+		Description: "Rewrite legacy DEFAULT_TENANT tenant of message subscriptions to default tenant",
+		Migrate:     migrateLegacySubscriptionTenant,
+	},
+	{
+		Version:     3,
+		Description: "Index pending jobs by type, priority and age for activation",
+		Migrate:     migratePendingJobIndex,
+	},
+}
+
+// MigrationResult describes applied or pending migration
+// Описывает примененную или ожидающую миграцию
+type MigrationResult struct {
+	Version     int    `json:"version"`
+	Description string `json:"description"`
+	Records     int    `json:"records"`
+}
+
+// MigrationReport describes schema migration run
+// Описывает запуск миграции схемы
+type MigrationReport struct {
+	FromVersion int               `json:"from_version"`
+	ToVersion   int               `json:"to_version"`
+	DryRun      bool              `json:"dry_run"`
+	Migrations  []MigrationResult `json:"migrations"`
+}
+
+// migrateSchema brings database to SchemaVersion, refusing newer schema
+// Приводит базу данных к SchemaVersion, отказываясь работать с более новой схемой
+func (s *BadgerStorage) migrateSchema() error {
+	report, err := migrateDatabase(s.db, false)
+	if err != nil {
+		return err
+	}
+	if len(report.Migrations) > 0 {
+		logger.Info("Storage schema migrated",
+			logger.Int("from_version", report.FromVersion),
+			logger.Int("to_version", report.ToVersion))
+	}
+	return nil
+}
+
+// MigrateDirectory migrates database directory which no engine uses. With
+// dryRun it reports pending migrations and records they change.
+// Мигрирует каталог базы данных, не используемый движком
+func MigrateDirectory(directory string, dryRun bool) (*MigrationReport, error) {
+	empty, err := isEmptyDirectory(directory)
+	if err != nil {
+		return nil, err
+	}
+	if empty {
+		return nil, fmt.Errorf("directory %s holds no database", directory)
+	}
+
+	opts := badger.DefaultOptions(directory)
+	opts.Logger = nil
+	opts.ReadOnly = dryRun
+	db, err := badger.Open(opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open directory %s: %w", directory, err)
+	}
+	defer db.Close()
+
+	return migrateDatabase(db, dryRun)
+}
+
+// migrateDatabase runs pending migrations in order, saving schema version
+// after each one so interrupted run continues from failed migration
+func migrateDatabase(db *badger.DB, dryRun bool) (*MigrationReport, error) {
+	if err := validateMigrations(); err != nil {
+		return nil, err
+	}
+
+	current, found, err := loadSchemaVersion(db)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		empty, err := isEmptyDatabase(db)
+		if err != nil {
+			return nil, err
+		}
+		current = unversionedSchemaVersion
+		if empty {
+			current = SchemaVersion
+		}
+	}
+
+	report := &MigrationReport{
+		FromVersion: current,
+		ToVersion:   SchemaVersion,
+		DryRun:      dryRun,
+		Migrations:  []MigrationResult{},
+	}
+	if current > SchemaVersion {
+		return nil, fmt.Errorf("storage schema version %d is newer than supported version %d, "+
+			"upgrade engine", current, SchemaVersion)
+	}
+
+	for _, migration := range migrations {
+		if migration.Version <= current {
+			continue
+		}
+
+		if !dryRun {
+			logger.Info("Running storage migration",
+				logger.Int("version", migration.Version),
+				logger.String("description", migration.Description))
+		}
+		records, err := migration.Migrate(db, dryRun)
+		if err != nil {
+			return nil, fmt.Errorf("storage migration %d failed: %w", migration.Version, err)
+		}
+		report.Migrations = append(report.Migrations, MigrationResult{
+			Version:     migration.Version,
+			Description: migration.Description,
+			Records:     records,
+		})
+
+		if !dryRun {
+			if err := saveSchemaVersion(db, migration.Version); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if !found && !dryRun && len(report.Migrations) == 0 {
+		if err := saveSchemaVersion(db, SchemaVersion); err != nil {
+			return nil, err
+		}
+	}
+	return report, nil
+}
+
+// validateMigrations checks registry is ordered and ends at SchemaVersion
+func validateMigrations() error {
+	previous := unversionedSchemaVersion
+	for _, migration := range migrations {
+		if migration.Version != previous+1 {
+			return fmt.Errorf("storage migration %d does not follow version %d", migration.Version, previous)
+		}
+		previous = migration.Version
+	}
+	if previous != SchemaVersion {
+		return fmt.Errorf("last storage migration %d does not match schema version %d", previous, SchemaVersion)
+	}
+	return nil
+}
+
+// loadSchemaVersion returns recorded schema version
+func loadSchemaVersion(db *badger.DB) (int, bool, error) {
+	var schemaVersion int
+	found := false
+	err := db.View(func(txn *badger.Txn) error {
+		item, err := txn.Get([]byte(SchemaVersionKey))
+		if err == badger.ErrKeyNotFound {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		value, err := item.ValueCopy(nil)
+		if err != nil {
+			return err
+		}
+		if len(value) != 8 {
+			return fmt.Errorf("invalid storage schema version")
+		}
+		schemaVersion = int(binary.BigEndian.Uint64(value))
+		found = true
+		return nil
+	})
+	if err != nil {
+		return 0, false, fmt.Errorf("failed to read storage schema version: %w", err)
+	}
+	return schemaVersion, found, nil
+}
+
+// saveSchemaVersion records schema version
+func saveSchemaVersion(db *badger.DB, schemaVersion int) error {
+	value := make([]byte, 8)
+	binary.BigEndian.PutUint64(value, uint64(schemaVersion))
+	err := db.Update(func(txn *badger.Txn) error {
+		return txn.Set([]byte(SchemaVersionKey), value)
+	})
+	if err != nil {
+		return fmt.Errorf("failed to save storage schema version: %w", err)
+	}
+	return nil
+}
+
+// isEmptyDatabase reports whether database holds no keys
+func isEmptyDatabase(db *badger.DB) (bool, error) {
+	empty := true
+	err := db.View(func(txn *badger.Txn) error {
+		opts := badger.DefaultIteratorOptions
+		opts.PrefetchValues = false
+		it := txn.NewIterator(opts)
+		defer it.Close()
+
+		it.Rewind()
+		empty = !it.Valid()
+		return nil
+	})
+	return empty, err
+}
+
+// rewriteRecords passes every record under prefix to rewrite and stores
+// returned value when rewrite reports change. Returns changed record count.
+func rewriteRecords(db *badger.DB, prefix string, dryRun bool,
+	rewrite func(value []byte) ([]byte, bool, error)) (int, error) {
+	var batch *badger.WriteBatch
+	if !dryRun {
+		batch = db.NewWriteBatch()
+		defer batch.Cancel()
+	}
+
+	changed := 0
+	err := db.View(func(txn *badger.Txn) error {
+		opts := badger.DefaultIteratorOptions
+		opts.Prefix = []byte(prefix)
+		it := txn.NewIterator(opts)
+		defer it.Close()
+
+		for it.Rewind(); it.Valid(); it.Next() {
+			item := it.Item()
+			value, err := item.ValueCopy(nil)
+			if err != nil {
+				return err
+			}
+			updated, ok, err := rewrite(value)
+			if err != nil {
+				return fmt.Errorf("record %s: %w", item.Key(), err)
+			}
+			if !ok {
+				continue
+			}
+			changed++
+			if batch != nil {
+				if err := batch.Set(item.KeyCopy(nil), updated); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	if batch != nil {
+		if err := batch.Flush(); err != nil {
+			return 0, err
+		}
+	}
+	return changed, nil
+}
+
+// migrateLegacySubscriptionTenant rewrites tenant "DEFAULT_TENANT" stamped on
+// message subscriptions before tenants were supported. Records are patched as
+// JSON objects so fields unknown to this migration are kept.
+func migrateLegacySubscriptionTenant(db *badger.DB, dryRun bool) (int, error) {
+	const legacyTenantID = `"DEFAULT_TENANT"`
+
+	return rewriteRecords(db, "msg_sub:", dryRun, func(value []byte) ([]byte, bool, error) {
+		var record map[string]json.RawMessage
+		if err := json.Unmarshal(value, &record); err != nil {
+			return nil, false, err
+		}
+		if string(record["tenant_id"]) != legacyTenantID {
+			return nil, false, nil
+		}
+
+		record["tenant_id"] = json.RawMessage(`""`)
+		updated, err := json.Marshal(record)
+		if err != nil {
+			return nil, false, err
+		}
+		return updated, true, nil
+	})
+}
@@ -9,6 +9,7 @@ This project is dual-licensed under AGPL-3.0 and AtomBPMN Commercial License.
 package storage
 
 import (
+	"encoding/json"
 	"fmt"
 
 	"atom-engine/src/core/models"
@@ -115,6 +116,62 @@ func (bs *BadgerStorage) LoadProcessInstancesByProcessKey(processKey string) ([]
 	return instances, nil
 }
 
+// ProcessInstanceFilter selects process instances passed by scan, empty
+// fields match every instance
+// Фильтр экземпляров процессов для сканирования
+type ProcessInstanceFilter struct {
+	ProcessKey string   // Process ID or versioned process key
+	States     []string // Instance states
+	TenantIDs  []string // Nil matches every tenant
+}
+
+// ScanProcessInstances passes process instances matching filter to handler
+// one by one, error returned by handler stops scan. Filter fields are read
+// before instance is decoded, so skipped instances cost no variable decoding.
+// Передает обработчику экземпляры процессов, подходящие под фильтр
+func (bs *BadgerStorage) ScanProcessInstances(
+	filter ProcessInstanceFilter,
+	handler func(instance *models.ProcessInstance) error,
+) error {
+	return bs.iterateWithPrefix(ProcessInstancePrefix, func(key []byte, value []byte) error {
+		var fields struct {
+			ProcessID  string `json:"process_id"`
+			ProcessKey string `json:"process_key"`
+			TenantID   string `json:"tenant_id"`
+			State      string `json:"state"`
+		}
+		if err := json.Unmarshal(value, &fields); err != nil {
+			// Unreadable instance is left for manual inspection
+			return nil
+		}
+		if filter.ProcessKey != "" && fields.ProcessID != filter.ProcessKey && fields.ProcessKey != filter.ProcessKey {
+			return nil
+		}
+		if len(filter.States) > 0 && !containsState(filter.States, fields.State) {
+			return nil
+		}
+		if !models.TenantAllowed(filter.TenantIDs, fields.TenantID) {
+			return nil
+		}
+
+		var instance models.ProcessInstance
+		if err := instance.FromJSON(value); err != nil {
+			return nil
+		}
+		return handler(&instance)
+	})
+}
+
+// containsState reports whether states include state
+func containsState(states []string, state string) bool {
+	for _, candidate := range states {
+		if candidate == state {
+			return true
+		}
+	}
+	return false
+}
+
 // LoadAllProcessInstances loads all process instances from storage
 // Загружает все экземпляры процессов из storage
 func (bs *BadgerStorage) LoadAllProcessInstances() ([]*models.ProcessInstance, error) {
@@ -404,3 +461,37 @@ func (bs *BadgerStorage) DeleteToken(tokenID string) error {
 		return txn.Delete([]byte(key))
 	})
 }
+
+// InstanceTenantID returns tenant of process instance, default tenant for
+// record without instance. Instance that fails to load is an error, so
+// record of other tenant is never filed under default tenant.
+// Возвращает арендатора экземпляра процесса, ошибку если экземпляр не загружен
+func InstanceTenantID(s Storage, instanceID string) (string, error) {
+	if instanceID == "" {
+		return models.DefaultTenantID, nil
+	}
+	if s == nil {
+		return "", fmt.Errorf("storage not available")
+	}
+	instance, err := s.LoadProcessInstance(instanceID)
+	if err != nil {
+		return "", fmt.Errorf("failed to load process instance %s: %w", instanceID, err)
+	}
+	if instance == nil {
+		return "", fmt.Errorf("process instance %s not found", instanceID)
+	}
+	return instance.TenantID, nil
+}
+
+// InstanceProcessKey returns process definition key of process instance,
+// empty when instance is unknown
+func InstanceProcessKey(s Storage, instanceID string) string {
+	if s == nil || instanceID == "" {
+		return ""
+	}
+	instance, err := s.LoadProcessInstance(instanceID)
+	if err != nil || instance == nil {
+		return ""
+	}
+	return instance.ProcessKey
+}
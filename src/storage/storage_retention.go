@@ -0,0 +1,254 @@
+/*
+This file is part of the AtomBPMN (R) project.
+Copyright (c) 2025 Matreska Market LLC (ООО «Matreska Market»).
+Authors: Matreska Team.
+
+This project is dual-licensed under AGPL-3.0 and AtomBPMN Commercial License.
+*/
+
+package storage
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"atom-engine/src/core/models"
+
+	"github.com/dgraph-io/badger/v3"
+)
+
+// History record kinds removed by retention
+// Виды записей истории, удаляемых по политике хранения
+const (
+	HistoryKindProcessInstance    = "process_instance"
+	HistoryKindToken              = "token"
+	HistoryKindJob                = "job"
+	HistoryKindIncident           = "incident"
+	HistoryKindMessageCorrelation = "message_correlation"
+	HistoryKindGatewaySync        = "gateway_sync"
+	HistoryKindSystemEvent        = "system_event"
+)
+
+// historyPrefixes maps history record kind to its key prefix. Records linked
+// to process instance are scanned in this order with instance records last,
+// so interrupted cleanup leaves instance to find remaining records again.
+var historyPrefixes = []struct {
+	kind   string
+	prefix string
+}{
+	{HistoryKindToken, TokenPrefix},
+	{HistoryKindJob, "job:"},
+	{HistoryKindIncident, "incident:"},
+	{HistoryKindMessageCorrelation, "msg_corr:"},
+	{HistoryKindGatewaySync, GatewaySyncPrefix},
+	{HistoryKindSystemEvent, "system_events:"},
+	{HistoryKindProcessInstance, ProcessInstancePrefix},
+}
+
+// historyDeleteChunk limits record deletions per write batch flush
+const historyDeleteChunk = 1000
+
+// valueLogGCDiscardRatio is share of stale data that makes value log file rewritten
+const valueLogGCDiscardRatio = 0.5
+
+// HistoryRecord is stored record selected by retention, Data holds record
+// JSON as stored
+// Запись истории, выбранная политикой хранения
+type HistoryRecord struct {
+	Kind string          `json:"kind"`
+	Key  string          `json:"key"`
+	Data json.RawMessage `json:"data"`
+}
+
+// historyFields are record fields retention selects by
+type historyFields struct {
+	ProcessInstanceID string     `json:"process_instance_id"`
+	Status            string     `json:"status"`
+	CreatedAt         time.Time  `json:"created_at"`
+	UpdatedAt         time.Time  `json:"updated_at"`
+	CompletedAt       *time.Time `json:"completed_at"`
+	ResolvedAt        *time.Time `json:"resolved_at"`
+}
+
+// ScanFinishedProcessInstances passes completed, canceled and failed
+// process instances to handler, error returned by handler stops scan
+// Передает обработчику завершенные, отмененные и упавшие экземпляры процессов
+func (bs *BadgerStorage) ScanFinishedProcessInstances(handler func(instance *models.ProcessInstance) error) error {
+	return bs.iterateWithPrefix(ProcessInstancePrefix, func(key []byte, value []byte) error {
+		var instance models.ProcessInstance
+		if err := json.Unmarshal(value, &instance); err != nil {
+			// Unreadable instance is left for manual inspection
+			return nil
+		}
+		if !instance.IsCompleted() {
+			return nil
+		}
+		return handler(&instance)
+	})
+}
+
+// ScanProcessInstanceHistory passes records of process instances to handler:
+// tokens, jobs, incidents, correlation results, gateway sync states and
+// instances themselves last
+// Передает обработчику записи экземпляров процессов, сами экземпляры последними
+func (bs *BadgerStorage) ScanProcessInstanceHistory(
+	instanceIDs map[string]bool,
+	handler func(record *HistoryRecord) error,
+) error {
+	for _, history := range historyPrefixes {
+		if history.kind == HistoryKindSystemEvent {
+			continue
+		}
+
+		kind := history.kind
+		err := bs.iterateWithPrefix(history.prefix, func(key []byte, value []byte) error {
+			var fields historyFields
+			if err := json.Unmarshal(value, &fields); err != nil {
+				return nil
+			}
+			instanceID := fields.ProcessInstanceID
+			if kind == HistoryKindProcessInstance {
+				instanceID = string(key[len(ProcessInstancePrefix):])
+			}
+			if !instanceIDs[instanceID] {
+				return nil
+			}
+			return handler(newHistoryRecord(kind, key, value))
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ScanExpiredHistory passes records of kind finished before cutoff to
+// handler: completed, canceled and error thrown jobs, resolved and dismissed
+// incidents, correlation results and system events by creation time
+// Передает обработчику записи вида, завершенные до cutoff
+func (bs *BadgerStorage) ScanExpiredHistory(
+	kind string,
+	before time.Time,
+	handler func(record *HistoryRecord) error,
+) error {
+	prefix := ""
+	for _, history := range historyPrefixes {
+		if history.kind == kind {
+			prefix = history.prefix
+		}
+	}
+
+	var finishedAt func(fields *historyFields) *time.Time
+	switch kind {
+	case HistoryKindJob:
+		finishedAt = func(fields *historyFields) *time.Time {
+			switch models.JobStatus(fields.Status) {
+			case models.JobStatusCompleted, models.JobStatusCanceled, models.JobStatusErrorThrown:
+			default:
+				return nil
+			}
+			if fields.CompletedAt != nil {
+				return fields.CompletedAt
+			}
+			return &fields.UpdatedAt
+		}
+	case HistoryKindIncident:
+		finishedAt = func(fields *historyFields) *time.Time {
+			// Incident statuses are owned by incidents package
+			if fields.Status != "RESOLVED" && fields.Status != "DISMISSED" {
+				return nil
+			}
+			if fields.ResolvedAt != nil {
+				return fields.ResolvedAt
+			}
+			return &fields.UpdatedAt
+		}
+	case HistoryKindMessageCorrelation, HistoryKindSystemEvent:
+		finishedAt = func(fields *historyFields) *time.Time {
+			return &fields.CreatedAt
+		}
+	default:
+		return fmt.Errorf("history kind %s has no expiry rule", kind)
+	}
+
+	return bs.iterateWithPrefix(prefix, func(key []byte, value []byte) error {
+		var fields historyFields
+		if err := json.Unmarshal(value, &fields); err != nil {
+			return nil
+		}
+		finished := finishedAt(&fields)
+		if finished == nil || finished.IsZero() || !finished.Before(before) {
+			return nil
+		}
+		return handler(newHistoryRecord(kind, key, value))
+	})
+}
+
+// DeleteHistoryRecords deletes history records by key
+// Удаляет записи истории по ключу
+func (bs *BadgerStorage) DeleteHistoryRecords(records []*HistoryRecord) error {
+	if err := bs.validateStorage(); err != nil {
+		return err
+	}
+
+	for start := 0; start < len(records); start += historyDeleteChunk {
+		end := start + historyDeleteChunk
+		if end > len(records) {
+			end = len(records)
+		}
+
+		batch := bs.db.NewWriteBatch()
+		for _, record := range records[start:end] {
+			if err := batch.Delete([]byte(record.Key)); err != nil {
+				batch.Cancel()
+				return fmt.Errorf("failed to delete history record %s: %w", record.Key, err)
+			}
+			// Pending job of finished instance leaves activation index with it
+			if record.Kind == HistoryKindJob {
+				var job models.Job
+				if json.Unmarshal(record.Data, &job) == nil && job.Status == models.JobStatusPending {
+					if err := batch.Delete(pendingJobIndexKey(&job)); err != nil {
+						batch.Cancel()
+						return fmt.Errorf("failed to delete pending job index %s: %w", record.Key, err)
+					}
+				}
+			}
+		}
+		if err := batch.Flush(); err != nil {
+			return fmt.Errorf("failed to delete history records: %w", err)
+		}
+	}
+	return nil
+}
+
+// CollectGarbage rewrites value log files mostly holding deleted records so
+// disk space is released, returns number of rewritten files
+// Перезаписывает файлы value log с удаленными записями, освобождая место на диске
+func (bs *BadgerStorage) CollectGarbage() (int, error) {
+	if err := bs.validateStorage(); err != nil {
+		return 0, err
+	}
+
+	rewritten := 0
+	for {
+		err := bs.db.RunValueLogGC(valueLogGCDiscardRatio)
+		if errors.Is(err, badger.ErrNoRewrite) {
+			return rewritten, nil
+		}
+		if err != nil {
+			return rewritten, fmt.Errorf("value log garbage collection failed: %w", err)
+		}
+		rewritten++
+	}
+}
+
+// newHistoryRecord copies key and value valid only inside iteration
+func newHistoryRecord(kind string, key, value []byte) *HistoryRecord {
+	return &HistoryRecord{
+		Kind: kind,
+		Key:  string(key),
+		Data: append(json.RawMessage(nil), value...),
+	}
+}
@@ -174,10 +174,41 @@ func (s *BadgerStorage) GetInfo() (*StorageInfo, error) {
 	info.Statistics["db_type"] = "badger"
 	info.Statistics["key_count"] = fmt.Sprintf("%d", keyCount)
 	info.Statistics["db_path"] = s.config.Path
+	if schemaVersion, found, err := loadSchemaVersion(s.db); err == nil && found {
+		info.Statistics["schema_version"] = fmt.Sprintf("%d", schemaVersion)
+	}
 
 	return info, nil
 }
 
+// GetDiskUsage returns BadgerDB LSM tree and value log sizes. Badger refreshes
+// its size estimate periodically, so files are summed until estimate is ready.
+// Возвращает размеры LSM дерева и value log BadgerDB. Badger обновляет оценку
+// размера периодически, поэтому до ее готовности суммируются размеры файлов.
+func (s *BadgerStorage) GetDiskUsage() (lsmBytes, vlogBytes int64) {
+	if s.db == nil {
+		return 0, 0
+	}
+	lsmBytes, vlogBytes = s.db.Size()
+	if lsmBytes > 0 || vlogBytes > 0 {
+		return lsmBytes, vlogBytes
+	}
+
+	_ = filepath.Walk(s.config.Path, func(path string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() {
+			return nil
+		}
+		switch filepath.Ext(path) {
+		case ".sst":
+			lsmBytes += info.Size()
+		case ".vlog":
+			vlogBytes += info.Size()
+		}
+		return nil
+	})
+	return lsmBytes, vlogBytes
+}
+
 // getDirSize calculates directory size recursively
 // Вычисляет размер директории рекурсивно
 func getDirSize(path string) (int64, error) {
@@ -10,20 +10,24 @@ package storage
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
-	"time"
 
+	"atom-engine/src/core/clock"
 	"atom-engine/src/core/logger"
 
 	"github.com/dgraph-io/badger/v3"
 )
 
+// ErrTimerNotFound is returned when timer is not persisted
+var ErrTimerNotFound = errors.New("timer not found")
+
 // SaveTimer saves timer to database
 // Сохраняет таймер в базу данных
 func (s *BadgerStorage) SaveTimer(timer *TimerRecord) error {
-	timer.UpdatedAt = time.Now()
+	timer.UpdatedAt = clock.Now()
 	if timer.CreatedAt.IsZero() {
-		timer.CreatedAt = time.Now()
+		timer.CreatedAt = clock.Now()
 	}
 
 	key := fmt.Sprintf("timer_%s", timer.ID)
@@ -65,7 +69,7 @@ func (s *BadgerStorage) LoadTimer(timerID string) (*TimerRecord, error) {
 	})
 
 	if err == badger.ErrKeyNotFound {
-		return nil, fmt.Errorf("timer not found: %s", timerID)
+		return nil, fmt.Errorf("%w: %s", ErrTimerNotFound, timerID)
 	}
 
 	if err != nil {
@@ -165,7 +169,7 @@ func (s *BadgerStorage) UpdateTimer(timer *TimerRecord) error {
 		return fmt.Errorf("storage not ready")
 	}
 
-	timer.UpdatedAt = time.Now()
+	timer.UpdatedAt = clock.Now()
 
 	data, err := json.Marshal(timer)
 	if err != nil {
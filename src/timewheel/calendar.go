@@ -0,0 +1,61 @@
+/*
+This file is part of the AtomBPMN (R) project.
+Copyright (c) 2025 Matreska Market LLC (ООО «Matreska Market»).
+Authors: Matreska Team.
+
+This project is dual-licensed under AGPL-3.0 and AtomBPMN Commercial License.
+*/
+
+package timewheel
+
+import (
+	"fmt"
+	"time"
+
+	"atom-engine/src/core/calendar"
+)
+
+// timerVarCalendar stores business calendar name in timer variables
+// Хранит имя бизнес-календаря в переменных таймера
+const timerVarCalendar = "calendar"
+
+// AddWorkingDuration adds ISO 8601 duration of working time to start.
+// Days and weeks count business days, hours, minutes and seconds count
+// working time. Years and months have no working time meaning.
+// Добавляет ISO 8601 длительность рабочего времени к start
+func AddWorkingDuration(cal *calendar.Calendar, start time.Time, durationStr string) (time.Time, error) {
+	duration, err := NewISO8601DurationParser().ParseCalendarDuration(durationStr)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if duration.Years != 0 || duration.Months != 0 {
+		return time.Time{}, fmt.Errorf("duration %s: years and months are not supported with business calendar %s",
+			durationStr, cal.Name())
+	}
+
+	due, err := cal.AddBusinessDays(start, duration.Days)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return cal.AddWorkingTime(due, duration.Clock)
+}
+
+// timerCalendar returns business calendar of timer, nil when timer has none
+// Возвращает бизнес-календарь таймера, nil если не задан
+func timerCalendar(variables map[string]interface{}) (*calendar.Calendar, error) {
+	name, ok := variables[timerVarCalendar].(string)
+	if !ok || name == "" {
+		return nil, nil
+	}
+	return calendar.Get(name)
+}
+
+// shiftToWorkingTime moves cycle occurrence of calendar timer to next working time
+// Переносит срабатывание цикла таймера с календарем на следующее рабочее время
+func shiftToWorkingTime(variables map[string]interface{}, due time.Time) (time.Time, error) {
+	cal, err := timerCalendar(variables)
+	if err != nil || cal == nil {
+		return due, err
+	}
+	return cal.NextWorkingTime(due)
+}
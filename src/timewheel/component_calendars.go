@@ -0,0 +1,142 @@
+/*
+This file is part of the AtomBPMN (R) project.
+Copyright (c) 2025 Matreska Market LLC (ООО «Matreska Market»).
+Authors: Matreska Team.
+
+This project is dual-licensed under AGPL-3.0 and AtomBPMN Commercial License.
+*/
+
+package timewheel
+
+import (
+	"fmt"
+
+	"atom-engine/src/core/calendar"
+	"atom-engine/src/core/clock"
+	"atom-engine/src/core/logger"
+	"atom-engine/src/core/models"
+)
+
+// LoadCalendars registers calendars from configuration and then calendars
+// created through API. Stored calendar cannot shadow configured one.
+// Регистрирует календари из конфигурации, затем созданные через API
+func (c *Component) LoadCalendars(configured []*models.BusinessCalendar) error {
+	for _, def := range configured {
+		def.Source = models.CalendarSourceConfig
+		compiled, err := calendar.Compile(def)
+		if err != nil {
+			return err
+		}
+		calendar.Register(compiled)
+	}
+
+	if c.storage == nil {
+		return nil
+	}
+
+	stored, err := c.storage.LoadAllBusinessCalendars()
+	if err != nil {
+		return fmt.Errorf("failed to load business calendars: %w", err)
+	}
+
+	for _, def := range stored {
+		if existing, err := calendar.Get(def.Name); err == nil &&
+			existing.Definition().Source == models.CalendarSourceConfig {
+			logger.Warn("Stored business calendar is shadowed by configuration",
+				logger.String("calendar", def.Name))
+			continue
+		}
+
+		compiled, err := calendar.Compile(def)
+		if err != nil {
+			logger.Error("Skipping invalid stored business calendar",
+				logger.String("calendar", def.Name),
+				logger.String("error", err.Error()))
+			continue
+		}
+		calendar.Register(compiled)
+	}
+
+	logger.Info("Business calendars loaded",
+		logger.Int("configured", len(configured)),
+		logger.Int("stored", len(stored)))
+	return nil
+}
+
+// PutCalendar creates or replaces business calendar defined through API
+// Создает или заменяет бизнес-календарь заданный через API
+func (c *Component) PutCalendar(def *models.BusinessCalendar) (*models.BusinessCalendar, error) {
+	if c.storage == nil {
+		return nil, fmt.Errorf("storage not available")
+	}
+
+	now := clock.Now()
+	def.Source = models.CalendarSourceAPI
+	def.CreatedAt = now
+	def.UpdatedAt = now
+
+	if existing, err := calendar.Get(def.Name); err == nil {
+		if existing.Definition().Source == models.CalendarSourceConfig {
+			return nil, fmt.Errorf("%w: %s", ErrCalendarReadOnly, def.Name)
+		}
+		def.CreatedAt = existing.Definition().CreatedAt
+	}
+
+	compiled, err := calendar.Compile(def)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := c.storage.SaveBusinessCalendar(def); err != nil {
+		return nil, fmt.Errorf("failed to save business calendar: %w", err)
+	}
+	calendar.Register(compiled)
+
+	logger.Info("Business calendar saved", logger.String("calendar", def.Name))
+	return def, nil
+}
+
+// GetCalendar returns business calendar definition by name
+// Возвращает определение бизнес-календаря по имени
+func (c *Component) GetCalendar(name string) (*models.BusinessCalendar, error) {
+	compiled, err := calendar.Get(name)
+	if err != nil {
+		return nil, err
+	}
+	return compiled.Definition(), nil
+}
+
+// ListCalendars returns all business calendar definitions
+// Возвращает определения всех бизнес-календарей
+func (c *Component) ListCalendars() []*models.BusinessCalendar {
+	compiled := calendar.List()
+	result := make([]*models.BusinessCalendar, 0, len(compiled))
+	for _, cal := range compiled {
+		result = append(result, cal.Definition())
+	}
+	return result
+}
+
+// DeleteCalendar deletes business calendar defined through API. Timers
+// already scheduled keep their due dates, new ones fail to resolve it.
+// Удаляет бизнес-календарь заданный через API
+func (c *Component) DeleteCalendar(name string) error {
+	existing, err := calendar.Get(name)
+	if err != nil {
+		return err
+	}
+	if existing.Definition().Source == models.CalendarSourceConfig {
+		return fmt.Errorf("%w: %s", ErrCalendarReadOnly, name)
+	}
+	if c.storage == nil {
+		return fmt.Errorf("storage not available")
+	}
+
+	if err := c.storage.DeleteBusinessCalendar(name); err != nil {
+		return fmt.Errorf("failed to delete business calendar: %w", err)
+	}
+	calendar.Unregister(name)
+
+	logger.Info("Business calendar deleted", logger.String("calendar", name))
+	return nil
+}
@@ -12,6 +12,7 @@ import (
 	"encoding/json"
 	"fmt"
 
+	"atom-engine/src/core/clock"
 	"atom-engine/src/core/models"
 	"atom-engine/src/storage"
 )
@@ -31,6 +32,12 @@ type StorageInterface interface {
 	// Операции с токенами (нужны для проверки scope boundary таймеров)
 	LoadToken(tokenID string) (*models.Token, error)
 	LoadTokensByProcessInstance(processInstanceID string) ([]*models.Token, error)
+
+	// Business calendar operations
+	// Операции с бизнес-календарями
+	SaveBusinessCalendar(calendar *models.BusinessCalendar) error
+	LoadAllBusinessCalendars() ([]*models.BusinessCalendar, error)
+	DeleteBusinessCalendar(name string) error
 }
 
 // Component represents timewheel component for core integration
@@ -158,3 +165,29 @@ func (c *Component) GetTimerInfo(timerID string) (level int, remainingSeconds in
 
 	return location.Level, int64(remaining.Seconds()), true
 }
+
+// GetClockStatus returns engine clock mode and time
+// Возвращает режим и время часов движка
+func (c *Component) GetClockStatus() (ClockStatus, error) {
+	if c.manager == nil {
+		return ClockStatus{}, fmt.Errorf("timewheel manager not initialized")
+	}
+	return c.manager.GetClockStatus(), nil
+}
+
+// AdvanceClock moves manual engine clock forward by ISO 8601 duration
+// firing due timers in order. Calendar units are applied from engine time.
+// Продвигает ручные часы движка на ISO 8601 длительность, запуская наступившие таймеры
+func (c *Component) AdvanceClock(duration string) (*ClockAdvanceResult, error) {
+	if c.manager == nil {
+		return nil, fmt.Errorf("timewheel manager not initialized")
+	}
+
+	calendarDuration, err := c.manager.parser.ParseCalendarDuration(duration)
+	if err != nil {
+		return nil, ErrTimerParsingFailed("duration", duration, err)
+	}
+
+	now := clock.Now()
+	return c.manager.AdvanceClock(calendarDuration.AddTo(now, 1).Sub(now))
+}
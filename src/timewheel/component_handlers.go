@@ -12,8 +12,10 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"time"
 
+	"atom-engine/src/core/clock"
+	"atom-engine/src/core/events"
+	"atom-engine/src/core/models"
 	"atom-engine/src/storage"
 )
 
@@ -74,6 +76,7 @@ func (c *Component) handleScheduleTimer(messageJSON string) error {
 		if err := c.storage.SaveTimer(timerRecord); err != nil {
 			return fmt.Errorf("failed to save timer to storage: %w", err)
 		}
+		publishTimerEvent(events.TypeTimerScheduled, timerRecord)
 	}
 
 	return nil
@@ -134,28 +137,39 @@ func (c *Component) handleGetStats() error {
 // timerRequestToRecord converts TimerRequest to storage.TimerRecord
 // Конвертирует TimerRequest в storage.TimerRecord
 func (c *Component) timerRequestToRecord(req *TimerRequest, timerID string) *storage.TimerRecord {
-	now := time.Now()
+	now := clock.Now()
 
 	// Convert ProcessContext to map
 	processContext := make(map[string]interface{})
+	tenantID := models.DefaultTenantID
 	if req.ProcessContext != nil {
+		tenantID = req.ProcessContext.TenantID
 		processContext["process_key"] = req.ProcessContext.ProcessKey
 		processContext["process_version"] = req.ProcessContext.ProcessVersion
 		processContext["process_name"] = req.ProcessContext.ProcessName
 		processContext["component_source"] = req.ProcessContext.ComponentSource
 	}
 
+	// Business calendar is persisted so restore computes working time due date
+	// Бизнес-календарь сохраняется чтобы восстановление учитывало рабочее время
+	var variables map[string]interface{}
+	if req.Calendar != nil && *req.Calendar != "" {
+		variables = map[string]interface{}{timerVarCalendar: *req.Calendar}
+	}
+
 	return &storage.TimerRecord{
 		ID:                timerID,
 		ElementID:         req.ElementID,
 		TokenID:           req.TokenID,
 		ProcessInstanceID: req.ProcessInstanceID,
+		TenantID:          tenantID,
 		TimerType:         string(req.TimerType),
 		ScheduledAt:       now, // Will be updated when actually scheduled
 		TimeDate:          req.TimeDate,
 		TimeDuration:      req.TimeDuration,
 		TimeCycle:         req.TimeCycle,
 		ProcessContext:    processContext,
+		Variables:         variables,
 		CreatedAt:         now,
 		UpdatedAt:         now,
 		State:             "SCHEDULED",
@@ -14,6 +14,8 @@ import (
 	"fmt"
 	"time"
 
+	"atom-engine/src/core/clock"
+	"atom-engine/src/core/events"
 	"atom-engine/src/core/models"
 	"atom-engine/src/storage"
 )
@@ -47,8 +49,10 @@ func (c *Component) RestoreTimers() error {
 
 		// Check if timer is overdue
 		// Проверяем просрочен ли таймер
-		now := time.Now()
-		if dueDate.Before(now) || dueDate.Equal(now) {
+		// Overdue cycle timers go through timewheel so that the cycle continues
+		// Просроченные циклические таймеры проходят через timewheel для продолжения цикла
+		now := clock.Now()
+		if (dueDate.Before(now) || dueDate.Equal(now)) && timerRecord.TimeCycle == nil {
 			// Timer is overdue - fire it immediately
 			// Таймер просрочен - запускаем немедленно
 			if err := c.fireOverdueTimer(timerRecord, dueDate); err == nil {
@@ -61,6 +65,7 @@ func (c *Component) RestoreTimers() error {
 		// Таймер еще валиден - восстанавливаем в timewheel с правильным DueDate
 		timerReq := c.timerRecordToRequest(timerRecord)
 		timerReq.RestoreDueDate = &dueDate // Set calculated DueDate for restoration
+		timerReq.RestoreVariables = c.restoreVariables(timerRecord, dueDate)
 		scheduleMessage := struct {
 			Type    string       `json:"type"`
 			Request TimerRequest `json:"request"`
@@ -98,11 +103,41 @@ func (c *Component) RestoreTimers() error {
 	return nil
 }
 
+// restoreVariables returns timer variables for restoration. Cycle timers
+// restored from their first record get cycle progress computed from schedule time.
+// Возвращает переменные таймера для восстановления, включая ход цикла
+func (c *Component) restoreVariables(record *storage.TimerRecord, dueDate time.Time) map[string]interface{} {
+	variables := make(map[string]interface{}, len(record.Variables))
+	for key, value := range record.Variables {
+		variables[key] = value
+	}
+
+	if record.TimeCycle == nil {
+		return variables
+	}
+	if _, exists := variables["time_cycle"]; !exists {
+		variables["time_cycle"] = *record.TimeCycle
+	}
+	if _, exists := variables[cycleVarAnchor]; !exists {
+		if cycle, err := NewISO8601DurationParser().ParseTimeCycle(*record.TimeCycle); err == nil {
+			anchor, anchorIteration := cycle.Anchor(record.ScheduledAt)
+			variables[cycleVarAnchor] = anchor.Format(time.RFC3339Nano)
+			variables[cycleVarAnchorIteration] = anchorIteration
+			if iteration, _, ok := cycle.Next(0, anchor, anchorIteration, record.ScheduledAt, record.ScheduledAt); ok {
+				variables[cycleVarIteration] = iteration
+			}
+			variables[cycleVarDueDate] = dueDate.Format(time.RFC3339Nano)
+		}
+	}
+
+	return variables
+}
+
 // timerRecordToRequest converts storage.TimerRecord to TimerRequest for restoration
 // Конвертирует storage.TimerRecord в TimerRequest для восстановления
 func (c *Component) timerRecordToRequest(record *storage.TimerRecord) TimerRequest {
 	// Convert ProcessContext back to models format
-	processContext := &models.TimerProcessContext{}
+	processContext := &models.TimerProcessContext{TenantID: record.TenantID}
 	if record.ProcessContext != nil {
 		if pk, ok := record.ProcessContext["process_key"].(string); ok {
 			processContext.ProcessKey = pk
@@ -138,35 +173,37 @@ func (c *Component) timerRecordToRequest(record *storage.TimerRecord) TimerReque
 // calculateOriginalDueDate calculates DueDate from timer record based on original schedule time
 // Вычисляет DueDate из записи таймера на основе оригинального времени планирования
 func (c *Component) calculateOriginalDueDate(record *storage.TimerRecord) (time.Time, error) {
-	parser := NewISO8601DurationParser()
+	return RecordDueDate(record)
+}
 
-	// Use ScheduledAt as base time for calculation
-	// Используем ScheduledAt как базовое время для расчета
-	baseTime := record.ScheduledAt
-
-	if record.TimeDate != nil {
-		// Absolute date timer
-		// Абсолютный таймер даты
-		return parser.ParseDate(*record.TimeDate)
-	} else if record.TimeDuration != nil {
-		// Duration-based timer
-		// Таймер на основе длительности
-		duration, err := parser.ParseDuration(*record.TimeDuration)
-		if err != nil {
-			return time.Time{}, err
-		}
-		return baseTime.Add(duration), nil
-	} else if record.TimeCycle != nil {
-		// Cycle-based timer - get first execution time
-		// Циклический таймер - получаем время первого выполнения
-		_, interval, err := parser.ParseRepeatingInterval(*record.TimeCycle)
-		if err != nil {
-			return time.Time{}, err
+// RecordDueDate returns due date of persisted timer
+// Возвращает время срабатывания сохраненного таймера
+func RecordDueDate(record *storage.TimerRecord) (time.Time, error) {
+	// Repeated cycle timers carry due date of their iteration
+	// Повторные циклические таймеры хранят время срабатывания своей итерации
+	if record.TimeCycle != nil {
+		if dueDate, ok := cycleDueDateFromVariables(record.Variables); ok {
+			return dueDate, nil
 		}
-		return baseTime.Add(interval), nil
 	}
 
-	return time.Time{}, fmt.Errorf("no timer definition found")
+	// Business calendar timers count working time from ScheduledAt
+	// Таймеры с бизнес-календарем считают рабочее время от ScheduledAt
+	cal, err := timerCalendar(record.Variables)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if cal != nil && record.TimeDate == nil && record.TimeDuration != nil {
+		return AddWorkingDuration(cal, record.ScheduledAt, *record.TimeDuration)
+	}
+
+	// Use ScheduledAt as base time for calculation
+	// Используем ScheduledAt как базовое время для расчета
+	dueDate, err := CalculateDueDate(record.TimeDate, record.TimeDuration, record.TimeCycle, record.ScheduledAt)
+	if err != nil || cal == nil {
+		return dueDate, err
+	}
+	return cal.NextWorkingTime(dueDate)
 }
 
 // fireOverdueTimer fires an overdue timer immediately and updates storage
@@ -184,13 +221,13 @@ func (c *Component) fireOverdueTimer(record *storage.TimerRecord, originalDueDat
 		DueDate:           originalDueDate,
 		Variables:         make(map[string]interface{}),
 		CreatedAt:         record.CreatedAt,
-		UpdatedAt:         time.Now(),
+		UpdatedAt:         clock.Now(),
 	}
 
 	// Convert ProcessContext back to models format
 	// Конвертируем ProcessContext обратно в формат models
 	if record.ProcessContext != nil {
-		processContext := &models.TimerProcessContext{}
+		processContext := &models.TimerProcessContext{TenantID: record.TenantID}
 		if pk, ok := record.ProcessContext["process_key"].(string); ok {
 			processContext.ProcessKey = pk
 		}
@@ -215,7 +252,7 @@ func (c *Component) fireOverdueTimer(record *storage.TimerRecord, originalDueDat
 		ProcessInstanceID: timer.ProcessInstanceID,
 		TimerType:         timer.Type,
 		ProcessContext:    timer.ProcessContext,
-		FiredAt:           time.Now(),
+		FiredAt:           clock.Now(),
 		Variables:         timer.Variables,
 	}
 
@@ -237,8 +274,11 @@ func (c *Component) fireOverdueTimer(record *storage.TimerRecord, originalDueDat
 	if c.storage != nil {
 		updatedRecord := *record
 		updatedRecord.State = "FIRED"
-		updatedRecord.UpdatedAt = time.Now()
-		return c.storage.SaveTimer(&updatedRecord)
+		updatedRecord.UpdatedAt = clock.Now()
+		if err := c.storage.SaveTimer(&updatedRecord); err != nil {
+			return err
+		}
+		publishTimerEvent(events.TypeTimerFired, &updatedRecord)
 	}
 
 	return nil
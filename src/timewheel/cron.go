@@ -0,0 +1,403 @@
+/*
+This file is part of the AtomBPMN (R) project.
+Copyright (c) 2025 Matreska Market LLC (ООО «Matreska Market»).
+Authors: Matreska Team.
+
+This project is dual-licensed under AGPL-3.0 and AtomBPMN Commercial License.
+*/
+
+package timewheel
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// cronSearchHorizon bounds search for next cron occurrence
+// Ограничивает поиск следующего срабатывания cron
+const cronSearchHorizon = 5 * 366 * 24 * time.Hour
+
+// cronMacros maps predefined schedules to six-field expressions
+// Предопределенные расписания в виде выражений из шести полей
+var cronMacros = map[string]string{
+	"@yearly":   "0 0 0 1 1 *",
+	"@annually": "0 0 0 1 1 *",
+	"@monthly":  "0 0 0 1 * *",
+	"@weekly":   "0 0 0 * * SUN",
+	"@daily":    "0 0 0 * * *",
+	"@midnight": "0 0 0 * * *",
+	"@hourly":   "0 0 * * * *",
+}
+
+var cronMonthNames = map[string]int{
+	"JAN": 1, "FEB": 2, "MAR": 3, "APR": 4, "MAY": 5, "JUN": 6,
+	"JUL": 7, "AUG": 8, "SEP": 9, "OCT": 10, "NOV": 11, "DEC": 12,
+}
+
+var cronDayNames = map[string]int{
+	"SUN": 0, "MON": 1, "TUE": 2, "WED": 3, "THU": 4, "FRI": 5, "SAT": 6,
+}
+
+// CronExpression is Zeebe (Spring) style cron schedule with six fields:
+// second minute hour day-of-month month day-of-week. Five fields without
+// seconds, @daily style macros and CRON_TZ=<zone> prefix are also accepted.
+// Day fields support L, L-n, nW, LW (day of month) and nL, n#k (day of week);
+// when both day fields are restricted a day must match both.
+// Cron расписание в стиле Zeebe (Spring) из шести полей
+type CronExpression struct {
+	expression string
+	seconds    uint64
+	minutes    uint64
+	hours      uint64
+	months     uint64
+	daysOfMon  []cronDayRule
+	daysOfWeek []cronDayRule
+	location   *time.Location
+}
+
+// cronDayRule matches single day-of-month or day-of-week item
+// Правило совпадения одного элемента дня месяца или недели
+type cronDayRule func(t time.Time) bool
+
+// IsCronExpression reports whether timer cycle is cron rather than ISO8601
+// Проверяет что цикл таймера задан cron выражением, а не ISO8601
+func IsCronExpression(value string) bool {
+	value = strings.TrimSpace(value)
+	return strings.HasPrefix(value, "@") || strings.ContainsAny(value, " \t") ||
+		strings.HasPrefix(strings.ToUpper(value), "CRON_TZ=")
+}
+
+// ParseCronExpression parses cron expression, local times are in location
+// unless CRON_TZ prefix overrides it
+// Парсит cron выражение, время берется в указанной зоне если нет префикса CRON_TZ
+func ParseCronExpression(expression string, location *time.Location) (*CronExpression, error) {
+	value := strings.TrimSpace(expression)
+	if upper := strings.ToUpper(value); strings.HasPrefix(upper, "CRON_TZ=") || strings.HasPrefix(upper, "TZ=") {
+		spec := strings.SplitN(value[strings.Index(value, "=")+1:], " ", 2)
+		if len(spec) != 2 {
+			return nil, fmt.Errorf("cron expression missing after time zone: %s", expression)
+		}
+		zone, err := time.LoadLocation(spec[0])
+		if err != nil {
+			return nil, fmt.Errorf("unknown time zone %s: %w", spec[0], err)
+		}
+		location = zone
+		value = strings.TrimSpace(spec[1])
+	}
+	if location == nil {
+		location = time.Local
+	}
+
+	if macro, exists := cronMacros[strings.ToLower(value)]; exists {
+		value = macro
+	}
+
+	fields := strings.Fields(strings.ToUpper(value))
+	if len(fields) == 5 {
+		fields = append([]string{"0"}, fields...)
+	}
+	if len(fields) != 6 {
+		return nil, fmt.Errorf("cron expression must have 6 fields, got %d: %s", len(fields), expression)
+	}
+
+	c := &CronExpression{expression: strings.TrimSpace(expression), location: location}
+
+	var err error
+	if c.seconds, err = parseCronField(fields[0], 0, 59, nil); err != nil {
+		return nil, fmt.Errorf("invalid seconds field: %w", err)
+	}
+	if c.minutes, err = parseCronField(fields[1], 0, 59, nil); err != nil {
+		return nil, fmt.Errorf("invalid minutes field: %w", err)
+	}
+	if c.hours, err = parseCronField(fields[2], 0, 23, nil); err != nil {
+		return nil, fmt.Errorf("invalid hours field: %w", err)
+	}
+	if c.daysOfMon, err = parseDayOfMonthField(fields[3]); err != nil {
+		return nil, fmt.Errorf("invalid day-of-month field: %w", err)
+	}
+	if c.months, err = parseCronField(fields[4], 1, 12, cronMonthNames); err != nil {
+		return nil, fmt.Errorf("invalid month field: %w", err)
+	}
+	if c.daysOfWeek, err = parseDayOfWeekField(fields[5]); err != nil {
+		return nil, fmt.Errorf("invalid day-of-week field: %w", err)
+	}
+
+	return c, nil
+}
+
+// String returns original expression
+// Возвращает исходное выражение
+func (c *CronExpression) String() string {
+	return c.expression
+}
+
+// Location returns time zone expression is evaluated in
+// Возвращает зону в которой вычисляется выражение
+func (c *CronExpression) Location() *time.Location {
+	return c.location
+}
+
+// Next returns first occurrence strictly after given time. Wall clock times
+// skipped by DST transition do not fire, repeated ones fire once.
+// Возвращает первое срабатывание строго после указанного времени
+func (c *CronExpression) Next(after time.Time) (time.Time, bool) {
+	t := after.In(c.location).Truncate(time.Second).Add(time.Second)
+	limit := t.Add(cronSearchHorizon)
+
+	for t.Before(limit) {
+		year, month, day := t.Date()
+
+		if !hasBit(c.months, int(month)) {
+			t = forward(t, time.Date(year, month+1, 1, 0, 0, 0, 0, c.location))
+			continue
+		}
+		if !c.matchDay(t) {
+			t = forward(t, time.Date(year, month, day+1, 0, 0, 0, 0, c.location))
+			continue
+		}
+		if !hasBit(c.hours, t.Hour()) {
+			// Absolute arithmetic keeps moving forward through DST transitions
+			t = t.Add(time.Duration(60-t.Minute())*time.Minute - time.Duration(t.Second())*time.Second)
+			continue
+		}
+		if !hasBit(c.minutes, t.Minute()) {
+			t = t.Add(time.Duration(60-t.Second()) * time.Second)
+			continue
+		}
+		if !hasBit(c.seconds, t.Second()) {
+			t = t.Add(time.Second)
+			continue
+		}
+
+		return t, true
+	}
+
+	return time.Time{}, false
+}
+
+// matchDay checks both day fields, unrestricted field matches any day
+// Проверяет оба поля дня, неограниченное поле совпадает с любым днем
+func (c *CronExpression) matchDay(t time.Time) bool {
+	return matchAnyRule(c.daysOfMon, t) && matchAnyRule(c.daysOfWeek, t)
+}
+
+// matchAnyRule reports whether any rule matches, empty list matches everything
+// Проверяет совпадение хотя бы одного правила, пустой список совпадает всегда
+func matchAnyRule(rules []cronDayRule, t time.Time) bool {
+	if len(rules) == 0 {
+		return true
+	}
+	for _, rule := range rules {
+		if rule(t) {
+			return true
+		}
+	}
+	return false
+}
+
+// forward returns next unless wall clock normalization moved it backwards
+// Возвращает next, если нормализация времени не сдвинула его назад
+func forward(current, next time.Time) time.Time {
+	if next.After(current) {
+		return next
+	}
+	return current.Add(time.Hour)
+}
+
+// hasBit checks bit in field set
+// Проверяет бит в наборе значений поля
+func hasBit(set uint64, value int) bool {
+	return set&(1<<uint(value)) != 0
+}
+
+// parseCronField parses comma separated values, ranges and steps into bit set
+// Парсит значения, диапазоны и шаги через запятую в набор битов
+func parseCronField(field string, min, max int, names map[string]int) (uint64, error) {
+	var set uint64
+	for _, item := range strings.Split(field, ",") {
+		from, to, step, err := parseCronRange(item, min, max, names)
+		if err != nil {
+			return 0, err
+		}
+		for value := from; value <= to; value += step {
+			set |= 1 << uint(value)
+		}
+	}
+	return set, nil
+}
+
+// parseCronRange parses "*", "?", "n", "a-b" with optional "/step"
+// Парсит "*", "?", "n", "a-b" с необязательным "/шаг"
+func parseCronRange(item string, min, max int, names map[string]int) (from, to, step int, err error) {
+	step = 1
+	if slash := strings.Index(item, "/"); slash >= 0 {
+		if step, err = strconv.Atoi(item[slash+1:]); err != nil || step <= 0 {
+			return 0, 0, 0, fmt.Errorf("invalid step in %q", item)
+		}
+		item = item[:slash]
+		// "n/step" runs from n to the end of the range
+		to = max
+	}
+
+	switch {
+	case item == "*" || item == "?":
+		return min, max, step, nil
+	case strings.Contains(item, "-"):
+		bounds := strings.SplitN(item, "-", 2)
+		if from, err = parseCronValue(bounds[0], names); err != nil {
+			return 0, 0, 0, err
+		}
+		if to, err = parseCronValue(bounds[1], names); err != nil {
+			return 0, 0, 0, err
+		}
+	default:
+		if from, err = parseCronValue(item, names); err != nil {
+			return 0, 0, 0, err
+		}
+		if to == 0 {
+			to = from
+		}
+	}
+
+	if from < min || to > max || from > to {
+		return 0, 0, 0, fmt.Errorf("value out of range %d-%d in %q", min, max, item)
+	}
+	return from, to, step, nil
+}
+
+// parseCronValue parses number or name
+// Парсит число или имя
+func parseCronValue(value string, names map[string]int) (int, error) {
+	if n, exists := names[value]; exists {
+		return n, nil
+	}
+	n, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid value %q", value)
+	}
+	return n, nil
+}
+
+// parseDayOfMonthField parses day-of-month with L, L-n, LW and nW
+// Парсит день месяца с L, L-n, LW и nW
+func parseDayOfMonthField(field string) ([]cronDayRule, error) {
+	if field == "*" || field == "?" {
+		return nil, nil
+	}
+
+	var rules []cronDayRule
+	for _, item := range strings.Split(field, ",") {
+		switch {
+		case item == "L":
+			rules = append(rules, func(t time.Time) bool {
+				return t.Day() == daysInMonth(t.Year(), t.Month())
+			})
+		case strings.HasPrefix(item, "L-"):
+			offset, err := strconv.Atoi(item[2:])
+			if err != nil || offset < 0 || offset > 30 {
+				return nil, fmt.Errorf("invalid offset in %q", item)
+			}
+			rules = append(rules, func(t time.Time) bool {
+				return t.Day() == daysInMonth(t.Year(), t.Month())-offset
+			})
+		case item == "LW":
+			rules = append(rules, func(t time.Time) bool {
+				return t.Day() == nearestWeekday(t.Year(), t.Month(), daysInMonth(t.Year(), t.Month()))
+			})
+		case strings.HasSuffix(item, "W"):
+			day, err := strconv.Atoi(strings.TrimSuffix(item, "W"))
+			if err != nil || day < 1 || day > 31 {
+				return nil, fmt.Errorf("invalid weekday in %q", item)
+			}
+			rules = append(rules, func(t time.Time) bool {
+				target := day
+				if last := daysInMonth(t.Year(), t.Month()); target > last {
+					target = last
+				}
+				return t.Day() == nearestWeekday(t.Year(), t.Month(), target)
+			})
+		default:
+			set, err := parseCronField(item, 1, 31, nil)
+			if err != nil {
+				return nil, err
+			}
+			rules = append(rules, func(t time.Time) bool { return hasBit(set, t.Day()) })
+		}
+	}
+	return rules, nil
+}
+
+// parseDayOfWeekField parses day-of-week with nL and n#k, 0 and 7 are Sunday
+// Парсит день недели с nL и n#k, 0 и 7 - воскресенье
+func parseDayOfWeekField(field string) ([]cronDayRule, error) {
+	if field == "*" || field == "?" {
+		return nil, nil
+	}
+
+	var rules []cronDayRule
+	for _, item := range strings.Split(field, ",") {
+		switch {
+		case strings.Contains(item, "#"):
+			parts := strings.SplitN(item, "#", 2)
+			weekday, err := parseWeekday(parts[0])
+			if err != nil {
+				return nil, err
+			}
+			nth, err := strconv.Atoi(parts[1])
+			if err != nil || nth < 1 || nth > 5 {
+				return nil, fmt.Errorf("invalid occurrence in %q", item)
+			}
+			rules = append(rules, func(t time.Time) bool {
+				return t.Weekday() == weekday && (t.Day()-1)/7+1 == nth
+			})
+		case len(item) > 1 && strings.HasSuffix(item, "L"):
+			weekday, err := parseWeekday(strings.TrimSuffix(item, "L"))
+			if err != nil {
+				return nil, err
+			}
+			rules = append(rules, func(t time.Time) bool {
+				return t.Weekday() == weekday && t.Day()+7 > daysInMonth(t.Year(), t.Month())
+			})
+		default:
+			set, err := parseCronField(item, 0, 7, cronDayNames)
+			if err != nil {
+				return nil, err
+			}
+			if hasBit(set, 7) {
+				set |= 1
+			}
+			rules = append(rules, func(t time.Time) bool { return hasBit(set, int(t.Weekday())) })
+		}
+	}
+	return rules, nil
+}
+
+// parseWeekday parses day-of-week number or name
+// Парсит номер или имя дня недели
+func parseWeekday(value string) (time.Weekday, error) {
+	n, err := parseCronValue(value, cronDayNames)
+	if err != nil || n < 0 || n > 7 {
+		return 0, fmt.Errorf("invalid day of week %q", value)
+	}
+	return time.Weekday(n % 7), nil
+}
+
+// nearestWeekday returns weekday closest to day without leaving the month
+// Возвращает ближайший к дню будний день в пределах месяца
+func nearestWeekday(year int, month time.Month, day int) int {
+	switch time.Date(year, month, day, 0, 0, 0, 0, time.UTC).Weekday() {
+	case time.Saturday:
+		if day == 1 {
+			return day + 2
+		}
+		return day - 1
+	case time.Sunday:
+		if day == daysInMonth(year, month) {
+			return day - 2
+		}
+		return day + 1
+	}
+	return day
+}
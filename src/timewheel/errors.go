@@ -20,6 +20,7 @@ var (
 	ErrInvalidConfig       = fmt.Errorf("invalid timing wheel configuration")
 	ErrWheelNotRunning     = fmt.Errorf("timing wheel is not running")
 	ErrWheelAlreadyRunning = fmt.Errorf("timing wheel is already running")
+	ErrCalendarReadOnly    = fmt.Errorf("business calendar is defined in configuration")
 )
 
 // ErrInvalidTimerRequest creates error for invalid timer request
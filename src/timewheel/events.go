@@ -0,0 +1,50 @@
+/*
+This file is part of the AtomBPMN (R) project.
+Copyright (c) 2025 Matreska Market LLC (ООО «Matreska Market»).
+Authors: Matreska Team.
+
+This project is dual-licensed under AGPL-3.0 and AtomBPMN Commercial License.
+*/
+
+package timewheel
+
+import (
+	"strings"
+	"time"
+
+	"atom-engine/src/core/events"
+	"atom-engine/src/core/models"
+	"atom-engine/src/storage"
+)
+
+// publishTimerEvent publishes timer lifecycle event of persisted timer record
+// Публикует событие жизненного цикла сохраненного таймера
+func publishTimerEvent(eventType string, record *storage.TimerRecord) {
+	processKey, _ := record.ProcessContext["process_key"].(string)
+	event := &events.Event{
+		Type:              eventType,
+		TenantID:          models.NormalizeTenantID(record.TenantID),
+		ProcessKey:        processKey,
+		ProcessInstanceID: record.ProcessInstanceID,
+		ElementID:         record.ElementID,
+		Attributes: map[string]string{
+			"timer_id":   record.ID,
+			"timer_type": record.TimerType,
+			"due_at":     record.ScheduledAt.UTC().Format(time.RFC3339),
+		},
+	}
+
+	// Process definition key is tenant/processID:vN
+	// Ключ определения процесса имеет вид tenant/processID:vN
+	if _, key := models.SplitTenantScopedKey(processKey); key != "" {
+		if i := strings.LastIndex(key, ":v"); i > 0 {
+			key = key[:i]
+		}
+		event.ProcessID = key
+	}
+	if record.TokenID != "" && record.TokenID != record.ProcessInstanceID {
+		event.Attributes["token_id"] = record.TokenID
+	}
+
+	events.Publish(event)
+}
@@ -10,8 +10,10 @@ package timewheel
 
 import (
 	"container/list"
+	"strconv"
 	"time"
 
+	"atom-engine/src/core/metrics"
 	"atom-engine/src/core/models"
 )
 
@@ -25,6 +27,8 @@ func NewTimingWheelLevel(levelID int, tick time.Duration, size int) *TimingWheel
 		currentSlot: 0,
 		slots:       make([]*list.List, size),
 		horizon:     tick * time.Duration(size),
+
+		latenessMetric: metrics.TimerFireLateness.WithLabelValues(strconv.Itoa(levelID)),
 	}
 
 	// Initialize all slots
@@ -36,20 +40,21 @@ func NewTimingWheelLevel(levelID int, tick time.Duration, size int) *TimingWheel
 	return level
 }
 
-// AddTimer adds timer to appropriate slot
-// Добавляет таймер в соответствующий слот
+// AddTimer adds timer to slot processed offset ticks after next tick
+// Добавляет таймер в слот, обрабатываемый через offset тиков после следующего
 func (twl *TimingWheelLevel) AddTimer(
 	timer *models.Timer,
 	handler TimerHandler,
-	delay time.Duration,
+	offset int,
 ) (*TimerAnchor, error) {
 	twl.mu.Lock()
 	defer twl.mu.Unlock()
 
-	// Calculate slot position using relative delay from current position
-	// Вычисляем позицию слота используя относительную задержку от текущей позиции
-	slots := int(delay / twl.tick)
-	slotIndex := (twl.currentSlot + slots) % twl.size
+	if offset < 0 || offset >= twl.size {
+		return nil, ErrTimerTooFar
+	}
+
+	slotIndex := (twl.currentSlot + offset) % twl.size
 	entry := &TimerEntry{
 		Timer:   timer,
 		Handler: handler,
@@ -71,6 +76,31 @@ func (twl *TimingWheelLevel) AddTimer(
 	return anchor, nil
 }
 
+// slotOffset returns offset for AddTimer of timer due after delay, phase is
+// time since last tick of this level. Level 0 picks first slot processed at
+// or after due date, higher levels pick last slot processed before it so timer
+// cascades down in time. Returns false if level cannot hold the delay.
+// Вычисляет смещение слота для задержки с учетом фазы уровня
+func (twl *TimingWheelLevel) slotOffset(delay, phase time.Duration) (int, bool) {
+	var offset int64
+	if twl.levelID == 0 {
+		offset = int64((delay+phase+twl.tick-1)/twl.tick) - 1
+		if offset < 0 {
+			offset = 0
+		}
+	} else {
+		offset = int64((delay+phase)/twl.tick) - 1
+		if offset < 0 {
+			return 0, false
+		}
+	}
+
+	if offset >= int64(twl.size) {
+		return 0, false
+	}
+	return int(offset), true
+}
+
 // RemoveTimer removes timer by anchor
 // Удаляет таймер по якорю
 func (twl *TimingWheelLevel) RemoveTimer(anchor *TimerAnchor) error {
@@ -115,34 +145,29 @@ func (twl *TimingWheelLevel) RemoveTimerBySlotAndID(slot int, timerID string) er
 	return ErrTimerNotFound
 }
 
-// Tick advances level by one tick and returns expired timers
-// Продвигает уровень на один тик и возвращает истекшие таймеры
+// Tick advances level by one tick and returns timers due at now and timers
+// that must cascade to lower levels. Lateness of due timers is recorded.
+// Продвигает уровень на один тик, возвращает наступившие и каскадируемые таймеры
 func (twl *TimingWheelLevel) Tick(now time.Time) ([]*TimerEntry, []*TimerEntry) {
 	twl.mu.Lock()
 	defer twl.mu.Unlock()
 
 	var expiredTimers []*TimerEntry
-	var overflowTimers []*TimerEntry
-
-	// Get current slot
-	// Получаем текущий слот
-	currentSlotList := twl.slots[twl.currentSlot]
+	var cascadeTimers []*TimerEntry
 
 	// Process all timers in current slot
 	// Обрабатываем все таймеры в текущем слоте
+	currentSlotList := twl.slots[twl.currentSlot]
 	for currentSlotList.Len() > 0 {
 		element := currentSlotList.Front()
 		entry := element.Value.(*TimerEntry)
 		currentSlotList.Remove(element)
 
-		// Check if timer should fire now using consistent time
-		// Проверяем должен ли таймер сработать сейчас используя единое время
-		if now.After(entry.Timer.DueDate) || now.Equal(entry.Timer.DueDate) {
+		if !now.Before(entry.Timer.DueDate) {
+			twl.recordLateness(now.Sub(entry.Timer.DueDate))
 			expiredTimers = append(expiredTimers, entry)
 		} else {
-			// Timer needs to be rescheduled to higher level
-			// Таймер нужно перепланировать на более высокий уровень
-			overflowTimers = append(overflowTimers, entry)
+			cascadeTimers = append(cascadeTimers, entry)
 		}
 	}
 
@@ -150,7 +175,34 @@ func (twl *TimingWheelLevel) Tick(now time.Time) ([]*TimerEntry, []*TimerEntry)
 	// Переходим к следующему слоту
 	twl.currentSlot = (twl.currentSlot + 1) % twl.size
 
-	return expiredTimers, overflowTimers
+	return expiredTimers, cascadeTimers
+}
+
+// recordLateness accounts fired timer lateness, caller holds lock
+// Учитывает опоздание сработавшего таймера, вызывающий держит блокировку
+func (twl *TimingWheelLevel) recordLateness(lateness time.Duration) {
+	twl.firedTimers++
+	twl.latenessTotal += lateness
+	twl.latenessMetric.Observe(lateness.Seconds())
+	if lateness > twl.latenessMax {
+		twl.latenessMax = lateness
+	}
+}
+
+// TakeAllTimers removes and returns all timer entries from level
+// Удаляет и возвращает все записи таймеров уровня
+func (twl *TimingWheelLevel) TakeAllTimers() []*TimerEntry {
+	twl.mu.Lock()
+	defer twl.mu.Unlock()
+
+	var allTimers []*TimerEntry
+	for _, slot := range twl.slots {
+		for slot.Len() > 0 {
+			element := slot.Front()
+			allTimers = append(allTimers, slot.Remove(element).(*TimerEntry))
+		}
+	}
+	return allTimers
 }
 
 // GetCurrentSlot returns current slot index
@@ -203,12 +255,23 @@ func (twl *TimingWheelLevel) GetStats() LevelStats {
 	twl.mu.RLock()
 	defer twl.mu.RUnlock()
 
-	return LevelStats{
+	totalTimers := 0
+	for _, slot := range twl.slots {
+		totalTimers += slot.Len()
+	}
+
+	stats := LevelStats{
 		LevelID:     twl.levelID,
 		Tick:        twl.tick,
 		Size:        twl.size,
 		CurrentSlot: twl.currentSlot,
-		TotalTimers: twl.GetTotalTimers(),
+		TotalTimers: totalTimers,
 		Horizon:     twl.horizon,
+		FiredTimers: twl.firedTimers,
+		MaxLateness: twl.latenessMax,
+	}
+	if twl.firedTimers > 0 {
+		stats.AvgLateness = twl.latenessTotal / time.Duration(twl.firedTimers)
 	}
+	return stats
 }
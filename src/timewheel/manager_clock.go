@@ -0,0 +1,108 @@
+/*
+This file is part of the AtomBPMN (R) project.
+Copyright (c) 2025 Matreska Market LLC (ООО «Matreska Market»).
+Authors: Matreska Team.
+
+This project is dual-licensed under AGPL-3.0 and AtomBPMN Commercial License.
+*/
+
+package timewheel
+
+import (
+	"fmt"
+	"time"
+
+	"atom-engine/src/core/clock"
+	"atom-engine/src/core/logger"
+)
+
+// Settle timings use wall time: they wait for engine goroutines, not for engine time
+// Тайминги ожидания по реальному времени: ждем горутины движка, а не время движка
+const (
+	advanceSettleQuiet   = 25 * time.Millisecond
+	advanceSettleTimeout = 5 * time.Second
+)
+
+// ClockStatus describes engine clock
+// Описывает часы движка
+type ClockStatus struct {
+	Mode string    `json:"mode"`
+	Now  time.Time `json:"now"`
+}
+
+// ClockAdvanceResult describes manual clock advance
+// Описывает продвижение ручных часов
+type ClockAdvanceResult struct {
+	From        time.Time `json:"from"`
+	To          time.Time `json:"to"`
+	FiredTimers int       `json:"fired_timers"`
+}
+
+// GetClockStatus returns engine clock mode and time
+// Возвращает режим и время часов движка
+func (m *Manager) GetClockStatus() ClockStatus {
+	if manual, err := clock.ManualClock(); err == nil {
+		return ClockStatus{Mode: clock.ModeManual, Now: manual.Now()}
+	}
+	return ClockStatus{Mode: clock.ModeReal, Now: clock.Now()}
+}
+
+// AdvanceClock moves manual engine clock forward. Every timer due within the
+// window fires in due date order with clock set to its due date, and engine
+// is given time to process it, so timers scheduled by that processing (next
+// cycle iteration, timers of following activities) fire within same advance.
+// Продвигает ручные часы движка, по порядку запуская все наступившие таймеры
+func (m *Manager) AdvanceClock(duration time.Duration) (*ClockAdvanceResult, error) {
+	manual, err := clock.ManualClock()
+	if err != nil {
+		return nil, err
+	}
+	if duration < 0 {
+		return nil, fmt.Errorf("cannot move clock backwards by %s", duration)
+	}
+
+	m.advanceMu.Lock()
+	defer m.advanceMu.Unlock()
+
+	result := &ClockAdvanceResult{From: manual.Now()}
+	result.To = result.From.Add(duration)
+
+	for {
+		entry := m.wheel.PopDueTimer(result.To)
+		if entry == nil {
+			break
+		}
+
+		manual.Set(entry.Timer.DueDate)
+		m.wheel.fireTimer(entry.Timer, entry.Handler)
+		result.FiredTimers++
+		m.settle()
+	}
+
+	manual.Set(result.To)
+	m.wheel.Reposition()
+
+	logger.Info("Engine clock advanced",
+		logger.String("from", result.From.Format(time.RFC3339)),
+		logger.String("to", result.To.Format(time.RFC3339)),
+		logger.Int("fired_timers", result.FiredTimers))
+
+	return result, nil
+}
+
+// settle waits until engine has consumed timer responses and queued timer requests.
+// Fired timer is processed asynchronously and may schedule new timers.
+// Ждет пока движок обработает ответы таймеров и очередь запросов таймеров
+func (m *Manager) settle() {
+	deadline := time.Now().Add(advanceSettleTimeout)
+	for {
+		time.Sleep(advanceSettleQuiet)
+		if len(m.requestChannel) == 0 && len(m.responseChannel) == 0 {
+			return
+		}
+		if time.Now().After(deadline) {
+			logger.Warn("Engine did not settle after timer fire during clock advance")
+			return
+		}
+	}
+}
@@ -10,8 +10,10 @@ package timewheel
 
 import (
 	"fmt"
+	"sync"
 	"time"
 
+	"atom-engine/src/core/events"
 	"atom-engine/src/core/logger"
 )
 
@@ -25,6 +27,7 @@ type Manager struct {
 	running         bool
 	stopChan        chan struct{}
 	storage         StorageInterface // For updating timer status
+	advanceMu       sync.Mutex       // Serializes manual clock advances
 }
 
 // NewManager creates new timing wheel manager
@@ -116,29 +119,35 @@ func (m *Manager) CancelTimer(timerID string) error {
 		// Just mark as cancelled in storage if available
 		// Таймер не в wheel (возможно уже сработал или не запланирован)
 		// Просто помечаем как отмененный в storage если доступен
-		if m.storage != nil {
-			timerRecord, loadErr := m.storage.LoadTimer(timerID)
-			if loadErr == nil {
-				timerRecord.State = "CANCELLED"
-				_ = m.storage.UpdateTimer(timerRecord)
-			}
-		}
+		m.markTimerCancelled(timerID)
 		return nil // Don't fail the operation
 	}
 
 	// Also mark as cancelled in storage
 	// Также помечаем как отмененный в storage
-	if m.storage != nil {
-		timerRecord, loadErr := m.storage.LoadTimer(timerID)
-		if loadErr == nil {
-			timerRecord.State = "CANCELLED"
-			_ = m.storage.UpdateTimer(timerRecord)
-		}
-	}
+	m.markTimerCancelled(timerID)
 
 	return nil
 }
 
+// markTimerCancelled marks stored timer as cancelled, cancellation of scheduled
+// timer is published
+// Помечает сохраненный таймер отмененным
+func (m *Manager) markTimerCancelled(timerID string) {
+	if m.storage == nil {
+		return
+	}
+	timerRecord, err := m.storage.LoadTimer(timerID)
+	if err != nil {
+		return
+	}
+	scheduled := timerRecord.State == "SCHEDULED"
+	timerRecord.State = "CANCELLED"
+	if err := m.storage.UpdateTimer(timerRecord); err == nil && scheduled {
+		publishTimerEvent(events.TypeTimerCanceled, timerRecord)
+	}
+}
+
 // processRequests processes incoming JSON requests
 // Обрабатывает входящие JSON запросы
 func (m *Manager) processRequests() {
@@ -12,6 +12,8 @@ import (
 	"context"
 	"time"
 
+	"atom-engine/src/core/clock"
+	"atom-engine/src/core/events"
 	"atom-engine/src/core/logger"
 	"atom-engine/src/core/models"
 	"atom-engine/src/storage"
@@ -47,7 +49,8 @@ func (m *Manager) handleTimerFired(ctx context.Context, timer *models.Timer) err
 		// Update only the state and timestamp, preserve everything else
 		// Обновляем только статус и timestamp, сохраняем все остальное
 		existingRecord.State = "FIRED"
-		existingRecord.UpdatedAt = time.Now()
+		existingRecord.UpdatedAt = clock.Now()
+		refreshProcessContext(timer, existingRecord)
 
 		err = m.storage.SaveTimer(existingRecord)
 		if err != nil {
@@ -56,6 +59,8 @@ func (m *Manager) handleTimerFired(ctx context.Context, timer *models.Timer) err
 			logger.Error("Failed to update timer status in storage",
 				logger.String("timer_id", timer.ID),
 				logger.String("error", err.Error()))
+		} else {
+			publishTimerEvent(events.TypeTimerFired, existingRecord)
 		}
 	}
 
@@ -76,22 +81,60 @@ func (m *Manager) handleTimerFired(ctx context.Context, timer *models.Timer) err
 	return nil
 }
 
-// handleCycleTimer handles cycle timer rescheduling
-// Обрабатывает переplanирование циклического таймера
+// refreshProcessContext takes process of stored timer, which instance
+// migration rewrites, so cycle repeats follow migrated instance
+func refreshProcessContext(timer *models.Timer, record *storage.TimerRecord) {
+	if timer.ProcessContext == nil || record.ProcessContext == nil {
+		return
+	}
+	processContext := *timer.ProcessContext
+	if processKey, ok := record.ProcessContext["process_key"].(string); ok {
+		processContext.ProcessKey = processKey
+	}
+	switch version := record.ProcessContext["process_version"].(type) {
+	case float64:
+		processContext.ProcessVersion = int(version)
+	case int:
+		processContext.ProcessVersion = version
+	}
+	timer.ProcessContext = &processContext
+}
+
+// handleCycleTimer handles cycle timer rescheduling. Next due date is derived
+// from cycle anchor rather than fire time, so late fires do not drift the schedule.
+// Обрабатывает переplanирование циклического таймера без накопления опозданий
 func (m *Manager) handleCycleTimer(timer *models.Timer, cycleStr string) error {
-	repeatCount, ok := timer.Variables["repeat_count"].(int)
-	if !ok {
-		return nil // Not a cycle timer
+	cycle, err := m.parser.ParseTimeCycle(cycleStr)
+	if err != nil {
+		return err
 	}
 
-	currentIteration, ok := timer.Variables["current_iteration"].(int)
+	currentIteration, ok := intVariable(timer.Variables, cycleVarIteration)
 	if !ok {
 		currentIteration = 1
 	}
 
+	// Timers scheduled before anchors were recorded repeat from their own due date
+	// Таймеры без сохраненного якоря повторяются от своего времени срабатывания
+	anchor, anchorIteration := timer.DueDate, currentIteration
+	if value, ok := timer.Variables[cycleVarAnchor].(string); ok {
+		if parsed, err := time.Parse(time.RFC3339Nano, value); err == nil {
+			anchor = parsed
+			anchorIteration, _ = intVariable(timer.Variables, cycleVarAnchorIteration)
+		}
+	}
+
 	// Check if we need to reschedule
 	// Проверяем нужно ли переplanировать
-	if repeatCount == -1 || currentIteration < repeatCount {
+	nextIteration, dueDate, ok := cycle.Next(currentIteration, anchor, anchorIteration, timer.DueDate, clock.Now())
+	if ok {
+		if dueDate, err = shiftToWorkingTime(timer.Variables, dueDate); err != nil {
+			logger.Error("Failed to apply business calendar to cycle timer",
+				logger.String("timer_id", timer.ID),
+				logger.String("error", err.Error()))
+			return err
+		}
+
 		// For BOUNDARY timers, check if parent scope is still active
 		// Для BOUNDARY таймеров проверяем активен ли еще родительский scope
 		if timer.Type == models.TimerTypeBoundary {
@@ -103,34 +146,25 @@ func (m *Manager) handleCycleTimer(timer *models.Timer, cycleStr string) error {
 			}
 		}
 
-		// Parse interval
-		// Парсим интервал
-		_, interval, err := m.parser.ParseRepeatingInterval(cycleStr)
-		if err != nil {
-			return err
-		}
-
 		// Create new timer for next iteration
 		// Создаем новый таймер для следующей итерации
 		nextTimer := *timer
 		nextTimer.ID = models.GenerateID()
-		nextTimer.DueDate = time.Now().Add(interval)
+		nextTimer.DueDate = dueDate
 		nextTimer.State = models.TimerStateScheduled
-		nextTimer.CreatedAt = time.Now()
-		nextTimer.UpdatedAt = time.Now()
-
-		// Ensure Variables is initialized before assignment
-		// Убеждаемся что Variables инициализирован перед присваиванием
-		if nextTimer.Variables == nil {
-			nextTimer.Variables = make(map[string]interface{})
-			// Copy original variables if they existed
-			if timer.Variables != nil {
-				for k, v := range timer.Variables {
-					nextTimer.Variables[k] = v
-				}
-			}
+		nextTimer.CreatedAt = clock.Now()
+		nextTimer.UpdatedAt = clock.Now()
+
+		// Copy variables so fired timer keeps its own iteration
+		// Копируем переменные чтобы сработавший таймер сохранил свою итерацию
+		nextTimer.Variables = make(map[string]interface{}, len(timer.Variables))
+		for k, v := range timer.Variables {
+			nextTimer.Variables[k] = v
 		}
-		nextTimer.Variables["current_iteration"] = currentIteration + 1
+		nextTimer.Variables[cycleVarIteration] = nextIteration
+		nextTimer.Variables[cycleVarAnchor] = anchor.Format(time.RFC3339Nano)
+		nextTimer.Variables[cycleVarAnchorIteration] = anchorIteration
+		nextTimer.Variables[cycleVarDueDate] = dueDate.Format(time.RFC3339Nano)
 
 		// Clear anchor from previous timer
 		// Очищаем якорь от предыдущего таймера
@@ -161,6 +195,7 @@ func (m *Manager) handleCycleTimer(timer *models.Timer, cycleStr string) error {
 
 			// Set process context
 			if nextTimer.ProcessContext != nil {
+				timerRecord.TenantID = nextTimer.ProcessContext.TenantID
 				timerRecord.ProcessContext = map[string]interface{}{
 					"process_key":      nextTimer.ProcessContext.ProcessKey,
 					"process_version":  nextTimer.ProcessContext.ProcessVersion,
@@ -172,12 +207,13 @@ func (m *Manager) handleCycleTimer(timer *models.Timer, cycleStr string) error {
 			if err := m.storage.SaveTimer(timerRecord); err != nil {
 				logger.Error("Failed to save repeat timer to storage",
 					logger.String("timer_id", nextTimer.ID),
-					logger.Int("iteration", currentIteration+1),
+					logger.Int("iteration", nextIteration),
 					logger.String("error", err.Error()))
 			} else {
+				publishTimerEvent(events.TypeTimerScheduled, timerRecord)
 				logger.Debug("Repeat timer saved to storage",
 					logger.String("timer_id", nextTimer.ID),
-					logger.Int("iteration", currentIteration+1))
+					logger.Int("iteration", nextIteration))
 			}
 		}
 
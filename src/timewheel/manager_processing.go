@@ -14,6 +14,7 @@ import (
 	"fmt"
 	"time"
 
+	"atom-engine/src/core/clock"
 	"atom-engine/src/core/models"
 )
 
@@ -44,6 +45,10 @@ func (m *Manager) ScheduleTimerRequest(ctx context.Context, req TimerRequest) (s
 		// Use existing ID for restoration
 		// Используем существующий ID для восстановления
 		timerID = *req.RestoreTimerID
+	} else if req.TimerID != nil && *req.TimerID != "" {
+		// Use ID assigned by timer owner
+		// Используем ID назначенный владельцем таймера
+		timerID = *req.TimerID
 	} else {
 		// Generate new ID for new timer
 		// Генерируем новый ID для нового таймера
@@ -59,8 +64,14 @@ func (m *Manager) ScheduleTimerRequest(ctx context.Context, req TimerRequest) (s
 		State:             models.TimerStateScheduled,
 		Variables:         make(map[string]interface{}),
 		ProcessContext:    req.ProcessContext,
-		CreatedAt:         time.Now(),
-		UpdatedAt:         time.Now(),
+		CreatedAt:         clock.Now(),
+		UpdatedAt:         clock.Now(),
+	}
+
+	// Business calendar is kept in variables so cycles and restores reuse it
+	// Бизнес-календарь хранится в переменных для циклов и восстановления
+	if req.Calendar != nil && *req.Calendar != "" {
+		timer.Variables[timerVarCalendar] = *req.Calendar
 	}
 
 	// Process timer definition
@@ -70,6 +81,9 @@ func (m *Manager) ScheduleTimerRequest(ctx context.Context, req TimerRequest) (s
 		// Use provided DueDate for restoration - don't recalculate
 		// Используем предоставленный DueDate для восстановления - не пересчитываем
 		timer.DueDate = *req.RestoreDueDate
+		for key, value := range req.RestoreVariables {
+			timer.Variables[key] = value
+		}
 	} else if req.TimeDate != nil {
 		err = m.processTimeDate(timer, *req.TimeDate)
 	} else if req.TimeDuration != nil {
@@ -142,6 +156,18 @@ func (m *Manager) processTimeDate(timer *models.Timer, dateStr string) error {
 		return err
 	}
 
+	// Date outside working time moves to next working time
+	// Дата вне рабочего времени переносится на следующее рабочее время
+	cal, err := timerCalendar(timer.Variables)
+	if err != nil {
+		return err
+	}
+	if cal != nil {
+		if dueDate, err = cal.NextWorkingTime(dueDate); err != nil {
+			return err
+		}
+	}
+
 	timer.DueDate = dueDate
 
 	// Ensure Variables is initialized before assignment
@@ -156,7 +182,7 @@ func (m *Manager) processTimeDate(timer *models.Timer, dateStr string) error {
 // processTimeDuration processes duration-based timer
 // Обрабатывает таймер на основе длительности
 func (m *Manager) processTimeDuration(timer *models.Timer, durationStr string, baseTime *time.Time) error {
-	duration, err := m.parser.ParseDuration(durationStr)
+	duration, err := m.parser.ParseCalendarDuration(durationStr)
 	if err != nil {
 		return err
 	}
@@ -167,10 +193,22 @@ func (m *Manager) processTimeDuration(timer *models.Timer, durationStr string, b
 	if baseTime != nil {
 		startTime = *baseTime
 	} else {
-		startTime = time.Now()
+		startTime = clock.Now()
 	}
 
-	timer.DueDate = startTime.Add(duration)
+	cal, err := timerCalendar(timer.Variables)
+	if err != nil {
+		return err
+	}
+	if cal != nil {
+		// Duration counts working time of business calendar
+		// Длительность считается в рабочем времени бизнес-календаря
+		if timer.DueDate, err = AddWorkingDuration(cal, startTime, durationStr); err != nil {
+			return err
+		}
+	} else {
+		timer.DueDate = duration.AddTo(startTime, 1)
+	}
 
 	// Ensure Variables is initialized before assignment
 	// Убеждаемся что Variables инициализирован перед присваиванием
@@ -184,7 +222,7 @@ func (m *Manager) processTimeDuration(timer *models.Timer, durationStr string, b
 // processTimeCycle processes cycle-based timer
 // Обрабатывает циклический таймер
 func (m *Manager) processTimeCycle(timer *models.Timer, cycleStr string, baseTime *time.Time) error {
-	repeatCount, interval, err := m.parser.ParseRepeatingInterval(cycleStr)
+	cycle, err := m.parser.ParseTimeCycle(cycleStr)
 	if err != nil {
 		return err
 	}
@@ -195,12 +233,20 @@ func (m *Manager) processTimeCycle(timer *models.Timer, cycleStr string, baseTim
 	if baseTime != nil {
 		startTime = *baseTime
 	} else {
-		startTime = time.Now()
+		startTime = clock.Now()
 	}
 
-	// For first execution
-	// Для первого выполнения
-	timer.DueDate = startTime.Add(interval)
+	// For first execution, explicit start in the past catches up to current occurrence
+	// Для первого выполнения, начало в прошлом догоняет текущее срабатывание
+	anchor, anchorIteration := cycle.Anchor(startTime)
+	iteration, dueDate, ok := cycle.Next(0, anchor, anchorIteration, startTime, clock.Now())
+	if !ok {
+		return fmt.Errorf("timer cycle has no occurrences: %s", cycleStr)
+	}
+	if dueDate, err = shiftToWorkingTime(timer.Variables, dueDate); err != nil {
+		return err
+	}
+	timer.DueDate = dueDate
 
 	// Ensure Variables is initialized before assignment
 	// Убеждаемся что Variables инициализирован перед присваиванием
@@ -208,9 +254,16 @@ func (m *Manager) processTimeCycle(timer *models.Timer, cycleStr string, baseTim
 		timer.Variables = make(map[string]interface{})
 	}
 	timer.Variables["time_cycle"] = cycleStr
-	timer.Variables["repeat_count"] = repeatCount
-	timer.Variables["interval"] = interval.String()
-	timer.Variables["current_iteration"] = 1
+	timer.Variables["repeat_count"] = cycle.Repetitions
+	if cycle.Cron != nil {
+		timer.Variables["interval"] = cycle.Cron.String()
+	} else {
+		timer.Variables["interval"] = cycle.Interval.String()
+	}
+	timer.Variables[cycleVarIteration] = iteration
+	timer.Variables[cycleVarAnchor] = anchor.Format(time.RFC3339Nano)
+	timer.Variables[cycleVarAnchorIteration] = anchorIteration
+	timer.Variables[cycleVarDueDate] = dueDate.Format(time.RFC3339Nano)
 
 	return nil
 }
@@ -10,10 +10,10 @@ package timewheel
 
 import (
 	"fmt"
-	"regexp"
-	"strconv"
 	"strings"
 	"time"
+
+	"atom-engine/src/core/duration"
 )
 
 // ISO8601DurationParser parses ISO8601 duration strings
@@ -26,76 +26,31 @@ func NewISO8601DurationParser() *ISO8601DurationParser {
 	return &ISO8601DurationParser{}
 }
 
-// ParseDuration parses ISO8601 duration string like "PT30S", "P1DT2H"
-// Парсит ISO8601 строку длительности типа "PT30S", "P1DT2H"
-func (p *ISO8601DurationParser) ParseDuration(durationStr string) (time.Duration, error) {
-	if durationStr == "" {
-		return 0, fmt.Errorf("empty duration string")
-	}
-
-	// Convert to uppercase for case-insensitive parsing
-	// Преобразуем в верхний регистр для регистронезависимого парсинга
-	durationStr = strings.ToUpper(durationStr)
-
-	// ISO8601 duration regex: P[nY][nM][nD][T[nH][nM][nS]]
-	regex := regexp.MustCompile(`^P(?:(\d+)Y)?(?:(\d+)M)?(?:(\d+)D)?(?:T(?:(\d+)H)?(?:(\d+)M)?(?:(\d+(?:\.\d+)?)S)?)?$`)
-	matches := regex.FindStringSubmatch(durationStr)
-
-	if matches == nil {
-		return 0, fmt.Errorf("invalid ISO8601 duration format: %s", durationStr)
-	}
-
-	var totalDuration time.Duration
-
-	// Years (approximate as 365 days)
-	// Годы (приблизительно 365 дней)
-	if matches[1] != "" {
-		if years, err := strconv.Atoi(matches[1]); err == nil {
-			totalDuration += time.Duration(years) * 365 * 24 * time.Hour
-		}
-	}
-
-	// Months (approximate as 30 days)
-	// Месяцы (приблизительно 30 дней)
-	if matches[2] != "" {
-		if months, err := strconv.Atoi(matches[2]); err == nil {
-			totalDuration += time.Duration(months) * 30 * 24 * time.Hour
-		}
-	}
-
-	// Days
-	// Дни
-	if matches[3] != "" {
-		if days, err := strconv.Atoi(matches[3]); err == nil {
-			totalDuration += time.Duration(days) * 24 * time.Hour
-		}
-	}
+// CalendarDuration is ISO8601 duration keeping calendar and clock parts apart
+// Длительность ISO8601 с раздельными календарной и временной частями
+type CalendarDuration = duration.Calendar
 
-	// Hours
-	// Часы
-	if matches[4] != "" {
-		if hours, err := strconv.Atoi(matches[4]); err == nil {
-			totalDuration += time.Duration(hours) * time.Hour
-		}
-	}
+// ParseCalendarDuration parses ISO8601 duration string like "P1M", "P1DT2H", "P2W"
+// Парсит ISO8601 строку длительности типа "P1M", "P1DT2H", "P2W"
+func (p *ISO8601DurationParser) ParseCalendarDuration(durationStr string) (CalendarDuration, error) {
+	return duration.ParseISO8601(durationStr)
+}
 
-	// Minutes
-	// Минуты
-	if matches[5] != "" {
-		if minutes, err := strconv.Atoi(matches[5]); err == nil {
-			totalDuration += time.Duration(minutes) * time.Minute
-		}
-	}
+// daysInMonth returns number of days in month
+// Возвращает количество дней в месяце
+func daysInMonth(year int, month time.Month) int {
+	return duration.DaysInMonth(year, month)
+}
 
-	// Seconds (can be decimal)
-	// Секунды (могут быть десятичными)
-	if matches[6] != "" {
-		if seconds, err := strconv.ParseFloat(matches[6], 64); err == nil {
-			totalDuration += time.Duration(seconds * float64(time.Second))
-		}
+// ParseDuration parses ISO8601 duration string like "PT30S", "P1DT2H" into fixed
+// length. Years and months are approximated; timers use ParseCalendarDuration.
+// Парсит ISO8601 строку длительности типа "PT30S", "P1DT2H"
+func (p *ISO8601DurationParser) ParseDuration(durationStr string) (time.Duration, error) {
+	d, err := p.ParseCalendarDuration(durationStr)
+	if err != nil {
+		return 0, err
 	}
-
-	return totalDuration, nil
+	return d.Approximate(), nil
 }
 
 // ParseRepeatingInterval parses repeating interval like "R5/PT30S"
@@ -103,71 +58,59 @@ func (p *ISO8601DurationParser) ParseDuration(durationStr string) (time.Duration
 func (p *ISO8601DurationParser) ParseRepeatingInterval(
 	intervalStr string,
 ) (repeatCount int, interval time.Duration, err error) {
-	if intervalStr == "" {
-		return 0, 0, fmt.Errorf("empty interval string")
-	}
-
-	// Convert to uppercase for case-insensitive parsing
-	// Преобразуем в верхний регистр для регистронезависимого парсинга
-	intervalStr = strings.ToUpper(intervalStr)
-
-	// Check if it starts with R
-	// Проверяем начинается ли с R
-	if !strings.HasPrefix(intervalStr, "R") {
-		return 0, 0, fmt.Errorf("repeating interval must start with 'R': %s", intervalStr)
-	}
-
-	// Split by '/'
-	// Разделяем по '/'
-	parts := strings.Split(intervalStr, "/")
-	if len(parts) != 2 {
-		return 0, 0, fmt.Errorf("invalid repeating interval format: %s", intervalStr)
-	}
-
-	// Parse repeat count
-	// Парсим количество повторений
-	repeatStr := strings.TrimPrefix(parts[0], "R")
-	if repeatStr == "" {
-		// Infinite repetition
-		// Бесконечное повторение
-		repeatCount = -1
-	} else {
-		var parseErr error
-		repeatCount, parseErr = strconv.Atoi(repeatStr)
-		if parseErr != nil {
-			return 0, 0, fmt.Errorf("invalid repeat count: %s", repeatStr)
-		}
-	}
-
-	// Parse duration
-	// Парсим длительность
-	interval, err = p.ParseDuration(parts[1])
+	cycle, err := p.ParseTimeCycle(intervalStr)
 	if err != nil {
-		return 0, 0, fmt.Errorf("invalid duration in repeating interval: %w", err)
+		return 0, 0, err
+	}
+	if cycle.Cron != nil {
+		return 0, 0, fmt.Errorf("cron expression has no fixed interval: %s", intervalStr)
 	}
 
-	return repeatCount, interval, nil
+	return cycle.Repetitions, cycle.Interval.Approximate(), nil
 }
 
-// ParseDate parses ISO8601 date string like "2025-12-31T23:59:59Z"
-// Парсит ISO8601 строку даты типа "2025-12-31T23:59:59Z"
+// ParseDate parses ISO8601 date string like "2025-12-31T23:59:59Z".
+// An IANA zone may follow in brackets: "2025-03-30T09:00:00[Europe/Berlin]"
+// is local time in that zone, with an offset the instant is kept and moved to the zone.
+// Парсит ISO8601 строку даты, допускается IANA зона в квадратных скобках
 func (p *ISO8601DurationParser) ParseDate(dateStr string) (time.Time, error) {
+	dateStr = strings.TrimSpace(dateStr)
 	if dateStr == "" {
 		return time.Time{}, fmt.Errorf("empty date string")
 	}
 
-	// Try different ISO8601 formats
-	// Пробуем разные форматы ISO8601
-	formats := []string{
-		time.RFC3339,
-		time.RFC3339Nano,
-		"2006-01-02T15:04:05Z",
-		"2006-01-02T15:04:05",
-		"2006-01-02",
+	if open := strings.Index(dateStr, "["); open > 0 && strings.HasSuffix(dateStr, "]") {
+		zone := dateStr[open+1 : len(dateStr)-1]
+		location, err := time.LoadLocation(zone)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("unknown time zone %s: %w", zone, err)
+		}
+		return parseDateIn(dateStr[:open], location)
 	}
 
-	for _, format := range formats {
+	return parseDateIn(dateStr, nil)
+}
+
+// parseDateIn parses date with explicit offset, or as local time in location
+// (UTC when location is nil)
+// Парсит дату с явным смещением или как локальное время в зоне
+func parseDateIn(dateStr string, location *time.Location) (time.Time, error) {
+	// Try different ISO8601 formats with explicit offset
+	// Пробуем разные форматы ISO8601 с явным смещением
+	for _, format := range []string{time.RFC3339, time.RFC3339Nano} {
 		if t, err := time.Parse(format, dateStr); err == nil {
+			if location != nil {
+				t = t.In(location)
+			}
+			return t, nil
+		}
+	}
+
+	if location == nil {
+		location = time.UTC
+	}
+	for _, format := range []string{"2006-01-02T15:04:05.999999999", "2006-01-02T15:04", "2006-01-02"} {
+		if t, err := time.ParseInLocation(format, dateStr, location); err == nil {
 			return t, nil
 		}
 	}
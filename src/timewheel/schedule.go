@@ -0,0 +1,307 @@
+/*
+This file is part of the AtomBPMN (R) project.
+Copyright (c) 2025 Matreska Market LLC (ООО «Matreska Market»).
+Authors: Matreska Team.
+
+This project is dual-licensed under AGPL-3.0 and AtomBPMN Commercial License.
+*/
+
+package timewheel
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Timer variables describing cycle progress
+// Переменные таймера, описывающие ход цикла
+const (
+	cycleVarAnchor          = "cycle_anchor"
+	cycleVarAnchorIteration = "cycle_anchor_iteration"
+	cycleVarIteration       = "current_iteration"
+	cycleVarDueDate         = "cycle_due_date"
+)
+
+// TimeCycle is parsed BPMN timeCycle: ISO8601 repeating interval
+// R[n][/start]/duration[/end] or cron expression
+// Разобранный BPMN timeCycle: повторяющийся интервал ISO8601 или cron выражение
+type TimeCycle struct {
+	Repetitions int // -1 for unbounded / -1 без ограничения
+	Start       *time.Time
+	Interval    CalendarDuration
+	End         *time.Time
+	Cron        *CronExpression
+	// Location is zone of calendar arithmetic and cron evaluation
+	// Зона для календарной арифметики и вычисления cron
+	Location *time.Location
+}
+
+// ParseTimeCycle parses timer cycle. Supported forms:
+//
+//	R5/PT10S                                    5 repetitions every 10 seconds from now
+//	R/2025-01-31T09:00:00[Europe/Berlin]/P1M    every month from start, first fire at start
+//	R/PT1H/2025-12-31T00:00:00Z                 hourly until end
+//	R/2025-01-01T00:00:00Z/P1D/2025-02-01T00:00:00Z
+//	0 0 9 * * MON-FRI                           cron, see CronExpression
+//
+// TZ=<zone> prefix sets zone for cycles without zoned start.
+// Парсит цикл таймера в формате ISO8601 или cron
+func (p *ISO8601DurationParser) ParseTimeCycle(cycleStr string) (*TimeCycle, error) {
+	value := strings.TrimSpace(cycleStr)
+	if value == "" {
+		return nil, fmt.Errorf("empty interval string")
+	}
+
+	cycle := &TimeCycle{Repetitions: -1, Location: time.Local}
+
+	if strings.HasPrefix(strings.ToUpper(value), "TZ=") {
+		spec := strings.SplitN(value[3:], " ", 2)
+		if len(spec) != 2 {
+			return nil, fmt.Errorf("timer cycle missing after time zone: %s", cycleStr)
+		}
+		location, err := time.LoadLocation(spec[0])
+		if err != nil {
+			return nil, fmt.Errorf("unknown time zone %s: %w", spec[0], err)
+		}
+		cycle.Location = location
+		value = strings.TrimSpace(spec[1])
+	}
+
+	if IsCronExpression(value) {
+		cron, err := ParseCronExpression(value, cycle.Location)
+		if err != nil {
+			return nil, err
+		}
+		cycle.Cron = cron
+		cycle.Location = cron.Location()
+		return cycle, nil
+	}
+
+	// Check if it starts with R
+	// Проверяем начинается ли с R
+	parts := splitInterval(value)
+	if !strings.HasPrefix(strings.ToUpper(parts[0]), "R") {
+		return nil, fmt.Errorf("repeating interval must start with 'R': %s", cycleStr)
+	}
+	if len(parts) < 2 || len(parts) > 4 {
+		return nil, fmt.Errorf("invalid repeating interval format: %s", cycleStr)
+	}
+
+	// Parse repeat count, empty means infinite repetition
+	// Парсим количество повторений, пустое - бесконечное повторение
+	if repeatStr := parts[0][1:]; repeatStr != "" {
+		count, err := strconv.Atoi(repeatStr)
+		if err != nil || count < 0 {
+			return nil, fmt.Errorf("invalid repeat count: %s", repeatStr)
+		}
+		cycle.Repetitions = count
+	}
+
+	// Remaining parts are [start/]duration[/end]
+	// Оставшиеся части: [начало/]длительность[/конец]
+	durationIndex := -1
+	for i, part := range parts[1:] {
+		if strings.HasPrefix(strings.ToUpper(part), "P") {
+			if durationIndex >= 0 {
+				return nil, fmt.Errorf("repeating interval has several durations: %s", cycleStr)
+			}
+			durationIndex = i + 1
+		}
+	}
+	if durationIndex < 0 {
+		return nil, fmt.Errorf("repeating interval has no duration: %s", cycleStr)
+	}
+	if durationIndex > 2 || len(parts)-durationIndex > 2 {
+		return nil, fmt.Errorf("invalid repeating interval format: %s", cycleStr)
+	}
+
+	interval, err := p.ParseCalendarDuration(parts[durationIndex])
+	if err != nil {
+		return nil, fmt.Errorf("invalid duration in repeating interval: %w", err)
+	}
+	if interval.IsZero() {
+		return nil, fmt.Errorf("repeating interval duration must be positive: %s", cycleStr)
+	}
+	cycle.Interval = interval
+
+	if durationIndex == 2 {
+		start, err := p.ParseDate(parts[1])
+		if err != nil {
+			return nil, fmt.Errorf("invalid start in repeating interval: %w", err)
+		}
+		cycle.Start = &start
+		cycle.Location = start.Location()
+	}
+	if durationIndex < len(parts)-1 {
+		end, err := p.ParseDate(parts[durationIndex+1])
+		if err != nil {
+			return nil, fmt.Errorf("invalid end in repeating interval: %w", err)
+		}
+		cycle.End = &end
+	}
+
+	return cycle, nil
+}
+
+// Anchor returns time and iteration number repetitions are counted from:
+// explicit start fires as iteration 1, otherwise first fire is one interval after base
+// Возвращает время и номер итерации от которых отсчитываются повторения
+func (c *TimeCycle) Anchor(base time.Time) (time.Time, int) {
+	if c.Start != nil {
+		return *c.Start, 1
+	}
+	return base.In(c.Location), 0
+}
+
+// Occurrence returns due date of iteration (1-based) and whether it exists.
+// Interval cycles are computed from anchor, not from previous fire, so late
+// fires do not shift the schedule; cron cycles use previous due date.
+// Возвращает время срабатывания итерации и признак ее существования
+func (c *TimeCycle) Occurrence(iteration int, anchor time.Time, anchorIteration int, previous time.Time) (time.Time, bool) {
+	if c.Repetitions >= 0 && iteration > c.Repetitions {
+		return time.Time{}, false
+	}
+
+	var due time.Time
+	if c.Cron != nil {
+		if previous.IsZero() {
+			previous = anchor
+		}
+		next, ok := c.Cron.Next(previous)
+		if !ok {
+			return time.Time{}, false
+		}
+		due = next
+	} else {
+		due = c.Interval.AddTo(anchor.In(c.Location), iteration-anchorIteration)
+	}
+
+	if c.End != nil && due.After(*c.End) {
+		return time.Time{}, false
+	}
+	return due, true
+}
+
+// Next returns next iteration after given one and its due date. Occurrences
+// missed while engine was late or down are collapsed, so at most one overdue
+// fire happens before the cycle is back on schedule.
+// Возвращает следующую итерацию и время срабатывания, пропуская упущенные
+func (c *TimeCycle) Next(
+	iteration int,
+	anchor time.Time,
+	anchorIteration int,
+	previous, now time.Time,
+) (int, time.Time, bool) {
+	next := iteration + 1
+	due, ok := c.Occurrence(next, anchor, anchorIteration, previous)
+	if !ok {
+		return 0, time.Time{}, false
+	}
+
+	// Jump over missed occurrences of fixed length intervals arithmetically
+	// Арифметически перескакиваем упущенные срабатывания интервалов фиксированной длины
+	if c.Cron == nil && c.Interval.Years == 0 && c.Interval.Months == 0 && c.Interval.Days == 0 {
+		if skip := int(now.Sub(due)/c.Interval.Clock) - 1; skip > 0 {
+			if c.Repetitions >= 0 && next+skip > c.Repetitions {
+				skip = c.Repetitions - next
+			}
+			if skip > 0 {
+				next += skip
+				due = c.Interval.AddTo(anchor.In(c.Location), next-anchorIteration)
+				if c.End != nil && due.After(*c.End) {
+					return 0, time.Time{}, false
+				}
+			}
+		}
+	}
+
+	for due.Before(now) {
+		following, exists := c.Occurrence(next+1, anchor, anchorIteration, due)
+		if !exists || following.After(now) {
+			break
+		}
+		next++
+		due = following
+	}
+
+	return next, due, true
+}
+
+// CalculateDueDate returns first due date of BPMN timer definition relative
+// to base time. Exactly one of definitions is expected to be set.
+// Вычисляет первое время срабатывания BPMN таймера относительно базового времени
+func CalculateDueDate(timeDate, timeDuration, timeCycle *string, base time.Time) (time.Time, error) {
+	parser := NewISO8601DurationParser()
+
+	switch {
+	case timeDate != nil:
+		return parser.ParseDate(*timeDate)
+	case timeDuration != nil:
+		duration, err := parser.ParseCalendarDuration(*timeDuration)
+		if err != nil {
+			return time.Time{}, err
+		}
+		return duration.AddTo(base, 1), nil
+	case timeCycle != nil:
+		cycle, err := parser.ParseTimeCycle(*timeCycle)
+		if err != nil {
+			return time.Time{}, err
+		}
+		anchor, anchorIteration := cycle.Anchor(base)
+		_, due, ok := cycle.Next(0, anchor, anchorIteration, base, base)
+		if !ok {
+			return time.Time{}, fmt.Errorf("timer cycle has no occurrences: %s", *timeCycle)
+		}
+		return due, nil
+	}
+
+	return time.Time{}, fmt.Errorf("no timer definition found")
+}
+
+// splitInterval splits repeating interval by "/" outside of [zone] brackets
+// Разделяет повторяющийся интервал по "/" вне скобок [зона]
+func splitInterval(value string) []string {
+	var parts []string
+	depth, start := 0, 0
+	for i, r := range value {
+		switch r {
+		case '[':
+			depth++
+		case ']':
+			depth--
+		case '/':
+			if depth == 0 {
+				parts = append(parts, value[start:i])
+				start = i + 1
+			}
+		}
+	}
+	return append(parts, value[start:])
+}
+
+// cycleDueDateFromVariables returns due date stored for repeated cycle timer
+// Возвращает сохраненное время срабатывания повторного циклического таймера
+func cycleDueDateFromVariables(variables map[string]interface{}) (time.Time, bool) {
+	if value, ok := variables[cycleVarDueDate].(string); ok {
+		if due, err := time.Parse(time.RFC3339Nano, value); err == nil {
+			return due, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// intVariable reads integer timer variable stored as int or restored from JSON
+// Читает целочисленную переменную таймера, в том числе восстановленную из JSON
+func intVariable(variables map[string]interface{}, key string) (int, bool) {
+	switch value := variables[key].(type) {
+	case int:
+		return value, true
+	case int64:
+		return int(value), true
+	case float64:
+		return int(value), true
+	}
+	return 0, false
+}
@@ -14,6 +14,7 @@ import (
 	"sync"
 	"time"
 
+	"atom-engine/src/core/metrics"
 	"atom-engine/src/core/models"
 )
 
@@ -37,11 +38,20 @@ type TimerRequest struct {
 	TimeDuration *string `json:"time_duration,omitempty"` // "PT30S"
 	TimeCycle    *string `json:"time_cycle,omitempty"`    // "R3/PT20S"
 
+	// Business calendar name, durations count working time and dates
+	// and cycle occurrences move to next working time
+	// Имя бизнес-календаря, длительности считаются в рабочем времени
+	Calendar *string `json:"calendar,omitempty"`
+
 	// Boundary timer specific
 	// Специфично для boundary таймеров
 	AttachedToRef  *string `json:"attached_to_ref,omitempty"`
 	CancelActivity *bool   `json:"cancel_activity,omitempty"`
 
+	// Caller assigned ID for new timer, lets owner cancel or match it later
+	// Назначенный вызывающим ID нового таймера для последующей отмены или сверки
+	TimerID *string `json:"timer_id,omitempty"`
+
 	// Restoration specific - if set, use this ID instead of generating new one
 	// Для восстановления - если установлен, используем этот ID вместо генерации нового
 	RestoreTimerID *string `json:"restore_timer_id,omitempty"`
@@ -50,6 +60,10 @@ type TimerRequest struct {
 	// Для восстановления - если установлен, используем этот DueDate вместо расчета из определений времени
 	RestoreDueDate *time.Time `json:"restore_due_date,omitempty"`
 
+	// Restoration specific - timer variables such as cycle progress
+	// Для восстановления - переменные таймера, например ход цикла
+	RestoreVariables map[string]interface{} `json:"restore_variables,omitempty"`
+
 	// Base time for consistent calculation - if set, use this instead of time.Now()
 	// Базовое время для консистентного расчета - если установлен, используем его вместо time.Now()
 	BaseTime *time.Time `json:"base_time,omitempty"`
@@ -104,10 +118,18 @@ type TimingWheelLevel struct {
 	levelID     int
 	tick        time.Duration
 	size        int
+	ratio       int64 // Level 0 ticks per tick of this level
 	currentSlot int
 	slots       []*list.List
 	horizon     time.Duration
 	mu          sync.RWMutex
+
+	// Fire lateness statistics
+	// Статистика опоздания срабатываний
+	firedTimers    int64
+	latenessTotal  time.Duration
+	latenessMax    time.Duration
+	latenessMetric metrics.Observer
 }
 
 // HierarchicalTimingWheel main timing wheel component
@@ -117,6 +139,8 @@ type HierarchicalTimingWheel struct {
 	timerIndex map[string]*TimerLocation // TimerID -> Location
 	running    bool
 	startTime  time.Time
+	tickCount  int64     // Level 0 ticks since start, drives higher levels
+	lastTick   time.Time // Engine time of last level 0 tick
 	ticker     *time.Ticker
 	stopChan   chan struct{}
 	mu         sync.RWMutex
@@ -142,8 +166,9 @@ type LevelConfig struct {
 	Size int    `json:"size"` // Number of slots
 }
 
-// Config configuration for timing wheel
-// Конфигурация timing wheel
+// Config configuration for timing wheel. Each level tick must be multiple
+// of previous level tick and each level must cover next level tick.
+// Конфигурация timing wheel. Тик уровня кратен тику предыдущего уровня
 type Config struct {
 	Levels []LevelConfig `json:"levels"`
 }
@@ -180,4 +205,7 @@ type LevelStats struct {
 	CurrentSlot int           `json:"current_slot"`
 	TotalTimers int           `json:"total_timers"`
 	Horizon     time.Duration `json:"horizon"`
+	FiredTimers int64         `json:"fired_timers"`
+	AvgLateness time.Duration `json:"avg_lateness"` // Mean delay between due date and fire
+	MaxLateness time.Duration `json:"max_lateness"`
 }
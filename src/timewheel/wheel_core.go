@@ -11,6 +11,9 @@ package timewheel
 import (
 	"fmt"
 	"time"
+
+	"atom-engine/src/core/clock"
+	"atom-engine/src/core/models"
 )
 
 // NewHierarchicalTimingWheel creates new hierarchical timing wheel
@@ -35,8 +38,30 @@ func NewHierarchicalTimingWheel(config Config, responseChannel chan<- string) (*
 		if err != nil {
 			return nil, fmt.Errorf("invalid tick duration %s: %w", levelConfig.Tick, err)
 		}
+		if tick <= 0 || levelConfig.Size <= 0 {
+			return nil, fmt.Errorf("%w: level %d must have positive tick and size", ErrInvalidConfig, i)
+		}
+
+		// Levels tick in lockstep driven by level 0, so ticks must nest and
+		// each level must cover next level tick, otherwise delays fall between levels
+		// Уровни тикают синхронно от уровня 0, поэтому тики должны быть кратны
+		if i > 0 {
+			previous := htw.levels[i-1]
+			if tick%previous.tick != 0 {
+				return nil, fmt.Errorf("%w: level %d tick %s is not multiple of %s",
+					ErrInvalidConfig, i, tick, previous.tick)
+			}
+			if previous.horizon < tick {
+				return nil, fmt.Errorf("%w: level %d horizon %s is shorter than level %d tick %s",
+					ErrInvalidConfig, i-1, previous.horizon, i, tick)
+			}
+		}
 
 		level := NewTimingWheelLevel(i, tick, levelConfig.Size)
+		level.ratio = 1
+		if i > 0 {
+			level.ratio = int64(tick / htw.levels[0].tick)
+		}
 		htw.levels = append(htw.levels, level)
 	}
 
@@ -54,7 +79,8 @@ func (htw *HierarchicalTimingWheel) Start() error {
 	}
 
 	htw.running = true
-	htw.startTime = time.Now()
+	htw.startTime = clock.Now()
+	htw.lastTick = htw.startTime
 
 	// Start with smallest tick interval
 	// Запускаем с наименьшим интервалом тика
@@ -88,30 +114,70 @@ func (htw *HierarchicalTimingWheel) Stop() error {
 	return nil
 }
 
-// findLevelForDelay finds appropriate level for given delay
-// Находит подходящий уровень для заданной задержки
-func (htw *HierarchicalTimingWheel) findLevelForDelay(delay time.Duration) *TimingWheelLevel {
-	// Simple and correct algorithm: select first level that can fit the timer
-	// Простой и правильный алгоритм: выбираем первый уровень, который может вместить таймер
-	for _, level := range htw.levels {
-		if delay <= level.GetHorizon() {
-			return level
-		}
+// ConfigWithResolution returns default configuration with level 0 tick set to
+// resolution. Sub-second resolution adds level covering one second before default levels.
+// Возвращает конфигурацию по умолчанию с тиком уровня 0 равным resolution
+func ConfigWithResolution(resolution time.Duration) (Config, error) {
+	if resolution <= 0 || resolution == time.Second {
+		return DefaultConfig, nil
 	}
-	return nil
+	if resolution > time.Second || time.Second%resolution != 0 {
+		return Config{}, fmt.Errorf("%w: resolution %s must divide one second", ErrInvalidConfig, resolution)
+	}
+
+	levels := make([]LevelConfig, 0, len(DefaultConfig.Levels)+1)
+	levels = append(levels, LevelConfig{Tick: resolution.String(), Size: int(time.Second / resolution)})
+	levels = append(levels, DefaultConfig.Levels...)
+	return Config{Levels: levels}, nil
+}
+
+// levelPhase returns time elapsed since last tick of level at now.
+// Caller holds wheel lock.
+// Возвращает время прошедшее с последнего тика уровня, вызывающий держит блокировку
+func (htw *HierarchicalTimingWheel) levelPhase(level *TimingWheelLevel, now time.Time) time.Duration {
+	base := now.Sub(htw.lastTick)
+	if base < 0 {
+		base = 0
+	}
+	if base >= htw.levels[0].tick {
+		base = htw.levels[0].tick - 1
+	}
+	return base + time.Duration(htw.tickCount%level.ratio)*htw.levels[0].tick
 }
 
-// getLevelIndex returns index of level
-// Возвращает индекс уровня
-func (htw *HierarchicalTimingWheel) getLevelIndex(targetLevel *TimingWheelLevel) int {
-	if targetLevel == nil {
-		return len(htw.levels) - 1
+// placeLocked puts entry into lowest level able to hold it, or fires it when
+// due. Caller holds wheel lock.
+// Помещает запись на нижний подходящий уровень или запускает если срок наступил
+func (htw *HierarchicalTimingWheel) placeLocked(timer *models.Timer, handler TimerHandler, now time.Time) error {
+	delay := timer.DueDate.Sub(now)
+	if delay <= 0 {
+		go htw.fireTimer(timer, handler)
+		return nil
 	}
 
-	for i, level := range htw.levels {
-		if level == targetLevel {
-			return i
+	for _, level := range htw.levels {
+		offset, ok := level.slotOffset(delay, htw.levelPhase(level, now))
+		if !ok {
+			continue
 		}
+
+		anchor, err := level.AddTimer(timer, handler, offset)
+		if err != nil {
+			return err
+		}
+
+		// Store anchor in timer variables for removal
+		// Сохраняем якорь в переменных таймера для удаления
+		if timer.Variables == nil {
+			timer.Variables = make(map[string]interface{})
+		}
+		timer.Variables["_anchor"] = anchor
+		htw.timerIndex[timer.ID] = &TimerLocation{
+			Level: anchor.Level,
+			Slot:  anchor.Slot,
+		}
+		return nil
 	}
-	return len(htw.levels) - 1
+
+	return ErrTimerTooFar
 }
@@ -11,8 +11,8 @@ package timewheel
 import (
 	"context"
 	"encoding/json"
-	"time"
 
+	"atom-engine/src/core/clock"
 	"atom-engine/src/core/models"
 )
 
@@ -31,39 +31,55 @@ func (htw *HierarchicalTimingWheel) run() {
 	}
 }
 
-// processTick processes one tick
-// Обрабатывает один тик
+// processTick advances wheel to engine time one level 0 tick at a time.
+// Higher levels tick when their tick divides elapsed level 0 ticks and
+// cascade timers down. Lag beyond level 0 horizon is handled by repositioning.
+// Продвигает колесо до времени движка по одному тику уровня 0
 func (htw *HierarchicalTimingWheel) processTick() {
+	htw.mu.Lock()
+	defer htw.mu.Unlock()
+
 	if len(htw.levels) == 0 {
 		return
 	}
 
-	now := time.Now()
+	now := clock.Now()
+	tick := htw.levels[0].tick
 
-	// Process L0 (most frequent level)
-	// Обрабатываем L0 (самый частый уровень)
-	expiredTimers, overflowTimers := htw.levels[0].Tick(now)
-
-	// Fire expired timers
-	// Запускаем истекшие таймеры
-	for _, entry := range expiredTimers {
-		go htw.fireTimer(entry.Timer, entry.Handler)
-		htw.removeFromIndex(entry.Timer.ID)
+	// Engine clock jumped or loop stalled, stepping would take too long
+	// Часы движка прыгнули или цикл завис, пошаговое продвижение слишком долгое
+	if now.Sub(htw.lastTick) > htw.levels[0].horizon {
+		htw.repositionLocked(now)
+		return
 	}
 
-	// Reschedule overflow timers
-	// Перепланируем переполненные таймеры
-	for _, entry := range overflowTimers {
-		htw.rescheduleTimer(entry)
-	}
+	for !htw.lastTick.Add(tick).After(now) {
+		htw.tickCount++
+		htw.lastTick = htw.lastTick.Add(tick)
+
+		var cascadeTimers []*TimerEntry
+		for _, level := range htw.levels {
+			if htw.tickCount%level.ratio != 0 {
+				// Ticks nest, higher levels do not tick either
+				// Тики вложены, более высокие уровни тоже не тикают
+				break
+			}
 
-	// Check if higher levels need to cascade
-	// Проверяем нужно ли каскадирование более высоких уровней
-	htw.processCascade()
+			expiredTimers, moved := level.Tick(now)
+			for _, entry := range expiredTimers {
+				delete(htw.timerIndex, entry.Timer.ID)
+				go htw.fireTimer(entry.Timer, entry.Handler)
+			}
+			cascadeTimers = append(cascadeTimers, moved...)
+		}
 
-	// Proactive cascade - move timers to correct levels
-	// Проактивное каскадирование - перемещаем таймеры на правильные уровни
-	htw.proactiveCascade(now)
+		// Cascade timers to lower levels after all levels ticked
+		// Каскадируем таймеры на нижние уровни после тика всех уровней
+		for _, entry := range cascadeTimers {
+			delete(htw.timerIndex, entry.Timer.ID)
+			_ = htw.placeLocked(entry.Timer, entry.Handler, now)
+		}
+	}
 }
 
 // fireTimer fires a timer by sending JSON response
@@ -72,7 +88,7 @@ func (htw *HierarchicalTimingWheel) fireTimer(timer *models.Timer, handler Timer
 	// Update timer state
 	// Обновляем состояние таймера
 	timer.State = models.TimerStateFired
-	timer.UpdatedAt = time.Now()
+	timer.UpdatedAt = clock.Now()
 
 	// Create response
 	// Создаем ответ
@@ -83,7 +99,7 @@ func (htw *HierarchicalTimingWheel) fireTimer(timer *models.Timer, handler Timer
 		ProcessInstanceID: timer.ProcessInstanceID,
 		TimerType:         timer.Type,
 		ProcessContext:    timer.ProcessContext,
-		FiredAt:           time.Now(),
+		FiredAt:           clock.Now(),
 		Variables:         timer.Variables,
 	}
 
@@ -107,97 +123,3 @@ func (htw *HierarchicalTimingWheel) fireTimer(timer *models.Timer, handler Timer
 		handler.HandleTimer(ctx, timer)
 	}
 }
-
-// processCascade processes cascade from higher levels
-// Обрабатывает каскадирование с более высоких уровней
-func (htw *HierarchicalTimingWheel) processCascade() {
-	// Implementation of cascade logic for higher levels
-	// Реализация логики каскадирования для более высоких уровней
-	// This is simplified - full implementation would track ticks per level
-	// Это упрощенная версия - полная реализация отслеживала бы тики на уровень
-}
-
-// proactiveCascade proactively cascades timers based on remaining time
-// Проактивно каскадирует таймеры на основе оставшегося времени
-func (htw *HierarchicalTimingWheel) proactiveCascade(now time.Time) {
-	htw.mu.Lock()
-	defer htw.mu.Unlock()
-
-	// Go through levels from highest to lowest (L1, L2, L3...)
-	// Проходим по уровням от высших к низшим (L1, L2, L3...)
-	for levelIndex := len(htw.levels) - 1; levelIndex >= 1; levelIndex-- {
-		level := htw.levels[levelIndex]
-
-		// Get all timers from current level
-		// Получаем все таймеры с текущего уровня
-		allTimers := level.GetAllTimers()
-		if len(allTimers) == 0 {
-			continue
-		}
-
-		var toReschedule []*TimerEntry
-
-		// Check each timer for cascade necessity
-		// Проверяем каждый таймер на необходимость каскадирования
-		for _, entry := range allTimers {
-			delta := entry.Timer.DueDate.Sub(now)
-
-			if delta <= 0 {
-				// Overdue timer, execute immediately
-				// Просроченный таймер, выполняем немедленно
-				toReschedule = append(toReschedule, entry)
-				continue
-			}
-
-			// Determine correct level for remaining time
-			// Определяем правильный уровень для оставшегося времени
-			correctLevel := htw.findLevelForDelay(delta)
-			correctLevelIndex := htw.getLevelIndex(correctLevel)
-
-			// If timer should be on a lower level
-			// Если таймер должен быть на более низком уровне
-			if correctLevelIndex < levelIndex {
-				toReschedule = append(toReschedule, entry)
-			}
-		}
-
-		// Move timers that need to be cascaded
-		// Перемещаем таймеры, которые нужно каскадировать
-		for _, entry := range toReschedule {
-			// Remove timer from current level
-			// Удаляем таймер с текущего уровня
-			if anchor, ok := entry.Timer.Variables["_anchor"].(*TimerAnchor); ok {
-				level.RemoveTimer(anchor)
-				delete(htw.timerIndex, entry.Timer.ID)
-			}
-
-			// Cascade to correct level
-			// Каскадируем на правильный уровень
-			delta := entry.Timer.DueDate.Sub(now)
-			if delta <= 0 {
-				// Execute immediately
-				// Выполняем немедленно
-				go htw.fireTimer(entry.Timer, entry.Handler)
-			} else {
-				// Add to correct level
-				// Добавляем на правильный уровень
-				targetLevel := htw.findLevelForDelay(delta)
-				if targetLevel != nil {
-					anchor, err := targetLevel.AddTimer(entry.Timer, entry.Handler, delta)
-					if err == nil && anchor != nil {
-						// Ensure Variables is initialized before assignment
-						// Убеждаемся что Variables инициализирован перед присваиванием
-						if entry.Timer.Variables == nil {
-							entry.Timer.Variables = make(map[string]interface{})
-						}
-						entry.Timer.Variables["_anchor"] = anchor
-						htw.timerIndex[entry.Timer.ID] = &TimerLocation{
-							Level: anchor.Level,
-							Slot:  anchor.Slot,
-						}
-					}
-				}
-			}
-		}
-	}
-}
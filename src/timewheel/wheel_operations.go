@@ -12,6 +12,7 @@ import (
 	"fmt"
 	"time"
 
+	"atom-engine/src/core/clock"
 	"atom-engine/src/core/models"
 )
 
@@ -27,45 +28,7 @@ func (htw *HierarchicalTimingWheel) AddTimer(timer *models.Timer, handler TimerH
 		return ErrTimerAlreadyExists
 	}
 
-	// Calculate delay
-	// Вычисляем задержку
-	delay := time.Until(timer.DueDate)
-	if delay <= 0 {
-		// Timer should fire immediately
-		// Таймер должен сработать немедленно
-		go htw.fireTimer(timer, handler)
-		return nil
-	}
-
-	// Find appropriate level
-	// Находим подходящий уровень
-	level := htw.findLevelForDelay(delay)
-	if level == nil {
-		return ErrTimerTooFar
-	}
-
-	// Add to level
-	// Добавляем в уровень
-	anchor, err := level.AddTimer(timer, handler, delay)
-	if err != nil {
-		return err
-	}
-
-	// Store in index
-	// Сохраняем в индексе
-	htw.timerIndex[timer.ID] = &TimerLocation{
-		Level: anchor.Level,
-		Slot:  anchor.Slot,
-	}
-
-	// Store anchor in timer variables for removal
-	// Сохраняем якорь в переменных таймера для удаления
-	if timer.Variables == nil {
-		timer.Variables = make(map[string]interface{})
-	}
-	timer.Variables["_anchor"] = anchor
-
-	return nil
+	return htw.placeLocked(timer, handler, clock.Now())
 }
 
 // RemoveTimer removes timer from timing wheel
@@ -169,7 +132,7 @@ func (htw *HierarchicalTimingWheel) GetRemainingTime(timerID string) (time.Durat
 			if entry, ok := e.Value.(*TimerEntry); ok && entry.Timer.ID == timerID {
 				// Use precise calculation based on DueDate
 				// Используем точный расчет на основе DueDate
-				remainingTime := time.Until(entry.Timer.DueDate)
+				remainingTime := clock.Until(entry.Timer.DueDate)
 				if remainingTime < 0 {
 					return 0, nil // Timer should fire now
 				}
@@ -192,7 +155,7 @@ func (htw *HierarchicalTimingWheel) GetRemainingTime(timerID string) (time.Durat
 	if slotsDiff == 0 {
 		// Same slot means timer fires within current tick
 		// Тот же слот означает что таймер сработает в текущем тике
-		return time.Second, nil
+		return level.tick, nil
 	}
 
 	remainingTime := time.Duration(slotsDiff) * level.tick
@@ -227,20 +190,64 @@ func (htw *HierarchicalTimingWheel) GetStats() Stats {
 	return stats
 }
 
-// removeFromIndex removes timer from index
-// Удаляет таймер из индекса
-func (htw *HierarchicalTimingWheel) removeFromIndex(timerID string) {
+// PopDueTimer removes earliest timer due at or before deadline from wheel.
+// Returns nil when no such timer is left.
+// Удаляет из колеса самый ранний таймер со сроком не позже deadline
+func (htw *HierarchicalTimingWheel) PopDueTimer(deadline time.Time) *TimerEntry {
 	htw.mu.Lock()
 	defer htw.mu.Unlock()
-	delete(htw.timerIndex, timerID)
+
+	for {
+		var earliest *TimerEntry
+		var earliestLevel *TimingWheelLevel
+		for _, level := range htw.levels {
+			for _, entry := range level.GetAllTimers() {
+				if entry.Timer.DueDate.After(deadline) || entry.Anchor == nil {
+					continue
+				}
+				if earliest == nil || entry.Timer.DueDate.Before(earliest.Timer.DueDate) {
+					earliest, earliestLevel = entry, level
+				}
+			}
+		}
+
+		if earliest == nil {
+			return nil
+		}
+
+		// Entry may have been taken by concurrent tick after snapshot
+		// Запись могла быть забрана параллельным тиком после снимка
+		if err := earliestLevel.RemoveTimerBySlotAndID(earliest.Anchor.Slot, earliest.Timer.ID); err != nil {
+			continue
+		}
+
+		delete(htw.timerIndex, earliest.Timer.ID)
+		return earliest
+	}
 }
 
-// rescheduleTimer reschedules timer to higher level
-// Перепланирует таймер на более высокий уровень
-func (htw *HierarchicalTimingWheel) rescheduleTimer(entry *TimerEntry) {
-	delay := time.Until(entry.Timer.DueDate)
-	level := htw.findLevelForDelay(delay)
-	if level != nil {
-		level.AddTimer(entry.Timer, entry.Handler, delay)
+// Reposition puts every timer into slot matching its remaining time.
+// Slots advance with ticks only, so it is required after engine clock jumps.
+// Переставляет таймеры в слоты по оставшемуся времени после скачка часов движка
+func (htw *HierarchicalTimingWheel) Reposition() {
+	htw.mu.Lock()
+	defer htw.mu.Unlock()
+
+	htw.repositionLocked(clock.Now())
+}
+
+// repositionLocked re-places all timers relative to now and restarts
+// tick accounting from now. Caller holds wheel lock.
+// Переставляет все таймеры относительно now, вызывающий держит блокировку
+func (htw *HierarchicalTimingWheel) repositionLocked(now time.Time) {
+	var entries []*TimerEntry
+	for _, level := range htw.levels {
+		entries = append(entries, level.TakeAllTimers()...)
+	}
+
+	htw.lastTick = now
+	for _, entry := range entries {
+		delete(htw.timerIndex, entry.Timer.ID)
+		_ = htw.placeLocked(entry.Timer, entry.Handler, now)
 	}
 }